@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kolah/eugene/internal/codegen"
+	"github.com/kolah/eugene/internal/loader"
+	"github.com/spf13/cobra"
+)
+
+func BundleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundle",
+		Short: "Bundle a spec and the files it references into one document",
+		Long: "Loads the spec as generation does, with its overlays applied, and writes a single\n" +
+			"self-contained document: objects referenced from other files are inlined and\n" +
+			"references within the spec are kept. With --flatten-allof, allOf compositions are\n" +
+			"merged by the rules of the flatten allof-strategy.",
+		Args: cobra.NoArgs,
+		RunE: runBundle,
+	}
+	cmd.Flags().StringP("spec", "s", "", "OpenAPI spec file path, or - to read it from stdin")
+	cmd.Flags().String("spec-format", "", "Format of the spec read from stdin: yaml, json (default: detected)")
+	cmd.Flags().StringSlice("overlay", nil, "OpenAPI Overlay documents applied to the spec, in order")
+	cmd.Flags().StringP("output", "o", "", "Output file, JSON when it ends in .json (default: YAML to stdout)")
+	cmd.Flags().Bool("flatten-allof", false, "Merge allOf compositions into one object schema")
+	_ = cmd.MarkFlagRequired("spec")
+	return cmd
+}
+
+func runBundle(cmd *cobra.Command, args []string) error {
+	path, _ := cmd.Flags().GetString("spec")
+	format, _ := cmd.Flags().GetString("spec-format")
+	overlays, _ := cmd.Flags().GetStringSlice("overlay")
+	output, _ := cmd.Flags().GetString("output")
+	flatten, _ := cmd.Flags().GetBool("flatten-allof")
+	if format != "" && format != "yaml" && format != "json" {
+		return fmt.Errorf("invalid --spec-format %q: must be yaml or json", format)
+	}
+	if format != "" && path != "-" {
+		return fmt.Errorf("--spec-format applies only to a spec read from stdin (--spec -)")
+	}
+
+	var (
+		result *loader.Result
+		err    error
+	)
+	if path == "-" {
+		var data []byte
+		if data, err = readStdinSpec(cmd); err == nil {
+			result, err = loader.LoadData(data, specName(path), format, overlays...)
+		}
+	} else {
+		result, err = loader.LoadFile(path, overlays...)
+	}
+	if err != nil {
+		return fmt.Errorf("loading spec: %w", err)
+	}
+	// Fail on the same spec errors generation does
+	spec, err := loader.Transform(result)
+	if err != nil {
+		return fmt.Errorf("transforming spec: %w", err)
+	}
+	if flatten {
+		if err := codegen.CheckAllOfConflicts(spec); err != nil {
+			return err
+		}
+	}
+
+	data, err := loader.Bundle(result, loader.BundleOptions{
+		FlattenAllOf: flatten,
+		JSON:         strings.EqualFold(filepath.Ext(output), ".json"),
+	})
+	if err != nil {
+		return err
+	}
+	if output == "" || output == "-" {
+		_, err := cmd.OutOrStdout().Write(data)
+		return err
+	}
+	if err := os.WriteFile(output, data, 0644); err != nil {
+		return fmt.Errorf("writing %s: %w", output, err)
+	}
+	infof(cmd, "Written: %s\n", output)
+	return nil
+}
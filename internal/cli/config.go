@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/kolah/eugene/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func ConfigCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect and scaffold eugene configuration",
+	}
+
+	cmd.AddCommand(newConfigValidateCmd(), newConfigInitCmd())
+
+	return cmd
+}
+
+func newConfigValidateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Check eugene.yaml for unknown keys and invalid values",
+		RunE:  runConfigValidate,
+	}
+	cmd.Flags().StringP("config", "c", "", "Config file path (default: eugene.yaml)")
+	return cmd
+}
+
+func runConfigValidate(cmd *cobra.Command, args []string) error {
+	path, _ := cmd.Flags().GetString("config")
+	if path == "" {
+		path = "eugene.yaml"
+	}
+
+	issues, err := config.CheckFile(path)
+	if err != nil {
+		return err
+	}
+	for _, issue := range issues {
+		cmd.PrintErrf("%s: %s\n", path, issue)
+	}
+
+	cfgs, err := config.LoadEach(cmd)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	if len(issues) > 0 {
+		return fmt.Errorf("%s: %d unknown key(s)", path, len(issues))
+	}
+
+	if len(cfgs) > 1 {
+		infof(cmd, "%s: valid (%d profiles)\n", path, len(cfgs))
+	} else {
+		infof(cmd, "%s: valid\n", path)
+	}
+	return nil
+}
+
+func newConfigInitCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "init [path]",
+		Short: "Write a commented eugene.yaml",
+		Args:  cobra.MaximumNArgs(1),
+		RunE:  runConfigInit,
+	}
+	cmd.Flags().Bool("force", false, "Overwrite an existing file")
+	return cmd
+}
+
+func runConfigInit(cmd *cobra.Command, args []string) error {
+	path := "eugene.yaml"
+	if len(args) > 0 {
+		path = args[0]
+	}
+
+	force, _ := cmd.Flags().GetBool("force")
+	if _, err := os.Stat(path); err == nil && !force {
+		return fmt.Errorf("refusing to overwrite %s: use --force", path)
+	}
+
+	if err := os.WriteFile(path, []byte(config.InitTemplate), 0644); err != nil {
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+	infof(cmd, "Written: %s\n", path)
+	return nil
+}
@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/kolah/eugene/internal/coverage"
+	"github.com/spf13/cobra"
+)
+
+func CoverageCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "coverage",
+		Short: "Report which operations the handlers of a Go package implement",
+		Long: "Loads the packages given by --pkg and reports, for each type implementing a\n" +
+			"generated ServerInterface or StrictServerInterface, which operations it implements\n" +
+			"and which still answer 501 Not Implemented through the Unimplemented server or a stub.",
+		Args: cobra.NoArgs,
+		RunE: runCoverage,
+	}
+	cmd.Flags().StringSlice("pkg", []string{"."}, "Packages declaring the handlers, as go build patterns such as ./internal/api")
+	cmd.Flags().String("format", "text", "Report format: text, json")
+	return cmd
+}
+
+func runCoverage(cmd *cobra.Command, args []string) error {
+	format, _ := cmd.Flags().GetString("format")
+	if format != "text" && format != "json" {
+		return fmt.Errorf("invalid --format %q: must be one of text, json", format)
+	}
+	patterns, _ := cmd.Flags().GetStringSlice("pkg")
+
+	report, err := coverage.Analyze(".", patterns...)
+	if err != nil {
+		return err
+	}
+	if format == "json" {
+		implemented, total := report.Implemented()
+		enc := json.NewEncoder(cmd.OutOrStdout())
+		enc.SetIndent("", "  ")
+		return enc.Encode(map[string]any{"handlers": report.Handlers, "implemented": implemented, "total": total})
+	}
+	writeCoverage(cmd.OutOrStdout(), report)
+	return nil
+}
+
+func writeCoverage(w io.Writer, report *coverage.Report) {
+	for _, h := range report.Handlers {
+		implemented, total := h.Implemented()
+		fmt.Fprintf(w, "%s implements %s (%s): %d/%d operations\n", h.Type, h.Interface, h.Position, implemented, total)
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		for _, op := range h.Operations {
+			detail := op.Position
+			if op.Reason != "" {
+				detail = op.Reason
+				if op.Position != "" {
+					detail += " at " + op.Position
+				}
+			}
+			fmt.Fprintf(tw, "  %s\t%s", op.Status, op.Name)
+			if detail != "" {
+				fmt.Fprintf(tw, "\t%s", detail)
+			}
+			fmt.Fprintln(tw)
+		}
+		tw.Flush()
+	}
+	implemented, total := report.Implemented()
+	fmt.Fprintf(w, "Coverage: %d/%d operations implemented (%.1f%%)\n", implemented, total, percent(implemented, total))
+}
+
+func percent(n, total int) float64 {
+	if total == 0 {
+		return 100
+	}
+	return float64(n) * 100 / float64(total)
+}
@@ -12,7 +12,7 @@ func GenerateCommand() *cobra.Command {
 	}
 
 	config.BindCommonFlags(cmd)
-	cmd.AddCommand(NewGoCmd())
+	cmd.AddCommand(NewGoCmd(), NewTSCmd(), NewJVMCmd())
 
 	return cmd
 }
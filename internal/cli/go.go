@@ -2,18 +2,28 @@ package cli
 
 import (
 	"bufio"
+	"bytes"
 	"fmt"
+	"io"
+	"log/slog"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/kolah/eugene/internal/codegen"
 	"github.com/kolah/eugene/internal/config"
 	"github.com/kolah/eugene/internal/loader"
+	"github.com/kolah/eugene/internal/model"
 	"github.com/spf13/cobra"
 )
 
-const eugeneMarker = "Code generated by eugene"
+const (
+	eugeneMarker = "Code generated by eugene"
+	keepMarker   = "//eugene:keep"
+)
 
 // checkCanOverwrite verifies that an existing file was generated by eugene.
 // Returns nil if the file doesn't exist or contains the eugene marker.
@@ -39,6 +49,153 @@ func checkCanOverwrite(path string) error {
 	return fmt.Errorf("refusing to overwrite %s: file exists but was not generated by eugene (missing %q marker)", path, eugeneMarker)
 }
 
+// hasKeepMarker reports whether an existing file opts out of regeneration
+// with the //eugene:keep marker. Kept files are neither overwritten nor pruned.
+func hasKeepMarker(path string) bool {
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	// Check first 5 lines for the marker
+	for i := 0; i < 5 && scanner.Scan(); i++ {
+		if strings.TrimSpace(scanner.Text()) == keepMarker {
+			return true
+		}
+	}
+	return false
+}
+
+// pruneOrphans deletes *.eugene.go files in dir, and in the package
+// directories below it that outputs write to, that were generated by a
+// previous run but are not part of the current outputs.
+func pruneOrphans(dir string, outputs []codegen.Output) ([]string, error) {
+	current := make(map[string]bool, len(outputs))
+	dirs := map[string]bool{dir: true}
+	for _, out := range outputs {
+		path := filepath.Join(dir, out.Filename)
+		current[path] = true
+		dirs[filepath.Dir(path)] = true
+	}
+
+	var matches []string
+	for d := range dirs {
+		found, err := filepath.Glob(filepath.Join(d, "*.eugene.go"))
+		if err != nil {
+			return nil, fmt.Errorf("listing %s: %w", d, err)
+		}
+		matches = append(matches, found...)
+	}
+	sort.Strings(matches)
+
+	var removed []string
+	for _, path := range matches {
+		if current[path] || hasKeepMarker(path) {
+			continue
+		}
+		// Only remove files we can prove eugene wrote
+		if err := checkCanOverwrite(path); err != nil {
+			continue
+		}
+		if err := os.Remove(path); err != nil {
+			return removed, fmt.Errorf("removing %s: %w", path, err)
+		}
+		removed = append(removed, path)
+	}
+	return removed, nil
+}
+
+// checkConflicts fails when hand-written files in the output directory declare
+// identifiers that the files about to be written also declare.
+func checkConflicts(dir, pkg string, outputs []codegen.Output, kept map[string]bool, pruning bool) error {
+	var written []codegen.Output
+	writing := make(map[string]bool)
+	for _, out := range outputs {
+		path := filepath.Join(dir, out.Filename)
+		if kept[path] {
+			continue
+		}
+		written = append(written, out)
+		writing[path] = true
+	}
+
+	matches, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return fmt.Errorf("listing %s: %w", dir, err)
+	}
+	var existing []string
+	for _, path := range matches {
+		if writing[path] {
+			continue
+		}
+		// Orphans about to be pruned won't be around to collide
+		if pruning && strings.HasSuffix(path, ".eugene.go") && !hasKeepMarker(path) && checkCanOverwrite(path) == nil {
+			continue
+		}
+		existing = append(existing, path)
+	}
+
+	conflicts, err := codegen.FindConflicts(pkg, written, existing)
+	if err != nil {
+		return err
+	}
+	if len(conflicts) > 0 {
+		return fmt.Errorf("generated code conflicts with existing declarations in %s:\n%s", dir, codegen.FormatConflicts(conflicts))
+	}
+	return nil
+}
+
+// initModule writes go.mod and doc.go into the output directory unless they
+// already exist, then runs go mod tidy to fill in go.sum.
+func initModule(cmd *cobra.Command, cfg *config.Config, title string, outputs []codegen.Output) error {
+	files, unresolved, err := codegen.ModuleFiles(cfg.Go.OutputOptions.InitModule, cfg.Go.Package, title, outputs)
+	if err != nil {
+		return fmt.Errorf("scaffolding module: %w", err)
+	}
+
+	for _, f := range files {
+		path := filepath.Join(cfg.Go.OutputDir, f.Filename)
+		if _, err := os.Stat(path); err == nil {
+			infof(cmd, "Exists: %s\n", path)
+			continue
+		}
+		if err := os.WriteFile(path, []byte(f.Content), 0644); err != nil {
+			return fmt.Errorf("writing %s: %w", path, err)
+		}
+		infof(cmd, "Written: %s\n", path)
+	}
+
+	tidy := exec.Command("go", "mod", "tidy")
+	tidy.Dir = cfg.Go.OutputDir
+	if output, err := tidy.CombinedOutput(); err != nil {
+		cmd.PrintErrf("Warning: go mod tidy failed, run it manually in %s: %v\n%s", cfg.Go.OutputDir, err, output)
+		for _, path := range unresolved {
+			cmd.PrintErrf("Warning: no pinned version for %s\n", path)
+		}
+	}
+	return nil
+}
+
+// writeLock records the generation metadata next to the generated files.
+func writeLock(cmd *cobra.Command, cfg *config.Config, specData []byte, outputs []codegen.Output) error {
+	lock, err := codegen.NewLock(cmd.Root().Version, cfg, specData, outputs, time.Now())
+	if err != nil {
+		return err
+	}
+	data, err := lock.Marshal()
+	if err != nil {
+		return fmt.Errorf("encoding lock: %w", err)
+	}
+	path := filepath.Join(cfg.Go.OutputDir, codegen.LockFilename)
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+	infof(cmd, "Written: %s\n", path)
+	return nil
+}
+
 func NewGoCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "go",
@@ -46,16 +203,7 @@ func NewGoCmd() *cobra.Command {
 		RunE:  runGoGenerate(""),
 	}
 
-	flags := cmd.PersistentFlags()
-	flags.StringP("output-dir", "o", "", "Output directory for generated Go code")
-	flags.StringP("package", "p", "", "Go package name")
-	flags.StringP("server-framework", "f", "", "Server framework: echo, chi, stdlib")
-	flags.String("enum-strategy", "", "Enum strategy: const, type, struct")
-	flags.String("uuid-package", "", "UUID type: string, google, gofrs")
-	flags.String("nullable-strategy", "", "Nullable strategy: pointer, nullable")
-	flags.String("allof-strategy", "", "AllOf strategy: embed (default), flatten")
-	flags.Bool("enable-yaml-tags", false, "Generate yaml tags")
-	flags.StringSlice("additional-initialisms", nil, "Additional initialisms")
+	bindGoFlags(cmd)
 
 	cmd.AddCommand(
 		newGoTypesCmd(),
@@ -63,12 +211,60 @@ func NewGoCmd() *cobra.Command {
 		newGoStrictServerCmd(),
 		newGoClientCmd(),
 		newGoSpecCmd(),
+		newGoToolsCmd(),
+		newGoEventsCmd(),
+		newGoLoadTestCmd(),
+		newGoMainCmd(),
 		newGoAllCmd(),
 	)
 
 	return cmd
 }
 
+// bindGoFlags binds the Go generation flags, which verify takes as well to
+// regenerate what a flag-driven run generated.
+func bindGoFlags(cmd *cobra.Command) {
+	flags := cmd.PersistentFlags()
+	flags.StringP("output-dir", "o", "", "Output directory for generated Go code")
+	flags.StringP("package", "p", "", "Go package name")
+	flags.StringP("server-framework", "f", "", "Server framework: echo, chi, stdlib")
+	flags.String("enum-strategy", "", "Enum strategy: const, type, struct")
+	flags.String("enum-unknown", "", "Unknown enum values in JSON: passthrough (default), reject, extend")
+	flags.String("unknown-fields", "", "Unknown JSON object members: ignore (default), error, capture")
+	flags.String("uuid-package", "", "UUID type: string, google, gofrs")
+	flags.String("nullable-strategy", "", "Nullable strategy: pointer, nullable")
+	flags.String("allof-strategy", "", "AllOf strategy: embed (default), flatten")
+	flags.Bool("enable-yaml-tags", false, "Generate yaml tags")
+	flags.StringSlice("additional-initialisms", nil, "Additional initialisms")
+	flags.String("init-module", "", "Scaffold go.mod and doc.go for this module path in the output directory")
+	flags.String("local-prefix", "", "Comma-separated import prefixes grouped after third-party imports")
+	flags.Bool("disable-import-grouping", false, "Keep generated imports in a single sorted block")
+	flags.Bool("single-file", false, "Bundle all targets into a single <package>.eugene.go file")
+	flags.Bool("lock-file", false, "Write eugene.lock with tool version and spec, config and file hashes")
+	flags.Bool("prune-orphans", false, "Delete *.eugene.go files no longer produced by the current targets")
+	flags.Bool("client-services", false, "Group client operations into per-tag service fields, e.g. client.Pets.Get")
+	flags.Bool("compact-client", false, "Generate client operations as thin wrappers over a table-driven core, for smaller binaries")
+	flags.Bool("split-by-tag", false, "Generate each tag's operations into a package of its own, sharing types from the output package")
+	flags.Bool("list-new-operations", false, "List the operations the spec adds to or removes from the generated server interfaces, without writing files")
+	flags.Bool("examples", false, "Write example_test.go files showing how to construct the client and register the server")
+	flags.Bool("strict-validation", false, "Generate NewValidatingStrictServer, which rejects requests breaking spec constraints with a typed 400")
+	flags.Bool("example-checks", false, "Generate CheckExamples, middleware reporting responses whose shape differs from the spec's examples")
+	flags.Bool("test-helpers", false, "Write testing.eugene.go with test servers, request builders and response assertions (needs client and a server target)")
+	flags.Bool("client-mock", false, "Write client_mock.eugene.go with ClientInterface and ClientMock, a mock with a function field per operation")
+	flags.Bool("vcr", false, "Write vcr.eugene.go with VCRTransport, which records client responses to golden files and replays them")
+	flags.Bool("health-endpoints", false, "Add /healthz, /readyz and /version routes to the generated server handlers")
+	flags.Bool("prefix-variables", false, "Serve and call every operation under the path of the server URL, with its variables such as /tenants/{tenantId}")
+	flags.Bool("permissions", false, "Write permissions.eugene.go with a constant per OAuth scope and Permissions, the scopes each operation requires")
+	flags.String("sort-fields", "", "Struct field order: spec (default) keeps the declaration order, alpha sorts by property name")
+	flags.Bool("field-provenance", false, "Comment each struct field with the schema it comes from, and whether allOf merged it in")
+	flags.Bool("package-doc", false, "Generate doc.eugene.go documenting the package from the spec's info, servers and tags")
+	flags.Bool("constraint-constants", false, "Write constraints.eugene.go with a constant per length, bound and size constraint of the schemas and a regexp per pattern")
+	flags.Bool("route-drift", false, "Write routes.eugene.go with CheckRoutes, reporting routes an echo or chi router serves outside the spec and operations it does not serve")
+	flags.Bool("cors", false, "Write cors.eugene.go with CORS, middleware answering the CORS preflight requests for the paths of the spec")
+	flags.Int64("max-body", 0, "Most bytes the servers read of a request body, unless x-oink-max-body sets it (default 1048576, -1 for no limit)")
+	flags.Bool("disallow-unknown-fields", false, "Reject JSON request bodies with fields their schema does not declare")
+}
+
 func newGoTypesCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "types",
@@ -80,7 +276,7 @@ func newGoTypesCmd() *cobra.Command {
 func newGoServerCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "server",
-		Short: "Generate Go server code",
+		Short: "Generate Go server code, with the types",
 		RunE:  runGoGenerate("server"),
 	}
 }
@@ -88,7 +284,7 @@ func newGoServerCmd() *cobra.Command {
 func newGoStrictServerCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "strict-server",
-		Short: "Generate Go strict server with typed responses",
+		Short: "Generate Go strict server with typed responses, with the types",
 		RunE:  runGoGenerate("strict-server"),
 	}
 }
@@ -109,6 +305,38 @@ func newGoSpecCmd() *cobra.Command {
 	}
 }
 
+func newGoToolsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "tools",
+		Short: "Generate an LLM tool manifest and CallTool dispatcher, with the client",
+		RunE:  runGoGenerate("tools"),
+	}
+}
+
+func newGoEventsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "events",
+		Short: "Generate publisher and subscriber interfaces from the AsyncAPI document",
+		RunE:  runGoGenerate("events"),
+	}
+}
+
+func newGoLoadTestCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "loadtest",
+		Short: "Generate a load-test scenario for vegeta and k6 from the spec's examples",
+		RunE:  runGoGenerate("loadtest"),
+	}
+}
+
+func newGoMainCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "main",
+		Short: "Scaffold a runnable server binary in cmd/server, with the server unless strict-server is generated",
+		RunE:  runGoGenerate("main"),
+	}
+}
+
 func newGoAllCmd() *cobra.Command {
 	return &cobra.Command{
 		Use:   "all",
@@ -117,74 +345,432 @@ func newGoAllCmd() *cobra.Command {
 	}
 }
 
-func runGoGenerate(target string) func(cmd *cobra.Command, args []string) error {
-	return func(cmd *cobra.Command, args []string) error {
-		var cliTargets []string
-		if target != "" {
-			cliTargets = []string{target}
-		}
-		cfg, err := config.Load(cmd, cliTargets)
+// generateOutputs loads the configured spec and renders all targets.
+func generateOutputs(cmd *cobra.Command, cfg *config.Config) (*loader.Result, *model.Spec, []codegen.Output, error) {
+	if len(cfg.Versions) > 0 {
+		return generateVersionOutputs(cmd, cfg)
+	}
+
+	result, spec, err := loadSpec(cmd, cfg)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	outputs, err := renderOutputs(cmd, cfg, result, spec, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return result, spec, outputs, nil
+}
+
+// renderOutputs renders all targets of a loaded spec, referring to the
+// schemas of shared through its package.
+func renderOutputs(cmd *cobra.Command, cfg *config.Config, result *loader.Result, spec *model.Spec, shared *codegen.SharedTypes) ([]codegen.Output, error) {
+	gen, err := codegen.New(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("creating generator: %w", err)
+	}
+	gen.SetToolVersion(cmd.Root().Version)
+
+	outputs, err := gen.Generate(spec, result.RawData)
+	if err != nil {
+		return nil, fmt.Errorf("generating code: %w", err)
+	}
+	outputs, err = shared.Qualify(outputs)
+	if err != nil {
+		return nil, fmt.Errorf("importing shared types: %w", err)
+	}
+	for _, out := range outputs {
+		slog.Debug("rendered output", "file", out.Filename, "bytes", len(out.Content))
+	}
+
+	if cfg.Go.OutputOptions.SingleFile {
+		outputs, err = codegen.Bundle(cfg.Go.Package, outputs)
 		if err != nil {
-			return err
+			return nil, fmt.Errorf("bundling output: %w", err)
 		}
+	}
+	return outputs, nil
+}
+
+// generation is what one profile, or the shared types package, generates.
+type generation struct {
+	cfg     *config.Config
+	result  *loader.Result
+	spec    *model.Spec
+	outputs []codegen.Output
+}
+
+// generateSharedTypes loads the spec of every profile and renders them
+// together, moving the schemas that two or more profiles declare identically
+// into the shared-types package. The shared package, when anything is
+// shared, comes first; its result holds the specs concatenated in profile
+// order.
+func generateSharedTypes(cmd *cobra.Command, cfgs []*config.Config) ([]generation, error) {
+	if all, _ := cmd.Flags().GetBool("all-profiles"); !all {
+		return nil, fmt.Errorf("shared-types extracts the schemas profiles share; run with --all-profiles")
+	}
 
-		result, err := loader.LoadFile(cfg.Spec)
+	gens := make([]generation, len(cfgs))
+	specs := make([]*model.Spec, len(cfgs))
+	for i, cfg := range cfgs {
+		infof(cmd, "Profile: %s\n", cfg.Profile)
+		result, spec, err := loadSpec(cmd, cfg)
 		if err != nil {
-			return fmt.Errorf("loading spec: %w", err)
+			return nil, fmt.Errorf("profile %s: %w", cfg.Profile, err)
 		}
+		gens[i] = generation{cfg: cfg, result: result, spec: spec}
+		specs[i] = spec
+	}
 
-		for _, w := range result.Warnings {
-			cmd.PrintErrf("Warning: %s\n", w)
-		}
+	sharedCfg := cfgs[0].ForSharedTypes()
+	gen, err := codegen.New(sharedCfg)
+	if err != nil {
+		return nil, fmt.Errorf("creating generator: %w", err)
+	}
+	gen.SetToolVersion(cmd.Root().Version)
+	shared, err := gen.GenerateShared(specs)
+	if err != nil {
+		return nil, fmt.Errorf("generating shared types: %w", err)
+	}
 
-		spec, err := loader.Transform(result)
+	for i := range gens {
+		gens[i].outputs, err = renderOutputs(cmd, gens[i].cfg, gens[i].result, gens[i].spec, shared)
 		if err != nil {
-			return fmt.Errorf("transforming spec: %w", err)
+			return nil, fmt.Errorf("profile %s: %w", gens[i].cfg.Profile, err)
 		}
+	}
+	if shared == nil {
+		infof(cmd, "Shared types: none\n")
+		return gens, nil
+	}
 
-		cmd.PrintErrf("Loaded OpenAPI %s: %s v%s\n", result.Version, spec.Info.Title, spec.Info.Version)
-		cmd.PrintErrf("  Schemas: %d\n", len(spec.Schemas))
-		cmd.PrintErrf("  Operations: %d\n", len(spec.Operations))
+	infof(cmd, "Shared types: %d schemas (%s)\n", len(shared.Schemas), strings.Join(shared.Schemas, ", "))
+	combined := &loader.Result{Version: gens[0].result.Version}
+	for _, g := range gens {
+		combined.RawData = append(combined.RawData, g.result.RawData...)
+	}
+	common := generation{
+		cfg:     sharedCfg,
+		result:  combined,
+		spec:    &model.Spec{Info: specs[0].Info},
+		outputs: shared.Outputs,
+	}
+	return append([]generation{common}, gens...), nil
+}
 
-		gen, err := codegen.New(cfg)
+// generateVersionOutputs loads the spec of every configured version and
+// renders them as sibling packages of the output directory. The returned
+// result holds the specs concatenated in version order, and the returned
+// spec the info of the first version and the warnings of all.
+func generateVersionOutputs(cmd *cobra.Command, cfg *config.Config) (*loader.Result, *model.Spec, []codegen.Output, error) {
+	combined := &loader.Result{}
+	merged := &model.Spec{}
+	versions := make([]codegen.VersionSpec, len(cfg.Versions))
+	for i, v := range cfg.Versions {
+		infof(cmd, "Version: %s\n", v.Name)
+		result, spec, err := loadSpec(cmd, cfg.ForVersion(v))
 		if err != nil {
-			return fmt.Errorf("creating generator: %w", err)
+			return nil, nil, nil, fmt.Errorf("version %s: %w", v.Name, err)
+		}
+		versions[i] = codegen.VersionSpec{Version: v, Spec: spec, Data: result.RawData}
+		combined.RawData = append(combined.RawData, result.RawData...)
+		if i == 0 {
+			combined.Version = result.Version
+			merged.Info = spec.Info
 		}
+		merged.Warnings = append(merged.Warnings, spec.Warnings...)
+	}
 
-		outputs, err := gen.Generate(spec, result.RawData)
-		if err != nil {
-			return fmt.Errorf("generating code: %w", err)
+	gen, err := codegen.New(cfg)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("creating generator: %w", err)
+	}
+	gen.SetToolVersion(cmd.Root().Version)
+
+	outputs, err := gen.GenerateVersions(versions)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("generating code: %w", err)
+	}
+	for _, out := range outputs {
+		slog.Debug("rendered output", "file", out.Filename, "bytes", len(out.Content))
+	}
+	return combined, merged, outputs, nil
+}
+
+// stdinSpec is the spec read from stdin. Stdin can be read only once, but
+// --all-profiles loads the spec for every profile.
+var stdinSpec []byte
+
+func readStdinSpec(cmd *cobra.Command) ([]byte, error) {
+	if stdinSpec != nil {
+		return stdinSpec, nil
+	}
+	data, err := io.ReadAll(cmd.InOrStdin())
+	if err != nil {
+		return nil, fmt.Errorf("reading stdin: %w", err)
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return nil, fmt.Errorf("reading stdin: no spec was piped in")
+	}
+	stdinSpec = data
+	return data, nil
+}
+
+// loadSpec loads and transforms the configured spec, applies tag filters and
+// schema pruning, and reports what was loaded.
+func loadSpec(cmd *cobra.Command, cfg *config.Config) (*loader.Result, *model.Spec, error) {
+	source := cfg.Spec
+	var (
+		result *loader.Result
+		err    error
+	)
+	switch {
+	case cfg.Schema != "":
+		source = cfg.Schema
+		result, err = loader.LoadSchemaFile(source)
+	case cfg.Spec == "-":
+		source = "stdin"
+		var data []byte
+		if data, err = readStdinSpec(cmd); err == nil {
+			result, err = loader.LoadData(data, source, cfg.SpecFormat, cfg.Overlays...)
 		}
+	default:
+		result, err = loader.LoadFile(source, cfg.Overlays...)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading spec: %w", err)
+	}
 
-		dryRun, _ := cmd.Flags().GetBool("dry-run")
-		if dryRun {
-			for _, out := range outputs {
-				cmd.Printf("// %s\n%s\n", out.Filename, out.Content)
-			}
-			return nil
+	for _, w := range result.Warnings {
+		cmd.PrintErrf("Warning: %s\n", w)
+	}
+
+	spec, err := loader.Transform(result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("transforming spec: %w", err)
+	}
+	if cfg.AsyncAPI != "" {
+		events, err := loader.LoadAsyncAPIFile(cfg.AsyncAPI)
+		if err != nil {
+			return nil, nil, fmt.Errorf("loading AsyncAPI document: %w", err)
 		}
+		if err := loader.MergeEvents(spec, events); err != nil {
+			return nil, nil, err
+		}
+	}
+	loader.FilterTags(spec, cfg.IncludeTags, cfg.ExcludeTags)
+	var skipped []string
+	if len(spec.Operations) == 0 {
+		skipped = cfg.DropOperationTargets()
+		if len(skipped) > 0 && len(cfg.Targets()) == 0 {
+			return nil, nil, fmt.Errorf("%s has no operations to generate %s from; generate types or spec instead", source, strings.Join(skipped, ", "))
+		}
+	}
+	var pruned []string
+	if cfg.PruneSchemas() {
+		pruned = loader.PruneSchemas(spec)
+		for _, name := range pruned {
+			slog.Debug("pruned unused schema", "schema", name)
+		}
+	}
+	if cfg.Strict && len(spec.Warnings) > 0 {
+		printWarnings(cmd, spec.Warnings)
+		return nil, nil, fmt.Errorf("strict mode: %d unsupported construct(s) in %s", len(spec.Warnings), source)
+	}
+	if failing := failingWarnings(cfg, spec.Warnings); len(failing) > 0 {
+		printWarnings(cmd, failing)
+		return nil, nil, fmt.Errorf("fail-on %s: %d construct(s) in %s", strings.Join(cfg.FailOn, ","), len(failing), source)
+	}
+	slog.Debug("resolved config", "profile", cfg.Profile, "targets", cfg.Targets(), "output", cfg.Go.OutputDir, "framework", cfg.Go.ServerFramework)
 
-		if err := os.MkdirAll(cfg.Go.OutputDir, 0755); err != nil {
-			return fmt.Errorf("creating output directory: %w", err)
+	if cfg.Schema != "" {
+		infof(cmd, "Loaded JSON Schema: %s\n", spec.Info.Title)
+	} else {
+		infof(cmd, "Loaded OpenAPI %s: %s v%s\n", result.Version, spec.Info.Title, spec.Info.Version)
+	}
+	infof(cmd, "  Schemas: %d\n", len(spec.Schemas))
+	if len(pruned) > 0 {
+		infof(cmd, "  Pruned: %d unused schemas (--keep-all-schemas keeps them)\n", len(pruned))
+	}
+	infof(cmd, "  Operations: %d\n", len(spec.Operations))
+	if len(skipped) > 0 {
+		infof(cmd, "  Skipping %s: the spec has no operations\n", strings.Join(skipped, ", "))
+	}
+	if cfg.AsyncAPI != "" {
+		infof(cmd, "  Events: %d\n", len(spec.Events))
+	}
+
+	return result, spec, nil
+}
+
+func runGoGenerate(target string) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var cliTargets []string
+		if target != "" {
+			cliTargets = []string{target}
+		}
+		cfgs, err := config.LoadAll(cmd, cliTargets)
+		if err != nil {
+			return err
 		}
 
-		// Check all files before writing any
-		for _, out := range outputs {
-			path := filepath.Join(cfg.Go.OutputDir, out.Filename)
-			if err := checkCanOverwrite(path); err != nil {
+		if cfgs[0].SharedTypes.Enabled() {
+			gens, err := generateSharedTypes(cmd, cfgs)
+			if err != nil {
 				return err
 			}
+			for _, g := range gens {
+				if err := writeOutputs(cmd, g.cfg, g.result, g.spec, g.outputs); err != nil {
+					if g.cfg.Profile != "" {
+						return fmt.Errorf("profile %s: %w", g.cfg.Profile, err)
+					}
+					return err
+				}
+			}
+			return nil
 		}
 
-		for _, out := range outputs {
-			path := filepath.Join(cfg.Go.OutputDir, out.Filename)
-			if err := os.WriteFile(path, []byte(out.Content), 0644); err != nil {
-				return fmt.Errorf("writing %s: %w", path, err)
+		for _, cfg := range cfgs {
+			if cfg.Profile != "" {
+				infof(cmd, "Profile: %s\n", cfg.Profile)
+			}
+			if err := generate(cmd, cfg); err != nil {
+				if cfg.Profile != "" {
+					return fmt.Errorf("profile %s: %w", cfg.Profile, err)
+				}
+				return err
 			}
-			cmd.PrintErrf("Written: %s\n", path)
 		}
+		return nil
+	}
+}
+
+// generate renders and writes all targets for one configuration.
+func generate(cmd *cobra.Command, cfg *config.Config) error {
+	result, spec, outputs, err := generateOutputs(cmd, cfg)
+	if err != nil {
+		return err
+	}
+	return writeOutputs(cmd, cfg, result, spec, outputs)
+}
 
+// listNewOperations prints the methods the outputs add to or remove from
+// the handler interfaces generated in dir, so implementers see what to write
+// before regenerating.
+func listNewOperations(cmd *cobra.Command, dir string, outputs []codegen.Output) error {
+	changes, err := codegen.DiffOperations(dir, outputs)
+	if err != nil {
+		return fmt.Errorf("comparing operations: %w", err)
+	}
+	if len(changes) == 0 {
+		cmd.Printf("No operation changes in %s\n", dir)
 		return nil
 	}
+	cmd.Printf("Operation changes in %s:\n", dir)
+	for _, c := range changes {
+		cmd.Printf("  %s\n", c)
+	}
+	return nil
 }
 
+// writeOutputs writes the rendered outputs of one configuration, or prints
+// them for --stdout and --dry-run.
+func writeOutputs(cmd *cobra.Command, cfg *config.Config, result *loader.Result, spec *model.Spec, outputs []codegen.Output) error {
+	defer printWarnings(cmd, spec.Warnings)
+
+	if listNew, _ := cmd.Flags().GetBool("list-new-operations"); listNew {
+		return listNewOperations(cmd, cfg.Go.OutputDir, outputs)
+	}
+
+	toStdout, _ := cmd.Flags().GetBool("stdout")
+	if toStdout && cfg.Go.OutputOptions.SplitByTag {
+		return fmt.Errorf("--stdout writes a single file and cannot be combined with split-by-tag")
+	}
+	if toStdout && len(cfg.Versions) > 0 {
+		return fmt.Errorf("--stdout writes a single file and cannot be combined with versions")
+	}
+	if toStdout && cfg.SharedTypes.Enabled() {
+		return fmt.Errorf("--stdout writes a single file and cannot be combined with shared-types")
+	}
+	if toStdout && !cfg.Go.OutputOptions.SingleFile {
+		var err error
+		outputs, err = codegen.Bundle(cfg.Go.Package, outputs)
+		if err != nil {
+			return fmt.Errorf("bundling output: %w", err)
+		}
+	}
+
+	if toStdout {
+		// Companion files are user-owned and never part of the stream
+		_, err := fmt.Fprint(cmd.OutOrStdout(), outputs[0].Content)
+		return err
+	}
+
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	if dryRun {
+		for _, out := range outputs {
+			cmd.Printf("// %s\n%s\n", out.Filename, out.Content)
+		}
+		return nil
+	}
+
+	if err := os.MkdirAll(cfg.Go.OutputDir, 0755); err != nil {
+		return fmt.Errorf("creating output directory: %w", err)
+	}
+
+	// Check all files before writing any
+	kept := make(map[string]bool)
+	for _, out := range outputs {
+		path := filepath.Join(cfg.Go.OutputDir, out.Filename)
+		if hasKeepMarker(path) {
+			kept[path] = true
+			continue
+		}
+		if err := checkCanOverwrite(path); err != nil {
+			return err
+		}
+	}
+
+	if err := checkConflicts(cfg.Go.OutputDir, cfg.Go.Package, outputs, kept, cfg.Go.OutputOptions.PruneOrphans); err != nil {
+		return err
+	}
+
+	for _, out := range outputs {
+		path := filepath.Join(cfg.Go.OutputDir, out.Filename)
+		if kept[path] {
+			infof(cmd, "Kept: %s\n", path)
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return fmt.Errorf("creating %s: %w", filepath.Dir(path), err)
+		}
+		if err := os.WriteFile(path, []byte(out.Content), 0644); err != nil {
+			return fmt.Errorf("writing %s: %w", path, err)
+		}
+		infof(cmd, "Written: %s\n", path)
+	}
+
+	if cfg.Go.OutputOptions.InitModule != "" {
+		if err := initModule(cmd, cfg, spec.Info.Title, outputs); err != nil {
+			return err
+		}
+	}
+
+	if cfg.Go.OutputOptions.LockFile {
+		if err := writeLock(cmd, cfg, result.RawData, outputs); err != nil {
+			return err
+		}
+	}
+
+	if cfg.Go.OutputOptions.PruneOrphans {
+		removed, err := pruneOrphans(cfg.Go.OutputDir, outputs)
+		for _, path := range removed {
+			infof(cmd, "Removed: %s\n", path)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/kolah/eugene/internal/codegen"
+	"github.com/kolah/eugene/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func NewJVMCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "jvm",
+		Short: "Generate Kotlin or Java types from OpenAPI spec",
+		RunE:  runJVMGenerate(""),
+	}
+
+	flags := cmd.PersistentFlags()
+	flags.StringP("output-dir", "o", "", "Output directory for generated Kotlin or Java code")
+	flags.StringP("package", "p", "", "Kotlin or Java package name, such as com.example.api")
+	flags.StringP("language", "l", "", "Language of the generated types: kotlin (default), java")
+
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "types",
+			Short: "Generate Kotlin data classes or Java records for the schemas",
+			RunE:  runJVMGenerate("types"),
+		},
+	)
+
+	return cmd
+}
+
+func runJVMGenerate(target string) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var cliTargets []string
+		if target != "" {
+			cliTargets = []string{target}
+		}
+		cfgs, err := config.LoadAll(cmd, cliTargets)
+		if err != nil {
+			return err
+		}
+		if cfgs[0].SharedTypes.Enabled() {
+			return fmt.Errorf("shared-types generates Go packages; generate jvm for each profile instead")
+		}
+
+		for _, cfg := range cfgs {
+			if cfg.Profile != "" {
+				infof(cmd, "Profile: %s\n", cfg.Profile)
+			}
+			if err := generateJVM(cmd, cfg); err != nil {
+				if cfg.Profile != "" {
+					return fmt.Errorf("profile %s: %w", cfg.Profile, err)
+				}
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// generateJVM renders and writes the Kotlin or Java types for one
+// configuration.
+func generateJVM(cmd *cobra.Command, cfg *config.Config) error {
+	_, spec, err := loadSpec(cmd, cfg)
+	if err != nil {
+		return err
+	}
+	defer printWarnings(cmd, spec.Warnings)
+
+	gen, err := codegen.New(cfg)
+	if err != nil {
+		return fmt.Errorf("creating generator: %w", err)
+	}
+	outputs, err := gen.GenerateJVM(spec)
+	if err != nil {
+		return fmt.Errorf("generating code: %w", err)
+	}
+
+	if toStdout, _ := cmd.Flags().GetBool("stdout"); toStdout && len(outputs) != 1 {
+		return fmt.Errorf("--stdout writes a single file; Java declares a file per type, generate kotlin instead")
+	}
+	return writeLanguageOutputs(cmd, cfg.JVM.OutputDir, outputs)
+}
@@ -0,0 +1,99 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kolah/eugene/internal/config"
+	"github.com/kolah/eugene/internal/loader"
+	"github.com/kolah/eugene/internal/model"
+	"github.com/spf13/cobra"
+)
+
+func LintCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lint <spec|->",
+		Short: "Report constructs in a spec that generated code cannot represent",
+		Long: "Loads the spec as generation does and reports each construct that degrades in\n" +
+			"generated code, such as compositions mapped to any or media types that are dropped.",
+		Args: cobra.ExactArgs(1),
+		RunE: runLint,
+	}
+	cmd.Flags().String("format", "text", "Report format: text, json, sarif")
+	cmd.Flags().StringSlice("fail-on", nil, "Exit with an error when findings fall in these categories: any-fallback, dropped-media-type, ignored-keyword, ignored-extension, invalid-type, synthesized-operation-id")
+	return cmd
+}
+
+func runLint(cmd *cobra.Command, args []string) error {
+	format, err := reportFormat(cmd)
+	if err != nil {
+		return err
+	}
+	failOn, _ := cmd.Flags().GetStringSlice("fail-on")
+	if err := config.ValidateFailOn(failOn); err != nil {
+		return err
+	}
+	result, spec, err := loadLintSpec(cmd, args[0])
+	if err != nil {
+		return err
+	}
+
+	name := specName(args[0])
+	findings := warningFindings(name, result.RawData, spec.Warnings)
+	switch format {
+	case "json":
+		err = writeJSON(cmd.OutOrStdout(), findings, nil)
+	case "sarif":
+		err = writeSARIF(cmd.OutOrStdout(), cmd.Root().Version, findings, nil)
+	default:
+		if len(findings) == 0 {
+			infof(cmd, "%s: no findings\n", name)
+			break
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "%d finding(s):\n", len(findings))
+		writeText(cmd.OutOrStdout(), findings)
+	}
+	if err != nil {
+		return err
+	}
+
+	cfg := &config.Config{FailOn: failOn}
+	if failing := failingWarnings(cfg, spec.Warnings); len(failing) > 0 {
+		return fmt.Errorf("fail-on %s: %d finding(s) in %s", strings.Join(failOn, ","), len(failing), name)
+	}
+	return nil
+}
+
+// loadLintSpec loads and transforms the spec at path, or stdin for "-",
+// without the tag and schema filters of a generation config, so every
+// construct is checked.
+func loadLintSpec(cmd *cobra.Command, path string) (*loader.Result, *model.Spec, error) {
+	var (
+		result *loader.Result
+		err    error
+	)
+	if path == "-" {
+		var data []byte
+		if data, err = readStdinSpec(cmd); err == nil {
+			result, err = loader.LoadData(data, specName(path), "")
+		}
+	} else {
+		result, err = loader.LoadFile(path)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading %s: %w", path, err)
+	}
+	spec, err := loader.Transform(result)
+	if err != nil {
+		return nil, nil, fmt.Errorf("transforming %s: %w", path, err)
+	}
+	return result, spec, nil
+}
+
+// specName names the spec at path, or stdin for "-", in reports.
+func specName(path string) string {
+	if path == "-" {
+		return "stdin"
+	}
+	return path
+}
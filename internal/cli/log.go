@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"log/slog"
+
+	"github.com/spf13/cobra"
+)
+
+// setupLogging installs the default slog logger based on --debug and --quiet.
+// Debug records explain resolver decisions; --quiet hides progress output.
+func setupLogging(cmd *cobra.Command, args []string) error {
+	debug, _ := cmd.Flags().GetBool("debug")
+	quiet, _ := cmd.Flags().GetBool("quiet")
+
+	level := slog.LevelInfo
+	switch {
+	case debug:
+		level = slog.LevelDebug
+	case quiet:
+		level = slog.LevelWarn
+	}
+
+	handler := slog.NewTextHandler(cmd.ErrOrStderr(), &slog.HandlerOptions{Level: level})
+	slog.SetDefault(slog.New(handler))
+	return nil
+}
+
+// infof prints progress output unless --quiet is set.
+func infof(cmd *cobra.Command, format string, args ...any) {
+	if quiet, _ := cmd.Flags().GetBool("quiet"); quiet {
+		return
+	}
+	cmd.PrintErrf(format, args...)
+}
@@ -0,0 +1,188 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"text/tabwriter"
+
+	"github.com/kolah/eugene/internal/loader"
+	"github.com/kolah/eugene/internal/model"
+	"github.com/spf13/cobra"
+)
+
+// finding is one lint warning or spec change, located in a spec file.
+type finding struct {
+	Rule     string `json:"rule"`  // warning kind or change kind, e.g. "composition" or "breaking"
+	Level    string `json:"level"` // SARIF level: error, warning or note
+	File     string `json:"file"`
+	Line     int    `json:"line,omitempty"`
+	Column   int    `json:"column,omitempty"`
+	Location string `json:"location"` // JSON pointer into the spec
+	Message  string `json:"message"`
+}
+
+// ruleDescriptions documents the rule IDs findings can carry.
+var ruleDescriptions = map[string]string{
+	string(model.WarningComposition):    "oneOf, anyOf or allOf is generated as any",
+	string(model.WarningIgnoredKeyword): "Schema keyword has no effect on generated code",
+	string(model.WarningMediaType):      "Additional media type is not generated",
+	string(model.WarningOperationID):    "operationId is synthesized from method and path",
+	string(model.WarningExtension):      "Malformed x-oink extension is ignored",
+	string(model.WarningInvalidType):    "Schema type is not a JSON Schema type and is generated as any",
+	string(loader.ChangeBreaking):       "Change breaks existing callers or generated code",
+	string(loader.ChangeAdditive):       "Change adds to the API without breaking callers",
+	string(loader.ChangeDocs):           "Change affects documentation only",
+}
+
+var reportFormats = []string{"text", "json", "sarif"}
+
+func reportFormat(cmd *cobra.Command) (string, error) {
+	format, _ := cmd.Flags().GetString("format")
+	if !slices.Contains(reportFormats, format) {
+		return "", fmt.Errorf("invalid --format %q: must be one of text, json, sarif", format)
+	}
+	return format, nil
+}
+
+func warningFindings(file string, data []byte, warnings []model.Warning) []finding {
+	positions := loader.NewPositions(data)
+	findings := make([]finding, len(warnings))
+	for i, w := range warnings {
+		line, col := positions.Find(w.Location)
+		findings[i] = finding{
+			Rule:     string(w.Kind),
+			Level:    "warning",
+			File:     file,
+			Line:     line,
+			Column:   col,
+			Location: w.Location,
+			Message:  w.Message,
+		}
+	}
+	return findings
+}
+
+func changeFindings(file string, data []byte, changes []loader.Change) []finding {
+	positions := loader.NewPositions(data)
+	findings := make([]finding, len(changes))
+	for i, c := range changes {
+		line, col := positions.Find(c.Location)
+		level := "note"
+		if c.Kind == loader.ChangeBreaking {
+			level = "error"
+		}
+		findings[i] = finding{
+			Rule:     string(c.Kind),
+			Level:    level,
+			File:     file,
+			Line:     line,
+			Column:   col,
+			Location: c.Location,
+			Message:  c.Message,
+		}
+	}
+	return findings
+}
+
+// writeText writes findings as a table with their file positions.
+func writeText(w io.Writer, findings []finding) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "  RULE\tPOSITION\tLOCATION\tMESSAGE")
+	for _, f := range findings {
+		fmt.Fprintf(tw, "  %s\t%s:%d:%d\t%s\t%s\n", f.Rule, f.File, f.Line, f.Column, f.Location, f.Message)
+	}
+	tw.Flush()
+}
+
+// writeJSON writes findings as an indented JSON document. Extra fields of
+// the command, such as the recommended bump, go next to the findings.
+func writeJSON(w io.Writer, findings []finding, extra map[string]any) error {
+	doc := map[string]any{"findings": findings}
+	for k, v := range extra {
+		doc[k] = v
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
+
+// writeSARIF writes findings as a SARIF 2.1.0 log with one run, which GitHub
+// code scanning accepts. Extra fields go to the properties of the run.
+func writeSARIF(w io.Writer, version string, findings []finding, extra map[string]any) error {
+	type message struct {
+		Text string `json:"text"`
+	}
+	type rule struct {
+		ID               string  `json:"id"`
+		ShortDescription message `json:"shortDescription"`
+	}
+	type region struct {
+		StartLine   int `json:"startLine,omitempty"`
+		StartColumn int `json:"startColumn,omitempty"`
+	}
+	type artifactLocation struct {
+		URI string `json:"uri"`
+	}
+	type physicalLocation struct {
+		ArtifactLocation artifactLocation `json:"artifactLocation"`
+		Region           *region          `json:"region,omitempty"`
+	}
+	type logicalLocation struct {
+		FullyQualifiedName string `json:"fullyQualifiedName"`
+	}
+	type location struct {
+		PhysicalLocation physicalLocation  `json:"physicalLocation"`
+		LogicalLocations []logicalLocation `json:"logicalLocations"`
+	}
+	type result struct {
+		RuleID    string     `json:"ruleId"`
+		Level     string     `json:"level"`
+		Message   message    `json:"message"`
+		Locations []location `json:"locations"`
+	}
+
+	rules := []rule{}
+	results := make([]result, len(findings))
+	for i, f := range findings {
+		if !slices.ContainsFunc(rules, func(r rule) bool { return r.ID == f.Rule }) {
+			rules = append(rules, rule{ID: f.Rule, ShortDescription: message{ruleDescriptions[f.Rule]}})
+		}
+		loc := physicalLocation{ArtifactLocation: artifactLocation{URI: f.File}}
+		if f.Line > 0 {
+			loc.Region = &region{StartLine: f.Line, StartColumn: f.Column}
+		}
+		results[i] = result{
+			RuleID:  f.Rule,
+			Level:   f.Level,
+			Message: message{f.Message},
+			Locations: []location{{
+				PhysicalLocation: loc,
+				LogicalLocations: []logicalLocation{{FullyQualifiedName: f.Location}},
+			}},
+		}
+	}
+
+	run := map[string]any{
+		"tool": map[string]any{
+			"driver": map[string]any{
+				"name":           "eugene",
+				"version":        version,
+				"informationUri": "https://github.com/kolah/eugene",
+				"rules":          rules,
+			},
+		},
+		"results": results,
+	}
+	if len(extra) > 0 {
+		run["properties"] = extra
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(map[string]any{
+		"$schema": "https://json.schemastore.org/sarif-2.1.0.json",
+		"version": "2.1.0",
+		"runs":    []any{run},
+	})
+}
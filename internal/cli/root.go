@@ -2,18 +2,32 @@ package cli
 
 import "github.com/spf13/cobra"
 
+// Version is the eugene release, overridable with -ldflags "-X".
+var Version = "1.0.0"
+
 func RootCmd() *cobra.Command {
 	root := &cobra.Command{
 		Use:     "eugene",
 		Short:   "Eugene - OpenAPI INterface Kit - oink! 🐷",
-		Version: "1.0.0",
+		Version: Version,
 
+		PersistentPreRunE: setupLogging,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return cmd.Help()
 		},
 	}
 
+	root.PersistentFlags().BoolP("debug", "v", false, "Log resolver decisions and other debug details")
+	root.PersistentFlags().BoolP("quiet", "q", false, "Only print warnings and errors")
+
 	root.AddCommand(GenerateCommand())
+	root.AddCommand(VerifyCommand())
+	root.AddCommand(ConfigCommand())
+	root.AddCommand(LintCommand())
+	root.AddCommand(VersionBumpCommand())
+	root.AddCommand(BundleCommand())
+	root.AddCommand(TemplatesCommand())
+	root.AddCommand(CoverageCommand())
 
 	return root
 }
@@ -0,0 +1,168 @@
+package cli
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/kolah/eugene/internal/config"
+	"github.com/kolah/eugene/internal/templates"
+	embeddedtmpl "github.com/kolah/eugene/templates"
+	"github.com/spf13/cobra"
+)
+
+func TemplatesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "templates",
+		Short: "Copy embedded templates and track them across eugene upgrades",
+	}
+
+	cmd.PersistentFlags().StringP("config", "c", "", "Config file path, for templates.delims (default: eugene.yaml)")
+	cmd.AddCommand(newTemplatesListCmd(), newTemplatesExportCmd(), newTemplatesDiffCmd())
+
+	return cmd
+}
+
+func newTemplatesListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the embedded templates",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return fs.WalkDir(embeddedtmpl.FS, ".", func(path string, d fs.DirEntry, err error) error {
+				if err == nil && !d.IsDir() && filepath.Ext(path) == ".tmpl" {
+					fmt.Fprintln(cmd.OutOrStdout(), path)
+				}
+				return err
+			})
+		},
+	}
+}
+
+func newTemplatesExportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export <template>...",
+		Short: "Copy embedded templates into a custom templates directory",
+		Long: "Copies each embedded template, such as go/server/chi.tmpl, to the same path\n" +
+			"below --dir. The copy starts with a header recording the eugene release and\n" +
+			"the hash of the template, which `eugene templates diff` checks after an upgrade.",
+		Args: cobra.MinimumNArgs(1),
+		RunE: runTemplatesExport,
+	}
+	cmd.Flags().StringP("dir", "d", "templates", "Custom templates directory")
+	cmd.Flags().Bool("force", false, "Overwrite existing templates")
+	return cmd
+}
+
+func runTemplatesExport(cmd *cobra.Command, args []string) error {
+	dir, _ := cmd.Flags().GetString("dir")
+	force, _ := cmd.Flags().GetBool("force")
+
+	delims, err := templateDelims(cmd)
+	if err != nil {
+		return err
+	}
+	for _, name := range args {
+		content, err := templates.Export(embeddedtmpl.FS, name, "v"+Version, delims)
+		if err != nil {
+			return err
+		}
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if _, err := os.Stat(path); err == nil && !force {
+			return fmt.Errorf("%s already exists (use --force to overwrite)", path)
+		}
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return fmt.Errorf("creating %s: %w", filepath.Dir(path), err)
+		}
+		if err := os.WriteFile(path, content, 0644); err != nil {
+			return fmt.Errorf("writing %s: %w", path, err)
+		}
+		infof(cmd, "Written: %s\n", path)
+	}
+	return nil
+}
+
+func newTemplatesDiffCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Show the upstream changes to the embedded templates custom ones were copied from",
+		Long: "Compares each template below --dir with the embedded template it was exported\n" +
+			"from. Templates whose embedded version changed since are printed as a unified\n" +
+			"diff from the custom template to the current embedded one: its additions are\n" +
+			"the upstream changes to port, its removals your customizations.",
+		Args: cobra.NoArgs,
+		RunE: runTemplatesDiff,
+	}
+	cmd.Flags().StringP("dir", "d", "templates", "Custom templates directory")
+	cmd.Flags().Bool("exit-code", false, "Exit with an error when an embedded template changed")
+	cmd.Flags().Bool("accept", false, "Record the current embedded templates in the headers of changed templates, once their changes are ported")
+	return cmd
+}
+
+func runTemplatesDiff(cmd *cobra.Command, args []string) error {
+	dir, _ := cmd.Flags().GetString("dir")
+	exitCode, _ := cmd.Flags().GetBool("exit-code")
+	accept, _ := cmd.Flags().GetBool("accept")
+
+	delims, err := templateDelims(cmd)
+	if err != nil {
+		return err
+	}
+	drifts, err := templates.CheckDrift(embeddedtmpl.FS, dir, delims)
+	if err != nil {
+		return err
+	}
+
+	changed := 0
+	for _, d := range drifts {
+		switch d.Status {
+		case templates.DriftChanged:
+			if accept {
+				if err := acceptTemplate(filepath.Join(dir, filepath.FromSlash(d.Name)), delims); err != nil {
+					return err
+				}
+				infof(cmd, "%s: accepted as of v%s\n", d.Name, Version)
+				continue
+			}
+			changed++
+			cmd.PrintErrf("%s: %s since %s\n", d.Name, d.Status, d.Copied.Version)
+			fmt.Fprint(cmd.OutOrStdout(), d.Diff)
+		case templates.DriftUpToDate:
+			infof(cmd, "%s: %s\n", d.Name, d.Status)
+		case templates.DriftUntracked:
+			cmd.PrintErrf("%s: %s, it has no eugene:template header (export it again to track it)\n", d.Name, d.Status)
+		case templates.DriftCustom:
+			infof(cmd, "%s: %s\n", d.Name, d.Status)
+		}
+	}
+	if exitCode && changed > 0 {
+		return fmt.Errorf("%d template(s) changed upstream", changed)
+	}
+	return nil
+}
+
+func acceptTemplate(path string, delims templates.Delims) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	content, err = templates.Accept(embeddedtmpl.FS, content, "v"+Version, delims)
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return os.WriteFile(path, content, 0644)
+}
+
+// templateDelims returns the delimiters templates.delims of the config file
+// sets for custom templates, which their provenance headers are written in.
+func templateDelims(cmd *cobra.Command) (templates.Delims, error) {
+	cfg, err := config.LoadTemplates(cmd)
+	if err != nil {
+		return templates.Delims{}, err
+	}
+	if len(cfg.Delims) == 2 {
+		return templates.Delims{Left: cfg.Delims[0], Right: cfg.Delims[1]}, nil
+	}
+	return templates.DefaultDelims, nil
+}
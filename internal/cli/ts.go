@@ -0,0 +1,131 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/kolah/eugene/internal/codegen"
+	"github.com/kolah/eugene/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func NewTSCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ts",
+		Short: "Generate a TypeScript client from OpenAPI spec",
+		RunE:  runTSGenerate(""),
+	}
+
+	cmd.PersistentFlags().StringP("output-dir", "o", "", "Output directory for generated TypeScript code")
+
+	cmd.AddCommand(
+		&cobra.Command{
+			Use:   "types",
+			Short: "Generate TypeScript interfaces for the schemas",
+			RunE:  runTSGenerate("types"),
+		},
+		&cobra.Command{
+			Use:   "client",
+			Short: "Generate a fetch-based TypeScript client, with the types",
+			RunE:  runTSGenerate("client"),
+		},
+		&cobra.Command{
+			Use:   "all",
+			Short: "Generate all TypeScript targets (types, client)",
+			RunE:  runTSGenerate("all"),
+		},
+	)
+
+	return cmd
+}
+
+func runTSGenerate(target string) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		var cliTargets []string
+		if target != "" {
+			cliTargets = []string{target}
+		}
+		cfgs, err := config.LoadAll(cmd, cliTargets)
+		if err != nil {
+			return err
+		}
+		if cfgs[0].SharedTypes.Enabled() {
+			return fmt.Errorf("shared-types generates Go packages; generate ts for each profile instead")
+		}
+
+		for _, cfg := range cfgs {
+			if cfg.Profile != "" {
+				infof(cmd, "Profile: %s\n", cfg.Profile)
+			}
+			if err := generateTS(cmd, cfg); err != nil {
+				if cfg.Profile != "" {
+					return fmt.Errorf("profile %s: %w", cfg.Profile, err)
+				}
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// generateTS renders and writes the TypeScript targets for one configuration.
+func generateTS(cmd *cobra.Command, cfg *config.Config) error {
+	_, spec, err := loadSpec(cmd, cfg)
+	if err != nil {
+		return err
+	}
+	defer printWarnings(cmd, spec.Warnings)
+
+	gen, err := codegen.New(cfg)
+	if err != nil {
+		return fmt.Errorf("creating generator: %w", err)
+	}
+	outputs, err := gen.GenerateTS(spec)
+	if err != nil {
+		return fmt.Errorf("generating code: %w", err)
+	}
+
+	if toStdout, _ := cmd.Flags().GetBool("stdout"); toStdout && len(outputs) != 1 {
+		return fmt.Errorf("--stdout writes a single file; the client imports types.ts, generate ts types instead")
+	}
+	return writeLanguageOutputs(cmd, cfg.TS.OutputDir, outputs)
+}
+
+// writeLanguageOutputs writes the files of a single-directory language
+// target to dir, or prints them for --stdout and --dry-run. Nothing is
+// written unless every file may be overwritten.
+func writeLanguageOutputs(cmd *cobra.Command, dir string, outputs []codegen.Output) error {
+	if toStdout, _ := cmd.Flags().GetBool("stdout"); toStdout {
+		for _, out := range outputs {
+			if _, err := fmt.Fprint(cmd.OutOrStdout(), out.Content); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if dryRun, _ := cmd.Flags().GetBool("dry-run"); dryRun {
+		for _, out := range outputs {
+			cmd.Printf("// %s\n%s\n", out.Filename, out.Content)
+		}
+		return nil
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("creating output directory: %w", err)
+	}
+	// Check all files before writing any
+	for _, out := range outputs {
+		if err := checkCanOverwrite(filepath.Join(dir, out.Filename)); err != nil {
+			return err
+		}
+	}
+	for _, out := range outputs {
+		path := filepath.Join(dir, out.Filename)
+		if err := os.WriteFile(path, []byte(out.Content), 0644); err != nil {
+			return fmt.Errorf("writing %s: %w", path, err)
+		}
+		infof(cmd, "Written: %s\n", path)
+	}
+	return nil
+}
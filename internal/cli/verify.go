@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/kolah/eugene/internal/codegen"
+	"github.com/kolah/eugene/internal/config"
+	"github.com/kolah/eugene/internal/loader"
+	"github.com/spf13/cobra"
+)
+
+func VerifyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify [targets...]",
+		Short: "Verify generated code matches eugene.lock",
+		Long: "Regenerates code in memory and compares the spec, configuration and file hashes\n" +
+			"against eugene.lock in the output directory, and checks the files on disk were not edited.\n" +
+			"Without targets, the targets recorded in eugene.lock are generated. Pass the Go flags\n" +
+			"the code was generated with when it was not generated from a config file alone.",
+		RunE: runVerify,
+	}
+
+	config.BindCommonFlags(cmd)
+	bindGoFlags(cmd)
+
+	return cmd
+}
+
+func runVerify(cmd *cobra.Command, args []string) error {
+	cfgs, err := config.LoadAll(cmd, args)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		for _, cfg := range cfgs {
+			if err := useLockedTargets(cfg); err != nil {
+				return err
+			}
+		}
+	}
+
+	if cfgs[0].SharedTypes.Enabled() {
+		gens, err := generateSharedTypes(cmd, cfgs)
+		if err != nil {
+			return err
+		}
+		for _, g := range gens {
+			if err := verifyOutputs(cmd, g.cfg, g.result, g.outputs); err != nil {
+				if g.cfg.Profile != "" {
+					return fmt.Errorf("profile %s: %w", g.cfg.Profile, err)
+				}
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, cfg := range cfgs {
+		if cfg.Profile != "" {
+			infof(cmd, "Profile: %s\n", cfg.Profile)
+		}
+		if err := verify(cmd, cfg); err != nil {
+			if cfg.Profile != "" {
+				return fmt.Errorf("profile %s: %w", cfg.Profile, err)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// useLockedTargets generates the targets recorded in the lock of cfg, since
+// generate may have taken them from the command line rather than the config.
+func useLockedTargets(cfg *config.Config) error {
+	lock, err := codegen.ReadLock(cfg.Go.OutputDir)
+	if err != nil {
+		return err
+	}
+	if len(lock.Targets) == 0 {
+		return nil
+	}
+	cfg.Go.Targets = lock.Targets
+	return cfg.Validate()
+}
+
+func verify(cmd *cobra.Command, cfg *config.Config) error {
+	result, _, outputs, err := generateOutputs(cmd, cfg)
+	if err != nil {
+		return err
+	}
+	return verifyOutputs(cmd, cfg, result, outputs)
+}
+
+// verifyOutputs compares the rendered outputs of one configuration with the
+// lock in its output directory.
+func verifyOutputs(cmd *cobra.Command, cfg *config.Config, result *loader.Result, outputs []codegen.Output) error {
+	recorded, err := codegen.ReadLock(cfg.Go.OutputDir)
+	if err != nil {
+		return err
+	}
+
+	current, err := codegen.NewLock(cmd.Root().Version, cfg, result.RawData, outputs, time.Now())
+	if err != nil {
+		return err
+	}
+
+	problems := recorded.Verify(current, cfg.Go.OutputDir)
+	if len(problems) > 0 {
+		for _, p := range problems {
+			cmd.PrintErrf("  %s\n", p)
+		}
+		return fmt.Errorf("generated code in %s does not match %s", cfg.Go.OutputDir, codegen.LockFilename)
+	}
+
+	infof(cmd, "Verified %d files against %s (eugene %s, generated %s)\n",
+		len(recorded.Files), codegen.LockFilename, recorded.Version, recorded.GeneratedAt.Format(time.RFC3339))
+	return nil
+}
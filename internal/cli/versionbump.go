@@ -0,0 +1,142 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/kolah/eugene/internal/loader"
+	"github.com/spf13/cobra"
+)
+
+func VersionBumpCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "version-bump <base-spec|-> <spec|->",
+		Short: "Recommend a SemVer bump from the changes between two specs",
+		Long: "Compares spec with base-spec and prints major, minor, patch or none: major for\n" +
+			"breaking changes, minor for additive ones and patch for documentation only.\n" +
+			"With --write, the bumped version is written to a file such as VERSION.",
+		Args: cobra.ExactArgs(2),
+		RunE: runVersionBump,
+	}
+	cmd.Flags().String("current", "", "Version to bump (default: the --write file, else info.version of base-spec)")
+	cmd.Flags().String("write", "", "Write the bumped version to this file")
+	cmd.Flags().String("format", "text", "Change report format: text, json, sarif")
+	return cmd
+}
+
+func runVersionBump(cmd *cobra.Command, args []string) error {
+	format, err := reportFormat(cmd)
+	if err != nil {
+		return err
+	}
+	if args[0] == "-" && args[1] == "-" {
+		return fmt.Errorf("only one of base-spec and spec can be read from stdin")
+	}
+	_, base, err := loadLintSpec(cmd, args[0])
+	if err != nil {
+		return err
+	}
+	result, next, err := loadLintSpec(cmd, args[1])
+	if err != nil {
+		return err
+	}
+
+	changes := loader.DiffSpecs(base, next)
+	bump := semverBump(loader.HighestChange(changes))
+	findings := changeFindings(specName(args[1]), result.RawData, changes)
+	switch format {
+	case "json":
+		err = writeJSON(cmd.OutOrStdout(), findings, map[string]any{"bump": bump})
+	case "sarif":
+		err = writeSARIF(cmd.OutOrStdout(), cmd.Root().Version, findings, map[string]any{"bump": bump})
+	default:
+		if quiet, _ := cmd.Flags().GetBool("quiet"); !quiet && len(findings) > 0 {
+			cmd.PrintErrf("%d change(s):\n", len(findings))
+			writeText(cmd.ErrOrStderr(), findings)
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), bump)
+	}
+	if err != nil {
+		return err
+	}
+
+	path, _ := cmd.Flags().GetString("write")
+	if path == "" {
+		return nil
+	}
+	current, _ := cmd.Flags().GetString("current")
+	if current == "" {
+		data, err := os.ReadFile(path)
+		switch {
+		case err == nil:
+			current = strings.TrimSpace(string(data))
+		case errors.Is(err, os.ErrNotExist):
+			current = base.Info.Version
+		default:
+			return err
+		}
+	}
+	bumped, err := bumpVersion(current, bump)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, []byte(bumped+"\n"), 0644); err != nil {
+		return fmt.Errorf("writing %s: %w", path, err)
+	}
+	infof(cmd, "Written: %s (%s -> %s)\n", path, current, bumped)
+	return nil
+}
+
+func semverBump(kind loader.ChangeKind) string {
+	switch kind {
+	case loader.ChangeBreaking:
+		return "major"
+	case loader.ChangeAdditive:
+		return "minor"
+	case loader.ChangeDocs:
+		return "patch"
+	}
+	return "none"
+}
+
+// bumpVersion applies bump to a MAJOR.MINOR.PATCH version, keeping a leading
+// "v" and dropping any pre-release or build suffix. Below 1.0.0 every level
+// moves down one place, so breaking changes bump the minor version.
+func bumpVersion(version, bump string) (string, error) {
+	prefix := ""
+	core := version
+	if rest, ok := strings.CutPrefix(core, "v"); ok {
+		prefix, core = "v", rest
+	}
+	if i := strings.IndexAny(core, "-+"); i >= 0 {
+		core = core[:i]
+	}
+	parts := strings.Split(core, ".")
+	if len(parts) != 3 {
+		return "", fmt.Errorf("version %q is not MAJOR.MINOR.PATCH", version)
+	}
+	var n [3]int
+	for i, p := range parts {
+		v, err := strconv.Atoi(p)
+		if err != nil || v < 0 {
+			return "", fmt.Errorf("version %q is not MAJOR.MINOR.PATCH", version)
+		}
+		n[i] = v
+	}
+
+	if bump == "none" {
+		return version, nil
+	}
+	level := map[string]int{"major": 0, "minor": 1, "patch": 2}[bump]
+	if n[0] == 0 && level < 2 {
+		level++
+	}
+	n[level]++
+	for i := level + 1; i < 3; i++ {
+		n[i] = 0
+	}
+	return fmt.Sprintf("%s%d.%d.%d", prefix, n[0], n[1], n[2]), nil
+}
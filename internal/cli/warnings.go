@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/kolah/eugene/internal/config"
+	"github.com/kolah/eugene/internal/model"
+	"github.com/spf13/cobra"
+)
+
+// printWarnings writes the unsupported-construct report as a table on stderr.
+func printWarnings(cmd *cobra.Command, warnings []model.Warning) {
+	if len(warnings) == 0 {
+		return
+	}
+	cmd.PrintErrf("\n%d unsupported construct(s):\n", len(warnings))
+	w := tabwriter.NewWriter(cmd.ErrOrStderr(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  KIND\tLOCATION\tMESSAGE")
+	for _, warning := range warnings {
+		fmt.Fprintf(w, "  %s\t%s\t%s\n", warning.Kind, warning.Location, warning.Message)
+	}
+	w.Flush()
+}
+
+// failingWarnings returns the warnings that fail generation under cfg.
+func failingWarnings(cfg *config.Config, warnings []model.Warning) []model.Warning {
+	var failing []model.Warning
+	for _, w := range warnings {
+		if cfg.FailsOn(w.Kind) {
+			failing = append(failing, w)
+		}
+	}
+	return failing
+}
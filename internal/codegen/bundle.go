@@ -0,0 +1,93 @@
+package codegen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+
+	"github.com/kolah/eugene/internal/golang"
+)
+
+// BundleFilename returns the name of the single-file bundle for a package.
+func BundleFilename(pkg string) string {
+	return pkg + ".eugene.go"
+}
+
+// Bundle concatenates all *.eugene.go outputs into a single file with merged
+// imports. User-owned companion files are returned unchanged after the bundle.
+func Bundle(pkg string, outputs []Output) ([]Output, error) {
+	var (
+		bodies    []string
+		imports   []string
+		seen      = make(map[string]bool)
+		companion []Output
+		doc       string
+	)
+
+	for _, out := range outputs {
+		if !strings.HasSuffix(out.Filename, ".eugene.go") {
+			companion = append(companion, out)
+			continue
+		}
+
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, out.Filename, out.Content, parser.ParseComments|parser.ImportsOnly)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", out.Filename, err)
+		}
+		if file.Doc != nil {
+			doc = out.Content[fset.Position(file.Doc.Pos()).Offset:fset.Position(file.Doc.End()).Offset] + "\n"
+		}
+
+		for _, spec := range file.Imports {
+			line := spec.Path.Value
+			if spec.Name != nil {
+				line = spec.Name.Name + " " + line
+			}
+			if !seen[line] {
+				seen[line] = true
+				imports = append(imports, line)
+			}
+		}
+
+		// The body starts after the last import declaration, or after the
+		// package clause when the file has no imports.
+		end := file.Name.End()
+		for _, decl := range file.Decls {
+			if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.IMPORT {
+				end = gen.End()
+			}
+		}
+		body := strings.TrimSpace(out.Content[fset.Position(end).Offset:])
+		if body != "" {
+			bodies = append(bodies, fmt.Sprintf("// --- %s ---\n\n%s", strings.TrimSuffix(out.Filename, ".eugene.go"), body))
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("// Code generated by eugene. DO NOT EDIT.\n\n")
+	// The package comment of doc.eugene.go stays the package comment
+	b.WriteString(doc)
+	fmt.Fprintf(&b, "package %s\n", pkg)
+	if len(imports) > 0 {
+		b.WriteString("\nimport (\n")
+		for _, imp := range imports {
+			fmt.Fprintf(&b, "\t%s\n", imp)
+		}
+		b.WriteString(")\n")
+	}
+	for _, body := range bodies {
+		b.WriteString("\n")
+		b.WriteString(body)
+		b.WriteString("\n")
+	}
+
+	formatted, err := golang.Format([]byte(b.String()))
+	if err != nil {
+		return nil, fmt.Errorf("formatting bundle: %w", err)
+	}
+
+	return append([]Output{{Filename: BundleFilename(pkg), Content: string(formatted)}}, companion...), nil
+}
@@ -0,0 +1,166 @@
+package codegen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Conflict is an identifier declared both by generated code and by a
+// hand-written file in the same package.
+type Conflict struct {
+	Name      string // identifier, or Type.Method for methods
+	Generated string // generated file declaring it
+	Position  string // file:line of the hand-written declaration
+}
+
+func (c Conflict) String() string {
+	return fmt.Sprintf("%s: %s is also generated in %s", c.Position, c.Name, c.Generated)
+}
+
+// FindConflicts parses the given hand-written files and reports top-level
+// identifiers that collide with declarations in the generated outputs.
+// Files belonging to a different package are ignored.
+func FindConflicts(pkg string, outputs []Output, files []string) ([]Conflict, error) {
+	generated := make(map[string]string)
+	for _, out := range outputs {
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, out.Filename, out.Content, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", out.Filename, err)
+		}
+		for _, name := range declaredNames(file) {
+			generated[name.name] = out.Filename
+		}
+	}
+
+	var conflicts []Conflict
+	for _, path := range files {
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", path, err)
+		}
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, path, src, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", path, err)
+		}
+		if file.Name.Name != pkg {
+			continue
+		}
+		for _, name := range declaredNames(file) {
+			if gen, ok := generated[name.name]; ok {
+				pos := fset.Position(name.pos)
+				conflicts = append(conflicts, Conflict{
+					Name:      name.name,
+					Generated: gen,
+					Position:  fmt.Sprintf("%s:%d", filepath.Base(pos.Filename), pos.Line),
+				})
+			}
+		}
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].Position < conflicts[j].Position
+	})
+	return conflicts, nil
+}
+
+// checkDeclarations fails when two generated files of a package declare the
+// same identifier, as a schema named like a helper of another target would;
+// formatting does not catch it and the package would not compile.
+func checkDeclarations(outputs []Output) error {
+	declared := make(map[string]string) // package and identifier to file
+	for _, out := range outputs {
+		if !strings.HasSuffix(out.Filename, ".go") {
+			continue
+		}
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, out.Filename, out.Content, parser.SkipObjectResolution)
+		if err != nil {
+			return fmt.Errorf("parsing %s: %w", out.Filename, err)
+		}
+		pkg := filepath.Join(filepath.Dir(out.Filename), file.Name.Name)
+		for _, name := range declaredNames(file) {
+			if name.name == "init" {
+				continue
+			}
+			key := pkg + " " + name.name
+			if prev, ok := declared[key]; ok && prev != out.Filename {
+				return fmt.Errorf("%s and %s both declare %s; rename the schema or operation it comes from", prev, out.Filename, name.name)
+			}
+			declared[key] = out.Filename
+		}
+	}
+	return nil
+}
+
+type declaredName struct {
+	name string
+	pos  token.Pos
+}
+
+// declaredNames lists package-level identifiers and methods (as Type.Method).
+func declaredNames(file *ast.File) []declaredName {
+	var names []declaredName
+	add := func(ident *ast.Ident) {
+		if ident != nil && ident.Name != "_" {
+			names = append(names, declaredName{name: ident.Name, pos: ident.Pos()})
+		}
+	}
+
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if d.Recv == nil || len(d.Recv.List) == 0 {
+				if d.Name.Name != "init" {
+					add(d.Name)
+				}
+				continue
+			}
+			if recv := receiverName(d.Recv.List[0].Type); recv != "" {
+				names = append(names, declaredName{name: recv + "." + d.Name.Name, pos: d.Name.Pos()})
+			}
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					add(s.Name)
+				case *ast.ValueSpec:
+					for _, n := range s.Names {
+						add(n)
+					}
+				}
+			}
+		}
+	}
+	return names
+}
+
+func receiverName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.StarExpr:
+		return receiverName(e.X)
+	case *ast.IndexExpr:
+		return receiverName(e.X)
+	case *ast.IndexListExpr:
+		return receiverName(e.X)
+	case *ast.Ident:
+		return e.Name
+	}
+	return ""
+}
+
+// FormatConflicts renders conflicts as a multi-line error message.
+func FormatConflicts(conflicts []Conflict) string {
+	lines := make([]string, len(conflicts))
+	for i, c := range conflicts {
+		lines[i] = "  " + c.String()
+	}
+	return strings.Join(lines, "\n")
+}
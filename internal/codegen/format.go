@@ -0,0 +1,60 @@
+package codegen
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+
+	"github.com/kolah/eugene/internal/golang"
+)
+
+// formatter formats rendered files in the background as targets produce
+// them. At most GOMAXPROCS files are formatted at once and add blocks while
+// all workers are busy, so unformatted sources are released as soon as
+// their formatted form exists instead of piling up.
+type formatter struct {
+	cache *golang.FormatCache
+	sem   chan struct{}
+	wg    sync.WaitGroup
+	files []*formattedFile
+}
+
+type formattedFile struct {
+	out Output
+	err error
+}
+
+func newFormatter(cache *golang.FormatCache) *formatter {
+	return &formatter{cache: cache, sem: make(chan struct{}, runtime.GOMAXPROCS(0))}
+}
+
+// add queues content for formatting as filename. label names the target in
+// error messages.
+func (f *formatter) add(label, filename, content string) {
+	file := &formattedFile{}
+	f.files = append(f.files, file)
+	f.sem <- struct{}{}
+	f.wg.Go(func() {
+		defer func() { <-f.sem }()
+		formatted, err := f.cache.Format([]byte(content))
+		if err != nil {
+			file.err = fmt.Errorf("formatting %s: %w", label, err)
+			return
+		}
+		file.out = Output{Filename: filename, Content: string(formatted)}
+	})
+}
+
+// wait returns the formatted files in the order they were added, or the
+// error of the first file that failed.
+func (f *formatter) wait() ([]Output, error) {
+	f.wg.Wait()
+	outputs := make([]Output, 0, len(f.files))
+	for _, file := range f.files {
+		if file.err != nil {
+			return nil, file.err
+		}
+		outputs = append(outputs, file.out)
+	}
+	return outputs, nil
+}
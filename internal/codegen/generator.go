@@ -2,16 +2,23 @@ package codegen
 
 import (
 	"fmt"
+	"log/slog"
+	"maps"
 
 	"github.com/kolah/eugene/internal/config"
 	"github.com/kolah/eugene/internal/golang"
+	"github.com/kolah/eugene/internal/jvm"
 	"github.com/kolah/eugene/internal/model"
 	"github.com/kolah/eugene/internal/targets/client"
+	doctarget "github.com/kolah/eugene/internal/targets/doc"
+	"github.com/kolah/eugene/internal/targets/events"
+	"github.com/kolah/eugene/internal/targets/loadtest"
 	"github.com/kolah/eugene/internal/targets/server"
 	spectarget "github.com/kolah/eugene/internal/targets/spec"
 	"github.com/kolah/eugene/internal/targets/strictserver"
 	"github.com/kolah/eugene/internal/targets/types"
 	"github.com/kolah/eugene/internal/templates"
+	"github.com/kolah/eugene/internal/typescript"
 	embeddedtmpl "github.com/kolah/eugene/templates"
 )
 
@@ -19,7 +26,9 @@ type Generator struct {
 	config        *config.Config
 	engine        templates.Engine
 	registry      *golang.EnumRegistry
+	formatCache   *golang.FormatCache
 	resolverState *golang.TemplateResolverState
+	toolVersion   string
 }
 
 type Output struct {
@@ -27,183 +36,411 @@ type Output struct {
 	Content  string
 }
 
+// MainFilename is the server binary scaffolded by the main target, relative
+// to the output directory.
+const MainFilename = "cmd/server/main.go"
+
 func New(cfg *config.Config) (*Generator, error) {
 	if len(cfg.Go.OutputOptions.AdditionalInitialisms) > 0 {
 		golang.SetAdditionalInitialisms(cfg.Go.OutputOptions.AdditionalInitialisms)
 	}
 
+	golang.SetFormatOptions(golang.FormatOptions{
+		LocalPrefix:           cfg.Go.OutputOptions.LocalPrefix,
+		DisableImportGrouping: cfg.Go.OutputOptions.DisableImportGrouping,
+	})
+	golang.SetFieldOrder(cfg.Go.OutputOptions.SortFields)
+
 	funcs, resolverState := golang.TemplateFuncsWithResolver(&cfg.Go.Types)
-	engine, err := templates.NewEngine(embeddedtmpl.FS, cfg.Templates.Dir, funcs)
+	// Every template is parsed up front, the ts and jvm ones with their own helpers
+	maps.Copy(funcs, typescript.TemplateFuncs())
+	maps.Copy(funcs, jvm.TemplateFuncs())
+	opts := templates.Options{
+		CustomDir:  cfg.Templates.Dir,
+		MissingKey: cfg.Templates.MissingKey,
+	}
+	if len(cfg.Templates.Delims) == 2 {
+		opts.LeftDelim, opts.RightDelim = cfg.Templates.Delims[0], cfg.Templates.Delims[1]
+	}
+	engine, err := templates.NewEngine(embeddedtmpl.FS, opts, funcs)
 	if err != nil {
 		return nil, fmt.Errorf("creating template engine: %w", err)
 	}
+	if cfg.Templates.DebugDir != "" {
+		engine.SetDebugDir(cfg.Templates.DebugDir)
+	}
+
+	formatCache, err := golang.OpenFormatCache(golang.DefaultFormatCacheDir())
+	if err != nil {
+		// Formatting works the same without the cache, only slower
+		slog.Debug("format cache disabled", "error", err)
+	}
 
 	return &Generator{
 		config:        cfg,
 		engine:        engine,
+		formatCache:   formatCache,
 		resolverState: resolverState,
 	}, nil
 }
 
+// SetToolVersion records the eugene release in generated code that reports
+// it, such as the client's default User-Agent.
+func (g *Generator) SetToolVersion(version string) {
+	g.toolVersion = version
+}
+
 func (g *Generator) Generate(spec *model.Spec, specData []byte) ([]Output, error) {
-	var outputs []Output
+	var routePath func(string) string
+	if g.config.HasTarget("server") || g.config.HasTarget("strict-server") {
+		target, err := server.New(g.config.Go.ServerFramework)
+		if err != nil {
+			return nil, err
+		}
+		routePath = target.RoutePath
+	}
+	if err := CheckOperations(spec.Operations, routePath); err != nil {
+		return nil, err
+	}
+	var routePrefix string
+	if g.config.Go.OutputOptions.PrefixVariables {
+		prefix, err := pathPrefix(spec)
+		if err != nil {
+			return nil, err
+		}
+		routePrefix = prefix.Path
+	}
+	if g.config.Go.OutputOptions.HealthEndpoints && routePath != nil {
+		if err := checkHealthEndpoints(spec.Operations, routePrefix); err != nil {
+			return nil, err
+		}
+	}
+	if g.config.Go.Types.AllOfStrategy == "flatten" {
+		if err := CheckAllOfConflicts(spec); err != nil {
+			return nil, err
+		}
+	}
 
-	g.registry = golang.NewEnumRegistry()
-	g.collectEnums(spec)
+	golang.SortSpecFields(spec)
 
-	var schemaNames []string
-	for _, s := range spec.Schemas {
-		schemaNames = append(schemaNames, golang.PascalCase(s.Name))
+	g.registry = golang.NewSpecEnumRegistry(spec)
+	g.resolverState.SetRegistry(g.registry)
+
+	if g.config.Go.OutputOptions.SplitByTag {
+		return g.generateSplit(spec, specData)
+	}
+	outputs, err := g.render(spec, specData, g.config.Go.Package, g.config.HasTarget)
+	if err != nil {
+		return nil, err
 	}
-	g.registry.AddReservedNames(schemaNames...)
+	return g.renderDoc(outputs, spec, g.config.Go.Package, "")
+}
 
-	var opNames []string
-	for _, op := range spec.Operations {
-		base := golang.PascalCase(op.ID)
-		opNames = append(opNames, base+"Response", base+"Request", base+"Params")
-		opNames = append(opNames, base+"MultipartRequest", base+"FormRequest", base+"QueryParams")
-		opNames = append(opNames, base+"RequestObject", base+"ResponseObject")
-		for _, r := range op.Responses {
-			opNames = append(opNames, base+r.StatusCode+"Response", base+r.StatusCode+"JSONResponse")
-		}
+// renderDoc adds doc.eugene.go, the package documentation of pkg, to outputs
+// when package-doc is set. parent is the package of the shared types of a
+// split-by-tag subpackage.
+func (g *Generator) renderDoc(outputs []Output, spec *model.Spec, pkg, parent string) ([]Output, error) {
+	if !g.config.Go.OutputOptions.PackageDoc {
+		return outputs, nil
+	}
+	content, err := doctarget.New().Generate(g.engine, spec, pkg, parent)
+	if err != nil {
+		return nil, fmt.Errorf("generating package doc: %w", err)
 	}
-	g.registry.AddReservedNames(opNames...)
+	files := newFormatter(g.formatCache)
+	files.add("package doc", "doc.eugene.go", content)
+	doc, err := files.wait()
+	if err != nil {
+		return nil, err
+	}
+	return append(outputs, doc...), nil
+}
 
-	g.registry.ResolveNames()
-	g.resolverState.SetRegistry(g.registry)
+// render runs the targets selected by hasTarget over spec, producing the
+// files of one package. Templates execute in order, since targets share the
+// enum registry and resolver state; each result is formatted in the
+// background while the next target renders.
+func (g *Generator) render(spec *model.Spec, specData []byte, pkg string, hasTarget func(string) bool) ([]Output, error) {
+	files := newFormatter(g.formatCache)
+	defer files.wait()
 
-	if g.config.Go.ServerFramework == "echo" && (g.config.HasTarget("server") || g.config.HasTarget("strict-server")) {
-		content, err := g.engine.Execute("go/server/echo_router.tmpl", map[string]string{"Package": g.config.Go.Package})
+	if g.config.Go.ServerFramework == "echo" && (hasTarget("server") || hasTarget("strict-server")) {
+		content, err := g.engine.Execute("go/server/echo_router.tmpl", map[string]string{"Package": pkg})
 		if err != nil {
 			return nil, fmt.Errorf("generating router: %w", err)
 		}
-		formatted, err := golang.Format([]byte(content))
+		files.add("router", "router.eugene.go", content)
+	}
+
+	if hasTarget("server") || hasTarget("strict-server") {
+		content, err := g.engine.Execute("go/server/operation.tmpl", map[string]string{"Package": pkg})
 		if err != nil {
-			return nil, fmt.Errorf("formatting router: %w", err)
+			return nil, fmt.Errorf("generating operation context: %w", err)
 		}
-		outputs = append(outputs, Output{
-			Filename: "router.eugene.go",
-			Content:  string(formatted),
+		files.add("operation context", "operation.eugene.go", content)
+
+		content, err = g.engine.Execute("go/server/body.tmpl", map[string]any{
+			"Package":               pkg,
+			"MaxBody":               g.config.MaxBody(),
+			"DisallowUnknownFields": g.config.Go.OutputOptions.DisallowUnknownFields,
 		})
+		if err != nil {
+			return nil, fmt.Errorf("generating request body decoding: %w", err)
+		}
+		files.add("request body decoding", "body.eugene.go", content)
+
+		if g.config.Go.OutputOptions.ExampleChecks {
+			content, err := server.GenerateExampleChecks(g.engine, spec, pkg)
+			if err != nil {
+				return nil, fmt.Errorf("generating example checks: %w", err)
+			}
+			files.add("example checks", "example_checks.eugene.go", content)
+		}
+
+		if g.config.Go.OutputOptions.HealthEndpoints {
+			content, err := g.engine.Execute("go/server/health.tmpl", map[string]string{
+				"Package":   pkg,
+				"Framework": g.config.Go.ServerFramework,
+				"Title":     spec.Info.Title,
+				"Version":   spec.Info.Version,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("generating health endpoints: %w", err)
+			}
+			files.add("health endpoints", "health.eugene.go", content)
+		}
+
+		if g.config.Go.OutputOptions.Permissions {
+			content, err := server.GeneratePermissions(g.engine, spec, pkg)
+			if err != nil {
+				return nil, fmt.Errorf("generating permissions: %w", err)
+			}
+			files.add("permissions", "permissions.eugene.go", content)
+		}
+
+		if g.config.Go.OutputOptions.PrefixVariables {
+			prefix, err := pathPrefix(spec)
+			if err != nil {
+				return nil, err
+			}
+			content, err := server.GeneratePrefix(g.engine, prefix, pkg, g.config.Go.ServerFramework)
+			if err != nil {
+				return nil, fmt.Errorf("generating path prefix: %w", err)
+			}
+			files.add("path prefix", "prefix.eugene.go", content)
+		}
+
+		// The operations are served under the path of the server URL with
+		// prefix-variables
+		var routePrefix string
+		if g.config.Go.OutputOptions.PrefixVariables {
+			p, err := pathPrefix(spec)
+			if err != nil {
+				return nil, err
+			}
+			routePrefix = p.Path
+		}
+
+		if g.config.Go.OutputOptions.RouteDrift {
+			content, err := server.GenerateRoutes(g.engine, spec, pkg, g.config.Go.ServerFramework, routePrefix, g.config.Go.OutputOptions.HealthEndpoints)
+			if err != nil {
+				return nil, fmt.Errorf("generating route drift check: %w", err)
+			}
+			files.add("route drift check", "routes.eugene.go", content)
+		}
+
+		if g.config.Go.OutputOptions.CORS {
+			content, err := server.GenerateCORS(g.engine, spec, pkg, routePrefix)
+			if err != nil {
+				return nil, fmt.Errorf("generating cors middleware: %w", err)
+			}
+			files.add("cors middleware", "cors.eugene.go", content)
+		}
 	}
 
-	if g.config.HasTarget("types") {
+	if hasTarget("types") {
 		target := types.New()
-		content, err := target.Generate(g.engine, spec, g.config.Go.Package, &g.config.Go.Types, &g.config.Go.OutputOptions, g.config.Go.ImportMapping, g.registry)
+		content, err := target.Generate(g.engine, spec, pkg, &g.config.Go.Types, &g.config.Go.OutputOptions, g.config.Go.ImportMapping, g.registry)
 		if err != nil {
 			return nil, fmt.Errorf("generating types: %w", err)
 		}
-		formatted, err := golang.Format([]byte(content))
+		files.add("types", "types.eugene.go", content)
+
+		marshalContent, err := target.GenerateMarshal(g.engine, spec, pkg)
 		if err != nil {
-			return nil, fmt.Errorf("formatting types: %w", err)
+			return nil, fmt.Errorf("generating marshal stubs: %w", err)
+		}
+		if marshalContent != "" {
+			files.add("marshal stubs", "types_marshal.go", marshalContent)
+		}
+
+		if g.config.Go.OutputOptions.ConstraintConstants {
+			content, err := target.GenerateConstraints(g.engine, spec, pkg)
+			if err != nil {
+				return nil, fmt.Errorf("generating constraint constants: %w", err)
+			}
+			if content != "" {
+				files.add("constraint constants", "constraints.eugene.go", content)
+			}
 		}
-		outputs = append(outputs, Output{
-			Filename: "types.eugene.go",
-			Content:  string(formatted),
-		})
 	}
 
-	if g.config.HasTarget("server") {
+	if hasTarget("server") {
 		target, err := server.New(g.config.Go.ServerFramework)
 		if err != nil {
 			return nil, err
 		}
-		content, err := target.Generate(g.engine, spec, g.config.Go.Package, &g.config.Go.Types, g.registry)
+		content, err := target.Generate(g.engine, spec, pkg, &g.config.Go.Types, &g.config.Go.OutputOptions, g.registry)
 		if err != nil {
 			return nil, fmt.Errorf("generating server: %w", err)
 		}
-		formatted, err := golang.Format([]byte(content))
-		if err != nil {
-			return nil, fmt.Errorf("formatting server: %w", err)
-		}
-		outputs = append(outputs, Output{
-			Filename: "server.eugene.go",
-			Content:  string(formatted),
-		})
+		files.add("server", "server.eugene.go", content)
 	}
 
-	if g.config.HasTarget("strict-server") {
+	if hasTarget("strict-server") {
 		target, err := strictserver.New(g.config.Go.ServerFramework)
 		if err != nil {
 			return nil, err
 		}
-		typesContent, err := target.GenerateTypes(g.engine, spec, g.config.Go.Package, &g.config.Go.Types, g.registry)
+		typesContent, err := target.GenerateTypes(g.engine, spec, pkg, &g.config.Go.Types, g.registry)
 		if err != nil {
 			return nil, fmt.Errorf("generating strict types: %w", err)
 		}
-		typesFormatted, err := golang.Format([]byte(typesContent))
-		if err != nil {
-			return nil, fmt.Errorf("formatting strict types: %w", err)
-		}
-		outputs = append(outputs, Output{
-			Filename: "strict_types.eugene.go",
-			Content:  string(typesFormatted),
-		})
-		adapterContent, err := target.GenerateAdapter(g.engine, spec, g.config.Go.Package, &g.config.Go.Types, g.registry)
+		files.add("strict types", "strict_types.eugene.go", typesContent)
+		adapterContent, err := target.GenerateAdapter(g.engine, spec, pkg, &g.config.Go.Types, &g.config.Go.OutputOptions, g.registry)
 		if err != nil {
 			return nil, fmt.Errorf("generating strict adapter: %w", err)
 		}
-		adapterFormatted, err := golang.Format([]byte(adapterContent))
-		if err != nil {
-			return nil, fmt.Errorf("formatting strict adapter: %w", err)
+		files.add("strict adapter", "strict_server.eugene.go", adapterContent)
+		if g.config.Go.OutputOptions.StrictValidation {
+			validationContent, err := target.GenerateValidation(g.engine, spec, pkg, &g.config.Go.Types, g.registry)
+			if err != nil {
+				return nil, fmt.Errorf("generating strict validation: %w", err)
+			}
+			files.add("strict validation", "strict_validation.eugene.go", validationContent)
 		}
-		outputs = append(outputs, Output{
-			Filename: "strict_server.eugene.go",
-			Content:  string(adapterFormatted),
-		})
 	}
 
-	if g.config.HasTarget("client") {
-		target := client.New()
-		content, err := target.Generate(g.engine, spec, g.config.Go.Package)
+	// The client and tools targets share the operation data built for spec
+	clientTarget := client.New(g.toolVersion)
+	if hasTarget("client") {
+		content, err := clientTarget.Generate(g.engine, spec, pkg, &g.config.Go.OutputOptions)
 		if err != nil {
 			return nil, fmt.Errorf("generating client: %w", err)
 		}
-		formatted, err := golang.Format([]byte(content))
+		files.add("client", "client.eugene.go", content)
+
+		if g.config.Go.OutputOptions.ClientMock {
+			content, err := clientTarget.GenerateMock(g.engine, spec, pkg, &g.config.Go.OutputOptions)
+			if err != nil {
+				return nil, fmt.Errorf("generating client mock: %w", err)
+			}
+			files.add("client mock", "client_mock.eugene.go", content)
+		}
+
+		if g.config.Go.OutputOptions.VCR {
+			content, err := clientTarget.GenerateVCR(g.engine, spec, pkg, &g.config.Go.OutputOptions)
+			if err != nil {
+				return nil, fmt.Errorf("generating vcr transport: %w", err)
+			}
+			files.add("vcr transport", "vcr.eugene.go", content)
+		}
+	}
+
+	if hasTarget("tools") {
+		content, err := clientTarget.GenerateTools(g.engine, spec, pkg, &g.config.Go.OutputOptions)
 		if err != nil {
-			return nil, fmt.Errorf("formatting client: %w", err)
+			return nil, fmt.Errorf("generating tools: %w", err)
 		}
-		outputs = append(outputs, Output{
-			Filename: "client.eugene.go",
-			Content:  string(formatted),
-		})
+		files.add("tools", "tools.eugene.go", content)
 	}
 
-	if g.config.HasTarget("spec") {
-		target := spectarget.New()
-		content, err := target.Generate(g.engine, specData, g.config.Go.Package)
+	if g.config.Go.OutputOptions.TestHelpers && hasTarget("client") && (hasTarget("server") || hasTarget("strict-server")) {
+		content, err := clientTarget.GenerateTestHelpers(g.engine, spec, pkg, &g.config.Go.OutputOptions,
+			g.config.Go.ServerFramework, hasTarget("server"), hasTarget("strict-server"))
 		if err != nil {
-			return nil, fmt.Errorf("generating spec: %w", err)
+			return nil, fmt.Errorf("generating test helpers: %w", err)
+		}
+		files.add("test helpers", "testing.eugene.go", content)
+	}
+
+	if g.config.Go.OutputOptions.Examples {
+		if hasTarget("client") {
+			content, err := clientTarget.GenerateExamples(g.engine, spec, pkg, &g.config.Go.OutputOptions)
+			if err != nil {
+				return nil, fmt.Errorf("generating client examples: %w", err)
+			}
+			files.add("client examples", "client_example_test.go", content)
+		}
+		if hasTarget("server") || hasTarget("strict-server") {
+			content, err := g.engine.Execute("go/examples_server.tmpl", map[string]any{
+				"Package":   pkg,
+				"Framework": g.config.Go.ServerFramework,
+				"Server":    hasTarget("server"),
+				"Strict":    hasTarget("strict-server"),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("generating server examples: %w", err)
+			}
+			files.add("server examples", "server_example_test.go", content)
 		}
-		formatted, err := golang.Format([]byte(content))
+	}
+
+	if hasTarget("main") {
+		importPath, err := packageImportPath(g.config.Go.OutputDir, g.config.Go.OutputOptions.InitModule)
 		if err != nil {
-			return nil, fmt.Errorf("formatting spec: %w", err)
+			return nil, fmt.Errorf("main: %w", err)
 		}
-		outputs = append(outputs, Output{
-			Filename: "spec.eugene.go",
-			Content:  string(formatted),
+		title := spec.Info.Title
+		if title == "" {
+			title = pkg
+		}
+		content, err := g.engine.Execute("go/main.tmpl", map[string]any{
+			"Title":      title,
+			"Package":    pkg,
+			"ImportPath": importPath,
+			"Framework":  g.config.Go.ServerFramework,
+			"Strict":     hasTarget("strict-server"),
 		})
+		if err != nil {
+			return nil, fmt.Errorf("generating main: %w", err)
+		}
+		files.add("main", MainFilename, content)
 	}
 
-	return outputs, nil
-}
+	if hasTarget("loadtest") {
+		target := loadtest.New()
+		content, err := target.Generate(g.engine, spec, pkg)
+		if err != nil {
+			return nil, fmt.Errorf("generating load test: %w", err)
+		}
+		files.add("load test", "loadtest.eugene.go", content)
+	}
 
-// collectEnums walks the spec and collects all enum usages for stable naming.
-func (g *Generator) collectEnums(spec *model.Spec) {
-	// Collect from operation parameters
-	for _, op := range spec.Operations {
-		for _, p := range op.Parameters {
-			if p.Schema != nil && len(p.Schema.Enum) > 0 {
-				g.registry.CollectEnum(p.Name, op.ID, p.Schema.Enum)
-			}
+	if hasTarget("events") {
+		target := events.New()
+		content, err := target.Generate(g.engine, spec, pkg)
+		if err != nil {
+			return nil, fmt.Errorf("generating events: %w", err)
 		}
+		files.add("events", "events.eugene.go", content)
 	}
 
-	for _, s := range spec.Schemas {
-		for _, prop := range s.Properties {
-			if prop.Schema != nil && len(prop.Schema.Enum) > 0 {
-				g.registry.CollectEnum(prop.Name, s.Name, prop.Schema.Enum)
-			}
+	if hasTarget("spec") {
+		target := spectarget.New()
+		content, err := target.Generate(g.engine, specData, pkg)
+		if err != nil {
+			return nil, fmt.Errorf("generating spec: %w", err)
 		}
+		files.add("spec", "spec.eugene.go", content)
 	}
+
+	outputs, err := files.wait()
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDeclarations(outputs); err != nil {
+		return nil, err
+	}
+	return outputs, nil
 }
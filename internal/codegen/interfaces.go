@@ -0,0 +1,111 @@
+package codegen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// handlerInterfaces are the generated interfaces that hand-written servers
+// implement.
+var handlerInterfaces = []string{"ServerInterface", "StrictServerInterface"}
+
+// OperationChange is a method added to or removed from a generated handler
+// interface since the files in the output directory were generated.
+type OperationChange struct {
+	Interface string // ServerInterface, or pets.ServerInterface in a split-by-tag package
+	Method    string
+	Added     bool
+}
+
+func (c OperationChange) String() string {
+	sign := "+"
+	if !c.Added {
+		sign = "-"
+	}
+	return fmt.Sprintf("%s %s.%s", sign, c.Interface, c.Method)
+}
+
+// DiffOperations compares the methods of the handler interfaces that outputs
+// declare with those of the same files in dir. Added methods come first, in
+// declaration order, then removed ones.
+func DiffOperations(dir string, outputs []Output) ([]OperationChange, error) {
+	before := make(map[string][]string)
+	after := make(map[string][]string)
+	for _, out := range outputs {
+		if !strings.HasSuffix(out.Filename, ".eugene.go") {
+			continue
+		}
+		if err := interfaceMethods(out.Filename, out.Content, after); err != nil {
+			return nil, err
+		}
+		data, err := os.ReadFile(filepath.Join(dir, out.Filename))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := interfaceMethods(out.Filename, data, before); err != nil {
+			return nil, err
+		}
+	}
+
+	var added, removed []OperationChange
+	for _, name := range slices.Sorted(maps.Keys(after)) {
+		for _, m := range after[name] {
+			if !slices.Contains(before[name], m) {
+				added = append(added, OperationChange{Interface: name, Method: m, Added: true})
+			}
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(before)) {
+		for _, m := range before[name] {
+			if !slices.Contains(after[name], m) {
+				removed = append(removed, OperationChange{Interface: name, Method: m})
+			}
+		}
+	}
+	return append(added, removed...), nil
+}
+
+// interfaceMethods adds the methods of the handler interfaces declared in a
+// generated file to methods, keyed by the interface name qualified with the
+// subpackage the file is in.
+func interfaceMethods(filename string, src any, methods map[string][]string) error {
+	file, err := parser.ParseFile(token.NewFileSet(), filename, src, parser.SkipObjectResolution)
+	if err != nil {
+		return fmt.Errorf("parsing %s: %w", filename, err)
+	}
+	prefix := ""
+	if dir := path.Dir(filepath.ToSlash(filename)); dir != "." {
+		prefix = path.Base(dir) + "."
+	}
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			ts := spec.(*ast.TypeSpec)
+			iface, ok := ts.Type.(*ast.InterfaceType)
+			if !ok || !slices.Contains(handlerInterfaces, ts.Name.Name) {
+				continue
+			}
+			key := prefix + ts.Name.Name
+			for _, field := range iface.Methods.List {
+				for _, name := range field.Names {
+					methods[key] = append(methods[key], name.Name)
+				}
+			}
+		}
+	}
+	return nil
+}
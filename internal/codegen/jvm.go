@@ -0,0 +1,27 @@
+package codegen
+
+import (
+	"fmt"
+
+	"github.com/kolah/eugene/internal/jvm"
+	"github.com/kolah/eugene/internal/model"
+	"github.com/kolah/eugene/internal/targets/jvmtypes"
+)
+
+// GenerateJVM renders the component schemas of spec as Kotlin, into
+// Types.kt, or as Java, into a file per record or enum.
+func (g *Generator) GenerateJVM(spec *model.Spec) ([]Output, error) {
+	dialect := jvm.Kotlin
+	if g.config.JVM.Language == string(jvm.Java) {
+		dialect = jvm.Java
+	}
+	files, err := jvmtypes.New().Generate(g.engine, spec, dialect, g.config.JVM.Package)
+	if err != nil {
+		return nil, fmt.Errorf("generating %s types: %w", dialect, err)
+	}
+	outputs := make([]Output, 0, len(files))
+	for _, f := range files {
+		outputs = append(outputs, Output{Filename: f.Filename, Content: f.Content})
+	}
+	return outputs, nil
+}
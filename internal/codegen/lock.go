@@ -0,0 +1,161 @@
+package codegen
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/kolah/eugene/internal/config"
+)
+
+// LockFilename is the name of the generation metadata file in the output directory.
+const LockFilename = "eugene.lock"
+
+// Lock records what produced a set of generated files, for audits and
+// for `eugene verify`.
+type Lock struct {
+	Version        string            `json:"version"`
+	GeneratedAt    time.Time         `json:"generated_at"`
+	Spec           string            `json:"spec"`
+	SpecSHA256     string            `json:"spec_sha256"`
+	AsyncAPI       string            `json:"asyncapi,omitempty"`
+	AsyncAPISHA256 string            `json:"asyncapi_sha256,omitempty"`
+	ConfigSHA256   string            `json:"config_sha256"`
+	Targets        []string          `json:"targets,omitempty"`
+	Files          map[string]string `json:"files"`
+}
+
+// NewLock builds the lock for outputs generated from specData with cfg.
+// The AsyncAPI document of cfg is read and hashed as well. Only *.eugene.go
+// files are recorded; companion files are user-owned.
+func NewLock(version string, cfg *config.Config, specData []byte, outputs []Output, now time.Time) (*Lock, error) {
+	cfgHash, err := configHash(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := cfg.Spec
+	if cfg.Schema != "" {
+		spec = cfg.Schema
+	}
+	lock := &Lock{
+		Version:      version,
+		GeneratedAt:  now.UTC().Truncate(time.Second),
+		Spec:         spec,
+		SpecSHA256:   hashBytes(specData),
+		ConfigSHA256: cfgHash,
+		Targets:      cfg.Go.Targets,
+		Files:        make(map[string]string),
+	}
+	if cfg.AsyncAPI != "" {
+		data, err := os.ReadFile(cfg.AsyncAPI)
+		if err != nil {
+			return nil, fmt.Errorf("hashing AsyncAPI document: %w", err)
+		}
+		lock.AsyncAPI, lock.AsyncAPISHA256 = cfg.AsyncAPI, hashBytes(data)
+	}
+	for _, out := range outputs {
+		if strings.HasSuffix(out.Filename, ".eugene.go") {
+			lock.Files[out.Filename] = hashBytes([]byte(out.Content))
+		}
+	}
+	return lock, nil
+}
+
+// ReadLock reads the lock file from dir.
+func ReadLock(dir string) (*Lock, error) {
+	data, err := os.ReadFile(filepath.Join(dir, LockFilename))
+	if err != nil {
+		return nil, fmt.Errorf("reading lock: %w", err)
+	}
+	var lock Lock
+	if err := json.Unmarshal(data, &lock); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", LockFilename, err)
+	}
+	return &lock, nil
+}
+
+// Marshal renders the lock as indented JSON.
+func (l *Lock) Marshal() ([]byte, error) {
+	data, err := json.MarshalIndent(l, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// Verify compares the recorded lock against a freshly computed one and the
+// files currently in dir. It returns one message per mismatch.
+func (l *Lock) Verify(current *Lock, dir string) []string {
+	var problems []string
+	if l.SpecSHA256 != current.SpecSHA256 {
+		problems = append(problems, fmt.Sprintf("spec %s changed since generation", current.Spec))
+	}
+	if l.AsyncAPISHA256 != current.AsyncAPISHA256 {
+		problems = append(problems, fmt.Sprintf("AsyncAPI document %s changed since generation", cmp.Or(current.AsyncAPI, l.AsyncAPI)))
+	}
+	if l.ConfigSHA256 != current.ConfigSHA256 {
+		problems = append(problems, "configuration changed since generation")
+	}
+
+	names := make(map[string]bool)
+	for name := range l.Files {
+		names[name] = true
+	}
+	for name := range current.Files {
+		names[name] = true
+	}
+	sorted := make([]string, 0, len(names))
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+
+	for _, name := range sorted {
+		recorded, inLock := l.Files[name]
+		regenerated, inCurrent := current.Files[name]
+		switch {
+		case !inLock:
+			problems = append(problems, fmt.Sprintf("%s would be generated but is not in %s", name, LockFilename))
+			continue
+		case !inCurrent:
+			problems = append(problems, fmt.Sprintf("%s is in %s but would no longer be generated", name, LockFilename))
+		case recorded != regenerated:
+			problems = append(problems, fmt.Sprintf("%s differs from a fresh generation (eugene %s, locked by %s)", name, current.Version, l.Version))
+		}
+
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s is missing", name))
+			continue
+		}
+		if hashBytes(data) != recorded {
+			problems = append(problems, fmt.Sprintf("%s was modified after generation", name))
+		}
+	}
+	return problems
+}
+
+// configHash hashes the settings that influence generated code. Whether the
+// lock is written and orphans pruned does not.
+func configHash(cfg *config.Config) (string, error) {
+	hashed := *cfg
+	hashed.Go.OutputOptions.LockFile, hashed.Go.OutputOptions.PruneOrphans = false, false
+	data, err := json.Marshal(hashed)
+	if err != nil {
+		return "", fmt.Errorf("hashing config: %w", err)
+	}
+	return hashBytes(data), nil
+}
+
+func hashBytes(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,106 @@
+package codegen
+
+import (
+	"fmt"
+	"go/parser"
+	"go/token"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// knownModules pins the versions of third-party modules generated code imports.
+var knownModules = map[string]string{
+	"github.com/labstack/echo/v4":      "v4.15.0",
+	"github.com/go-chi/chi/v5":         "v5.2.3",
+	"github.com/google/uuid":           "v1.6.0",
+	"github.com/gofrs/uuid":            "v4.4.0+incompatible",
+	"github.com/oapi-codegen/nullable": "v1.1.0",
+}
+
+// ModuleFiles returns a go.mod requiring the modules imported by outputs and,
+// when pkg is set, a doc.go describing the package. Imports outside knownModules are returned
+// as unresolved so the caller can ask for `go mod tidy`.
+func ModuleFiles(modulePath, pkg, title string, outputs []Output) (files []Output, unresolved []string, err error) {
+	required := make(map[string]bool)
+	others := make(map[string]bool)
+	for _, out := range outputs {
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, out.Filename, out.Content, parser.ImportsOnly)
+		if err != nil {
+			return nil, nil, fmt.Errorf("parsing %s: %w", out.Filename, err)
+		}
+		for _, spec := range file.Imports {
+			path, _ := strconv.Unquote(spec.Path.Value)
+			if isStdlib(path) || path == modulePath || strings.HasPrefix(path, modulePath+"/") {
+				continue
+			}
+			if mod := moduleFor(path); mod != "" {
+				required[mod] = true
+			} else {
+				others[path] = true
+			}
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "module %s\n\ngo %s\n", modulePath, goVersion())
+	if len(required) > 0 {
+		mods := sortedKeys(required)
+		b.WriteString("\nrequire (\n")
+		for _, mod := range mods {
+			fmt.Fprintf(&b, "\t%s %s\n", mod, knownModules[mod])
+		}
+		b.WriteString(")\n")
+	}
+
+	files = []Output{{Filename: "go.mod", Content: b.String()}}
+	// Multi-version output has no package at the module root
+	if pkg != "" {
+		doc := fmt.Sprintf("// Package %s is generated by eugene", pkg)
+		if title != "" {
+			doc += " from the " + title + " OpenAPI specification"
+		}
+		doc += ".\npackage " + pkg + "\n"
+		files = append(files, Output{Filename: "doc.go", Content: doc})
+	}
+	return files, sortedKeys(others), nil
+}
+
+func moduleFor(path string) string {
+	for mod := range knownModules {
+		if path == mod || strings.HasPrefix(path, mod+"/") {
+			return mod
+		}
+	}
+	return ""
+}
+
+// isStdlib reports whether path looks like a standard library import.
+func isStdlib(path string) bool {
+	first, _, _ := strings.Cut(path, "/")
+	return !strings.Contains(first, ".")
+}
+
+// goVersion returns the major.minor version of the running toolchain.
+func goVersion() string {
+	v, ok := strings.CutPrefix(runtime.Version(), "go1.")
+	if !ok {
+		return "1.22"
+	}
+	minor, _, _ := strings.Cut(v, ".")
+	if _, err := strconv.Atoi(minor); err != nil {
+		return "1.22"
+	}
+	return "1." + minor
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,111 @@
+package codegen
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/kolah/eugene/internal/golang"
+	"github.com/kolah/eugene/internal/model"
+)
+
+var pathParamPattern = regexp.MustCompile(`\{[^}]*\}`)
+
+// CheckOperations reports operations that cannot be generated side by side:
+// operation IDs that map to the same Go name, and paths that register the
+// same route once path parameter names are ignored. routePath converts an
+// OpenAPI path to the framework's pattern for the message; when nil, route
+// collisions are not checked.
+func CheckOperations(ops []model.Operation, routePath func(string) string) error {
+	var problems []string
+
+	names := make(map[string]model.Operation)
+	for _, op := range ops {
+		name := golang.PascalCase(op.ID)
+		if prev, ok := names[name]; ok {
+			problems = append(problems, fmt.Sprintf("operationId %q (%s %s) and %q (%s %s) both generate %s",
+				prev.ID, prev.Method, prev.Path, op.ID, op.Method, op.Path, name))
+			continue
+		}
+		names[name] = op
+	}
+
+	if routePath != nil {
+		routes := make(map[string]model.Operation)
+		for _, op := range ops {
+			key := string(op.Method) + " " + pathParamPattern.ReplaceAllString(op.Path, "{}")
+			if prev, ok := routes[key]; ok {
+				problems = append(problems, fmt.Sprintf("%s %s and %s %s match the same requests (routes %s and %s)",
+					prev.Method, prev.Path, op.Method, op.Path, routePath(prev.Path), routePath(op.Path)))
+				continue
+			}
+			routes[key] = op
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("conflicting operations:\n  %s", strings.Join(problems, "\n  "))
+}
+
+// healthPaths are the routes health-endpoints registers at the root.
+var healthPaths = []string{"/healthz", "/readyz", "/version"}
+
+// checkHealthEndpoints reports a GET operation on a route health-endpoints
+// registers, which the stdlib ServeMux panics on and echo and chi silently
+// override. prefix is the path the operations are served under.
+func checkHealthEndpoints(ops []model.Operation, prefix string) error {
+	for _, op := range ops {
+		if op.Method == model.MethodGet && slices.Contains(healthPaths, prefix+op.Path) {
+			return fmt.Errorf("health-endpoints: operation %s (%s %s) is served on the %s route of the health endpoints", op.ID, op.Method, op.Path, prefix+op.Path)
+		}
+	}
+	return nil
+}
+
+// pathPrefix returns the server URL path that prefix-variables serves the
+// operations under. Its variables must not share a name with the path
+// parameters of an operation.
+func pathPrefix(spec *model.Spec) (*model.PathPrefix, error) {
+	prefix, err := spec.ServerPathPrefix()
+	if err != nil {
+		return nil, fmt.Errorf("prefix-variables: %w", err)
+	}
+	if prefix == nil {
+		return nil, errors.New("prefix-variables: no server URL has variables in its path")
+	}
+	for _, v := range prefix.Variables {
+		for _, op := range spec.Operations {
+			if strings.Contains(op.Path, "{"+v.Name+"}") {
+				return nil, fmt.Errorf("prefix-variables: server URL variable %s is also a path parameter of %s %s", v.Name, op.Method, op.Path)
+			}
+		}
+	}
+	return prefix, nil
+}
+
+// CheckAllOfConflicts reports the properties the flatten allOf strategy, of
+// generated types or of a flattened bundle, would have to pick a declaration
+// for. A composition that sets x-oink-allof-conflict resolves its conflicts,
+// which are then recorded as warnings of spec.
+func CheckAllOfConflicts(spec *model.Spec) error {
+	var problems []string
+	for _, c := range golang.FindAllOfConflicts(spec) {
+		if c.Resolution == "" {
+			problems = append(problems, c.String())
+			continue
+		}
+		spec.Warnings = append(spec.Warnings, model.Warning{
+			Kind:     model.WarningComposition,
+			Location: c.Other.Origin,
+			Message:  fmt.Sprintf("%s; x-oink-allof-conflict keeps the %s", c, c.Resolution),
+		})
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("conflicting allOf properties (set x-oink-allof-conflict: first or last on the composition to pick one):\n  %s", strings.Join(problems, "\n  "))
+}
@@ -0,0 +1,72 @@
+package codegen
+
+import (
+	"fmt"
+
+	"github.com/kolah/eugene/internal/loader"
+	"github.com/kolah/eugene/internal/model"
+)
+
+// SharedTypes is a package of the component schemas that several specs
+// declare identically.
+type SharedTypes struct {
+	// Outputs are the files of the package, relative to its directory.
+	Outputs []Output
+	// Schemas names the schemas moved into the package.
+	Schemas []string
+
+	pkg        string
+	importPath string
+	exported   map[string]bool
+}
+
+// GenerateShared moves the component schemas that two or more specs declare
+// identically out of the specs and renders them as the types of the
+// configured package. It returns nil when the specs share no schema.
+func (g *Generator) GenerateShared(specs []*model.Spec) (*SharedTypes, error) {
+	schemas := loader.SharedSchemas(specs)
+	if len(schemas) == 0 {
+		return nil, nil
+	}
+	importPath, err := packageImportPath(g.config.Go.OutputDir, g.config.Go.OutputOptions.InitModule)
+	if err != nil {
+		return nil, fmt.Errorf("shared package: %w", err)
+	}
+
+	names := make([]string, len(schemas))
+	for i, s := range schemas {
+		names[i] = s.Name
+	}
+	for _, spec := range specs {
+		loader.RemoveSchemas(spec, names)
+	}
+
+	cfg := *g.config
+	cfg.Go.Targets = []string{"types"}
+	common := &model.Spec{Info: specs[0].Info, Schemas: schemas}
+	outputs, err := g.generatePackage(&cfg, common, nil)
+	if err != nil {
+		return nil, fmt.Errorf("shared package: %w", err)
+	}
+	exported, err := exportedNames(outputs)
+	if err != nil {
+		return nil, err
+	}
+	return &SharedTypes{
+		Outputs:    outputs,
+		Schemas:    names,
+		pkg:        cfg.Go.Package,
+		importPath: importPath,
+		exported:   exported,
+	}, nil
+}
+
+// Qualify rewrites files generated from one of the specs to refer to the
+// shared schemas through the shared package. A nil SharedTypes leaves the
+// files unchanged.
+func (s *SharedTypes) Qualify(files []Output) ([]Output, error) {
+	if s == nil {
+		return files, nil
+	}
+	return qualifyShared(files, s.pkg, s.importPath, s.exported)
+}
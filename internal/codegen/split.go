@@ -0,0 +1,264 @@
+package codegen
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/kolah/eugene/internal/golang"
+	"github.com/kolah/eugene/internal/model"
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+// splitTargets are rendered once per tag package. Everything else (types,
+// spec, events) stays in the shared package in the output directory.
+var splitTargets = map[string]bool{
+	"server":        true,
+	"strict-server": true,
+	"client":        true,
+	"tools":         true,
+	"loadtest":      true,
+}
+
+type tagPackage struct {
+	Name       string
+	Operations []model.Operation
+}
+
+// generateSplit renders untagged operations and the shared targets into the
+// configured package and the operations of each tag into a subpackage named
+// after the tag. Subpackages import the shared package for schema types.
+func (g *Generator) generateSplit(spec *model.Spec, specData []byte) ([]Output, error) {
+	pkg := g.config.Go.Package
+	untagged, tags := splitByTag(spec.Operations, pkg)
+
+	// The shared types declare the parameter enums of every operation, which
+	// the tag packages then reference, so they render from the whole spec
+	outputs, err := g.render(spec, specData, pkg, func(target string) bool {
+		return target == "types" && g.config.HasTarget(target)
+	})
+	if err != nil {
+		return nil, err
+	}
+	shared := *spec
+	shared.Operations = untagged
+	rest, err := g.render(&shared, specData, pkg, func(target string) bool {
+		return target != "types" && g.config.HasTarget(target)
+	})
+	if err != nil {
+		return nil, err
+	}
+	outputs = append(outputs, rest...)
+	outputs, err = g.renderDoc(outputs, spec, pkg, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) == 0 {
+		return outputs, nil
+	}
+
+	importPath, err := packageImportPath(g.config.Go.OutputDir, g.config.Go.OutputOptions.InitModule)
+	if err != nil {
+		return nil, fmt.Errorf("split-by-tag: %w", err)
+	}
+	exported, err := exportedNames(outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	hasTarget := func(target string) bool {
+		return splitTargets[target] && g.config.HasTarget(target)
+	}
+	for _, tag := range tags {
+		tagSpec := *spec
+		tagSpec.Operations = tag.Operations
+		files, err := g.render(&tagSpec, nil, tag.Name, hasTarget)
+		if err != nil {
+			return nil, fmt.Errorf("package %s: %w", tag.Name, err)
+		}
+		files, err = g.renderDoc(files, &tagSpec, tag.Name, pkg)
+		if err != nil {
+			return nil, fmt.Errorf("package %s: %w", tag.Name, err)
+		}
+		files, err = qualifyShared(files, pkg, importPath, exported)
+		if err != nil {
+			return nil, fmt.Errorf("package %s: %w", tag.Name, err)
+		}
+		for _, f := range files {
+			f.Filename = path.Join(tag.Name, f.Filename)
+			outputs = append(outputs, f)
+		}
+	}
+	return outputs, nil
+}
+
+// splitByTag groups operations by the package name of their first tag, in
+// spec order. Tags that map to the same package name share it.
+func splitByTag(ops []model.Operation, pkg string) (untagged []model.Operation, tags []tagPackage) {
+	index := make(map[string]int)
+	for _, op := range ops {
+		name := ""
+		if len(op.Tags) > 0 {
+			name = tagPackageName(op.Tags[0], pkg)
+		}
+		if name == "" {
+			untagged = append(untagged, op)
+			continue
+		}
+		i, ok := index[name]
+		if !ok {
+			i = len(tags)
+			index[name] = i
+			tags = append(tags, tagPackage{Name: name})
+		}
+		tags[i].Operations = append(tags[i].Operations, op)
+	}
+	return untagged, tags
+}
+
+// tagPackageName turns a tag into a lower-case package name ("Pet Store"
+// becomes petstore). Names that are keywords or equal to the shared
+// package get an "api" suffix.
+func tagPackageName(tag, pkg string) string {
+	name := strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, strings.ToLower(golang.SnakeCase(tag)))
+	if name == "" {
+		return ""
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		name = "tag" + name
+	}
+	if token.IsKeyword(name) || name == pkg {
+		name += "api"
+	}
+	return name
+}
+
+// qualifyShared rewrites references to declarations of the shared package
+// (exported) that files of a tag package do not declare themselves as
+// pkg.Name, importing the shared package where needed.
+func qualifyShared(files []Output, pkg, importPath string, exported map[string]bool) ([]Output, error) {
+	fset := token.NewFileSet()
+	parsed := make([]*ast.File, len(files))
+	local := make(map[string]bool)
+	for i, f := range files {
+		file, err := parser.ParseFile(fset, f.Filename, f.Content, parser.ParseComments)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", f.Filename, err)
+		}
+		parsed[i] = file
+		for _, name := range declaredNames(file) {
+			local[name.name] = true
+		}
+	}
+
+	out := make([]Output, len(files))
+	for i, file := range parsed {
+		unresolved := make(map[*ast.Ident]bool)
+		for _, ident := range file.Unresolved {
+			if exported[ident.Name] && !local[ident.Name] {
+				unresolved[ident] = true
+			}
+		}
+		if len(unresolved) == 0 {
+			out[i] = files[i]
+			continue
+		}
+
+		astutil.Apply(file, nil, func(c *astutil.Cursor) bool {
+			if ident, ok := c.Node().(*ast.Ident); ok && unresolved[ident] {
+				c.Replace(&ast.SelectorExpr{X: ast.NewIdent(pkg), Sel: ast.NewIdent(ident.Name)})
+			}
+			return true
+		})
+		if path.Base(importPath) == pkg {
+			astutil.AddImport(fset, file, importPath)
+		} else {
+			astutil.AddNamedImport(fset, file, pkg, importPath)
+		}
+
+		var buf bytes.Buffer
+		if err := printer.Fprint(&buf, fset, file); err != nil {
+			return nil, fmt.Errorf("printing %s: %w", files[i].Filename, err)
+		}
+		formatted, err := golang.Format(buf.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("formatting %s: %w", files[i].Filename, err)
+		}
+		out[i] = Output{Filename: files[i].Filename, Content: string(formatted)}
+	}
+	return out, nil
+}
+
+// packageImportPath returns the import path of dir: the init-module path
+// when a module is scaffolded there, otherwise dir's path within the
+// nearest enclosing go.mod.
+func packageImportPath(dir, initModule string) (string, error) {
+	if initModule != "" {
+		return initModule, nil
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	for root := abs; ; root = filepath.Dir(root) {
+		modulePath, err := readModulePath(filepath.Join(root, "go.mod"))
+		if err != nil {
+			return "", err
+		}
+		if modulePath != "" {
+			rel, err := filepath.Rel(root, abs)
+			if err != nil {
+				return "", err
+			}
+			return path.Join(modulePath, filepath.ToSlash(rel)), nil
+		}
+		if filepath.Dir(root) == root {
+			return "", fmt.Errorf("%s is not inside a Go module; set init-module to scaffold one", dir)
+		}
+	}
+}
+
+// readModulePath returns the module path declared by a go.mod file, or ""
+// when the file does not exist.
+func readModulePath(gomod string) (string, error) {
+	f, err := os.Open(gomod)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		rest, ok := strings.CutPrefix(strings.TrimSpace(scanner.Text()), "module")
+		if !ok || rest == "" || (rest[0] != ' ' && rest[0] != '\t') {
+			continue
+		}
+		rest, _, _ = strings.Cut(rest, "//")
+		rest = strings.TrimSpace(rest)
+		if unquoted, err := strconv.Unquote(rest); err == nil {
+			rest = unquoted
+		}
+		return rest, nil
+	}
+	if err := scanner.Err(); err != nil {
+		return "", fmt.Errorf("reading %s: %w", gomod, err)
+	}
+	return "", fmt.Errorf("%s has no module directive", gomod)
+}
@@ -0,0 +1,34 @@
+package codegen
+
+import (
+	"fmt"
+
+	"github.com/kolah/eugene/internal/model"
+	"github.com/kolah/eugene/internal/targets/ts"
+)
+
+// GenerateTS renders the configured TypeScript targets of spec: types.ts,
+// and client.ts importing it.
+func (g *Generator) GenerateTS(spec *model.Spec) ([]Output, error) {
+	if err := CheckOperations(spec.Operations, nil); err != nil {
+		return nil, err
+	}
+
+	target := ts.New()
+	var outputs []Output
+	if g.config.HasTarget("types") {
+		content, err := target.GenerateTypes(g.engine, spec)
+		if err != nil {
+			return nil, fmt.Errorf("generating types.ts: %w", err)
+		}
+		outputs = append(outputs, Output{Filename: "types.ts", Content: content})
+	}
+	if g.config.HasTarget("client") {
+		content, err := target.GenerateClient(g.engine, spec)
+		if err != nil {
+			return nil, fmt.Errorf("generating client.ts: %w", err)
+		}
+		outputs = append(outputs, Output{Filename: "client.ts", Content: content})
+	}
+	return outputs, nil
+}
@@ -0,0 +1,94 @@
+package codegen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"strings"
+
+	"github.com/kolah/eugene/internal/config"
+	"github.com/kolah/eugene/internal/model"
+)
+
+// VersionSpec is a loaded spec of one configured version.
+type VersionSpec struct {
+	Version config.Version
+	Spec    *model.Spec
+	Data    []byte
+}
+
+// GenerateVersions renders each version into the package and subdirectory
+// named after it. Component schemas that two or more versions declare
+// identically are rendered once into the shared package, which the versions
+// import instead of declaring their own copy.
+func (g *Generator) GenerateVersions(versions []VersionSpec) ([]Output, error) {
+	specs := make([]*model.Spec, len(versions))
+	for i, v := range versions {
+		specs[i] = v.Spec
+	}
+
+	sharedPkg := g.config.SharedPackage()
+	sharedGen, err := New(g.config.ForVersion(config.Version{Name: sharedPkg}))
+	if err != nil {
+		return nil, err
+	}
+	sharedGen.SetToolVersion(g.toolVersion)
+	shared, err := sharedGen.GenerateShared(specs)
+	if err != nil {
+		return nil, err
+	}
+
+	var outputs []Output
+	if shared != nil {
+		outputs = prefixOutputs(sharedPkg, shared.Outputs)
+	}
+	for _, v := range versions {
+		files, err := g.generatePackage(g.config.ForVersion(v.Version), v.Spec, v.Data)
+		if err != nil {
+			return nil, fmt.Errorf("version %s: %w", v.Version.Name, err)
+		}
+		files, err = shared.Qualify(files)
+		if err != nil {
+			return nil, fmt.Errorf("version %s: %w", v.Version.Name, err)
+		}
+		outputs = append(outputs, prefixOutputs(v.Version.Name, files)...)
+	}
+	return outputs, nil
+}
+
+// generatePackage renders spec with a generator of its own, since the enum
+// registry and resolver state belong to one package.
+func (g *Generator) generatePackage(cfg *config.Config, spec *model.Spec, specData []byte) ([]Output, error) {
+	gen, err := New(cfg)
+	if err != nil {
+		return nil, err
+	}
+	gen.SetToolVersion(g.toolVersion)
+	return gen.Generate(spec, specData)
+}
+
+// exportedNames returns the exported top-level names the files declare.
+func exportedNames(files []Output) (map[string]bool, error) {
+	exported := make(map[string]bool)
+	for _, out := range files {
+		file, err := parser.ParseFile(token.NewFileSet(), out.Filename, out.Content, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", out.Filename, err)
+		}
+		for _, name := range declaredNames(file) {
+			if ast.IsExported(name.name) && !strings.Contains(name.name, ".") {
+				exported[name.name] = true
+			}
+		}
+	}
+	return exported, nil
+}
+
+func prefixOutputs(dir string, files []Output) []Output {
+	for i := range files {
+		files[i].Filename = path.Join(dir, files[i].Filename)
+	}
+	return files
+}
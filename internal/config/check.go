@@ -0,0 +1,223 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/knadh/koanf/parsers/yaml"
+	"github.com/knadh/koanf/providers/file"
+	"github.com/knadh/koanf/v2"
+)
+
+// KeyIssue is an unknown key found in a config file.
+type KeyIssue struct {
+	Key        string
+	Suggestion string // closest known key, empty if none is close enough
+}
+
+func (i KeyIssue) String() string {
+	if i.Suggestion != "" {
+		return fmt.Sprintf("unknown key %q (did you mean %q?)", i.Key, i.Suggestion)
+	}
+	return fmt.Sprintf("unknown key %q", i.Key)
+}
+
+// CheckFile reports keys in the config file at path that eugene doesn't
+// recognize. Keys inside profiles are checked against the same schema.
+func CheckFile(path string) ([]KeyIssue, error) {
+	k := koanf.New(".")
+	if err := k.Load(file.Provider(path), yaml.Parser()); err != nil {
+		return nil, fmt.Errorf("reading config file: %w", err)
+	}
+
+	known, maps := knownKeys(reflect.TypeOf(Config{}), "")
+	var issues []KeyIssue
+	for _, key := range k.Keys() {
+		rel := key
+		if rest, ok := strings.CutPrefix(key, "profiles."); ok {
+			_, rel, _ = strings.Cut(rest, ".")
+		}
+		if rel == "" || known[rel] || hasMapPrefix(rel, maps) {
+			continue
+		}
+		issues = append(issues, KeyIssue{Key: key, Suggestion: suggestKey(rel, known, strings.TrimSuffix(key, rel))})
+	}
+	return issues, nil
+}
+
+// knownKeys walks the koanf tags of t and returns leaf keys and the keys of
+// free-form maps whose children are not checked.
+func knownKeys(t reflect.Type, prefix string) (map[string]bool, []string) {
+	known := make(map[string]bool)
+	var maps []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("koanf")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		key := prefix + tag
+		switch f.Type.Kind() {
+		case reflect.Struct:
+			sub, subMaps := knownKeys(f.Type, key+".")
+			for k := range sub {
+				known[k] = true
+			}
+			maps = append(maps, subMaps...)
+		case reflect.Map:
+			maps = append(maps, key)
+		default:
+			known[key] = true
+		}
+	}
+	return known, maps
+}
+
+func hasMapPrefix(key string, maps []string) bool {
+	return slices.ContainsFunc(maps, func(m string) bool {
+		return strings.HasPrefix(key, m+".")
+	})
+}
+
+// suggestKey returns the known key closest to key, allowing roughly one edit
+// per three characters.
+func suggestKey(key string, known map[string]bool, prefix string) string {
+	best, bestDist := "", len(key)/3+1
+	for candidate := range known {
+		d := editDistance(key, candidate)
+		if d < bestDist || (d == bestDist && candidate < best) {
+			best, bestDist = candidate, d
+		}
+	}
+	if best == "" {
+		return ""
+	}
+	return prefix + best
+}
+
+// editDistance is the optimal string alignment distance: insertions,
+// deletions, substitutions and adjacent transpositions each count as one.
+func editDistance(a, b string) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(a)][len(b)]
+}
+
+// InitTemplate is the commented config written by `eugene config init`.
+const InitTemplate = `# eugene configuration
+# See https://github.com/kolah/eugene for all options.
+
+# OpenAPI spec to generate from
+spec: api/openapi.yaml
+# versions:                    # instead of spec: one package per API version
+#   - name: v1
+#     spec: api/v1.yaml
+# overlays: []                 # OpenAPI Overlay documents applied to the spec
+# asyncapi: api/asyncapi.yaml  # events and payload types generated alongside the spec
+# schema: order.schema.json    # bare JSON Schema instead of spec (types target only)
+
+# templates:
+#   dir: ./custom-templates   # override embedded templates
+#   debug-dir: ./tmpl-data    # dump the data of each template as JSON
+#   missing-key: default      # default, zero or error on a missing map key
+#   delims: ["{{", "}}"]      # delimiters of the custom templates
+
+# exclude-schemas: []          # schemas to skip
+# include-tags: []             # only generate operations with these tags
+# exclude-tags: []             # skip operations with these tags
+# keep-all-schemas: false      # keep schemas no generated operation uses
+# strict: false                # fail on constructs eugene cannot represent
+# fail-on: [any-fallback]      # fail on these degradations only, see README
+
+go:
+  package: api
+  output-dir: ./gen
+  server-framework: echo       # echo, chi or stdlib
+
+  targets:                     # types, server, strict-server, client, spec, tools, events, loadtest, main
+    - types
+    - server
+    - client
+
+  types:
+    enum-strategy: const       # const, type or struct
+    enum-unknown: passthrough  # passthrough, reject or extend
+    unknown-fields: ignore     # ignore, error or capture
+    uuid-package: string       # string, google or gofrs
+    nullable-strategy: pointer # pointer or nullable
+    allof-strategy: embed      # embed or flatten
+
+  # output-options:
+  #   enable-yaml-tags: false
+  #   additional-initialisms: [GTIN, SKU]
+  #   init-module: github.com/myorg/api
+  #   local-prefix: github.com/myorg
+  #   disable-import-grouping: false
+  #   single-file: false
+  #   lock-file: false
+  #   prune-orphans: false
+  #   client-services: false
+  #   compact-client: false
+  #   split-by-tag: false
+  #   shared-package: common   # package of schemas shared by versions
+  #   examples: false
+  #   strict-validation: false  # needs the strict-server target
+  #   example-checks: false
+  #   test-helpers: false
+  #   client-mock: false
+  #   vcr: false
+  #   health-endpoints: false
+  #   prefix-variables: false  # serve operations under server URL variables like /tenants/{tenantId}
+  #   permissions: false
+  #   sort-fields: spec        # spec or alpha
+  #   field-provenance: false
+  #   package-doc: false
+  #   constraint-constants: false
+  #   route-drift: false       # echo and chi only
+  #   cors: false
+  #   max-body: 1048576        # request body limit in bytes, -1 for none
+  #   disallow-unknown-fields: false  # needs unknown-fields: error
+
+  # import-mapping:
+  #   "#/components/schemas/Error": github.com/myorg/api/common
+
+# ts:                          # eugene generate ts
+#   output-dir: ./web/src/api
+#   targets: [types, client]
+
+# jvm:                         # eugene generate jvm
+#   output-dir: ./android/src/main/kotlin/com/example/api
+#   package: com.example.api
+#   language: kotlin           # kotlin or java
+
+# shared-types:                # schemas several profiles declare, generated once
+#   package: common
+#   output-dir: ./gen/common
+
+# profiles:
+#   billing:
+#     spec: api/billing.yaml
+#     go:
+#       package: billing
+#       output-dir: ./gen/billing
+`
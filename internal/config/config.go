@@ -2,23 +2,72 @@ package config
 
 import (
 	"fmt"
+	"go/token"
+	"maps"
 	"os"
+	"path"
+	"path/filepath"
 	"slices"
+	"strings"
 
 	"github.com/knadh/koanf/parsers/yaml"
 	"github.com/knadh/koanf/providers/confmap"
 	"github.com/knadh/koanf/providers/file"
 	"github.com/knadh/koanf/v2"
+	"github.com/kolah/eugene/internal/model"
 	"github.com/spf13/cobra"
 )
 
 type Config struct {
+	// Profile is the name of the profile this config was built from, if any.
+	Profile        string         `koanf:"-"`
 	Spec           string         `koanf:"spec"`
+	SpecFormat     string         `koanf:"spec-format"`
+	Overlays       []string       `koanf:"overlays"`
+	AsyncAPI       string         `koanf:"asyncapi"`
+	Schema         string         `koanf:"schema"`
 	Templates      TemplateConfig `koanf:"templates"`
 	ExcludeSchemas []string       `koanf:"exclude-schemas"`
 	IncludeTags    []string       `koanf:"include-tags"`
 	ExcludeTags    []string       `koanf:"exclude-tags"`
+	KeepAllSchemas bool           `koanf:"keep-all-schemas"`
+	Strict         bool           `koanf:"strict"`
+	FailOn         []string       `koanf:"fail-on"`
+	Versions       []Version      `koanf:"versions"`
+	SharedTypes    SharedTypes    `koanf:"shared-types"`
 	Go             GoConfig       `koanf:"go"`
+	TS             TSConfig       `koanf:"ts"`
+	JVM            JVMConfig      `koanf:"jvm"`
+	// Language is the generate subcommand this config was built for: go, ts
+	// or jvm.
+	Language string `koanf:"-"`
+}
+
+// Languages are the generate subcommands, each configured by its own section.
+const (
+	LanguageGo  = "go"
+	LanguageTS  = "ts"
+	LanguageJVM = "jvm"
+)
+
+// SharedTypes is the package that --all-profiles generates the schemas
+// declared identically by several profiles into, once, instead of into each
+// profile's package.
+type SharedTypes struct {
+	Package   string `koanf:"package"`
+	OutputDir string `koanf:"output-dir"`
+}
+
+// Enabled reports whether shared types are configured.
+func (s SharedTypes) Enabled() bool {
+	return s.Package != "" || s.OutputDir != ""
+}
+
+// Version is one API version of a multi-version run, generated from its own
+// spec into a subdirectory and package of the output directory.
+type Version struct {
+	Name string `koanf:"name"`
+	Spec string `koanf:"spec"`
 }
 
 type GoConfig struct {
@@ -31,36 +80,216 @@ type GoConfig struct {
 	Targets         []string          `koanf:"targets"`
 }
 
+// TSConfig configures the TypeScript client, generated from the same spec
+// as the Go code.
+type TSConfig struct {
+	OutputDir string   `koanf:"output-dir"`
+	Targets   []string `koanf:"targets"`
+}
+
+// JVMConfig configures the Kotlin or Java types, generated from the same
+// spec as the Go code.
+type JVMConfig struct {
+	OutputDir string   `koanf:"output-dir"`
+	Package   string   `koanf:"package"`
+	Language  string   `koanf:"language"` // kotlin (default) or java
+	Targets   []string `koanf:"targets"`
+}
+
 type TemplateConfig struct {
-	Dir string `koanf:"dir"`
+	Dir        string   `koanf:"dir"`
+	DebugDir   string   `koanf:"debug-dir"`
+	MissingKey string   `koanf:"missing-key"`
+	Delims     []string `koanf:"delims"`
 }
 
 type TypesConfig struct {
 	EnumStrategy     string `koanf:"enum-strategy"`
+	EnumUnknown      string `koanf:"enum-unknown"`
+	UnknownFields    string `koanf:"unknown-fields"`
 	UUIDPackage      string `koanf:"uuid-package"`
 	NullableStrategy string `koanf:"nullable-strategy"`
 	AllOfStrategy    string `koanf:"allof-strategy"`
 }
 
+// Validate checks the type mapping settings.
+func (t TypesConfig) Validate() error {
+	validEnumStrategies := map[string]bool{"": true, "const": true, "type": true, "struct": true}
+	if !validEnumStrategies[t.EnumStrategy] {
+		return fmt.Errorf("invalid enum strategy: %s (valid: const, type, struct)", t.EnumStrategy)
+	}
+
+	validEnumUnknown := map[string]bool{"": true, "reject": true, "passthrough": true, "extend": true}
+	if !validEnumUnknown[t.EnumUnknown] {
+		return fmt.Errorf("invalid enum-unknown: %s (valid: reject, passthrough, extend)", t.EnumUnknown)
+	}
+
+	validUnknownFields := map[string]bool{"": true, "ignore": true, "error": true, "capture": true}
+	if !validUnknownFields[t.UnknownFields] {
+		return fmt.Errorf("invalid unknown-fields: %s (valid: ignore, error, capture)", t.UnknownFields)
+	}
+
+	validUUIDPackages := map[string]bool{"": true, "string": true, "google": true, "gofrs": true}
+	if !validUUIDPackages[t.UUIDPackage] {
+		return fmt.Errorf("invalid uuid package: %s (valid: string, google, gofrs)", t.UUIDPackage)
+	}
+
+	validNullableStrategies := map[string]bool{"": true, "pointer": true, "nullable": true}
+	if !validNullableStrategies[t.NullableStrategy] {
+		return fmt.Errorf("invalid nullable strategy: %s (valid: pointer, nullable)", t.NullableStrategy)
+	}
+
+	validAllOfStrategies := map[string]bool{"": true, "embed": true, "flatten": true}
+	if !validAllOfStrategies[t.AllOfStrategy] {
+		return fmt.Errorf("invalid allof strategy: %s (valid: embed, flatten)", t.AllOfStrategy)
+	}
+	return nil
+}
+
 type OutputOptions struct {
 	EnableYAMLTags        bool     `koanf:"enable-yaml-tags"`
 	AdditionalInitialisms []string `koanf:"additional-initialisms"`
+	PruneOrphans          bool     `koanf:"prune-orphans"`
+	SingleFile            bool     `koanf:"single-file"`
+	LocalPrefix           string   `koanf:"local-prefix"`
+	DisableImportGrouping bool     `koanf:"disable-import-grouping"`
+	InitModule            string   `koanf:"init-module"`
+	LockFile              bool     `koanf:"lock-file"`
+	ClientServices        bool     `koanf:"client-services"`
+	CompactClient         bool     `koanf:"compact-client"`
+	SplitByTag            bool     `koanf:"split-by-tag"`
+	Examples              bool     `koanf:"examples"`
+	StrictValidation      bool     `koanf:"strict-validation"`
+	ExampleChecks         bool     `koanf:"example-checks"`
+	TestHelpers           bool     `koanf:"test-helpers"`
+	ClientMock            bool     `koanf:"client-mock"`
+	VCR                   bool     `koanf:"vcr"`
+	HealthEndpoints       bool     `koanf:"health-endpoints"`
+	PrefixVariables       bool     `koanf:"prefix-variables"`
+	Permissions           bool     `koanf:"permissions"`
+	SortFields            string   `koanf:"sort-fields"`
+	FieldProvenance       bool     `koanf:"field-provenance"`
+	PackageDoc            bool     `koanf:"package-doc"`
+	ConstraintConstants   bool     `koanf:"constraint-constants"`
+	RouteDrift            bool     `koanf:"route-drift"`
+	CORS                  bool     `koanf:"cors"`
+	MaxBody               int64    `koanf:"max-body"`
+	DisallowUnknownFields bool     `koanf:"disallow-unknown-fields"`
+	SharedPackage         string   `koanf:"shared-package"`
 }
 
+// DefaultMaxBody is the most bytes generated servers read of a request
+// body when max-body is not set.
+const DefaultMaxBody = 1 << 20
+
+// DefaultSharedPackage holds the schemas that versions declare identically
+// when shared-package is not set.
+const DefaultSharedPackage = "common"
+
 // BindCommonFlags binds language-agnostic flags to the generate command
 func BindCommonFlags(cmd *cobra.Command) {
 	flags := cmd.PersistentFlags()
 
 	flags.StringP("config", "c", "", "Config file path (default: eugene.yaml)")
-	flags.StringP("spec", "s", "", "OpenAPI spec file path")
+	flags.StringP("spec", "s", "", "OpenAPI spec file path, or - to read it from stdin")
+	flags.String("spec-format", "", "Format of the spec read from stdin: yaml, json (default: detected)")
+	flags.StringSlice("overlay", nil, "OpenAPI Overlay documents applied to the spec, in order")
+	flags.String("schema", "", "JSON Schema document to generate types from instead of an OpenAPI spec")
+	flags.String("asyncapi", "", "AsyncAPI document whose message payloads and events are generated alongside the spec")
 	flags.String("templates", "", "Custom templates directory")
+	flags.String("debug-templates", "", "Directory to write the data passed to each template into, as JSON")
 	flags.StringSlice("exclude-schemas", nil, "Schemas to exclude")
 	flags.StringSlice("include-tags", nil, "Tags to include (exclusive)")
 	flags.StringSlice("exclude-tags", nil, "Tags to exclude")
+	flags.Bool("keep-all-schemas", false, "Keep schemas not reachable from the generated operations")
 	flags.Bool("dry-run", false, "Print output without writing files")
+	flags.Bool("strict", false, "Fail when the spec uses constructs eugene cannot represent")
+	flags.StringSlice("fail-on", nil, "Fail on these degradations only: any-fallback, dropped-media-type, ignored-keyword, ignored-extension, invalid-type, synthesized-operation-id")
+	flags.String("profile", "", "Generation profile from the config file")
+	flags.Bool("all-profiles", false, "Run every generation profile from the config file")
+	flags.Bool("stdout", false, "Write all targets as a single file to stdout")
 }
 
+// Load builds the configuration from the config file and flags. When the
+// --profile flag is set, the named profile is merged over the top-level settings.
 func Load(cmd *cobra.Command, targets []string) (*Config, error) {
+	k, err := loadFile(cmd)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := getFlagString(cmd, "profile")
+	return build(cmd, k, profile, targets)
+}
+
+// LoadAll returns one configuration per profile when --all-profiles is set,
+// and the single configuration from Load otherwise.
+func LoadAll(cmd *cobra.Command, targets []string) ([]*Config, error) {
+	if !getFlagBool(cmd, "all-profiles") {
+		cfg, err := Load(cmd, targets)
+		if err != nil {
+			return nil, err
+		}
+		return []*Config{cfg}, nil
+	}
+
+	k, err := loadFile(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if len(k.MapKeys("profiles")) == 0 {
+		return nil, fmt.Errorf("--all-profiles requires profiles in the config file")
+	}
+	return buildProfiles(cmd, k, targets)
+}
+
+// LoadEach builds every profile defined in the config file, or the top-level
+// configuration when there are none. It is used to validate a whole file.
+func LoadEach(cmd *cobra.Command) ([]*Config, error) {
+	k, err := loadFile(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if len(k.MapKeys("profiles")) == 0 {
+		cfg, err := build(cmd, k, "", nil)
+		if err != nil {
+			return nil, err
+		}
+		return []*Config{cfg}, nil
+	}
+	return buildProfiles(cmd, k, nil)
+}
+
+// LoadTemplates reads the templates settings of the config file, for commands
+// that work on custom templates without generating.
+func LoadTemplates(cmd *cobra.Command) (TemplateConfig, error) {
+	k, err := loadFile(cmd)
+	if err != nil {
+		return TemplateConfig{}, err
+	}
+	cfg := Config{}
+	if err := k.Unmarshal("templates", &cfg.Templates); err != nil {
+		return TemplateConfig{}, fmt.Errorf("unmarshaling config: %w", err)
+	}
+	if err := cfg.validateTemplates(); err != nil {
+		return TemplateConfig{}, err
+	}
+	return cfg.Templates, nil
+}
+
+func buildProfiles(cmd *cobra.Command, k *koanf.Koanf, targets []string) ([]*Config, error) {
+	var cfgs []*Config
+	for _, name := range k.MapKeys("profiles") {
+		cfg, err := build(cmd, k, name, targets)
+		if err != nil {
+			return nil, err
+		}
+		cfgs = append(cfgs, cfg)
+	}
+	return cfgs, nil
+}
+
+func loadFile(cmd *cobra.Command) (*koanf.Koanf, error) {
 	k := koanf.New(".")
 
 	configFile, _ := cmd.Flags().GetString("config")
@@ -78,6 +307,21 @@ func Load(cmd *cobra.Command, targets []string) (*Config, error) {
 			return nil, fmt.Errorf("reading config file: %w", err)
 		}
 	}
+	return k, nil
+}
+
+func build(cmd *cobra.Command, file *koanf.Koanf, profile string, targets []string) (*Config, error) {
+	k := file.Copy()
+	k.Delete("profiles")
+
+	if profile != "" {
+		if !file.Exists("profiles." + profile) {
+			return nil, fmt.Errorf("unknown profile: %s", profile)
+		}
+		if err := k.Merge(file.Cut("profiles." + profile)); err != nil {
+			return nil, fmt.Errorf("loading profile %s: %w", profile, err)
+		}
+	}
 
 	flagsMap := buildFlagsMap(cmd)
 	if len(flagsMap) > 0 {
@@ -90,34 +334,132 @@ func Load(cmd *cobra.Command, targets []string) (*Config, error) {
 	if err := k.Unmarshal("", &cfg); err != nil {
 		return nil, fmt.Errorf("unmarshaling config: %w", err)
 	}
+	cfg.Profile = profile
+	cfg.Language = commandLanguage(cmd)
 
 	// CLI targets override config file targets
-	if len(targets) > 0 {
-		cfg.Go.Targets = targets
+	switch cfg.Language {
+	case LanguageTS:
+		if len(targets) > 0 {
+			cfg.TS.Targets = targets
+		}
+		cfg.TS.Targets = expandTSTargets(cfg.TS.Targets)
+	case LanguageJVM:
+		if len(targets) > 0 {
+			cfg.JVM.Targets = targets
+		}
+		cfg.JVM.Targets = expandJVMTargets(cfg.JVM.Targets)
+	default:
+		if len(targets) > 0 {
+			cfg.Go.Targets = targets
+		}
+		// Expand "all" target
+		cfg.Go.Targets = expandTargets(cfg.Go.Targets)
 	}
 
-	// Expand "all" target
-	cfg.Go.Targets = expandTargets(cfg.Go.Targets)
-
 	if err := cfg.Validate(); err != nil {
+		if profile != "" {
+			return nil, fmt.Errorf("profile %s: %w", profile, err)
+		}
 		return nil, err
 	}
 
 	return &cfg, nil
 }
 
+func getFlagBool(cmd *cobra.Command, name string) bool {
+	if v, err := cmd.Flags().GetBool(name); err == nil && v {
+		return v
+	}
+	v, _ := cmd.PersistentFlags().GetBool(name)
+	return v
+}
+
+func getFlagString(cmd *cobra.Command, name string) string {
+	if v, err := cmd.Flags().GetString(name); err == nil && v != "" {
+		return v
+	}
+	if v, err := cmd.PersistentFlags().GetString(name); err == nil {
+		return v
+	}
+	return ""
+}
+
+// commandLanguage returns the language subcommand of generate that cmd runs
+// under. Commands outside generate, like config validate, check the Go
+// configuration.
+func commandLanguage(cmd *cobra.Command) string {
+	for c := cmd; c.HasParent(); c = c.Parent() {
+		if c.Parent().Name() == "generate" {
+			return c.Name()
+		}
+	}
+	return LanguageGo
+}
+
 func expandTargets(targets []string) []string {
 	var result []string
 	for _, t := range targets {
-		if t == "all" {
+		switch t {
+		case "all":
 			result = append(result, "types", "server", "client", "spec", "strict-server")
-		} else {
+		case "tools":
+			// CallTool dispatches through the generated client
+			result = append(result, "client", "tools")
+		case "main":
+			// The scaffold serves the handlers of the server or strict-server
+			if !slices.Contains(targets, "server") && !slices.Contains(targets, "strict-server") && !slices.Contains(targets, "all") {
+				result = append(result, "server")
+			}
+			result = append(result, "main")
+		default:
 			result = append(result, t)
 		}
 	}
+	// The servers use the parameter enums and schema types of the types target
+	if (slices.Contains(result, "server") || slices.Contains(result, "strict-server")) && !slices.Contains(result, "types") {
+		result = append([]string{"types"}, result...)
+	}
 	return result
 }
 
+// expandTSTargets expands "all", and no targets at all, to every TypeScript
+// target. The client refers to the interfaces of types, which it always
+// comes with.
+func expandTSTargets(targets []string) []string {
+	if len(targets) == 0 {
+		targets = []string{"all"}
+	}
+	var result []string
+	for _, t := range targets {
+		switch t {
+		case "all", "client":
+			result = append(result, "types", "client")
+		default:
+			result = append(result, t)
+		}
+	}
+	slices.Sort(result)
+	return slices.Compact(result)
+}
+
+// expandJVMTargets expands "all", and no targets at all, to every JVM
+// target: types.
+func expandJVMTargets(targets []string) []string {
+	if len(targets) == 0 {
+		return []string{"types"}
+	}
+	var result []string
+	for _, t := range targets {
+		if t == "all" {
+			t = "types"
+		}
+		result = append(result, t)
+	}
+	slices.Sort(result)
+	return slices.Compact(result)
+}
+
 func buildFlagsMap(cmd *cobra.Command) map[string]any {
 	m := make(map[string]any)
 
@@ -158,12 +500,27 @@ func buildFlagsMap(cmd *cobra.Command) map[string]any {
 	if v := getString("spec"); v != "" {
 		m["spec"] = v
 	}
+	if v := getString("spec-format"); v != "" {
+		m["spec-format"] = v
+	}
+	if v := getStringSlice("overlay"); len(v) > 0 {
+		m["overlays"] = v
+	}
+	if v := getString("schema"); v != "" {
+		m["schema"] = v
+	}
+	if v := getString("asyncapi"); v != "" {
+		m["asyncapi"] = v
+	}
 	if v := getString("output-dir"); v != "" {
-		m["go.output-dir"] = v
+		m[commandLanguage(cmd)+".output-dir"] = v
 	}
 	if v := getString("templates"); v != "" {
 		m["templates.dir"] = v
 	}
+	if v := getString("debug-templates"); v != "" {
+		m["templates.debug-dir"] = v
+	}
 	if v := getStringSlice("exclude-schemas"); len(v) > 0 {
 		m["exclude-schemas"] = v
 	}
@@ -173,17 +530,36 @@ func buildFlagsMap(cmd *cobra.Command) map[string]any {
 	if v := getStringSlice("exclude-tags"); len(v) > 0 {
 		m["exclude-tags"] = v
 	}
+	if flagChanged("keep-all-schemas") {
+		m["keep-all-schemas"] = getBool("keep-all-schemas")
+	}
+	if flagChanged("strict") {
+		m["strict"] = getBool("strict")
+	}
+	if v := getStringSlice("fail-on"); len(v) > 0 {
+		m["fail-on"] = v
+	}
 
-	// Go-specific flags (under go. namespace)
 	if v := getString("package"); v != "" {
-		m["go.package"] = v
+		m[commandLanguage(cmd)+".package"] = v
 	}
+	if v := getString("language"); v != "" {
+		m["jvm.language"] = v
+	}
+
+	// Go-specific flags (under go. namespace)
 	if v := getString("server-framework"); v != "" {
 		m["go.server-framework"] = v
 	}
 	if v := getString("enum-strategy"); v != "" {
 		m["go.types.enum-strategy"] = v
 	}
+	if v := getString("enum-unknown"); v != "" {
+		m["go.types.enum-unknown"] = v
+	}
+	if v := getString("unknown-fields"); v != "" {
+		m["go.types.unknown-fields"] = v
+	}
 	if v := getString("uuid-package"); v != "" {
 		m["go.types.uuid-package"] = v
 	}
@@ -199,17 +575,135 @@ func buildFlagsMap(cmd *cobra.Command) map[string]any {
 	if v := getStringSlice("additional-initialisms"); len(v) > 0 {
 		m["go.output-options.additional-initialisms"] = v
 	}
+	if v := getString("init-module"); v != "" {
+		m["go.output-options.init-module"] = v
+	}
+	if v := getString("local-prefix"); v != "" {
+		m["go.output-options.local-prefix"] = v
+	}
+	if flagChanged("disable-import-grouping") {
+		m["go.output-options.disable-import-grouping"] = getBool("disable-import-grouping")
+	}
+	if flagChanged("single-file") {
+		m["go.output-options.single-file"] = getBool("single-file")
+	}
+	if flagChanged("lock-file") {
+		m["go.output-options.lock-file"] = getBool("lock-file")
+	}
+	if flagChanged("prune-orphans") {
+		m["go.output-options.prune-orphans"] = getBool("prune-orphans")
+	}
+	if flagChanged("client-services") {
+		m["go.output-options.client-services"] = getBool("client-services")
+	}
+	if flagChanged("compact-client") {
+		m["go.output-options.compact-client"] = getBool("compact-client")
+	}
+	if flagChanged("split-by-tag") {
+		m["go.output-options.split-by-tag"] = getBool("split-by-tag")
+	}
+	if flagChanged("examples") {
+		m["go.output-options.examples"] = getBool("examples")
+	}
+	if flagChanged("strict-validation") {
+		m["go.output-options.strict-validation"] = getBool("strict-validation")
+	}
+	if flagChanged("example-checks") {
+		m["go.output-options.example-checks"] = getBool("example-checks")
+	}
+	if flagChanged("test-helpers") {
+		m["go.output-options.test-helpers"] = getBool("test-helpers")
+	}
+	if flagChanged("client-mock") {
+		m["go.output-options.client-mock"] = getBool("client-mock")
+	}
+	if flagChanged("vcr") {
+		m["go.output-options.vcr"] = getBool("vcr")
+	}
+	if flagChanged("health-endpoints") {
+		m["go.output-options.health-endpoints"] = getBool("health-endpoints")
+	}
+	if flagChanged("prefix-variables") {
+		m["go.output-options.prefix-variables"] = getBool("prefix-variables")
+	}
+	if flagChanged("permissions") {
+		m["go.output-options.permissions"] = getBool("permissions")
+	}
+	if v := getString("sort-fields"); v != "" {
+		m["go.output-options.sort-fields"] = v
+	}
+	if flagChanged("field-provenance") {
+		m["go.output-options.field-provenance"] = getBool("field-provenance")
+	}
+	if flagChanged("package-doc") {
+		m["go.output-options.package-doc"] = getBool("package-doc")
+	}
+	if flagChanged("constraint-constants") {
+		m["go.output-options.constraint-constants"] = getBool("constraint-constants")
+	}
+	if flagChanged("route-drift") {
+		m["go.output-options.route-drift"] = getBool("route-drift")
+	}
+	if flagChanged("cors") {
+		m["go.output-options.cors"] = getBool("cors")
+	}
+	if flagChanged("max-body") {
+		if v, err := cmd.Flags().GetInt64("max-body"); err == nil {
+			m["go.output-options.max-body"] = v
+		}
+	}
+	if flagChanged("disallow-unknown-fields") {
+		m["go.output-options.disallow-unknown-fields"] = getBool("disallow-unknown-fields")
+	}
 
 	return m
 }
 
 func (c *Config) Validate() error {
-	if c.Spec == "" {
+	if len(c.Versions) > 0 {
+		if err := c.validateVersions(); err != nil {
+			return err
+		}
+	} else if c.Spec == "" && c.Schema == "" {
 		return fmt.Errorf("spec file is required")
 	}
-	if c.Go.Package == "" {
+	if c.Schema != "" {
+		if c.Spec != "" {
+			return fmt.Errorf("spec and schema are mutually exclusive")
+		}
+		if len(c.Overlays) > 0 {
+			return fmt.Errorf("overlays apply to an OpenAPI spec, not to schema input")
+		}
+		for _, t := range c.Targets() {
+			if t != "types" {
+				return fmt.Errorf("JSON Schema input only supports the types target, got %s", t)
+			}
+		}
+	}
+	// templates apply to every language
+	if err := c.validateTemplates(); err != nil {
+		return err
+	}
+	switch c.Language {
+	case LanguageTS:
+		return c.validateTS()
+	case LanguageJVM:
+		return c.validateJVM()
+	}
+	if c.Go.Package == "" && len(c.Versions) == 0 {
 		return fmt.Errorf("package name is required")
 	}
+	if err := ValidateFailOn(c.FailOn); err != nil {
+		return err
+	}
+	if c.SpecFormat != "" {
+		if c.SpecFormat != "yaml" && c.SpecFormat != "json" {
+			return fmt.Errorf("invalid spec-format: %s (valid: yaml, json)", c.SpecFormat)
+		}
+		if c.Spec != "-" {
+			return fmt.Errorf("spec-format applies to a spec read from stdin (spec: -)")
+		}
+	}
 	if c.Go.OutputDir == "" {
 		return fmt.Errorf("output directory is required")
 	}
@@ -219,40 +713,306 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("invalid server framework: %s (valid: echo, chi, stdlib)", c.Go.ServerFramework)
 	}
 
-	validEnumStrategies := map[string]bool{"": true, "const": true, "type": true, "struct": true}
-	if !validEnumStrategies[c.Go.Types.EnumStrategy] {
-		return fmt.Errorf("invalid enum strategy: %s (valid: const, type, struct)", c.Go.Types.EnumStrategy)
+	if err := c.Go.Types.Validate(); err != nil {
+		return err
 	}
 
-	validUUIDPackages := map[string]bool{"": true, "string": true, "google": true, "gofrs": true}
-	if !validUUIDPackages[c.Go.Types.UUIDPackage] {
-		return fmt.Errorf("invalid uuid package: %s (valid: string, google, gofrs)", c.Go.Types.UUIDPackage)
+	validSortFields := map[string]bool{"": true, "spec": true, "alpha": true}
+	if !validSortFields[c.Go.OutputOptions.SortFields] {
+		return fmt.Errorf("invalid sort-fields: %s (valid: spec, alpha)", c.Go.OutputOptions.SortFields)
 	}
 
-	validNullableStrategies := map[string]bool{"": true, "pointer": true, "nullable": true}
-	if !validNullableStrategies[c.Go.Types.NullableStrategy] {
-		return fmt.Errorf("invalid nullable strategy: %s (valid: pointer, nullable)", c.Go.Types.NullableStrategy)
+	if c.Go.OutputOptions.MaxBody < -1 {
+		return fmt.Errorf("invalid max-body: %d (bytes, or -1 for no limit)", c.Go.OutputOptions.MaxBody)
 	}
 
-	validAllOfStrategies := map[string]bool{"": true, "embed": true, "flatten": true}
-	if !validAllOfStrategies[c.Go.Types.AllOfStrategy] {
-		return fmt.Errorf("invalid allof strategy: %s (valid: embed, flatten)", c.Go.Types.AllOfStrategy)
+	// structs that decode themselves, such as union variants, never see the
+	// decoder's DisallowUnknownFields, so they must reject unknown members too
+	if c.Go.OutputOptions.DisallowUnknownFields && c.Go.Types.UnknownFields != "error" {
+		return fmt.Errorf("disallow-unknown-fields requires unknown-fields: error")
 	}
 
 	validTargets := map[string]bool{
 		"types": true, "server": true, "client": true,
-		"spec": true, "strict-server": true,
+		"spec": true, "strict-server": true, "tools": true, "events": true,
+		"loadtest": true, "main": true,
 	}
 	for _, t := range c.Go.Targets {
 		if !validTargets[t] {
-			return fmt.Errorf("invalid target: %s (valid: types, server, client, spec, strict-server)", t)
+			return fmt.Errorf("invalid target: %s (valid: types, server, client, spec, strict-server, tools, events, loadtest, main)", t)
+		}
+	}
+	if c.Go.OutputOptions.SplitByTag && c.Go.OutputOptions.SingleFile {
+		return fmt.Errorf("split-by-tag writes one package per tag and cannot be combined with single-file")
+	}
+	if c.Go.OutputOptions.RouteDrift {
+		if c.Go.ServerFramework == "stdlib" {
+			return fmt.Errorf("route-drift lists the routes of echo and chi routers; the stdlib ServeMux cannot list its routes")
 		}
+		if c.Go.OutputOptions.SplitByTag {
+			return fmt.Errorf("route-drift compares a router with the operations of one package and cannot be combined with split-by-tag")
+		}
+	}
+	if c.HasTarget("main") && c.Go.OutputOptions.SplitByTag {
+		return fmt.Errorf("main target serves a single package and cannot be combined with split-by-tag")
+	}
+	if c.Go.OutputOptions.StrictValidation && !c.HasTarget("strict-server") {
+		return fmt.Errorf("strict-validation wraps the strict server and requires the strict-server target")
+	}
+	if c.HasTarget("events") && c.AsyncAPI == "" {
+		return fmt.Errorf("events target requires an AsyncAPI document (asyncapi)")
+	}
+	if c.SharedTypes.Enabled() {
+		if err := c.validateSharedTypes(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateTemplates checks the template options, which every language's
+// engine reads.
+func (c *Config) validateTemplates() error {
+	validMissingKeys := map[string]bool{"": true, "default": true, "zero": true, "error": true}
+	if !validMissingKeys[c.Templates.MissingKey] {
+		return fmt.Errorf("invalid templates missing-key: %s (valid: default, zero, error)", c.Templates.MissingKey)
 	}
+	if len(c.Templates.Delims) > 0 && (len(c.Templates.Delims) != 2 || c.Templates.Delims[0] == "" || c.Templates.Delims[1] == "") {
+		return fmt.Errorf("templates delims must be a left and a right delimiter, such as [\"[[\", \"]]\"]")
+	}
+	return nil
+}
 
+// validateTS checks the settings the TypeScript generator reads. It renders
+// a single spec into a single directory, without the Go output options.
+func (c *Config) validateTS() error {
+	if len(c.Versions) > 0 {
+		return fmt.Errorf("versions are generated as Go packages; generate ts from one spec at a time")
+	}
+	if err := ValidateFailOn(c.FailOn); err != nil {
+		return err
+	}
+	if c.SpecFormat != "" && c.Spec != "-" {
+		return fmt.Errorf("spec-format applies to a spec read from stdin (spec: -)")
+	}
+	if c.TS.OutputDir == "" {
+		return fmt.Errorf("output directory is required (ts.output-dir)")
+	}
+	for _, t := range c.TS.Targets {
+		if t != "types" && t != "client" {
+			return fmt.Errorf("invalid ts target: %s (valid: types, client)", t)
+		}
+	}
 	return nil
 }
 
+// validateJVM checks the settings the JVM generator reads. Like the
+// TypeScript one, it renders a single spec into a single directory.
+func (c *Config) validateJVM() error {
+	if len(c.Versions) > 0 {
+		return fmt.Errorf("versions are generated as Go packages; generate jvm from one spec at a time")
+	}
+	if err := ValidateFailOn(c.FailOn); err != nil {
+		return err
+	}
+	if c.SpecFormat != "" && c.Spec != "-" {
+		return fmt.Errorf("spec-format applies to a spec read from stdin (spec: -)")
+	}
+	if c.JVM.OutputDir == "" {
+		return fmt.Errorf("output directory is required (jvm.output-dir)")
+	}
+	if c.JVM.Package == "" {
+		return fmt.Errorf("package name is required (jvm.package)")
+	}
+	if c.JVM.Language != "" && c.JVM.Language != "kotlin" && c.JVM.Language != "java" {
+		return fmt.Errorf("invalid jvm language: %s (valid: kotlin, java)", c.JVM.Language)
+	}
+	for _, t := range c.JVM.Targets {
+		if t != "types" {
+			return fmt.Errorf("invalid jvm target: %s (valid: types)", t)
+		}
+	}
+	return nil
+}
+
+func (c *Config) validateSharedTypes() error {
+	if len(c.Versions) > 0 {
+		return fmt.Errorf("shared-types applies across profiles; versions share schemas through shared-package")
+	}
+	if c.SharedTypes.Package == "" || c.SharedTypes.OutputDir == "" {
+		return fmt.Errorf("shared-types needs both package and output-dir")
+	}
+	if !token.IsIdentifier(c.SharedTypes.Package) || token.IsKeyword(c.SharedTypes.Package) {
+		return fmt.Errorf("invalid shared-types package: %q is not a Go package name", c.SharedTypes.Package)
+	}
+	if filepath.Clean(c.SharedTypes.OutputDir) == filepath.Clean(c.Go.OutputDir) {
+		return fmt.Errorf("shared-types output-dir must differ from the output directory %s", c.Go.OutputDir)
+	}
+	return nil
+}
+
+func (c *Config) validateVersions() error {
+	if c.Spec != "" || c.Schema != "" || c.AsyncAPI != "" || len(c.Overlays) > 0 {
+		return fmt.Errorf("versions name their own specs and cannot be combined with spec, schema, asyncapi or overlays")
+	}
+	if c.Go.OutputOptions.SingleFile {
+		return fmt.Errorf("versions write one package each and cannot be combined with single-file")
+	}
+	shared := c.SharedPackage()
+	if !token.IsIdentifier(shared) || token.IsKeyword(shared) {
+		return fmt.Errorf("invalid shared-package: %q is not a Go package name", shared)
+	}
+	seen := make(map[string]bool)
+	for _, v := range c.Versions {
+		if !token.IsIdentifier(v.Name) || token.IsKeyword(v.Name) {
+			return fmt.Errorf("invalid version name: %q is not a Go package name", v.Name)
+		}
+		if v.Name == shared {
+			return fmt.Errorf("version %s has the name of the shared package", v.Name)
+		}
+		if seen[v.Name] {
+			return fmt.Errorf("duplicate version: %s", v.Name)
+		}
+		seen[v.Name] = true
+		if v.Spec == "" {
+			return fmt.Errorf("version %s: spec file is required", v.Name)
+		}
+	}
+	return nil
+}
+
+// SharedPackage returns the package that versions put their identical
+// schemas in.
+func (c *Config) SharedPackage() string {
+	if c.Go.OutputOptions.SharedPackage != "" {
+		return c.Go.OutputOptions.SharedPackage
+	}
+	return DefaultSharedPackage
+}
+
+// MaxBody returns the most bytes generated servers read of a request body,
+// 0 for no limit.
+func (c *Config) MaxBody() int64 {
+	switch {
+	case c.Go.OutputOptions.MaxBody < 0:
+		return 0
+	case c.Go.OutputOptions.MaxBody == 0:
+		return DefaultMaxBody
+	}
+	return c.Go.OutputOptions.MaxBody
+}
+
+// ForVersion returns the configuration of one version: its spec, generated
+// into the package and subdirectory named after it.
+func (c *Config) ForVersion(v Version) *Config {
+	vc := *c
+	vc.Versions = nil
+	vc.Spec = v.Spec
+	vc.Go.Package = v.Name
+	vc.Go.OutputDir = filepath.Join(c.Go.OutputDir, v.Name)
+	vc.Go.Targets = slices.Clone(c.Go.Targets)
+	if c.Go.OutputOptions.InitModule != "" {
+		vc.Go.OutputOptions.InitModule = path.Join(c.Go.OutputOptions.InitModule, v.Name)
+	}
+	return &vc
+}
+
+// ForSharedTypes returns the configuration of the shared types package:
+// the types target only, generated into its own package and directory.
+func (c *Config) ForSharedTypes() *Config {
+	sc := *c
+	sc.Profile = ""
+	sc.Spec = ""
+	sc.Go.Package = c.SharedTypes.Package
+	sc.Go.OutputDir = c.SharedTypes.OutputDir
+	sc.Go.Targets = []string{"types"}
+	sc.Go.OutputOptions.InitModule = ""
+	sc.Go.OutputOptions.SplitByTag = false
+	return &sc
+}
+
+// PruneSchemas reports whether schemas unreachable from the generated
+// operations are dropped: when operations are filtered by tag or only a
+// client is generated, unless keep-all-schemas is set.
+func (c *Config) PruneSchemas() bool {
+	if c.KeepAllSchemas {
+		return false
+	}
+	if len(c.IncludeTags) > 0 || len(c.ExcludeTags) > 0 {
+		return true
+	}
+	return c.HasTarget("client") && !c.HasTarget("server") && !c.HasTarget("strict-server")
+}
+
 // HasTarget checks if a specific target should be generated
 func (c *Config) HasTarget(target string) bool {
-	return slices.Contains(c.Go.Targets, target)
+	return slices.Contains(c.Targets(), target)
+}
+
+// Targets returns the targets of the language the config was built for.
+func (c *Config) Targets() []string {
+	switch c.Language {
+	case LanguageTS:
+		return c.TS.Targets
+	case LanguageJVM:
+		return c.JVM.Targets
+	}
+	return c.Go.Targets
+}
+
+// operationTargets render the spec's operations and produce nothing useful,
+// or code that does not compile, without any.
+var operationTargets = []string{"server", "strict-server", "client", "tools", "loadtest", "main"}
+
+// failOnKinds maps the fail-on categories to the warning kinds they cover.
+var failOnKinds = map[string]model.WarningKind{
+	"any-fallback":             model.WarningComposition,
+	"dropped-media-type":       model.WarningMediaType,
+	"ignored-keyword":          model.WarningIgnoredKeyword,
+	"ignored-extension":        model.WarningExtension,
+	"invalid-type":             model.WarningInvalidType,
+	"synthesized-operation-id": model.WarningOperationID,
+}
+
+// ValidateFailOn checks that categories are all fail-on categories.
+func ValidateFailOn(categories []string) error {
+	for _, category := range categories {
+		if _, ok := failOnKinds[category]; !ok {
+			valid := slices.Sorted(maps.Keys(failOnKinds))
+			return fmt.Errorf("invalid fail-on category: %s (valid: %s)", category, strings.Join(valid, ", "))
+		}
+	}
+	return nil
+}
+
+// FailsOn reports whether a warning of kind falls in one of the fail-on
+// categories. Strict mode fails on every kind regardless.
+func (c *Config) FailsOn(kind model.WarningKind) bool {
+	return slices.ContainsFunc(c.FailOn, func(category string) bool {
+		return failOnKinds[category] == kind
+	})
+}
+
+// DropOperationTargets removes the targets that need operations, for specs
+// without paths such as components-only model packages. It returns the
+// targets removed.
+func (c *Config) DropOperationTargets() []string {
+	var dropped, kept []string
+	for _, t := range c.Targets() {
+		if slices.Contains(operationTargets, t) {
+			dropped = append(dropped, t)
+		} else {
+			kept = append(kept, t)
+		}
+	}
+	switch c.Language {
+	case LanguageTS:
+		c.TS.Targets = kept
+	case LanguageJVM:
+		c.JVM.Targets = kept
+	default:
+		c.Go.Targets = kept
+	}
+	return dropped
 }
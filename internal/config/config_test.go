@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/kolah/eugene/internal/model"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 )
@@ -120,6 +121,32 @@ func TestConfigValidate(t *testing.T) {
 			wantErr:     true,
 			errContains: "invalid enum strategy",
 		},
+		{
+			name: "invalid enum unknown",
+			config: Config{
+				Spec: "spec.yaml",
+				Go: GoConfig{
+					OutputDir: "output",
+					Package:   "gen",
+					Types:     TypesConfig{EnumUnknown: "ignore"},
+				},
+			},
+			wantErr:     true,
+			errContains: "invalid enum-unknown",
+		},
+		{
+			name: "invalid unknown fields",
+			config: Config{
+				Spec: "spec.yaml",
+				Go: GoConfig{
+					OutputDir: "output",
+					Package:   "gen",
+					Types:     TypesConfig{UnknownFields: "reject"},
+				},
+			},
+			wantErr:     true,
+			errContains: "invalid unknown-fields",
+		},
 		{
 			name: "valid enum strategy const",
 			config: Config{
@@ -205,6 +232,60 @@ func TestConfigValidate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "invalid sort-fields",
+			config: Config{
+				Spec: "spec.yaml",
+				Go: GoConfig{
+					OutputDir:     "output",
+					Package:       "gen",
+					OutputOptions: OutputOptions{SortFields: "name"},
+				},
+			},
+			wantErr:     true,
+			errContains: "invalid sort-fields",
+		},
+		{
+			name: "valid sort-fields alpha",
+			config: Config{
+				Spec: "spec.yaml",
+				Go: GoConfig{
+					OutputDir:     "output",
+					Package:       "gen",
+					OutputOptions: OutputOptions{SortFields: "alpha"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid templates missing-key",
+			config: Config{
+				Spec:      "spec.yaml",
+				Templates: TemplateConfig{MissingKey: "panic"},
+				Go:        GoConfig{OutputDir: "output", Package: "gen"},
+			},
+			wantErr:     true,
+			errContains: "invalid templates missing-key",
+		},
+		{
+			name: "templates delims need both sides",
+			config: Config{
+				Spec:      "spec.yaml",
+				Templates: TemplateConfig{Delims: []string{"[["}},
+				Go:        GoConfig{OutputDir: "output", Package: "gen"},
+			},
+			wantErr:     true,
+			errContains: "templates delims",
+		},
+		{
+			name: "valid templates missing-key and delims",
+			config: Config{
+				Spec:      "spec.yaml",
+				Templates: TemplateConfig{MissingKey: "error", Delims: []string{"[[", "]]"}},
+				Go:        GoConfig{OutputDir: "output", Package: "gen"},
+			},
+			wantErr: false,
+		},
 		{
 			name: "empty enum strategy is valid",
 			config: Config{
@@ -229,6 +310,358 @@ func TestConfigValidate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "schema input",
+			config: Config{
+				Schema: "order.schema.json",
+				Go:     GoConfig{OutputDir: "output", Package: "gen", Targets: []string{"types"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "schema input with spec",
+			config: Config{
+				Spec:   "spec.yaml",
+				Schema: "order.schema.json",
+				Go:     GoConfig{OutputDir: "output", Package: "gen"},
+			},
+			wantErr:     true,
+			errContains: "mutually exclusive",
+		},
+		{
+			name: "schema input with overlays",
+			config: Config{
+				Schema:   "order.schema.json",
+				Overlays: []string{"patch.yaml"},
+				Go:       GoConfig{OutputDir: "output", Package: "gen"},
+			},
+			wantErr:     true,
+			errContains: "overlays apply to an OpenAPI spec",
+		},
+		{
+			name: "schema input with client target",
+			config: Config{
+				Schema: "order.schema.json",
+				Go:     GoConfig{OutputDir: "output", Package: "gen", Targets: []string{"types", "client"}},
+			},
+			wantErr:     true,
+			errContains: "only supports the types target",
+		},
+		{
+			name: "events target without asyncapi",
+			config: Config{
+				Spec: "spec.yaml",
+				Go:   GoConfig{OutputDir: "output", Package: "gen", Targets: []string{"types", "events"}},
+			},
+			wantErr:     true,
+			errContains: "events target requires an AsyncAPI document",
+		},
+		{
+			name: "events target with asyncapi",
+			config: Config{
+				Spec:     "spec.yaml",
+				AsyncAPI: "events.yaml",
+				Go:       GoConfig{OutputDir: "output", Package: "gen", Targets: []string{"types", "events"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "split by tag with single file",
+			config: Config{
+				Spec: "spec.yaml",
+				Go: GoConfig{
+					OutputDir:     "output",
+					Package:       "gen",
+					OutputOptions: OutputOptions{SplitByTag: true, SingleFile: true},
+				},
+			},
+			wantErr:     true,
+			errContains: "cannot be combined with single-file",
+		},
+		{
+			name: "main with split by tag",
+			config: Config{
+				Spec: "spec.yaml",
+				Go: GoConfig{
+					OutputDir:     "output",
+					Package:       "gen",
+					Targets:       []string{"types", "server", "main"},
+					OutputOptions: OutputOptions{SplitByTag: true},
+				},
+			},
+			wantErr:     true,
+			errContains: "main target serves a single package",
+		},
+		{
+			name: "versions",
+			config: Config{
+				Versions: []Version{{Name: "v1", Spec: "v1.yaml"}, {Name: "v2", Spec: "v2.yaml"}},
+				Go:       GoConfig{OutputDir: "output"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "versions with spec",
+			config: Config{
+				Spec:     "spec.yaml",
+				Versions: []Version{{Name: "v1", Spec: "v1.yaml"}},
+				Go:       GoConfig{OutputDir: "output"},
+			},
+			wantErr:     true,
+			errContains: "cannot be combined with spec",
+		},
+		{
+			name: "duplicate version",
+			config: Config{
+				Versions: []Version{{Name: "v1", Spec: "v1.yaml"}, {Name: "v1", Spec: "v2.yaml"}},
+				Go:       GoConfig{OutputDir: "output"},
+			},
+			wantErr:     true,
+			errContains: "duplicate version: v1",
+		},
+		{
+			name: "version named like the shared package",
+			config: Config{
+				Versions: []Version{{Name: "common", Spec: "v1.yaml"}},
+				Go:       GoConfig{OutputDir: "output"},
+			},
+			wantErr:     true,
+			errContains: "name of the shared package",
+		},
+		{
+			name: "invalid version name",
+			config: Config{
+				Versions: []Version{{Name: "v1.0", Spec: "v1.yaml"}},
+				Go:       GoConfig{OutputDir: "output"},
+			},
+			wantErr:     true,
+			errContains: "invalid version name",
+		},
+		{
+			name: "shared types",
+			config: Config{
+				Spec:        "spec.yaml",
+				SharedTypes: SharedTypes{Package: "common", OutputDir: "gen/common"},
+				Go:          GoConfig{OutputDir: "gen/pets", Package: "pets"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "shared types without output dir",
+			config: Config{
+				Spec:        "spec.yaml",
+				SharedTypes: SharedTypes{Package: "common"},
+				Go:          GoConfig{OutputDir: "gen/pets", Package: "pets"},
+			},
+			wantErr:     true,
+			errContains: "needs both package and output-dir",
+		},
+		{
+			name: "shared types in the output directory",
+			config: Config{
+				Spec:        "spec.yaml",
+				SharedTypes: SharedTypes{Package: "common", OutputDir: "gen/pets/"},
+				Go:          GoConfig{OutputDir: "gen/pets", Package: "pets"},
+			},
+			wantErr:     true,
+			errContains: "must differ from the output directory",
+		},
+		{
+			name: "fail-on categories",
+			config: Config{
+				Spec:   "spec.yaml",
+				FailOn: []string{"any-fallback", "dropped-media-type", "ignored-keyword"},
+				Go:     GoConfig{OutputDir: "output", Package: "gen"},
+			},
+		},
+		{
+			name: "spec from stdin",
+			config: Config{
+				Spec:       "-",
+				SpecFormat: "json",
+				Go:         GoConfig{OutputDir: "output", Package: "gen"},
+			},
+		},
+		{
+			name: "invalid spec format",
+			config: Config{
+				Spec:       "-",
+				SpecFormat: "toml",
+				Go:         GoConfig{OutputDir: "output", Package: "gen"},
+			},
+			wantErr:     true,
+			errContains: "invalid spec-format: toml",
+		},
+		{
+			name: "spec format for a spec file",
+			config: Config{
+				Spec:       "spec.yaml",
+				SpecFormat: "yaml",
+				Go:         GoConfig{OutputDir: "output", Package: "gen"},
+			},
+			wantErr:     true,
+			errContains: "spec-format applies to a spec read from stdin",
+		},
+		{
+			name: "invalid fail-on category",
+			config: Config{
+				Spec:   "spec.yaml",
+				FailOn: []string{"any-fallback", "media-type"},
+				Go:     GoConfig{OutputDir: "output", Package: "gen"},
+			},
+			wantErr:     true,
+			errContains: "invalid fail-on category: media-type",
+		},
+		{
+			name: "ts without a Go package",
+			config: Config{
+				Spec:     "spec.yaml",
+				Language: LanguageTS,
+				TS:       TSConfig{OutputDir: "web/api", Targets: []string{"client", "types"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "ts missing output dir",
+			config: Config{
+				Spec:     "spec.yaml",
+				Language: LanguageTS,
+				Go:       GoConfig{OutputDir: "output", Package: "gen"},
+			},
+			wantErr:     true,
+			errContains: "output directory is required (ts.output-dir)",
+		},
+		{
+			name: "invalid ts target",
+			config: Config{
+				Spec:     "spec.yaml",
+				Language: LanguageTS,
+				TS:       TSConfig{OutputDir: "web/api", Targets: []string{"server"}},
+			},
+			wantErr:     true,
+			errContains: "invalid ts target: server",
+		},
+		{
+			name: "ts with invalid templates missing-key",
+			config: Config{
+				Spec:      "spec.yaml",
+				Language:  LanguageTS,
+				TS:        TSConfig{OutputDir: "web/api"},
+				Templates: TemplateConfig{MissingKey: "nope"},
+			},
+			wantErr:     true,
+			errContains: "invalid templates missing-key: nope",
+		},
+		{
+			name: "route-drift with stdlib",
+			config: Config{
+				Spec: "spec.yaml",
+				Go: GoConfig{
+					OutputDir:       "output",
+					Package:         "gen",
+					ServerFramework: "stdlib",
+					OutputOptions:   OutputOptions{RouteDrift: true},
+				},
+			},
+			wantErr:     true,
+			errContains: "stdlib ServeMux cannot list its routes",
+		},
+		{
+			name: "negative max-body",
+			config: Config{
+				Spec: "spec.yaml",
+				Go: GoConfig{
+					OutputDir:     "output",
+					Package:       "gen",
+					OutputOptions: OutputOptions{MaxBody: -2},
+				},
+			},
+			wantErr:     true,
+			errContains: "invalid max-body: -2",
+		},
+		{
+			name: "disallow-unknown-fields with unknown-fields error",
+			config: Config{
+				Spec: "spec.yaml",
+				Go: GoConfig{
+					OutputDir:     "output",
+					Package:       "gen",
+					Types:         TypesConfig{UnknownFields: "error"},
+					OutputOptions: OutputOptions{DisallowUnknownFields: true},
+				},
+			},
+		},
+		{
+			name: "disallow-unknown-fields without unknown-fields error",
+			config: Config{
+				Spec: "spec.yaml",
+				Go: GoConfig{
+					OutputDir:     "output",
+					Package:       "gen",
+					Types:         TypesConfig{UnknownFields: "capture"},
+					OutputOptions: OutputOptions{DisallowUnknownFields: true},
+				},
+			},
+			wantErr:     true,
+			errContains: "disallow-unknown-fields requires unknown-fields: error",
+		},
+		{
+			name: "strict-validation without strict-server",
+			config: Config{
+				Spec: "spec.yaml",
+				Go: GoConfig{
+					OutputDir:     "output",
+					Package:       "gen",
+					Targets:       []string{"types", "server"},
+					OutputOptions: OutputOptions{StrictValidation: true},
+				},
+			},
+			wantErr:     true,
+			errContains: "strict-validation wraps the strict server and requires the strict-server target",
+		},
+		{
+			name: "strict-validation with strict-server",
+			config: Config{
+				Spec: "spec.yaml",
+				Go: GoConfig{
+					OutputDir:     "output",
+					Package:       "gen",
+					Targets:       []string{"types", "strict-server"},
+					OutputOptions: OutputOptions{StrictValidation: true},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "jvm types",
+			config: Config{
+				Spec:     "spec.yaml",
+				Language: LanguageJVM,
+				JVM:      JVMConfig{OutputDir: "android/api", Package: "com.example.api", Language: "java", Targets: []string{"types"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "jvm missing package",
+			config: Config{
+				Spec:     "spec.yaml",
+				Language: LanguageJVM,
+				Go:       GoConfig{OutputDir: "output", Package: "gen"},
+				JVM:      JVMConfig{OutputDir: "android/api"},
+			},
+			wantErr:     true,
+			errContains: "package name is required (jvm.package)",
+		},
+		{
+			name: "invalid jvm language",
+			config: Config{
+				Spec:     "spec.yaml",
+				Language: LanguageJVM,
+				JVM:      JVMConfig{OutputDir: "android/api", Package: "com.example.api", Language: "scala"},
+			},
+			wantErr:     true,
+			errContains: "invalid jvm language: scala",
+		},
 	}
 
 	for _, tt := range tests {
@@ -317,6 +750,47 @@ go:
 	require.True(t, cfg.HasTarget("client"))
 }
 
+func TestLoadTS(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configContent := `
+spec: api.yaml
+go:
+  output-dir: ./output
+  package: gen
+ts:
+  output-dir: ./web/src/api
+`
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "eugene.yaml"), []byte(configContent), 0644))
+
+	oldWd, _ := os.Getwd()
+	os.Chdir(tmpDir)
+	defer os.Chdir(oldWd)
+
+	generate := &cobra.Command{Use: "generate"}
+	BindCommonFlags(generate)
+	ts := &cobra.Command{Use: "ts"}
+	ts.PersistentFlags().StringP("output-dir", "o", "", "")
+	client := &cobra.Command{Use: "client"}
+	generate.AddCommand(ts)
+	ts.AddCommand(client)
+
+	cfg, err := Load(client, nil)
+	require.NoError(t, err)
+	require.Equal(t, LanguageTS, cfg.Language)
+	require.Equal(t, "./web/src/api", cfg.TS.OutputDir)
+	require.Equal(t, []string{"client", "types"}, cfg.Targets(), "no targets generates all")
+
+	// --output-dir sets the directory of the language it runs under
+	require.NoError(t, ts.PersistentFlags().Set("output-dir", "./out"))
+	cfg, err = Load(ts, []string{"types"})
+	require.NoError(t, err)
+	require.Equal(t, "./out", cfg.TS.OutputDir)
+	require.Equal(t, "./output", cfg.Go.OutputDir)
+	require.Equal(t, []string{"types"}, cfg.Targets())
+	require.False(t, cfg.HasTarget("client"))
+}
+
 func TestLoadWithExplicitConfigPath(t *testing.T) {
 	tmpDir := t.TempDir()
 
@@ -343,6 +817,105 @@ go:
 	require.Equal(t, "./custom", cfg.Go.OutputDir)
 }
 
+func TestLoadProfiles(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configContent := `
+spec: api.yaml
+go:
+  output-dir: ./output
+  package: gen
+  server-framework: echo
+  targets: [types]
+profiles:
+  billing:
+    spec: billing.yaml
+    go:
+      output-dir: ./billing
+      package: billing
+      targets: [types, client]
+  users:
+    go:
+      package: users
+`
+	configPath := filepath.Join(tmpDir, "eugene.yaml")
+	err := os.WriteFile(configPath, []byte(configContent), 0644)
+	require.NoError(t, err)
+
+	oldWd, _ := os.Getwd()
+	os.Chdir(tmpDir)
+	defer os.Chdir(oldWd)
+
+	t.Run("single profile", func(t *testing.T) {
+		cmd := &cobra.Command{}
+		BindCommonFlags(cmd)
+		bindGoFlags(cmd)
+		cmd.PersistentFlags().Set("profile", "billing")
+
+		cfg, err := Load(cmd, nil)
+		require.NoError(t, err)
+		require.Equal(t, "billing", cfg.Profile)
+		require.Equal(t, "billing.yaml", cfg.Spec)
+		require.Equal(t, "./billing", cfg.Go.OutputDir)
+		require.Equal(t, "echo", cfg.Go.ServerFramework, "inherited from top level")
+		require.Equal(t, []string{"types", "client"}, cfg.Go.Targets)
+	})
+
+	t.Run("all profiles", func(t *testing.T) {
+		cmd := &cobra.Command{}
+		BindCommonFlags(cmd)
+		bindGoFlags(cmd)
+		cmd.PersistentFlags().Set("all-profiles", "true")
+
+		cfgs, err := LoadAll(cmd, nil)
+		require.NoError(t, err)
+		require.Len(t, cfgs, 2)
+		require.Equal(t, "billing", cfgs[0].Profile)
+		require.Equal(t, "users", cfgs[1].Profile)
+		require.Equal(t, "api.yaml", cfgs[1].Spec)
+		require.Equal(t, "users", cfgs[1].Go.Package)
+		require.Equal(t, []string{"types"}, cfgs[1].Go.Targets)
+	})
+
+	t.Run("unknown profile", func(t *testing.T) {
+		cmd := &cobra.Command{}
+		BindCommonFlags(cmd)
+		bindGoFlags(cmd)
+		cmd.PersistentFlags().Set("profile", "missing")
+
+		_, err := Load(cmd, nil)
+		require.EqualError(t, err, "unknown profile: missing")
+	})
+}
+
+func TestCheckFile(t *testing.T) {
+	configContent := `
+spec: api.yaml
+go:
+  package: gen
+  output-dir: ./gen
+  types:
+    enum-stratergy: const
+  import-mapping:
+    "#/components/schemas/Error": github.com/acme/common
+  colour: blue
+profiles:
+  billing:
+    spce: billing.yaml
+`
+	configPath := filepath.Join(t.TempDir(), "eugene.yaml")
+	err := os.WriteFile(configPath, []byte(configContent), 0644)
+	require.NoError(t, err)
+
+	issues, err := CheckFile(configPath)
+	require.NoError(t, err)
+	require.Equal(t, []KeyIssue{
+		{Key: "go.colour"},
+		{Key: "go.types.enum-stratergy", Suggestion: "go.types.enum-strategy"},
+		{Key: "profiles.billing.spce", Suggestion: "profiles.billing.spec"},
+	}, issues)
+}
+
 func TestBuildFlagsMap(t *testing.T) {
 	cmd := &cobra.Command{}
 	BindCommonFlags(cmd)
@@ -353,6 +926,9 @@ func TestBuildFlagsMap(t *testing.T) {
 	cmd.Flags().Set("output-dir", "./out")
 	cmd.Flags().Set("server-framework", "chi")
 	cmd.Flags().Set("enum-strategy", "type")
+	cmd.Flags().Set("prune-orphans", "true")
+	cmd.Flags().Set("local-prefix", "github.com/acme")
+	cmd.PersistentFlags().Set("fail-on", "any-fallback,ignored-keyword")
 
 	m := buildFlagsMap(cmd)
 
@@ -361,6 +937,9 @@ func TestBuildFlagsMap(t *testing.T) {
 	require.Equal(t, "./out", m["go.output-dir"])
 	require.Equal(t, "chi", m["go.server-framework"])
 	require.Equal(t, "type", m["go.types.enum-strategy"])
+	require.Equal(t, true, m["go.output-options.prune-orphans"])
+	require.Equal(t, "github.com/acme", m["go.output-options.local-prefix"])
+	require.Equal(t, []string{"any-fallback", "ignored-keyword"}, m["fail-on"])
 }
 
 func TestHasTarget(t *testing.T) {
@@ -376,6 +955,89 @@ func TestHasTarget(t *testing.T) {
 	require.False(t, cfg.HasTarget("spec"))
 }
 
+func TestDropOperationTargets(t *testing.T) {
+	cfg := &Config{Go: GoConfig{Targets: []string{"types", "server", "client", "tools", "spec", "strict-server"}}}
+	require.Equal(t, []string{"server", "client", "tools", "strict-server"}, cfg.DropOperationTargets())
+	require.Equal(t, []string{"types", "spec"}, cfg.Go.Targets)
+	require.Empty(t, cfg.DropOperationTargets())
+}
+
+func TestFailsOn(t *testing.T) {
+	cfg := &Config{FailOn: []string{"any-fallback", "dropped-media-type"}}
+	require.True(t, cfg.FailsOn(model.WarningComposition))
+	require.True(t, cfg.FailsOn(model.WarningMediaType))
+	require.False(t, cfg.FailsOn(model.WarningIgnoredKeyword))
+	require.False(t, (&Config{Strict: true}).FailsOn(model.WarningComposition), "strict is checked on its own")
+}
+
+func TestMaxBody(t *testing.T) {
+	require.Equal(t, int64(DefaultMaxBody), (&Config{}).MaxBody())
+	require.Equal(t, int64(4096), (&Config{Go: GoConfig{OutputOptions: OutputOptions{MaxBody: 4096}}}).MaxBody())
+	require.Zero(t, (&Config{Go: GoConfig{OutputOptions: OutputOptions{MaxBody: -1}}}).MaxBody(), "-1 lifts the limit")
+}
+
+func TestForVersion(t *testing.T) {
+	cfg := &Config{
+		Versions: []Version{{Name: "v1", Spec: "v1.yaml"}},
+		Go: GoConfig{
+			OutputDir:     "api",
+			Targets:       []string{"types", "server"},
+			OutputOptions: OutputOptions{InitModule: "example.com/api"},
+		},
+	}
+	vc := cfg.ForVersion(cfg.Versions[0])
+	require.Empty(t, vc.Versions)
+	require.Equal(t, "v1.yaml", vc.Spec)
+	require.Equal(t, "v1", vc.Go.Package)
+	require.Equal(t, filepath.Join("api", "v1"), vc.Go.OutputDir)
+	require.Equal(t, "example.com/api/v1", vc.Go.OutputOptions.InitModule)
+
+	vc.Go.Targets[0] = "client"
+	require.Equal(t, "types", cfg.Go.Targets[0])
+}
+
+func TestForSharedTypes(t *testing.T) {
+	cfg := &Config{
+		Profile:     "pets",
+		Spec:        "pets.yaml",
+		SharedTypes: SharedTypes{Package: "common", OutputDir: "gen/common"},
+		Go: GoConfig{
+			OutputDir:     "gen/pets",
+			Package:       "pets",
+			Targets:       []string{"types", "server"},
+			Types:         TypesConfig{EnumStrategy: "type"},
+			OutputOptions: OutputOptions{InitModule: "example.com/pets", SplitByTag: true},
+		},
+	}
+	sc := cfg.ForSharedTypes()
+	require.Empty(t, sc.Profile)
+	require.Equal(t, "common", sc.Go.Package)
+	require.Equal(t, "gen/common", sc.Go.OutputDir)
+	require.Equal(t, []string{"types"}, sc.Go.Targets)
+	require.Equal(t, "type", sc.Go.Types.EnumStrategy)
+	require.Empty(t, sc.Go.OutputOptions.InitModule)
+	require.False(t, sc.Go.OutputOptions.SplitByTag)
+	require.Equal(t, []string{"types", "server"}, cfg.Go.Targets)
+}
+
+func TestExpandTargets(t *testing.T) {
+	require.Equal(t, []string{"types", "server", "client", "spec", "strict-server"}, expandTargets([]string{"all"}))
+	require.Equal(t, []string{"types", "client", "tools"}, expandTargets([]string{"types", "tools"}))
+	require.Equal(t, []string{"types", "server", "main"}, expandTargets([]string{"main"}))
+	require.Equal(t, []string{"types", "server"}, expandTargets([]string{"server"}))
+	require.Equal(t, []string{"types", "strict-server"}, expandTargets([]string{"strict-server"}))
+	require.Equal(t, []string{"types", "strict-server", "main"}, expandTargets([]string{"types", "strict-server", "main"}))
+}
+
+func TestPruneSchemas(t *testing.T) {
+	targets := func(t ...string) GoConfig { return GoConfig{Targets: t} }
+	require.False(t, (&Config{Go: targets("types", "server", "client")}).PruneSchemas())
+	require.True(t, (&Config{Go: targets("types", "client")}).PruneSchemas(), "client-only generation")
+	require.True(t, (&Config{IncludeTags: []string{"pets"}, Go: targets("types", "server")}).PruneSchemas(), "tag filter")
+	require.False(t, (&Config{KeepAllSchemas: true, Go: targets("types", "client")}).PruneSchemas())
+	require.False(t, (&Config{Go: targets("types")}).PruneSchemas())
+}
+
 // Helper to bind Go-specific flags for testing
 func bindGoFlags(cmd *cobra.Command) {
 	flags := cmd.Flags()
@@ -387,4 +1049,49 @@ func bindGoFlags(cmd *cobra.Command) {
 	flags.String("nullable-strategy", "", "Nullable strategy: pointer, nullable")
 	flags.Bool("enable-yaml-tags", false, "Generate yaml tags")
 	flags.StringSlice("additional-initialisms", nil, "Additional initialisms")
+	flags.Bool("prune-orphans", false, "Delete stale *.eugene.go files")
+	flags.String("local-prefix", "", "Local import prefixes")
+}
+
+func TestLoadJVM(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	configContent := `
+spec: api.yaml
+go:
+  output-dir: ./output
+  package: gen
+jvm:
+  output-dir: ./android/api
+  package: com.example.api
+`
+	require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "eugene.yaml"), []byte(configContent), 0644))
+
+	oldWd, _ := os.Getwd()
+	os.Chdir(tmpDir)
+	defer os.Chdir(oldWd)
+
+	generate := &cobra.Command{Use: "generate"}
+	BindCommonFlags(generate)
+	jvm := &cobra.Command{Use: "jvm"}
+	jvm.PersistentFlags().StringP("package", "p", "", "")
+	jvm.PersistentFlags().StringP("language", "l", "", "")
+	generate.AddCommand(jvm)
+
+	cfg, err := Load(jvm, nil)
+	require.NoError(t, err)
+	require.Equal(t, LanguageJVM, cfg.Language)
+	require.Equal(t, "com.example.api", cfg.JVM.Package)
+	require.Empty(t, cfg.JVM.Language, "kotlin by default")
+	require.Equal(t, []string{"types"}, cfg.Targets(), "no targets generates all")
+
+	// --package sets the package of the language it runs under
+	require.NoError(t, jvm.PersistentFlags().Set("package", "org.example"))
+	require.NoError(t, jvm.PersistentFlags().Set("language", "java"))
+	cfg, err = Load(jvm, []string{"all"})
+	require.NoError(t, err)
+	require.Equal(t, "org.example", cfg.JVM.Package)
+	require.Equal(t, "gen", cfg.Go.Package)
+	require.Equal(t, "java", cfg.JVM.Language)
+	require.Equal(t, []string{"types"}, cfg.Targets())
 }
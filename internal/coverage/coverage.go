@@ -0,0 +1,331 @@
+// Package coverage reports how much of a spec a service implements: which
+// methods of the generated server interfaces its handlers implement, and
+// which still answer 501 Not Implemented.
+//
+// Handlers are found with go/packages among the non-generated types of the
+// loaded packages. A method counts as unimplemented when it is promoted from
+// the generated UnimplementedServer or UnimplementedStrictServer, or when
+// its body is a stub: empty, or a statement or two that answer 501, panic,
+// say they are not implemented or defer to the Unimplemented server.
+package coverage
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// Status is whether an operation is implemented.
+type Status string
+
+const (
+	Implemented   Status = "implemented"
+	Unimplemented Status = "unimplemented"
+)
+
+// serverInterfaces maps the generated server interfaces to the
+// implementations of them that answer 501 to every operation.
+var serverInterfaces = map[string]string{
+	"ServerInterface":       "UnimplementedServer",
+	"StrictServerInterface": "UnimplementedStrictServer",
+}
+
+// Report is the coverage of the handlers found in the loaded packages.
+type Report struct {
+	Handlers []Handler `json:"handlers"`
+}
+
+// Handler is a type implementing a generated server interface.
+type Handler struct {
+	Type       string      `json:"type"`      // such as *api.Handlers
+	Interface  string      `json:"interface"` // such as api.StrictServerInterface
+	Position   string      `json:"position"`
+	Operations []Operation `json:"operations"`
+}
+
+// Operation is a method of the interface, named after the operation.
+type Operation struct {
+	Name     string `json:"name"`
+	Status   Status `json:"status"`
+	Reason   string `json:"reason,omitempty"` // why it is unimplemented
+	Position string `json:"position,omitempty"`
+}
+
+// Implemented returns the number of operations implemented, and the total.
+func (h Handler) Implemented() (int, int) {
+	n := 0
+	for _, op := range h.Operations {
+		if op.Status == Implemented {
+			n++
+		}
+	}
+	return n, len(h.Operations)
+}
+
+// Implemented returns the number of operations implemented across all
+// handlers, and the total.
+func (r *Report) Implemented() (int, int) {
+	implemented, total := 0, 0
+	for _, h := range r.Handlers {
+		n, t := h.Implemented()
+		implemented += n
+		total += t
+	}
+	return implemented, total
+}
+
+// Analyze loads the packages matching patterns, relative to dir, and
+// reports the coverage of the handlers declared in them. The generated
+// server interfaces are looked up in those packages and the packages they
+// import.
+func Analyze(dir string, patterns ...string) (*Report, error) {
+	// Dependencies are type-checked from source rather than read from
+	// export data, whose format follows the Go toolchain installed.
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedCompiledGoFiles |
+			packages.NeedImports | packages.NeedDeps | packages.NeedTypes |
+			packages.NeedTypesSizes | packages.NeedSyntax | packages.NeedTypesInfo,
+		Dir:  dir,
+		Fset: token.NewFileSet(),
+	}
+	pkgs, err := packages.Load(cfg, patterns...)
+	if err != nil {
+		return nil, fmt.Errorf("loading packages: %w", err)
+	}
+	if n := packages.PrintErrors(pkgs); n > 0 {
+		return nil, fmt.Errorf("loading packages: %d error(s)", n)
+	}
+
+	if dir, err = filepath.Abs(dir); err != nil {
+		return nil, err
+	}
+	a := &analyzer{
+		fset:      cfg.Fset,
+		dir:       dir,
+		decls:     make(map[*types.Func]*ast.FuncDecl),
+		generated: make(map[token.Pos]bool),
+	}
+	for _, pkg := range pkgs {
+		a.index(pkg)
+	}
+	interfaces := a.interfaces(pkgs)
+	if len(interfaces) == 0 {
+		return nil, fmt.Errorf("no generated ServerInterface or StrictServerInterface in %s or its imports", strings.Join(patterns, " "))
+	}
+
+	report := &Report{}
+	for _, pkg := range pkgs {
+		scope := pkg.Types.Scope()
+		for _, name := range scope.Names() {
+			tn, ok := scope.Lookup(name).(*types.TypeName)
+			if !ok || tn.IsAlias() || a.generated[tn.Pos()] {
+				continue
+			}
+			named, ok := tn.Type().(*types.Named)
+			if !ok || types.IsInterface(named) {
+				continue
+			}
+			for _, iface := range interfaces {
+				if h, ok := a.handler(named, iface); ok {
+					report.Handlers = append(report.Handlers, h)
+				}
+			}
+		}
+	}
+	if len(report.Handlers) == 0 {
+		return nil, fmt.Errorf("no type in %s implements a generated server interface", strings.Join(patterns, " "))
+	}
+	return report, nil
+}
+
+// serverInterface is a generated server interface and its Unimplemented
+// implementation.
+type serverInterface struct {
+	name          *types.TypeName
+	iface         *types.Interface
+	unimplemented *types.TypeName
+}
+
+type analyzer struct {
+	fset      *token.FileSet
+	dir       string
+	decls     map[*types.Func]*ast.FuncDecl
+	generated map[token.Pos]bool // type declarations in generated files
+}
+
+// index records the method declarations of pkg, and the types declared in
+// its generated files.
+func (a *analyzer) index(pkg *packages.Package) {
+	for _, file := range pkg.Syntax {
+		generated := ast.IsGenerated(file)
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if fn, ok := pkg.TypesInfo.Defs[decl.Name].(*types.Func); ok && decl.Recv != nil {
+					a.decls[fn] = decl
+				}
+			case *ast.GenDecl:
+				if !generated || decl.Tok != token.TYPE {
+					continue
+				}
+				for _, spec := range decl.Specs {
+					a.generated[spec.(*ast.TypeSpec).Name.Pos()] = true
+				}
+			}
+		}
+	}
+}
+
+// interfaces returns the generated server interfaces declared in pkgs or
+// the packages they import.
+func (a *analyzer) interfaces(pkgs []*packages.Package) []serverInterface {
+	seen := make(map[*types.Package]bool)
+	var result []serverInterface
+	visit := func(pkg *types.Package) {
+		if seen[pkg] {
+			return
+		}
+		seen[pkg] = true
+		for _, name := range slices.Sorted(maps.Keys(serverInterfaces)) {
+			tn, ok := pkg.Scope().Lookup(name).(*types.TypeName)
+			if !ok {
+				continue
+			}
+			iface, ok := tn.Type().Underlying().(*types.Interface)
+			unimplemented, _ := pkg.Scope().Lookup(serverInterfaces[name]).(*types.TypeName)
+			if ok && unimplemented != nil {
+				result = append(result, serverInterface{name: tn, iface: iface, unimplemented: unimplemented})
+			}
+		}
+	}
+	for _, pkg := range pkgs {
+		visit(pkg.Types)
+		for _, imp := range pkg.Types.Imports() {
+			visit(imp)
+		}
+	}
+	return result
+}
+
+// handler reports the coverage of named, if it or a pointer to it
+// implements the interface. Wrappers that implement it through an embedded
+// interface, such as middleware, are not handlers.
+func (a *analyzer) handler(named *types.Named, si serverInterface) (Handler, bool) {
+	var recv types.Type = named
+	if !types.Implements(recv, si.iface) {
+		recv = types.NewPointer(named)
+		if !types.Implements(recv, si.iface) {
+			return Handler{}, false
+		}
+	}
+	qualify := func(pkg *types.Package) string { return pkg.Name() }
+	h := Handler{
+		Type:      types.TypeString(recv, qualify),
+		Interface: types.TypeString(si.name.Type(), qualify),
+		Position:  a.position(named.Obj().Pos()),
+	}
+
+	methods := types.NewMethodSet(recv)
+	for i := range si.iface.NumMethods() {
+		name := si.iface.Method(i).Name()
+		fn := methods.Lookup(si.iface.Method(i).Pkg(), name).Obj().(*types.Func)
+		if types.IsInterface(fn.Signature().Recv().Type()) {
+			return Handler{}, false
+		}
+		op := Operation{Name: name, Status: Implemented}
+		if decl, ok := a.decls[fn]; ok {
+			op.Position = a.position(decl.Pos())
+		}
+		if reason := a.unimplemented(fn, si); reason != "" {
+			op.Status, op.Reason = Unimplemented, reason
+		}
+		h.Operations = append(h.Operations, op)
+	}
+	return h, true
+}
+
+// unimplemented returns why fn does not implement its operation, or an
+// empty string when it does.
+func (a *analyzer) unimplemented(fn *types.Func, si serverInterface) string {
+	recv := fn.Signature().Recv().Type()
+	if ptr, ok := recv.(*types.Pointer); ok {
+		recv = ptr.Elem()
+	}
+	if named, ok := recv.(*types.Named); ok && named.Obj() == si.unimplemented {
+		return "promoted from " + si.unimplemented.Name()
+	}
+	decl, ok := a.decls[fn]
+	if !ok || decl.Body == nil {
+		return "" // declared in a package that was not loaded
+	}
+	return stub(decl.Body)
+}
+
+// stubStatements is the most statements a stub answering 501 has, such as
+// an http.Error followed by a return.
+const stubStatements = 2
+
+// stub returns why body is a stub, or an empty string when it is not.
+func stub(body *ast.BlockStmt) string {
+	if len(body.List) == 0 {
+		return "empty body"
+	}
+	if len(body.List) > stubStatements {
+		return ""
+	}
+	reason := ""
+	ast.Inspect(body, func(n ast.Node) bool {
+		if reason != "" {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.Ident:
+			switch {
+			case n.Name == "StatusNotImplemented":
+				reason = "answers 501"
+			case strings.HasPrefix(n.Name, "Unimplemented"):
+				reason = "defers to " + n.Name
+			}
+		case *ast.BasicLit:
+			switch n.Kind {
+			case token.INT:
+				if n.Value == "501" {
+					reason = "answers 501"
+				}
+			case token.STRING:
+				s, _ := strconv.Unquote(n.Value)
+				s = strings.ToLower(s)
+				if strings.Contains(s, "not implemented") || strings.Contains(s, "unimplemented") || strings.Contains(s, "implement me") {
+					reason = "not implemented"
+				}
+			}
+		case *ast.CallExpr:
+			if ident, ok := n.Fun.(*ast.Ident); ok && ident.Name == "panic" {
+				reason = "panics"
+			}
+		}
+		return true
+	})
+	return reason
+}
+
+// position returns pos as file:line, relative to the analyzed directory.
+func (a *analyzer) position(pos token.Pos) string {
+	p := a.fset.Position(pos)
+	if !p.IsValid() {
+		return ""
+	}
+	file := p.Filename
+	if rel, err := filepath.Rel(a.dir, file); err == nil && !strings.HasPrefix(rel, "..") {
+		file = rel
+	}
+	return fmt.Sprintf("%s:%d", file, p.Line)
+}
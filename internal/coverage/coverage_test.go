@@ -0,0 +1,128 @@
+package coverage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// generated stands in for the server eugene generates, with two operations.
+const generated = `// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import "net/http"
+
+type ServerInterface interface {
+	GetPet(w http.ResponseWriter, r *http.Request)
+	ListPets(w http.ResponseWriter, r *http.Request)
+}
+
+type UnimplementedServer struct{}
+
+func (UnimplementedServer) GetPet(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) ListPets(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+func (s ServerInterfaceWrapper) GetPet(w http.ResponseWriter, r *http.Request) {
+	s.Handler.GetPet(w, r)
+}
+
+func (s ServerInterfaceWrapper) ListPets(w http.ResponseWriter, r *http.Request) {
+	s.Handler.ListPets(w, r)
+}
+`
+
+const handlers = `package api
+
+import (
+	"net/http"
+
+	"example.com/svc/gen"
+)
+
+type Handlers struct {
+	gen.UnimplementedServer
+}
+
+func (h *Handlers) GetPet(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte("{}"))
+}
+
+type Stubs struct{}
+
+func (Stubs) GetPet(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(501)
+}
+
+func (Stubs) ListPets(w http.ResponseWriter, r *http.Request) {
+	panic("implement me")
+}
+
+// logging is middleware, not a handler
+type logging struct {
+	gen.ServerInterface
+}
+
+func (l logging) GetPet(w http.ResponseWriter, r *http.Request) {
+	l.ServerInterface.GetPet(w, r)
+}
+`
+
+func TestAnalyze(t *testing.T) {
+	// The fixture module is outside any workspace the tests run in
+	t.Setenv("GOWORK", "off")
+	dir := t.TempDir()
+	write := func(name, content string) {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	write("go.mod", "module example.com/svc\n\ngo 1.25\n")
+	write("gen/server.eugene.go", generated)
+	write("api/handlers.go", handlers)
+
+	report, err := Analyze(dir, "./api")
+	require.NoError(t, err)
+	require.Equal(t, []Handler{
+		{
+			Type:      "*api.Handlers",
+			Interface: "gen.ServerInterface",
+			Position:  "api/handlers.go:9",
+			Operations: []Operation{
+				{Name: "GetPet", Status: Implemented, Position: "api/handlers.go:13"},
+				{Name: "ListPets", Status: Unimplemented, Reason: "promoted from UnimplementedServer"},
+			},
+		},
+		{
+			Type:      "api.Stubs",
+			Interface: "gen.ServerInterface",
+			Position:  "api/handlers.go:18",
+			Operations: []Operation{
+				{Name: "GetPet", Status: Unimplemented, Reason: "answers 501", Position: "api/handlers.go:20"},
+				{Name: "ListPets", Status: Unimplemented, Reason: "panics", Position: "api/handlers.go:24"},
+			},
+		},
+	}, report.Handlers)
+
+	implemented, total := report.Implemented()
+	require.Equal(t, 1, implemented)
+	require.Equal(t, 4, total)
+
+	t.Run("generated package", func(t *testing.T) {
+		// The wrappers of the generated package are not handlers
+		_, err := Analyze(dir, "./gen")
+		require.ErrorContains(t, err, "no type in ./gen implements a generated server interface")
+	})
+}
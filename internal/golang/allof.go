@@ -0,0 +1,88 @@
+package golang
+
+import (
+	"fmt"
+
+	"github.com/kolah/eugene/internal/model"
+)
+
+// AllOfConflict is a property that the members of an allOf composition
+// declare with different types. The flatten strategy keeps one declaration.
+type AllOfConflict struct {
+	First      model.Property
+	Other      model.Property
+	Resolution string // x-oink-allof-conflict of the composition, "" when unset
+}
+
+func (c AllOfConflict) String() string {
+	return fmt.Sprintf("allOf members declare %q as %s in %s and as %s in %s",
+		c.First.Name, propertyType(c.First.Schema), c.First.Origin, propertyType(c.Other.Schema), c.Other.Origin)
+}
+
+// FindAllOfConflicts returns the properties that allOf compositions of spec
+// declare more than once with different types, in the order the flatten
+// strategy merges their members.
+func FindAllOfConflicts(spec *model.Spec) []AllOfConflict {
+	var conflicts []AllOfConflict
+	walkSpecSchemas(spec, func(s *model.Schema) {
+		if len(s.AllOf) == 0 {
+			return
+		}
+		var resolution string
+		if s.Extensions != nil {
+			resolution = s.Extensions.AllOfConflict
+		}
+		first := make(map[string]model.Property)
+		add := func(props []model.Property) {
+			for _, prop := range props {
+				prev, ok := first[prop.Name]
+				if !ok {
+					first[prop.Name] = prop
+					continue
+				}
+				if propertyType(prev.Schema) != propertyType(prop.Schema) {
+					conflicts = append(conflicts, AllOfConflict{First: prev, Other: prop, Resolution: resolution})
+				}
+			}
+		}
+		visiting := make(map[string]bool)
+		var collect func(members []*model.Schema)
+		collect = func(members []*model.Schema) {
+			for _, m := range members {
+				if m.Ref == "" {
+					add(m.Properties)
+					continue
+				}
+				target := spec.SchemaByRef(m.Ref)
+				if target == nil || visiting[m.Ref] {
+					continue
+				}
+				visiting[m.Ref] = true
+				collect(target.AllOf)
+				add(target.Properties)
+				visiting[m.Ref] = false
+			}
+		}
+		collect(s.AllOf)
+	})
+	return conflicts
+}
+
+// propertyType describes the type a property schema generates, for telling
+// conflicting declarations apart: the component it references, or its type
+// and format.
+func propertyType(s *model.Schema) string {
+	switch {
+	case s == nil:
+		return "any"
+	case s.Ref != "" && (s.Type == "" || s.Type == model.TypeObject || len(s.Enum) > 0):
+		return s.Ref
+	case s.Type == model.TypeArray:
+		return "array of " + propertyType(s.Items)
+	case s.Type == "":
+		return "any"
+	case s.Format != "":
+		return string(s.Type) + " (" + s.Format + ")"
+	}
+	return string(s.Type)
+}
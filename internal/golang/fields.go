@@ -0,0 +1,48 @@
+package golang
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/kolah/eugene/internal/model"
+)
+
+// Field orders for generated structs.
+const (
+	// FieldOrderSpec keeps properties in the order the spec declares them,
+	// allOf members first to last.
+	FieldOrderSpec = "spec"
+	// FieldOrderAlpha sorts properties by name.
+	FieldOrderAlpha = "alpha"
+)
+
+var fieldOrder = FieldOrderSpec
+
+// SetFieldOrder sets the order of struct fields: FieldOrderSpec, the
+// default when order is empty, or FieldOrderAlpha.
+// This should be called once during initialization before generation.
+func SetFieldOrder(order string) {
+	if order == "" {
+		order = FieldOrderSpec
+	}
+	fieldOrder = order
+}
+
+// SortSpecFields puts the properties of every schema of spec in the field
+// order. Spec order needs no work: the loader keeps the declaration order.
+func SortSpecFields(spec *model.Spec) {
+	if fieldOrder != FieldOrderAlpha {
+		return
+	}
+	walkSpecSchemas(spec, sortProperties)
+}
+
+// sortProperties sorts the properties of s by name in alpha field order.
+func sortProperties(s *model.Schema) {
+	if fieldOrder != FieldOrderAlpha {
+		return
+	}
+	slices.SortStableFunc(s.Properties, func(a, b model.Property) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+}
@@ -1,14 +1,65 @@
 package golang
 
 import (
+	"bytes"
+	"go/format"
+
 	"golang.org/x/tools/imports"
 )
 
+// FormatOptions controls how generated code is formatted.
+type FormatOptions struct {
+	// LocalPrefix groups imports with these comma-separated prefixes after
+	// third-party imports, like goimports -local.
+	LocalPrefix string
+	// DisableImportGrouping keeps all imports in a single sorted block
+	// instead of splitting standard library and third-party imports.
+	DisableImportGrouping bool
+}
+
+var formatOptions FormatOptions
+
+// SetFormatOptions sets the options used by Format.
+// This should be called once during initialization before generation.
+func SetFormatOptions(opts FormatOptions) {
+	formatOptions = opts
+	imports.LocalPrefix = opts.LocalPrefix
+}
+
 func Format(src []byte) ([]byte, error) {
-	return imports.Process("", src, &imports.Options{
+	out, err := imports.Process("", src, &imports.Options{
 		Comments:   true,
 		TabIndent:  true,
 		TabWidth:   8,
 		FormatOnly: false,
 	})
+	if err != nil {
+		return nil, err
+	}
+	if formatOptions.DisableImportGrouping {
+		return format.Source(ungroupImports(out))
+	}
+	return out, nil
+}
+
+// ungroupImports removes the blank lines goimports puts between import
+// groups so that gofmt sorts the block as a whole.
+func ungroupImports(src []byte) []byte {
+	start := bytes.Index(src, []byte("\nimport (\n"))
+	if start < 0 {
+		return src
+	}
+	start += len("\nimport (\n")
+	end := bytes.Index(src[start:], []byte("\n)\n"))
+	if end < 0 {
+		return src
+	}
+	end += start
+
+	block := bytes.ReplaceAll(src[start:end], []byte("\n\n"), []byte("\n"))
+	var buf bytes.Buffer
+	buf.Write(src[:start])
+	buf.Write(block)
+	buf.Write(src[end:])
+	return buf.Bytes()
 }
@@ -0,0 +1,97 @@
+package golang
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const formatSrc = `package gen
+
+import (
+	"fmt"
+	"github.com/acme/api/common"
+	"github.com/google/uuid"
+)
+
+var _ = fmt.Sprint(uuid.UUID{}, common.Error{})
+`
+
+func TestFormat_LocalPrefix(t *testing.T) {
+	SetFormatOptions(FormatOptions{LocalPrefix: "github.com/acme"})
+	defer SetFormatOptions(FormatOptions{})
+
+	out, err := Format([]byte(formatSrc))
+	require.NoError(t, err)
+	require.Contains(t, string(out), "import (\n\t\"fmt\"\n\n\t\"github.com/google/uuid\"\n\n\t\"github.com/acme/api/common\"\n)")
+}
+
+func TestFormat_DisableImportGrouping(t *testing.T) {
+	SetFormatOptions(FormatOptions{DisableImportGrouping: true})
+	defer SetFormatOptions(FormatOptions{})
+
+	out, err := Format([]byte(formatSrc))
+	require.NoError(t, err)
+	require.Contains(t, string(out), "import (\n\t\"fmt\"\n\t\"github.com/acme/api/common\"\n\t\"github.com/google/uuid\"\n)")
+}
+
+func TestFormatCache(t *testing.T) {
+	cache, err := OpenFormatCache(t.TempDir())
+	require.NoError(t, err)
+
+	want, err := Format([]byte(formatSrc))
+	require.NoError(t, err)
+	out, err := cache.Format([]byte(formatSrc))
+	require.NoError(t, err)
+	require.Equal(t, string(want), string(out))
+
+	// A second run is served from the entry written by the first
+	path := filepath.Join(cache.dir, cache.key([]byte(formatSrc))[:2], cache.key([]byte(formatSrc)))
+	require.NoError(t, os.WriteFile(path, []byte("cached"), 0644))
+	out, err = cache.Format([]byte(formatSrc))
+	require.NoError(t, err)
+	require.Equal(t, "cached", string(out))
+
+	// Format options are part of the key
+	SetFormatOptions(FormatOptions{DisableImportGrouping: true})
+	defer SetFormatOptions(FormatOptions{})
+	out, err = cache.Format([]byte(formatSrc))
+	require.NoError(t, err)
+	require.Contains(t, string(out), "import (\n\t\"fmt\"\n\t\"github.com/acme/api/common\"")
+
+	var nilCache *FormatCache
+	out, err = nilCache.Format([]byte(formatSrc))
+	require.NoError(t, err)
+	require.Contains(t, string(out), "package gen")
+}
+
+func TestFormatCacheTrim(t *testing.T) {
+	cache, err := OpenFormatCache(t.TempDir())
+	require.NoError(t, err)
+	cache.maxSize = 100
+	now := time.Now()
+	write := func(name string, size int, used time.Time) string {
+		path := filepath.Join(cache.dir, name[:2], name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, make([]byte, size), 0644))
+		require.NoError(t, os.Chtimes(path, used, used))
+		return path
+	}
+	stale := write("aa-stale", 1, now.Add(-formatCacheMaxAge-time.Hour))
+	old := write("bb-old", 60, now.Add(-2*time.Hour))
+	recent := write("cc-recent", 60, now.Add(-time.Hour))
+	fresh := write("dd-fresh", 1, now)
+
+	// A trim is not due right after opening
+	cache.trimIfDue(now)
+	require.FileExists(t, stale)
+
+	cache.trimIfDue(now.Add(formatCacheTrimInterval + time.Minute))
+	require.NoFileExists(t, stale, "unused for too long")
+	require.NoFileExists(t, old, "least recently used over the size bound")
+	require.FileExists(t, recent)
+	require.FileExists(t, fresh)
+}
@@ -0,0 +1,234 @@
+package golang
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FormatCacheEnv names the environment variable that overrides the format
+// cache directory. Setting it to "off" disables the cache.
+const FormatCacheEnv = "EUGENE_CACHE"
+
+// Entries are trimmed like the Go build cache: at most once a day, entries
+// unused for formatCacheMaxAge are removed, then the least recently used
+// until the cache fits in formatCacheMaxSize.
+const (
+	formatCacheMaxAge       = 5 * 24 * time.Hour
+	formatCacheMaxSize      = 256 << 20
+	formatCacheTrimInterval = 24 * time.Hour
+	formatCacheTouch        = time.Hour // a hit refreshes an entry's mtime when older
+	formatCacheTrimFile     = "trim.txt"
+)
+
+// FormatCache keeps formatted files on disk, keyed by a hash of the
+// unformatted source, the format options and the toolchain, so that
+// regenerating from an unchanged spec skips goimports. A nil *FormatCache
+// formats without caching.
+type FormatCache struct {
+	dir     string
+	maxSize int64
+}
+
+// DefaultFormatCacheDir returns $EUGENE_CACHE, or eugene/format under the
+// user cache directory. It returns "" when the cache is disabled or no
+// cache directory is available.
+func DefaultFormatCacheDir() string {
+	if dir := os.Getenv(FormatCacheEnv); dir != "" {
+		if dir == "off" {
+			return ""
+		}
+		return dir
+	}
+	base, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(base, "eugene", "format")
+}
+
+// OpenFormatCache returns a cache stored in dir, creating the directory.
+// An empty dir returns a nil cache.
+func OpenFormatCache(dir string) (*FormatCache, error) {
+	if dir == "" {
+		return nil, nil
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("creating format cache: %w", err)
+	}
+	c := &FormatCache{dir: dir, maxSize: formatCacheMaxSize}
+	c.trimIfDue(time.Now())
+	return c, nil
+}
+
+// Format is like the package-level Format but returns the cached result
+// for a source formatted before. Failing to read or write the cache only
+// costs the formatting run.
+func (c *FormatCache) Format(src []byte) ([]byte, error) {
+	if c == nil {
+		return Format(src)
+	}
+	key := c.key(src)
+	path := filepath.Join(c.dir, key[:2], key)
+	if out, err := os.ReadFile(path); err == nil {
+		// the mtime records use, for trimming
+		if info, err := os.Stat(path); err == nil && time.Since(info.ModTime()) > formatCacheTouch {
+			now := time.Now()
+			os.Chtimes(path, now, now)
+		}
+		return out, nil
+	}
+
+	out, err := Format(src)
+	if err != nil {
+		return nil, err
+	}
+	c.store(path, out)
+	return out, nil
+}
+
+func (c *FormatCache) key(src []byte) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%s\x00%q\x00%t\x00", runtime.Version(), formatterVersion(), formatOptions.LocalPrefix, formatOptions.DisableImportGrouping)
+	h.Write(src)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// store writes out through a temporary file so concurrent generators never
+// read a partial entry.
+func (c *FormatCache) store(path string, out []byte) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(out)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+// trimIfDue trims the cache when the last trim, recorded by the mtime of
+// trim.txt, is older than formatCacheTrimInterval.
+func (c *FormatCache) trimIfDue(now time.Time) {
+	marker := filepath.Join(c.dir, formatCacheTrimFile)
+	if info, err := os.Stat(marker); err == nil && now.Sub(info.ModTime()) < formatCacheTrimInterval {
+		return
+	}
+	c.trim(now)
+	if err := os.WriteFile(marker, nil, 0644); err == nil {
+		os.Chtimes(marker, now, now)
+	}
+}
+
+// trim removes the entries unused since formatCacheMaxAge before now, and
+// left-over temporary files, then the least recently used entries until
+// the rest fits in maxSize.
+func (c *FormatCache) trim(now time.Time) {
+	type entry struct {
+		path string
+		size int64
+		used time.Time
+	}
+	var (
+		entries []entry
+		total   int64
+	)
+	filepath.WalkDir(c.dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || d.Name() == formatCacheTrimFile {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return nil
+		}
+		if now.Sub(info.ModTime()) > formatCacheMaxAge ||
+			strings.HasPrefix(d.Name(), ".tmp-") && now.Sub(info.ModTime()) > formatCacheTouch {
+			os.Remove(path)
+			return nil
+		}
+		entries = append(entries, entry{path, info.Size(), info.ModTime()})
+		total += info.Size()
+		return nil
+	})
+	slices.SortFunc(entries, func(a, b entry) int { return a.used.Compare(b.used) })
+	for _, e := range entries {
+		if total <= c.maxSize {
+			break
+		}
+		if os.Remove(e.path) == nil {
+			total -= e.size
+		}
+	}
+}
+
+// formatterVersion identifies the eugene build and the golang.org/x/tools
+// release goimports comes from, as either may change the formatted output.
+// A development build, whose version does not change with its code, is
+// identified by a hash of its executable.
+var formatterVersion = sync.OnceValue(func() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return executableHash()
+	}
+	version := info.Main.Version
+	devel := version == "" || version == "(devel)"
+	for _, setting := range info.Settings {
+		if setting.Key == "vcs.revision" || setting.Key == "vcs.modified" {
+			version += " " + setting.Value
+		}
+		if setting.Key == "vcs.modified" && setting.Value == "true" {
+			devel = true
+		}
+	}
+	for _, dep := range info.Deps {
+		if dep.Path == "golang.org/x/tools" {
+			version += " " + dep.Version
+			if dep.Replace != nil {
+				devel = true
+			}
+		}
+	}
+	if devel {
+		version += " " + executableHash()
+	}
+	return version
+})
+
+// executableHash returns a hash of the running executable, or "" when it
+// cannot be read.
+func executableHash() string {
+	path, err := os.Executable()
+	if err != nil {
+		return ""
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return ""
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -77,6 +77,8 @@ func TemplateFuncs() template.FuncMap {
 		"isRequired":     IsRequired,
 		"needsPointer":   needsPointerAny,
 		"isJSONIgnored":  isJSONIgnoredAny,
+		"isEmbedded":     isEmbeddedAny,
+		"hasCustomJSON":  hasCustomJSONAny,
 		"goNameExt":      goNameExtAny,
 		"goTypeExt":      goTypeExtAny,
 		"lower":          strings.ToLower,
@@ -89,6 +91,17 @@ func TemplateFuncs() template.FuncMap {
 		"refToTypeName":  RefToTypeName,
 		"goBaseType":     goBaseTypeAny,
 		"enumLiteral":    enumLiteralAny,
+		"enumUnknown":    enumUnknownAny,
+		"isBitmask":      isBitmaskAny,
+		"bitmaskFlags":   bitmaskFlagsAny,
+		"orderedMap":     isOrderedMapAny,
+		"orderedStruct":  isOrderedStructAny,
+		"jsonNames":      jsonNamesAny,
+		"isRaw":          isRawAny,
+		"isEnvelope":     isEnvelopeAny,
+		"envelopeField":  envelopePayloadAny,
+		"variantIface":   HasVariantInterface,
+		"discValues":     DiscriminatorValues,
 		"dict":           Dict,
 		"statusCodeInt":  StatusCodeInt,
 		"title":          Title,
@@ -108,9 +121,22 @@ func structTagWithYAMLAny(s any, name string, required bool, enableYAML bool) st
 	return StructTagWithOptions(toSchemaPtr(s), name, required, enableYAML)
 }
 func isJSONIgnoredAny(s any) bool            { return IsJSONIgnored(toSchemaPtr(s)) }
+func isEmbeddedAny(s any) bool               { return IsEmbedded(toSchemaPtr(s)) }
+func hasCustomJSONAny(s any) bool            { return HasCustomJSON(toSchemaPtr(s)) }
 func goNameExtAny(s any, name string) string { return GoNameWithExtension(toSchemaPtr(s), name) }
 func goTypeExtAny(s any) string              { return GoTypeWithExtension(toSchemaPtr(s)) }
 func enumLiteralAny(s any, v any) string     { return EnumLiteral(toSchemaPtr(s), v) }
+func enumUnknownAny(s any) UnknownEnumValue  { return EnumUnknown(toSchemaPtr(s)) }
+func isBitmaskAny(s any) bool                { return IsBitmask(toSchemaPtr(s)) }
+func bitmaskFlagsAny(s any) []BitmaskFlag    { return BitmaskFlags(toSchemaPtr(s)) }
+func isOrderedMapAny(s any) bool             { return IsOrderedMap(toSchemaPtr(s)) }
+func isOrderedStructAny(s any) bool          { return IsOrderedStruct(toSchemaPtr(s)) }
+func jsonNamesAny(s any) []string            { return JSONNames(toSchemaPtr(s)) }
+func isRawAny(s any) bool                    { return IsRaw(toSchemaPtr(s)) }
+func isEnvelopeAny(s any) bool               { return IsEnvelope(toSchemaPtr(s)) }
+func envelopePayloadAny(s any) *model.Property {
+	return EnvelopePayload(toSchemaPtr(s))
+}
 
 // RefToTypeName extracts the type name from a $ref string.
 func RefToTypeName(ref string) string {
@@ -148,6 +174,207 @@ func EnumLiteral(s *model.Schema, v any) string {
 	}
 }
 
+// UnknownEnumValue is the XxxUnknown sentinel that unknown values of an enum
+// decode to with enum-unknown: extend.
+type UnknownEnumValue struct {
+	Literal string // Go literal of the sentinel value
+	Member  bool   // an enum value already named Unknown serves as the sentinel
+}
+
+// EnumUnknown picks the sentinel of an enum: the value named Unknown if the
+// enum has one, otherwise the zero value of its type, or for integer and
+// number enums that contain zero, one below the smallest value.
+func EnumUnknown(s *model.Schema) UnknownEnumValue {
+	for _, v := range s.Enum {
+		if PascalCase(fmt.Sprintf("%v", v)) == "Unknown" {
+			return UnknownEnumValue{Literal: EnumLiteral(s, v), Member: true}
+		}
+	}
+	switch s.Type {
+	case model.TypeInteger, model.TypeNumber:
+		lowest, hasZero := 0.0, false
+		for i, v := range s.Enum {
+			f, err := strconv.ParseFloat(fmt.Sprintf("%v", v), 64)
+			if err != nil {
+				continue
+			}
+			if i == 0 || f < lowest {
+				lowest = f
+			}
+			hasZero = hasZero || f == 0
+		}
+		if hasZero {
+			return UnknownEnumValue{Literal: strconv.FormatFloat(lowest-1, 'f', -1, 64)}
+		}
+		return UnknownEnumValue{Literal: "0"}
+	case model.TypeBoolean:
+		return UnknownEnumValue{Literal: "false"}
+	default:
+		return UnknownEnumValue{Literal: `""`}
+	}
+}
+
+// IsBitmask reports whether s is an integer enum generated as bit flags
+// (x-oink-bitmask).
+func IsBitmask(s *model.Schema) bool {
+	return s != nil && s.Extensions != nil && s.Extensions.Bitmask
+}
+
+// IsParameterEnum reports whether s is an inline string enum of an operation
+// parameter, which the types target declares as a named type for the server
+// targets to convert parameter values to.
+func IsParameterEnum(s *model.Schema) bool {
+	return s != nil && s.Ref == "" && s.Type == model.TypeString && len(s.Enum) > 0
+}
+
+// BitmaskFlag is one flag of a bitmask enum.
+type BitmaskFlag struct {
+	Name    string // JSON name, from x-enum-varnames or the value
+	GoName  string // constant name suffix
+	Literal string
+}
+
+// BitmaskFlags returns the flags of a bitmask enum in enum order.
+func BitmaskFlags(s *model.Schema) []BitmaskFlag {
+	flags := make([]BitmaskFlag, 0, len(s.Enum))
+	for i, v := range s.Enum {
+		name := fmt.Sprintf("%v", v)
+		if i < len(s.Extensions.EnumVarNames) && s.Extensions.EnumVarNames[i] != "" {
+			name = s.Extensions.EnumVarNames[i]
+		}
+		flags = append(flags, BitmaskFlag{Name: name, GoName: PascalCase(name), Literal: EnumLiteral(s, v)})
+	}
+	return flags
+}
+
+// IsOrderedMap reports whether s is an additionalProperties map generated as
+// an OrderedMap, which keeps its keys in wire order (x-oink-ordered).
+func IsOrderedMap(s *model.Schema) bool {
+	return isOrdered(s) && len(s.Properties) == 0
+}
+
+// IsOrderedStruct reports whether s is a struct with both properties and
+// additionalProperties whose JSON methods write the properties in
+// declaration order followed by the additional properties (x-oink-ordered).
+func IsOrderedStruct(s *model.Schema) bool {
+	return isOrdered(s) && len(s.Properties) > 0 && !HasCustomJSON(s)
+}
+
+func isOrdered(s *model.Schema) bool {
+	return s != nil && s.Extensions != nil && s.Extensions.Ordered && s.AdditionalProperties != nil
+}
+
+// UsesOrderedMap reports whether any schema, at any depth, is generated with
+// an OrderedMap.
+func UsesOrderedMap(schemas []model.Schema) bool {
+	return anySchema(schemas, isOrdered)
+}
+
+// IsRaw reports whether s is passed through undecoded as json.RawMessage
+// (x-oink-raw).
+func IsRaw(s *model.Schema) bool {
+	return s != nil && s.Extensions != nil && s.Extensions.Raw
+}
+
+// UsesRawJSON reports whether any schema, at any depth, is generated as
+// json.RawMessage.
+func UsesRawJSON(schemas []model.Schema) bool {
+	return anySchema(schemas, IsRaw)
+}
+
+// IsEnvelope reports whether s is generated as an alias of the generic
+// Envelope[T] (x-oink-envelope).
+func IsEnvelope(s *model.Schema) bool {
+	return EnvelopePayload(s) != nil
+}
+
+// EnvelopePayload returns the property of an envelope schema whose type is
+// the type parameter of Envelope[T], or nil if s is not an envelope.
+func EnvelopePayload(s *model.Schema) *model.Property {
+	if s == nil || s.Extensions == nil || s.Extensions.Envelope == "" {
+		return nil
+	}
+	for i := range s.Properties {
+		if s.Properties[i].Name == s.Extensions.Envelope {
+			return &s.Properties[i]
+		}
+	}
+	return nil
+}
+
+// HasVariantInterface reports whether a union gets a <Name>Variant marker
+// interface: it has a discriminator and every variant is a $ref to a schema
+// generated as a struct, which can carry the marker method.
+func HasVariantInterface(t ResolvedType) bool {
+	if !t.IsUnion || t.Discriminator == nil || len(t.Variants) == 0 {
+		return false
+	}
+	for _, v := range t.Variants {
+		s := v.Schema
+		if s == nil || s.Ref == "" || GoTypeWithExtension(s) != "" || IsRaw(s) || IsEnvelope(s) || len(s.Enum) > 0 {
+			return false
+		}
+		if len(s.AllOf) == 0 && (s.Type != model.TypeObject || len(s.Properties) == 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// DiscriminatorValues returns the discriminator values of a union variant:
+// its mapping keys, or without a mapping the name of the referenced schema.
+func DiscriminatorValues(v UnionVariant) []string {
+	if len(v.DiscValues) > 0 || v.Schema == nil {
+		return v.DiscValues
+	}
+	return []string{v.Schema.Ref[strings.LastIndex(v.Schema.Ref, "/")+1:]}
+}
+
+// anySchema reports whether match holds for any of the schemas or the
+// properties, items and additionalProperties below them.
+func anySchema(schemas []model.Schema, match func(*model.Schema) bool) bool {
+	var walk func(s *model.Schema) bool
+	walk = func(s *model.Schema) bool {
+		if s == nil {
+			return false
+		}
+		if match(s) {
+			return true
+		}
+		for _, prop := range s.Properties {
+			if walk(prop.Schema) {
+				return true
+			}
+		}
+		return walk(s.Items) || walk(s.AdditionalProperties)
+	}
+	for i := range schemas {
+		if walk(&schemas[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// JSONNames returns the JSON names of the properties of s in declaration
+// order.
+func JSONNames(s *model.Schema) []string {
+	names := make([]string, 0, len(s.Properties))
+	for _, prop := range s.Properties {
+		names = append(names, JSONName(prop))
+	}
+	return names
+}
+
+// JSONName returns the JSON name of a property, using x-oink-go-json-name if
+// specified.
+func JSONName(prop model.Property) string {
+	if prop.Schema != nil && prop.Schema.Extensions != nil && prop.Schema.Extensions.JSONName != "" {
+		return prop.Schema.Extensions.JSONName
+	}
+	return prop.Name
+}
+
 // Dict creates a map from key-value pairs for use in templates.
 func Dict(values ...any) map[string]any {
 	if len(values)%2 != 0 {
@@ -188,7 +415,7 @@ func NeedsPointer(s *model.Schema, required []string) bool {
 	if s == nil {
 		return false
 	}
-	if IsRequired(s.Name, required) {
+	if IsRequired(s.Name, required) || IsRaw(s) {
 		return false
 	}
 	if s.Nullable {
@@ -212,11 +439,15 @@ func NullableType(cfg *config.TypesConfig, baseType string) string {
 	return "*" + baseType
 }
 
-// StatusCodeInt converts an HTTP status code string to int.
+// StatusCodeInt converts an HTTP status code string to int. Range codes
+// such as "2XX" map to the first code of their class.
 func StatusCodeInt(code string) int {
 	if code == "default" {
 		return 500
 	}
+	if class := StatusCodeClass(code); class != 0 {
+		return class * 100
+	}
 	n, err := strconv.Atoi(code)
 	if err != nil {
 		return 500
@@ -224,6 +455,47 @@ func StatusCodeInt(code string) int {
 	return n
 }
 
+// StatusCodeClass returns the class (1-5) of a range status code such as
+// "2XX" or "4xx", and 0 for exact codes and "default".
+func StatusCodeClass(code string) int {
+	if len(code) != 3 || code[0] < '1' || code[0] > '5' || !strings.EqualFold(code[1:], "XX") {
+		return 0
+	}
+	return int(code[0] - '0')
+}
+
+// StatusCodeName returns the identifier form of a status code used in
+// generated names: "200", "2XX" or "Default".
+func StatusCodeName(code string) string {
+	if code == "default" {
+		return "Default"
+	}
+	return strings.ToUpper(code)
+}
+
+// FormArrayDelimiter returns the separator used to join array values for the given
+// encoding style. An empty result means values are exploded into repeated keys.
+func FormArrayDelimiter(style string, explode *bool) string {
+	exploded := explode == nil || *explode
+	if style == "" || style == "form" {
+		if exploded {
+			return ""
+		}
+		return ","
+	}
+	if explode != nil && *explode {
+		return ""
+	}
+	switch style {
+	case "spaceDelimited":
+		return " "
+	case "pipeDelimited":
+		return "|"
+	default:
+		return ""
+	}
+}
+
 // Title returns the string in title case (first letter uppercase, rest lowercase).
 func Title(s string) string {
 	if s == "" {
@@ -249,6 +521,9 @@ func StructTagWithOptions(s *model.Schema, name string, required bool, enableYAM
 	}
 
 	ext := s.Extensions
+	if ext != nil && ext.JSONName != "" {
+		name = ext.JSONName
+	}
 	if ext != nil && ext.JSONIgnore {
 		if enableYAML {
 			return "`json:\"-\" yaml:\"-\"`"
@@ -271,8 +546,8 @@ func StructTagWithOptions(s *model.Schema, name string, required bool, enableYAM
 		jsonParts = append(jsonParts, "omitempty")
 	}
 
-	// Determine omitzero
-	if ext != nil && ext.OmitZero != nil && *ext.OmitZero {
+	// Determine omitzero; omitempty never omits an OrderedMap, which is a struct
+	if ext != nil && ext.OmitZero != nil && *ext.OmitZero || omitEmpty && IsOrderedMap(s) {
 		jsonParts = append(jsonParts, "omitzero")
 	}
 
@@ -318,6 +593,34 @@ func IsJSONIgnored(s *model.Schema) bool {
 	return s.Extensions.JSONIgnore
 }
 
+// IsEmbedded returns true if the schema is a $ref marked with x-oink-embed: true.
+func IsEmbedded(s *model.Schema) bool {
+	if s == nil || s.Extensions == nil {
+		return false
+	}
+	return s.Extensions.Embed && s.Ref != ""
+}
+
+// MarshalMode returns the x-oink-marshal mode ("text", "binary" or "custom"),
+// or an empty string if the schema uses the generated marshalers.
+func MarshalMode(s *model.Schema) string {
+	if s == nil || s.Extensions == nil {
+		return ""
+	}
+	switch s.Extensions.Marshal {
+	case "text", "binary", "custom":
+		return s.Extensions.Marshal
+	}
+	return ""
+}
+
+// HasCustomJSON returns true if generated MarshalJSON/UnmarshalJSON methods
+// must be skipped because the user implements them (or TextMarshaler) by hand.
+func HasCustomJSON(s *model.Schema) bool {
+	mode := MarshalMode(s)
+	return mode == "text" || mode == "custom"
+}
+
 // GoNameWithExtension returns the field name, using x-oink-go-name if specified.
 func GoNameWithExtension(s *model.Schema, name string) string {
 	if s != nil && s.Extensions != nil && s.Extensions.GoName != "" {
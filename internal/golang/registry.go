@@ -1,8 +1,11 @@
 package golang
 
 import (
+	"log/slog"
 	"sort"
 	"strings"
+
+	"github.com/kolah/eugene/internal/model"
 )
 
 // EnumUsage records where an enum is used in the spec.
@@ -33,6 +36,44 @@ func NewEnumRegistry() *EnumRegistry {
 	}
 }
 
+// NewSpecEnumRegistry creates an EnumRegistry holding the enums of the
+// operation parameters and schema properties of spec, with their names
+// resolved around the schema names and the types generated per operation.
+func NewSpecEnumRegistry(spec *model.Spec) *EnumRegistry {
+	r := NewEnumRegistry()
+	for _, op := range spec.Operations {
+		for _, p := range op.Parameters {
+			if p.Schema != nil && len(p.Schema.Enum) > 0 {
+				r.CollectEnum(p.Name, op.ID, p.Schema.Enum)
+			}
+		}
+	}
+	for _, s := range spec.Schemas {
+		for _, prop := range s.Properties {
+			if prop.Schema != nil && len(prop.Schema.Enum) > 0 {
+				r.CollectEnum(prop.Name, s.Name, prop.Schema.Enum)
+			}
+		}
+	}
+
+	for _, s := range spec.Schemas {
+		r.AddReservedNames(PascalCase(s.Name))
+	}
+	for _, op := range spec.Operations {
+		base := PascalCase(op.ID)
+		r.AddReservedNames(base+"Response", base+"Request", base+"Params")
+		r.AddReservedNames(base+"MultipartRequest", base+"FormRequest", base+"QueryParams")
+		r.AddReservedNames(base+"RequestObject", base+"ResponseObject")
+		for _, resp := range op.Responses {
+			code := StatusCodeName(resp.StatusCode)
+			r.AddReservedNames(base+code+"Response", base+code+"JSONResponse")
+		}
+	}
+
+	r.ResolveNames()
+	return r
+}
+
 // AddReservedNames registers names that cannot be used for enum types
 // (e.g. top-level schema names that would cause collisions).
 func (r *EnumRegistry) AddReservedNames(names ...string) {
@@ -71,6 +112,7 @@ func (r *EnumRegistry) ResolveNames() {
 	for _, valuesKey := range keys {
 		usages := groups[valuesKey]
 		name := r.determineName(usages, valuesKey)
+		slog.Debug("named enum", "name", name, "values", valuesKey, "usages", len(usages))
 		r.valueToName[valuesKey] = name
 		r.nameToValues[name] = valuesKey
 	}
@@ -99,14 +141,17 @@ func (r *EnumRegistry) determineName(usages []EnumUsage, valuesKey string) strin
 	if r.reservedNames[baseName] {
 		candidate := baseName + "Enum"
 		if r.reservedNames[candidate] {
+			slog.Debug("enum name taken by a schema", "name", baseName, "also", candidate, "using", usages[0].ParentName+baseName)
 			return usages[0].ParentName + baseName
 		}
+		slog.Debug("enum name taken by a schema", "name", baseName, "using", candidate)
 		return candidate
 	}
 
 	// Check for collision with different values
 	if existingKey, taken := r.nameToValues[baseName]; taken && existingKey != valuesKey {
 		suffix := valueSuffix(usages[0].Values)
+		slog.Debug("enum name taken by other values", "name", baseName, "existing", existingKey, "using", baseName+suffix)
 		return baseName + suffix
 	}
 
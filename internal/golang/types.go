@@ -2,6 +2,7 @@ package golang
 
 import (
 	"fmt"
+	"log/slog"
 	"sort"
 	"strings"
 
@@ -18,6 +19,10 @@ func GoType(s *model.Schema) string {
 		return refToTypeName(s.Ref)
 	}
 
+	if IsRaw(s) {
+		return "json.RawMessage"
+	}
+
 	if len(s.AllOf) > 0 || len(s.OneOf) > 0 || len(s.AnyOf) > 0 {
 		return "any"
 	}
@@ -37,7 +42,10 @@ func GoType(s *model.Schema) string {
 	case model.TypeObject:
 		if s.AdditionalProperties != nil {
 			valueType := GoType(s.AdditionalProperties)
-			return "map[string]" + valueType
+			if IsOrderedMap(s) {
+				return "OrderedMap[" + valueType + "]"
+			}
+			return "map[" + mapKeyType(s, "string") + "]" + valueType
 		}
 		if len(s.Properties) == 0 {
 			return "map[string]any"
@@ -165,6 +173,11 @@ func JSONTag(name string, required bool) string {
 
 // TypeResolver resolves OpenAPI schemas to Go types with context awareness.
 // It collects nested types that need to be generated separately.
+//
+// A resolver accumulates state for one generated file: the nested types it
+// collected, the names it handed out and the mapped imports it used. To reuse
+// it for another file, call Reset in between. A TypeResolver is not safe for
+// concurrent use.
 type TypeResolver struct {
 	cfg           *config.TypesConfig
 	importMapping map[string]string
@@ -189,10 +202,11 @@ type ResolvedType struct {
 
 // UnionVariant represents a variant in a oneOf/anyOf union.
 type UnionVariant struct {
-	Name      string
-	TypeName  string
-	DiscValue string
-	Schema    *model.Schema
+	Name       string
+	TypeName   string
+	DiscValue  string
+	DiscValues []string // every mapping key naming the variant, sorted; DiscValue is the first
+	Schema     *model.Schema
 }
 
 // NewTypeResolver creates a new TypeResolver with the given configuration.
@@ -231,11 +245,23 @@ func NewTypeResolverWithSchemaLookup(cfg *config.TypesConfig, importMapping map[
 	}
 }
 
-// NestedTypes returns all nested types collected during resolution.
+// NestedTypes returns all nested types collected during resolution, in the
+// order they were first resolved.
 func (r *TypeResolver) NestedTypes() []ResolvedType {
 	return r.nestedTypes
 }
 
+// Reset forgets the nested types, names and mapped imports collected so far,
+// keeping the configuration, import mapping, schema lookup and registry. The
+// registry is shared between targets, so the enums it marked generated stay
+// generated.
+func (r *TypeResolver) Reset() {
+	r.nestedTypes = nil
+	clear(r.seen)
+	clear(r.enumValues)
+	clear(r.mappedImports)
+}
+
 // MappedImports returns all import paths used from import mapping.
 func (r *TypeResolver) MappedImports() []string {
 	var imports []string
@@ -258,6 +284,7 @@ func packageName(importPath string) string {
 // ResolveType resolves a schema to a Go type name, collecting nested types as needed.
 func (r *TypeResolver) ResolveType(s *model.Schema, parentName, fieldName string) string {
 	if s == nil {
+		slog.Debug("resolved type to any", "field", fieldPath(parentName, fieldName), "reason", "missing schema")
 		return "any"
 	}
 
@@ -274,6 +301,10 @@ func (r *TypeResolver) ResolveType(s *model.Schema, parentName, fieldName string
 		return refToTypeName(s.Ref)
 	}
 
+	if IsRaw(s) {
+		return "json.RawMessage"
+	}
+
 	// Handle oneOf/anyOf unions
 	if len(s.OneOf) > 0 || len(s.AnyOf) > 0 {
 		return r.resolveUnion(s, parentName, fieldName)
@@ -303,11 +334,89 @@ func (r *TypeResolver) ResolveType(s *model.Schema, parentName, fieldName string
 		return "[]" + itemType
 	case model.TypeObject:
 		return r.resolveObject(s, parentName, fieldName)
+	default:
+		slog.Debug("resolved type to any", "field", fieldPath(parentName, fieldName), "reason", "schema has no type", "type", string(s.Type))
+		return "any"
+	}
+}
+
+// ResolveSchema resolves a component schema to its Go type name, collecting
+// the nested types declared for it: the union or allOf it is, or the types
+// of its properties.
+func (r *TypeResolver) ResolveSchema(s *model.Schema) string {
+	if len(s.OneOf) > 0 || len(s.AnyOf) > 0 || len(s.AllOf) > 0 {
+		return r.ResolveType(s, "", s.Name)
+	}
+	if payload := EnvelopePayload(s); payload != nil {
+		// the shared properties belong to Envelope[T]
+		for _, prop := range s.Properties {
+			if prop.Name == payload.Name {
+				r.ResolveType(prop.Schema, s.Name, prop.Name)
+			} else {
+				r.ResolveType(prop.Schema, "Envelope", prop.Name)
+			}
+		}
+		return PascalCase(s.Name)
+	}
+	for _, prop := range s.Properties {
+		r.ResolveType(prop.Schema, s.Name, prop.Name)
+	}
+	if IsOrderedStruct(s) {
+		r.ResolveType(s.AdditionalProperties, s.Name, "AdditionalProperties")
+	}
+	return PascalCase(s.Name)
+}
+
+// ResolveParamType resolves the schema of a parameter of operationID to the
+// Go type the server targets declare it as, or the schema of a request or
+// response body when operationID and paramName are empty. References name
+// the component type, inline parameter enums the type the types target
+// declares for them, and inline objects resolve to any rather than to a
+// nested type.
+func (r *TypeResolver) ResolveParamType(s *model.Schema, operationID, paramName string) string {
+	if s == nil {
+		return "any"
+	}
+	if s.Ref != "" {
+		parts := splitRef(s.Ref)
+		if len(parts) > 0 {
+			return PascalCase(parts[len(parts)-1])
+		}
+	}
+	// Inline string enums are declared by the types target, named from operation+param
+	if IsParameterEnum(s) && operationID != "" && paramName != "" {
+		return r.ResolveType(s, PascalCase(operationID), paramName)
+	}
+	switch s.Type {
+	case model.TypeString:
+		return r.ResolveType(s, "", "")
+	case model.TypeInteger:
+		if s.Format == "int64" {
+			return "int64"
+		}
+		if s.Format == "int32" {
+			return "int32"
+		}
+		return "int"
+	case model.TypeNumber:
+		return "float64"
+	case model.TypeBoolean:
+		return "bool"
+	case model.TypeArray:
+		return "[]" + r.ResolveParamType(s.Items, "", "")
 	default:
 		return "any"
 	}
 }
 
+// fieldPath formats a parent/field pair for log messages.
+func fieldPath(parentName, fieldName string) string {
+	if parentName == "" {
+		return fieldName
+	}
+	return parentName + "." + fieldName
+}
+
 func (r *TypeResolver) goStringType(format string) string {
 	switch format {
 	case "date-time", "date":
@@ -337,6 +446,23 @@ func (r *TypeResolver) uuidType() string {
 	}
 }
 
+// mapKeyType returns the key type of a map schema set by x-oink-map-key.
+// encoding/json converts integer and TextMarshaler keys to and from JSON
+// object keys itself. uuidType is the Go type of uuid keys.
+func mapKeyType(s *model.Schema, uuidType string) string {
+	if s.Extensions == nil {
+		return "string"
+	}
+	switch s.Extensions.MapKey {
+	case "int", "int32", "int64":
+		return s.Extensions.MapKey
+	case "uuid":
+		return uuidType
+	default:
+		return "string"
+	}
+}
+
 // UUIDImport returns the import path for UUID if needed.
 func (r *TypeResolver) UUIDImport() string {
 	if r.cfg == nil {
@@ -353,12 +479,16 @@ func (r *TypeResolver) UUIDImport() string {
 }
 
 func (r *TypeResolver) resolveObject(s *model.Schema, parentName, fieldName string) string {
-	if s.AdditionalProperties != nil {
+	if s.AdditionalProperties != nil && !IsOrderedStruct(s) {
 		valueType := r.ResolveType(s.AdditionalProperties, parentName, fieldName+"Value")
-		return "map[string]" + valueType
+		if IsOrderedMap(s) {
+			return "OrderedMap[" + valueType + "]"
+		}
+		return "map[" + mapKeyType(s, r.uuidType()) + "]" + valueType
 	}
 
 	if len(s.Properties) == 0 {
+		slog.Debug("resolved type to map[string]any", "field", fieldPath(parentName, fieldName), "reason", "object without properties or additionalProperties")
 		return "map[string]any"
 	}
 
@@ -376,6 +506,9 @@ func (r *TypeResolver) resolveObject(s *model.Schema, parentName, fieldName stri
 		r.ResolveType(prop.Schema, nestedName, prop.Name)
 		resolvedSchema.Properties[i] = prop
 	}
+	if IsOrderedStruct(&resolvedSchema) {
+		r.ResolveType(resolvedSchema.AdditionalProperties, nestedName, "AdditionalProperties")
+	}
 
 	r.nestedTypes = append(r.nestedTypes, ResolvedType{
 		Name:   nestedName,
@@ -482,14 +615,18 @@ func (r *TypeResolver) resolveUnion(s *model.Schema, parentName, fieldName strin
 		}
 		v.Schema = variant
 
-		// Check if discriminator mapping provides a value
+		// Check if discriminator mapping provides values; several keys may
+		// name the same variant
 		if s.Discriminator != nil && s.Discriminator.Mapping != nil {
 			for discVal, ref := range s.Discriminator.Mapping {
 				if variant.Ref == ref || refToTypeName(ref) == v.TypeName {
-					v.DiscValue = discVal
-					break
+					v.DiscValues = append(v.DiscValues, discVal)
 				}
 			}
+			if len(v.DiscValues) > 0 {
+				sort.Strings(v.DiscValues)
+				v.DiscValue = v.DiscValues[0]
+			}
 		}
 
 		variants = append(variants, v)
@@ -518,7 +655,8 @@ func (r *TypeResolver) resolveAllOf(s *model.Schema, parentName, fieldName strin
 
 	if shouldFlatten {
 		// Flatten all allOf schemas including $refs
-		merged := r.flattenAllOfSchemas(s.AllOf, nestedName)
+		keepLast := s.Extensions != nil && s.Extensions.AllOfConflict == "last"
+		merged := r.flattenAllOfSchemas(s.AllOf, nestedName, keepLast)
 		merged.Name = nestedName
 		r.nestedTypes = append(r.nestedTypes, ResolvedType{
 			Name:    nestedName,
@@ -561,7 +699,7 @@ func (r *TypeResolver) mergeAllOfSchemas(schemas []*model.Schema, parentName str
 		Type: model.TypeObject,
 	}
 
-	requiredMap := make(map[string]bool)
+	seenRequired := make(map[string]bool)
 
 	for _, s := range schemas {
 		if s.Ref != "" {
@@ -571,34 +709,58 @@ func (r *TypeResolver) mergeAllOfSchemas(schemas []*model.Schema, parentName str
 
 		for _, prop := range s.Properties {
 			r.ResolveType(prop.Schema, parentName, prop.Name)
+			prop.Via = "allOf"
 			merged.Properties = append(merged.Properties, prop)
 		}
 
-		for _, req := range s.Required {
-			requiredMap[req] = true
-		}
+		merged.Required = appendRequired(merged.Required, seenRequired, s.Required)
 
 		if s.Description != "" && merged.Description == "" {
 			merged.Description = s.Description
 		}
 	}
 
-	for req := range requiredMap {
-		merged.Required = append(merged.Required, req)
-	}
+	sortProperties(merged)
 
 	return merged
 }
 
+// appendRequired appends the names of required not yet in seen, keeping the
+// order the allOf members declare them in.
+func appendRequired(dst []string, seen map[string]bool, required []string) []string {
+	for _, req := range required {
+		if !seen[req] {
+			seen[req] = true
+			dst = append(dst, req)
+		}
+	}
+	return dst
+}
+
 // flattenAllOfSchemas merges all allOf schemas (including $refs) into a single flat schema.
-// This is used when allof-strategy is set to "flatten".
-func (r *TypeResolver) flattenAllOfSchemas(schemas []*model.Schema, parentName string) *model.Schema {
+// This is used when allof-strategy is set to "flatten". A property declared by
+// several members keeps its first declaration, or its last with keepLast.
+func (r *TypeResolver) flattenAllOfSchemas(schemas []*model.Schema, parentName string, keepLast bool) *model.Schema {
 	merged := &model.Schema{
 		Type: model.TypeObject,
 	}
 
-	requiredMap := make(map[string]bool)
-	seenProps := make(map[string]bool)
+	seenRequired := make(map[string]bool)
+	seenProps := make(map[string]int) // index of each property in merged.Properties
+
+	addProperty := func(prop model.Property) {
+		prop.Via = "allOf"
+		if i, ok := seenProps[prop.Name]; ok {
+			if keepLast {
+				r.ResolveType(prop.Schema, parentName, prop.Name)
+				merged.Properties[i] = prop
+			}
+			return
+		}
+		seenProps[prop.Name] = len(merged.Properties)
+		r.ResolveType(prop.Schema, parentName, prop.Name)
+		merged.Properties = append(merged.Properties, prop)
+	}
 
 	var flatten func(schemas []*model.Schema)
 	flatten = func(schemas []*model.Schema) {
@@ -615,17 +777,10 @@ func (r *TypeResolver) flattenAllOfSchemas(schemas []*model.Schema, parentName s
 				}
 				// Add properties from the referenced schema
 				for _, prop := range refSchema.Properties {
-					if seenProps[prop.Name] {
-						continue // First occurrence wins
-					}
-					seenProps[prop.Name] = true
-					r.ResolveType(prop.Schema, parentName, prop.Name)
-					merged.Properties = append(merged.Properties, prop)
+					addProperty(prop)
 				}
 				// Add required fields
-				for _, req := range refSchema.Required {
-					requiredMap[req] = true
-				}
+				merged.Required = appendRequired(merged.Required, seenRequired, refSchema.Required)
 				if refSchema.Description != "" && merged.Description == "" {
 					merged.Description = refSchema.Description
 				}
@@ -634,17 +789,10 @@ func (r *TypeResolver) flattenAllOfSchemas(schemas []*model.Schema, parentName s
 
 			// Inline schema - add properties directly
 			for _, prop := range s.Properties {
-				if seenProps[prop.Name] {
-					continue // First occurrence wins
-				}
-				seenProps[prop.Name] = true
-				r.ResolveType(prop.Schema, parentName, prop.Name)
-				merged.Properties = append(merged.Properties, prop)
+				addProperty(prop)
 			}
 
-			for _, req := range s.Required {
-				requiredMap[req] = true
-			}
+			merged.Required = appendRequired(merged.Required, seenRequired, s.Required)
 
 			if s.Description != "" && merged.Description == "" {
 				merged.Description = s.Description
@@ -653,10 +801,7 @@ func (r *TypeResolver) flattenAllOfSchemas(schemas []*model.Schema, parentName s
 	}
 
 	flatten(schemas)
-
-	for req := range requiredMap {
-		merged.Required = append(merged.Required, req)
-	}
+	sortProperties(merged)
 
 	return merged
 }
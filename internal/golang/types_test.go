@@ -32,6 +32,8 @@ func TestGoType(t *testing.T) {
 		{"array of integers", &model.Schema{Type: model.TypeArray, Items: &model.Schema{Type: model.TypeInteger}}, "[]int"},
 		{"empty object", &model.Schema{Type: model.TypeObject}, "map[string]any"},
 		{"object with additional properties", &model.Schema{Type: model.TypeObject, AdditionalProperties: &model.Schema{Type: model.TypeString}}, "map[string]string"},
+		{"ordered additional properties", &model.Schema{Type: model.TypeObject, AdditionalProperties: &model.Schema{Type: model.TypeString}, Extensions: &model.SchemaExtensions{Ordered: true}}, "OrderedMap[string]"},
+		{"raw", &model.Schema{Type: model.TypeObject, Extensions: &model.SchemaExtensions{Raw: true}}, "json.RawMessage"},
 		{"ref", &model.Schema{Ref: "#/components/schemas/Pet"}, "Pet"},
 		{"ref with path", &model.Schema{Ref: "#/components/schemas/my_pet"}, "MyPet"},
 		{"oneOf", &model.Schema{OneOf: []*model.Schema{{Type: model.TypeString}}}, "any"},
@@ -91,6 +93,17 @@ func TestJSONTag(t *testing.T) {
 	}
 }
 
+func TestStructTagWithOptions_JSONName(t *testing.T) {
+	s := &model.Schema{
+		Type:       model.TypeString,
+		Extensions: &model.SchemaExtensions{JSONName: "legacy_code"},
+	}
+
+	require.Equal(t, "`json:\"legacy_code,omitempty\"`", StructTag(s, "legacyCode", false))
+	require.Equal(t, "`json:\"legacy_code\" yaml:\"legacy_code\"`", StructTagWithOptions(s, "legacyCode", true, true))
+	require.Equal(t, "LegacyCode", GoNameWithExtension(s, "legacyCode"))
+}
+
 func TestNeedsTimeImport(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -168,6 +181,59 @@ func TestTypeResolver_NestedObject(t *testing.T) {
 	require.Equal(t, "UserPreferences", nested[0].Name)
 }
 
+func TestTypeResolver_Reset(t *testing.T) {
+	r := NewTypeResolverWithRegistry(&config.TypesConfig{}, map[string]string{
+		"#/components/schemas/Money": "example.com/shared/money",
+	}, nil)
+
+	object := &model.Schema{
+		Type: model.TypeObject,
+		Properties: []model.Property{
+			{Name: "name", Schema: &model.Schema{Type: model.TypeString}},
+		},
+	}
+	require.Equal(t, "UserPreferences", r.ResolveType(object, "User", "Preferences"))
+	require.Equal(t, "money.Money", r.ResolveType(&model.Schema{Ref: "#/components/schemas/Money"}, "", ""))
+	require.Len(t, r.NestedTypes(), 1)
+	require.Len(t, r.MappedImports(), 1)
+
+	r.Reset()
+	require.Empty(t, r.NestedTypes())
+	require.Empty(t, r.MappedImports())
+
+	// The same name is collected again for the next file
+	require.Equal(t, "UserPreferences", r.ResolveType(object, "User", "Preferences"))
+	require.Len(t, r.NestedTypes(), 1)
+}
+
+func TestTypeResolver_ResolveParamType(t *testing.T) {
+	r := NewTypeResolver(&config.TypesConfig{UUIDPackage: "google"})
+
+	tests := []struct {
+		name     string
+		schema   *model.Schema
+		expected string
+	}{
+		{"nil", nil, "any"},
+		{"ref", &model.Schema{Ref: "#/components/schemas/pet_status"}, "PetStatus"},
+		{"uuid", &model.Schema{Type: model.TypeString, Format: "uuid"}, "uuid.UUID"},
+		{"int32", &model.Schema{Type: model.TypeInteger, Format: "int32"}, "int32"},
+		{"number", &model.Schema{Type: model.TypeNumber, Format: "float"}, "float64"},
+		{"array", &model.Schema{Type: model.TypeArray, Items: &model.Schema{Type: model.TypeInteger, Format: "int64"}}, "[]int64"},
+		{"inline object", &model.Schema{Type: model.TypeObject, Properties: []model.Property{{Name: "a", Schema: &model.Schema{Type: model.TypeString}}}}, "any"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, r.ResolveParamType(tt.schema, "", ""))
+		})
+	}
+	require.Empty(t, r.NestedTypes())
+
+	// An inline parameter enum is named from the operation and parameter
+	enum := &model.Schema{Type: model.TypeString, Enum: []any{"asc", "desc"}}
+	require.Equal(t, "ListPetsOrder", r.ResolveParamType(enum, "listPets", "order"))
+}
+
 func TestTypeResolver_Union(t *testing.T) {
 	r := NewTypeResolver(&config.TypesConfig{})
 
@@ -205,3 +271,100 @@ func TestTypeResolver_AllOf(t *testing.T) {
 	require.True(t, nested[0].IsAllOf)
 }
 
+func TestTypeResolver_AllOfFieldOrder(t *testing.T) {
+	base := &model.Schema{
+		Type: model.TypeObject,
+		Properties: []model.Property{
+			{Name: "zone", Schema: &model.Schema{Type: model.TypeString}},
+			{Name: "id", Schema: &model.Schema{Type: model.TypeString}},
+		},
+		Required: []string{"zone", "id"},
+	}
+	schema := &model.Schema{
+		AllOf: []*model.Schema{
+			{Ref: "#/components/schemas/Base"},
+			{
+				Type: model.TypeObject,
+				Properties: []model.Property{
+					{Name: "name", Schema: &model.Schema{Type: model.TypeString}},
+					{Name: "age", Schema: &model.Schema{Type: model.TypeInteger}},
+				},
+				Required: []string{"name", "id", "age"},
+			},
+		},
+	}
+	lookup := func(ref string) *model.Schema { return base }
+
+	propertyNames := func(s *model.Schema) []string {
+		var names []string
+		for _, p := range s.Properties {
+			names = append(names, p.Name)
+		}
+		return names
+	}
+
+	tests := []struct {
+		order    string
+		props    []string
+		required []string
+	}{
+		{"", []string{"zone", "id", "name", "age"}, []string{"zone", "id", "name", "age"}},
+		{FieldOrderAlpha, []string{"age", "id", "name", "zone"}, []string{"zone", "id", "name", "age"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.order, func(t *testing.T) {
+			SetFieldOrder(tt.order)
+			defer SetFieldOrder("")
+
+			r := NewTypeResolverWithSchemaLookup(&config.TypesConfig{AllOfStrategy: "flatten"}, nil, nil, lookup)
+			r.ResolveType(schema, "Model", "Combined")
+
+			nested := r.NestedTypes()
+			require.Len(t, nested, 1)
+			require.Equal(t, tt.props, propertyNames(nested[0].Schema))
+			require.Equal(t, tt.required, nested[0].Schema.Required)
+		})
+	}
+}
+
+
+func TestStatusCodes(t *testing.T) {
+	tests := []struct {
+		code  string
+		value int
+		class int
+		name  string
+	}{
+		{"200", 200, 0, "200"},
+		{"default", 500, 0, "Default"},
+		{"2XX", 200, 2, "2XX"},
+		{"4xx", 400, 4, "4XX"},
+		{"6XX", 500, 0, "6XX"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			require.Equal(t, tt.value, StatusCodeInt(tt.code))
+			require.Equal(t, tt.class, StatusCodeClass(tt.code))
+			require.Equal(t, tt.name, StatusCodeName(tt.code))
+		})
+	}
+}
+
+func TestEnumUnknown(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema *model.Schema
+		want   UnknownEnumValue
+	}{
+		{"string", &model.Schema{Type: model.TypeString, Enum: []any{"a", "b"}}, UnknownEnumValue{Literal: `""`}},
+		{"member", &model.Schema{Type: model.TypeString, Enum: []any{"a", "UNKNOWN"}}, UnknownEnumValue{Literal: `"UNKNOWN"`, Member: true}},
+		{"integer", &model.Schema{Type: model.TypeInteger, Enum: []any{1, 2}}, UnknownEnumValue{Literal: "0"}},
+		{"integer with zero", &model.Schema{Type: model.TypeInteger, Enum: []any{2, 0, -3}}, UnknownEnumValue{Literal: "-4"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, EnumUnknown(tt.schema))
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package golang
+
+import "github.com/kolah/eugene/internal/model"
+
+// walkSpecSchemas calls fn once for every schema of spec: the components,
+// the schemas of operation parameters, bodies, responses, streams and
+// callbacks, and the schemas nested in them. References are not followed;
+// the component a reference points to is walked on its own.
+func walkSpecSchemas(spec *model.Spec, fn func(s *model.Schema)) {
+	visited := make(map[*model.Schema]bool)
+	var walk func(s *model.Schema)
+	walk = func(s *model.Schema) {
+		if s == nil || s.Ref != "" || visited[s] {
+			return
+		}
+		visited[s] = true
+		fn(s)
+		for _, p := range s.Properties {
+			walk(p.Schema)
+		}
+		walk(s.Items)
+		walk(s.AdditionalProperties)
+		for _, sub := range s.AllOf {
+			walk(sub)
+		}
+		for _, sub := range s.OneOf {
+			walk(sub)
+		}
+		for _, sub := range s.AnyOf {
+			walk(sub)
+		}
+	}
+	walkContent := func(content []model.MediaTypeContent) {
+		for _, c := range content {
+			walk(c.Schema)
+		}
+	}
+	walkResponses := func(responses []model.Response) {
+		for _, resp := range responses {
+			walkContent(resp.Content)
+			for _, h := range resp.Headers {
+				walk(h.Schema)
+			}
+		}
+	}
+
+	for i := range spec.Schemas {
+		walk(&spec.Schemas[i])
+	}
+	for _, op := range spec.Operations {
+		for _, p := range op.Parameters {
+			walk(p.Schema)
+		}
+		if op.RequestBody != nil {
+			walkContent(op.RequestBody.Content)
+		}
+		walkResponses(op.Responses)
+		if op.Streaming != nil {
+			walk(op.Streaming.EventSchema)
+		}
+		for _, cb := range op.Callbacks {
+			for _, cbOp := range cb.Operations {
+				if cbOp.RequestBody != nil {
+					walkContent(cbOp.RequestBody.Content)
+				}
+				walkResponses(cbOp.Responses)
+			}
+		}
+	}
+}
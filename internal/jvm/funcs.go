@@ -0,0 +1,86 @@
+package jvm
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// TemplateFuncs returns the helpers of the jvm templates, prefixed like the
+// Go and ts helpers they share the template engine with.
+func TemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"jvmDoc":       Doc,
+		"javaDoc":      JavaDoc,
+		"javaString":   func(s string) string { return stringLiteral(s, false) },
+		"kotlinString": func(s string) string { return stringLiteral(s, true) },
+	}
+}
+
+// Doc returns text as a KDoc or Javadoc comment, with its lines indented by
+// indent spaces after the first. It returns an empty string for no text.
+func Doc(indent int, text string) string {
+	text = strings.TrimSpace(strings.ReplaceAll(text, "*/", "*&#47;"))
+	if text == "" {
+		return ""
+	}
+	lines := strings.Split(text, "\n")
+	if len(lines) == 1 {
+		return "/** " + lines[0] + " */"
+	}
+	pad := strings.Repeat(" ", indent)
+	var b strings.Builder
+	b.WriteString("/**\n")
+	for _, line := range lines {
+		b.WriteString(pad + strings.TrimRight(" * "+line, " ") + "\n")
+	}
+	b.WriteString(pad + " */")
+	return b.String()
+}
+
+// JavaDoc returns the Javadoc comment of d, documenting the components of a
+// record with @param tags.
+func JavaDoc(d Declaration) string {
+	text := strings.TrimSpace(d.Doc)
+	var params []string
+	for _, f := range d.Fields {
+		if doc := strings.TrimSpace(f.Doc); doc != "" {
+			params = append(params, "@param "+f.Name+" "+strings.ReplaceAll(doc, "\n", " "))
+		}
+	}
+	if len(params) > 0 {
+		if text != "" {
+			text += "\n\n"
+		}
+		text += strings.Join(params, "\n")
+	}
+	return Doc(0, text)
+}
+
+// stringLiteral returns s as a double-quoted literal, with the $ of Kotlin
+// string templates escaped.
+func stringLiteral(s string, kotlin bool) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch {
+		case r == '"' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '$' && kotlin:
+			b.WriteString(`\$`)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r < 0x20:
+			fmt.Fprintf(&b, `\u%04x`, r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
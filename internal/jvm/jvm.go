@@ -0,0 +1,425 @@
+// Package jvm maps the spec model to Kotlin and Java, for the jvm targets.
+// Object schemas become Kotlin data classes or Java records, string enums
+// enum classes, and the other component schemas Kotlin type aliases. Java
+// has no aliases, so references to such schemas use their type directly.
+package jvm
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/kolah/eugene/internal/golang"
+	"github.com/kolah/eugene/internal/model"
+)
+
+// Dialect is the JVM language declarations are written in.
+type Dialect string
+
+const (
+	Kotlin Dialect = "kotlin"
+	Java   Dialect = "java"
+)
+
+// Kind is what a schema is declared as.
+type Kind string
+
+const (
+	KindClass Kind = "class" // Kotlin data class, Java record
+	KindEnum  Kind = "enum"
+	KindAlias Kind = "alias" // Kotlin typealias
+)
+
+// Declaration is a type declared for a component schema, or for an inline
+// object or enum nested in one.
+type Declaration struct {
+	Doc        string
+	Deprecated bool
+	Name       string
+	Kind       Kind
+	Fields     []Field     // KindClass
+	Values     []EnumValue // KindEnum
+	Type       string      // KindAlias
+	Imports    []string    // used by the declaration, sorted
+}
+
+type Field struct {
+	Doc        string
+	Deprecated bool
+	Name       string
+	JSONName   string // property name, when it differs from Name
+	Type       string
+	Optional   bool // may be missing or null
+}
+
+type EnumValue struct {
+	Name  string
+	Value string // as sent
+}
+
+// Declarations returns the declarations of the component schemas of spec,
+// each followed by the declarations of the inline objects and enums of its
+// properties, named after the property as in the Go types: PetOwner.
+func Declarations(dialect Dialect, spec *model.Spec) []Declaration {
+	r := &resolver{dialect: dialect, spec: spec, taken: make(map[string]bool)}
+	for i := range spec.Schemas {
+		r.taken[ClassName(spec.Schemas[i].Name)] = true
+	}
+	for i := range spec.Schemas {
+		s := &spec.Schemas[i]
+		name := ClassName(s.Name)
+		switch {
+		case isClass(s):
+			r.declareClass(name, s)
+		case isEnum(s):
+			r.declareEnum(name, s)
+		case dialect == Kotlin:
+			r.declareAlias(name, s)
+		}
+	}
+	return r.decls
+}
+
+type resolver struct {
+	dialect   Dialect
+	spec      *model.Spec
+	decls     []Declaration
+	taken     map[string]bool
+	imports   map[string]bool // of the declaration being resolved
+	resolving map[string]bool // aliases being inlined, for Java
+}
+
+// isClass reports whether s is declared as a class: an object with
+// properties, or an allOf composition merging them.
+func isClass(s *model.Schema) bool {
+	if len(s.OneOf)+len(s.AnyOf) > 0 || len(s.Enum) > 0 {
+		return false
+	}
+	return len(s.AllOf) > 0 || (s.Type == model.TypeObject && len(s.Properties) > 0)
+}
+
+func isEnum(s *model.Schema) bool {
+	return len(s.Enum) > 0 && s.Type == model.TypeString
+}
+
+func (r *resolver) declare(d Declaration, resolve func(d *Declaration)) {
+	outer := r.imports
+	r.imports = make(map[string]bool)
+	index := len(r.decls)
+	r.decls = append(r.decls, d)
+	resolve(&d) // may append the declarations nested in d
+	for imp := range r.imports {
+		d.Imports = append(d.Imports, imp)
+	}
+	slices.Sort(d.Imports)
+	r.decls[index] = d
+	r.imports = outer
+}
+
+func (r *resolver) declareClass(name string, s *model.Schema) {
+	r.declare(Declaration{Doc: s.Description, Deprecated: s.Deprecated, Name: name, Kind: KindClass}, func(d *Declaration) {
+		props, required := r.properties(s)
+		seen := make(map[string]bool)
+		for _, p := range props {
+			field := Field{Name: FieldName(p.Name, r.dialect), Optional: !slices.Contains(required, p.Name)}
+			for seen[field.Name] {
+				field.Name += "_"
+			}
+			seen[field.Name] = true
+			if field.Name != p.Name {
+				field.JSONName = p.Name
+				r.annotation()
+			}
+			if p.Schema != nil {
+				field.Doc, field.Deprecated = p.Schema.Description, p.Schema.Deprecated
+				field.Optional = field.Optional || p.Schema.Nullable
+			}
+			field.Type = r.typeOf(p.Schema, name+ClassName(p.Name))
+			d.Fields = append(d.Fields, field)
+		}
+	})
+}
+
+func (r *resolver) declareEnum(name string, s *model.Schema) {
+	r.declare(Declaration{Doc: s.Description, Deprecated: s.Deprecated, Name: name, Kind: KindEnum}, func(d *Declaration) {
+		r.annotation()
+		seen := make(map[string]bool)
+		for _, v := range s.Enum {
+			value, ok := v.(string)
+			if !ok {
+				continue // null in a nullable enum
+			}
+			constant := ConstantName(value)
+			for seen[constant] {
+				constant += "_"
+			}
+			seen[constant] = true
+			d.Values = append(d.Values, EnumValue{Name: constant, Value: value})
+		}
+	})
+}
+
+func (r *resolver) declareAlias(name string, s *model.Schema) {
+	r.declare(Declaration{Doc: s.Description, Deprecated: s.Deprecated, Name: name, Kind: KindAlias}, func(d *Declaration) {
+		d.Type = r.typeOf(s, name+"Value")
+		if s.Nullable {
+			d.Type = nullable(d.Type)
+		}
+	})
+}
+
+// annotation records the import of the annotation naming a property or
+// enum value as sent.
+func (r *resolver) annotation() {
+	if r.dialect == Kotlin {
+		r.imports["kotlinx.serialization.SerialName"] = true
+	} else {
+		r.imports["com.fasterxml.jackson.annotation.JsonProperty"] = true
+	}
+}
+
+// properties returns the properties of s and those it requires, merged from
+// the members of an allOf composition; the first declaration of a property
+// wins.
+func (r *resolver) properties(s *model.Schema) ([]model.Property, []string) {
+	var props []model.Property
+	var required []string
+	seen := make(map[string]bool)
+	visiting := make(map[string]bool)
+	var collect func(s *model.Schema)
+	collect = func(s *model.Schema) {
+		if s.Ref != "" {
+			target := r.spec.SchemaByRef(s.Ref)
+			if target == nil || visiting[s.Ref] {
+				return
+			}
+			visiting[s.Ref] = true
+			defer delete(visiting, s.Ref)
+			s = target
+		}
+		for _, member := range s.AllOf {
+			collect(member)
+		}
+		for _, p := range s.Properties {
+			if !seen[p.Name] {
+				seen[p.Name] = true
+				props = append(props, p)
+			}
+		}
+		required = append(required, s.Required...)
+	}
+	collect(s)
+	return props, required
+}
+
+// typeOf returns the type of s, declaring its inline objects and enums as
+// name.
+func (r *resolver) typeOf(s *model.Schema, name string) string {
+	if s == nil {
+		return r.anyType()
+	}
+	if s.Ref != "" {
+		return r.refType(s.Ref)
+	}
+	if isClass(s) {
+		name = r.unique(name)
+		r.declareClass(name, s)
+		return name
+	}
+	if isEnum(s) {
+		name = r.unique(name)
+		r.declareEnum(name, s)
+		return name
+	}
+	if len(s.OneOf)+len(s.AnyOf) > 0 {
+		return r.anyType()
+	}
+
+	switch s.Type {
+	case model.TypeString:
+		return "String"
+	case model.TypeInteger:
+		if s.Format == "int32" {
+			return r.pick("Int", "Integer")
+		}
+		return "Long"
+	case model.TypeNumber:
+		if s.Format == "float" {
+			return "Float"
+		}
+		return "Double"
+	case model.TypeBoolean:
+		return "Boolean"
+	case model.TypeArray:
+		r.javaImport("java.util.List")
+		return "List<" + r.typeOf(s.Items, name+"Item") + ">"
+	case model.TypeObject:
+		r.javaImport("java.util.Map")
+		if s.AdditionalProperties != nil {
+			return "Map<String, " + r.typeOf(s.AdditionalProperties, name+"Value") + ">"
+		}
+		return "Map<String, " + r.anyType() + ">"
+	}
+	return r.anyType()
+}
+
+// refType returns the type of the component schema ref refers to. Java
+// spells out the type of a schema Kotlin declares an alias for.
+func (r *resolver) refType(ref string) string {
+	target := r.spec.SchemaByRef(ref)
+	if target == nil || r.dialect == Kotlin || isClass(target) || isEnum(target) {
+		return ClassName(ref[strings.LastIndex(ref, "/")+1:])
+	}
+	if r.resolving == nil {
+		r.resolving = make(map[string]bool)
+	}
+	if r.resolving[ref] {
+		return r.anyType() // an alias of itself, through arrays or maps
+	}
+	r.resolving[ref] = true
+	defer delete(r.resolving, ref)
+	return r.typeOf(target, ClassName(target.Name)+"Value")
+}
+
+// anyType is the type of a value of any shape, such as a oneOf.
+func (r *resolver) anyType() string {
+	if r.dialect == Kotlin {
+		r.imports["kotlinx.serialization.json.JsonElement"] = true
+		return "JsonElement"
+	}
+	r.imports["com.fasterxml.jackson.databind.JsonNode"] = true
+	return "JsonNode"
+}
+
+func (r *resolver) pick(kotlin, java string) string {
+	if r.dialect == Kotlin {
+		return kotlin
+	}
+	return java
+}
+
+func (r *resolver) javaImport(path string) {
+	if r.dialect == Java {
+		r.imports[path] = true
+	}
+}
+
+func (r *resolver) unique(name string) string {
+	candidate := name
+	for i := 2; r.taken[candidate]; i++ {
+		candidate = fmt.Sprintf("%s%d", name, i)
+	}
+	r.taken[candidate] = true
+	return candidate
+}
+
+func nullable(typ string) string {
+	if strings.HasSuffix(typ, "?") {
+		return typ
+	}
+	return typ + "?"
+}
+
+// ClassName returns the name of the class declared for the schema name,
+// such as PetOwner for pet_owner. Unlike the Go names, initialisms keep the
+// case of the spec: UserId stays UserId.
+func ClassName(name string) string {
+	var b strings.Builder
+	for _, word := range words(name) {
+		b.WriteString(upperFirst(word))
+	}
+	if b.Len() == 0 || unicode.IsDigit(rune(b.String()[0])) {
+		return "T" + b.String()
+	}
+	return b.String()
+}
+
+// FieldName returns the name of the property name, escaped when it is a
+// keyword of dialect.
+func FieldName(name string, dialect Dialect) string {
+	var b strings.Builder
+	for i, word := range words(name) {
+		if i == 0 {
+			b.WriteString(lowerFirst(word))
+		} else {
+			b.WriteString(upperFirst(word))
+		}
+	}
+	field := b.String()
+	if field == "" || unicode.IsDigit(rune(field[0])) {
+		field = "_" + field
+	}
+	switch {
+	case dialect == Kotlin && kotlinKeywords[field]:
+		return "`" + field + "`"
+	case dialect == Java && javaKeywords[field]:
+		return field + "_"
+	}
+	return field
+}
+
+// ConstantName returns the name of the enum constant for value, such as
+// IN_PROGRESS for in-progress.
+func ConstantName(value string) string {
+	name := strings.ToUpper(golang.SnakeCase(identifierRunes(value)))
+	if name == "" {
+		return "EMPTY"
+	}
+	if unicode.IsDigit(rune(name[0])) {
+		return "V" + name
+	}
+	return name
+}
+
+// identifierRunes replaces the characters of s no identifier may hold with
+// word separators.
+func identifierRunes(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, s)
+}
+
+// words splits s at the characters no identifier may hold.
+func words(s string) []string {
+	return strings.FieldsFunc(identifierRunes(s), func(r rune) bool { return r == '_' })
+}
+
+func upperFirst(s string) string {
+	r := []rune(s)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
+
+func lowerFirst(s string) string {
+	r := []rune(s)
+	r[0] = unicode.ToLower(r[0])
+	return string(r)
+}
+
+var kotlinKeywords = map[string]bool{
+	"as": true, "break": true, "class": true, "continue": true, "do": true,
+	"else": true, "false": true, "for": true, "fun": true, "if": true,
+	"in": true, "interface": true, "is": true, "null": true, "object": true,
+	"package": true, "return": true, "super": true, "this": true, "throw": true,
+	"true": true, "try": true, "typealias": true, "typeof": true, "val": true,
+	"var": true, "when": true, "while": true,
+}
+
+var javaKeywords = map[string]bool{
+	"abstract": true, "assert": true, "boolean": true, "break": true, "byte": true,
+	"case": true, "catch": true, "char": true, "class": true, "const": true,
+	"continue": true, "default": true, "do": true, "double": true, "else": true,
+	"enum": true, "extends": true, "final": true, "finally": true, "float": true,
+	"for": true, "goto": true, "if": true, "implements": true, "import": true,
+	"instanceof": true, "int": true, "interface": true, "long": true, "native": true,
+	"new": true, "package": true, "private": true, "protected": true, "public": true,
+	"return": true, "short": true, "static": true, "strictfp": true, "super": true,
+	"switch": true, "synchronized": true, "this": true, "throw": true, "throws": true,
+	"transient": true, "try": true, "void": true, "volatile": true, "while": true,
+	"true": true, "false": true, "null": true, "record": true, "var": true,
+	"yield": true, "_": true,
+}
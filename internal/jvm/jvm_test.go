@@ -0,0 +1,90 @@
+package jvm
+
+import (
+	"testing"
+
+	"github.com/kolah/eugene/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNames(t *testing.T) {
+	require.Equal(t, "PetOwner", ClassName("pet_owner"))
+	require.Equal(t, "UserId", ClassName("userId"))
+	require.Equal(t, "T2fa", ClassName("2fa"))
+
+	require.Equal(t, "xTrace", FieldName("x-trace", Kotlin))
+	require.Equal(t, "requestId", FieldName("request_id", Java))
+	require.Equal(t, "`class`", FieldName("class", Kotlin))
+	require.Equal(t, "class_", FieldName("class", Java))
+	require.Equal(t, "_1st", FieldName("1st", Kotlin))
+
+	require.Equal(t, "IN_PROGRESS", ConstantName("in-progress"))
+	require.Equal(t, "V2FA", ConstantName("2fa"))
+	require.Equal(t, "EMPTY", ConstantName(""))
+}
+
+func TestDeclarations(t *testing.T) {
+	spec := &model.Spec{Schemas: []model.Schema{
+		{
+			Name:     "Pet",
+			Type:     model.TypeObject,
+			Required: []string{"id"},
+			Properties: []model.Property{
+				{Name: "id", Schema: &model.Schema{Type: model.TypeInteger, Format: "int32"}},
+				{Name: "pet-kind", Schema: &model.Schema{Type: model.TypeString, Enum: []any{"cat", "dog"}}},
+				{Name: "tags", Schema: &model.Schema{Type: model.TypeArray, Items: &model.Schema{Ref: "#/components/schemas/Tags"}}},
+				{Name: "extra", Schema: &model.Schema{OneOf: []*model.Schema{{Type: model.TypeString}, {Type: model.TypeInteger}}}},
+			},
+		},
+		{Name: "Tags", Type: model.TypeArray, Items: &model.Schema{Type: model.TypeString}},
+	}}
+
+	t.Run("kotlin", func(t *testing.T) {
+		decls := Declarations(Kotlin, spec)
+		require.Len(t, decls, 3)
+
+		pet := decls[0]
+		require.Equal(t, KindClass, pet.Kind)
+		require.Equal(t, []string{"kotlinx.serialization.SerialName", "kotlinx.serialization.json.JsonElement"}, pet.Imports)
+		require.Equal(t, []Field{
+			{Name: "id", Type: "Int"},
+			{Name: "petKind", JSONName: "pet-kind", Type: "PetPetKind", Optional: true},
+			{Name: "tags", Type: "List<Tags>", Optional: true},
+			{Name: "extra", Type: "JsonElement", Optional: true},
+		}, pet.Fields)
+
+		kind := decls[1]
+		require.Equal(t, KindEnum, kind.Kind)
+		require.Equal(t, "PetPetKind", kind.Name)
+		require.Equal(t, []EnumValue{{Name: "CAT", Value: "cat"}, {Name: "DOG", Value: "dog"}}, kind.Values)
+
+		require.Equal(t, Declaration{Name: "Tags", Kind: KindAlias, Type: "List<String>"}, decls[2])
+	})
+
+	t.Run("java", func(t *testing.T) {
+		decls := Declarations(Java, spec)
+		require.Len(t, decls, 2, "Java has no aliases")
+
+		pet := decls[0]
+		require.Equal(t, []string{
+			"com.fasterxml.jackson.annotation.JsonProperty",
+			"com.fasterxml.jackson.databind.JsonNode",
+			"java.util.List",
+		}, pet.Imports)
+		require.Equal(t, "Integer", pet.Fields[0].Type)
+		require.Equal(t, "List<List<String>>", pet.Fields[2].Type, "aliases are spelled out")
+	})
+}
+
+func TestJavaDoc(t *testing.T) {
+	require.Empty(t, JavaDoc(Declaration{}))
+	require.Equal(t, "/**\n * A pet.\n *\n * @param id Unique ID\n */", JavaDoc(Declaration{
+		Doc:    "A pet.",
+		Fields: []Field{{Name: "id", Doc: "Unique ID"}, {Name: "name"}},
+	}))
+}
+
+func TestStringLiteral(t *testing.T) {
+	require.Equal(t, `"\"$x\"\n"`, stringLiteral("\"$x\"\n", false))
+	require.Equal(t, `"\$x"`, stringLiteral("$x", true))
+}
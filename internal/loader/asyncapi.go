@@ -0,0 +1,393 @@
+package loader
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kolah/eugene/internal/golang"
+	"github.com/kolah/eugene/internal/model"
+	"github.com/pb33f/libopenapi/datamodel"
+	"go.yaml.in/yaml/v4"
+)
+
+const componentSchemaPrefix = "#/components/schemas/"
+
+// LoadAsyncAPIFile reads an AsyncAPI 2.x or 3.0 document and returns a spec
+// holding its event operations and the schemas of their message payloads.
+// Payload schemas are transformed like OpenAPI component schemas: the
+// document's components.schemas, plus one schema per inline payload named
+// after its message.
+func LoadAsyncAPIFile(path string) (*model.Spec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading AsyncAPI file: %w", err)
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("resolving absolute path: %w", err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parsing AsyncAPI document: %w", err)
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 {
+		return nil, fmt.Errorf("parsing AsyncAPI document: empty document")
+	}
+
+	a := &asyncAPIDoc{
+		root:     doc.Content[0],
+		schemas:  yamlMapping(),
+		payloads: make(map[string]string),
+	}
+	version := yamlScalar(a.root, "asyncapi")
+	var ops []model.EventOperation
+	switch {
+	case strings.HasPrefix(version, "2."):
+		ops, err = a.operationsV2()
+	case strings.HasPrefix(version, "3."):
+		ops, err = a.operationsV3()
+	default:
+		return nil, fmt.Errorf("unsupported AsyncAPI version: %q (2.x and 3.x supported)", version)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := loadWithConfig(a.schemaDocument(), &datamodel.DocumentConfiguration{
+		BasePath:            filepath.Dir(absPath),
+		AllowFileReferences: true,
+	}, "")
+	if err != nil {
+		return nil, fmt.Errorf("loading AsyncAPI payload schemas: %w", err)
+	}
+	spec, err := Transform(result)
+	if err != nil {
+		return nil, err
+	}
+	spec.Events = ops
+	spec.Warnings = append(spec.Warnings, a.warnings...)
+	return spec, nil
+}
+
+// MergeEvents adds the event operations and payload schemas of an AsyncAPI
+// spec to an OpenAPI spec. A component schema named like an OpenAPI schema
+// is taken from the OpenAPI document, so both documents can share
+// components. An inline message payload named like one is an error, since
+// the message would silently carry the OpenAPI schema.
+func MergeEvents(spec, events *model.Spec) error {
+	for _, op := range events.Events {
+		for _, msg := range op.Messages {
+			if !msg.Inline {
+				continue
+			}
+			if name := strings.TrimPrefix(msg.Payload.Ref, componentSchemaPrefix); schemaExists(spec.Schemas, name) {
+				return fmt.Errorf("AsyncAPI message %s: its payload schema %s clashes with the OpenAPI schema %s; rename the message or move its payload to components.schemas", msg.Name, name, name)
+			}
+		}
+	}
+	for _, s := range events.Schemas {
+		if !schemaExists(spec.Schemas, s.Name) {
+			spec.Schemas = append(spec.Schemas, s)
+		}
+	}
+	spec.Events = append(spec.Events, events.Events...)
+	spec.Warnings = append(spec.Warnings, events.Warnings...)
+	return nil
+}
+
+type asyncAPIDoc struct {
+	root     *yaml.Node
+	schemas  *yaml.Node        // components.schemas of the synthesized OpenAPI document
+	payloads map[string]string // message name -> payload schema name
+	warnings []model.Warning
+}
+
+// operationsV2 reads channel publish/subscribe operations. AsyncAPI 2 names
+// them from the client's point of view: the application receives what
+// clients publish and sends what they subscribe to.
+func (a *asyncAPIDoc) operationsV2() ([]model.EventOperation, error) {
+	a.copyComponentSchemas()
+
+	var ops []model.EventOperation
+	channels := yamlGet(a.root, "channels")
+	for i := 0; channels != nil && i+1 < len(channels.Content); i += 2 {
+		address := channels.Content[i].Value
+		channel, _, err := a.resolve(channels.Content[i+1])
+		if err != nil {
+			return nil, err
+		}
+		for _, kind := range []struct {
+			key    string
+			action model.EventAction
+		}{{"subscribe", model.EventSend}, {"publish", model.EventReceive}} {
+			node := yamlGet(channel, kind.key)
+			if node == nil {
+				continue
+			}
+			location := "#/channels/" + escapePointer(address) + "/" + kind.key
+			op := model.EventOperation{
+				ID:          yamlScalar(node, "operationId"),
+				Action:      kind.action,
+				Channel:     address,
+				Address:     address,
+				Summary:     yamlScalar(node, "summary"),
+				Description: yamlScalar(node, "description"),
+			}
+			if op.ID == "" {
+				op.ID = SynthesizeOperationID(model.Method(kind.action), address)
+			}
+			message := yamlGet(node, "message")
+			var refs []*yaml.Node
+			if oneOf := yamlGet(message, "oneOf"); oneOf != nil {
+				refs = oneOf.Content
+			} else if message != nil {
+				refs = []*yaml.Node{message}
+			}
+			for _, ref := range refs {
+				msg, ok, err := a.message(ref, "", op.ID, location+"/message")
+				if err != nil {
+					return nil, err
+				}
+				if ok {
+					op.Messages = append(op.Messages, msg)
+				}
+			}
+			if len(op.Messages) > 0 {
+				ops = append(ops, op)
+			}
+		}
+	}
+	return ops, nil
+}
+
+// operationsV3 reads the operations map. Operations without a messages list
+// use every message of their channel.
+func (a *asyncAPIDoc) operationsV3() ([]model.EventOperation, error) {
+	a.copyComponentSchemas()
+
+	var ops []model.EventOperation
+	operations := yamlGet(a.root, "operations")
+	for i := 0; operations != nil && i+1 < len(operations.Content); i += 2 {
+		id := operations.Content[i].Value
+		location := "#/operations/" + escapePointer(id)
+		node, _, err := a.resolve(operations.Content[i+1])
+		if err != nil {
+			return nil, err
+		}
+
+		var action model.EventAction
+		switch yamlScalar(node, "action") {
+		case "send":
+			action = model.EventSend
+		case "receive":
+			action = model.EventReceive
+		default:
+			return nil, fmt.Errorf("%s: action must be send or receive", location)
+		}
+
+		channel, channelName, err := a.resolve(yamlGet(node, "channel"))
+		if err != nil {
+			return nil, err
+		}
+		if channel == nil {
+			return nil, fmt.Errorf("%s: channel is required", location)
+		}
+		op := model.EventOperation{
+			ID:          id,
+			Action:      action,
+			Channel:     channelName,
+			Address:     yamlScalar(channel, "address"),
+			Summary:     yamlScalar(node, "summary"),
+			Description: yamlScalar(node, "description"),
+		}
+		if op.Address == "" {
+			op.Address = channelName
+		}
+		if op.Channel == "" {
+			op.Channel = op.Address
+		}
+
+		if list := yamlGet(node, "messages"); list != nil {
+			for j, ref := range list.Content {
+				msg, ok, err := a.message(ref, "", id, fmt.Sprintf("%s/messages/%d", location, j))
+				if err != nil {
+					return nil, err
+				}
+				if ok {
+					op.Messages = append(op.Messages, msg)
+				}
+			}
+		} else if messages := yamlGet(channel, "messages"); messages != nil {
+			for j := 0; j+1 < len(messages.Content); j += 2 {
+				key := messages.Content[j].Value
+				msg, ok, err := a.message(messages.Content[j+1], key, id, location+"/channel/messages/"+escapePointer(key))
+				if err != nil {
+					return nil, err
+				}
+				if ok {
+					op.Messages = append(op.Messages, msg)
+				}
+			}
+		}
+		if len(op.Messages) > 0 {
+			ops = append(ops, op)
+		}
+	}
+	return ops, nil
+}
+
+// copyComponentSchemas seeds the synthesized document with components.schemas.
+func (a *asyncAPIDoc) copyComponentSchemas() {
+	if schemas := yamlGet(yamlGet(a.root, "components"), "schemas"); schemas != nil && schemas.Kind == yaml.MappingNode {
+		a.schemas.Content = append(a.schemas.Content, schemas.Content...)
+	}
+}
+
+// message resolves a message and registers its payload schema. The name is
+// the last $ref segment, else the message's name or messageId, else key,
+// else derived from the operation.
+func (a *asyncAPIDoc) message(node *yaml.Node, key, opID, location string) (model.EventMessage, bool, error) {
+	msg, refName, err := a.resolve(node)
+	if err != nil || msg == nil {
+		return model.EventMessage{}, false, err
+	}
+	name := refName
+	for _, candidate := range []string{yamlScalar(msg, "name"), yamlScalar(msg, "messageId"), key, opID + "Message"} {
+		if name == "" {
+			name = candidate
+		}
+	}
+
+	if format := yamlScalar(msg, "schemaFormat"); format != "" && !isJSONSchemaFormat(format) {
+		a.warnings = append(a.warnings, model.Warning{
+			Kind:     model.WarningMediaType,
+			Location: location,
+			Message:  fmt.Sprintf("message %s is not generated: schemaFormat %s is not JSON Schema", name, format),
+		})
+		return model.EventMessage{}, false, nil
+	}
+
+	payload, err := a.payload(name, yamlGet(msg, "payload"))
+	if err != nil {
+		return model.EventMessage{}, false, fmt.Errorf("%s: %w", location, err)
+	}
+	return model.EventMessage{
+		Name:        name,
+		Summary:     yamlScalar(msg, "summary"),
+		ContentType: yamlScalar(msg, "contentType"),
+		Payload:     payload,
+		Inline:      payload != nil && payload.Ref == componentSchemaPrefix+a.payloads[name],
+	}, true, nil
+}
+
+// payload returns a reference to the schema of a message payload, adding
+// inline payloads to the synthesized components under the message name.
+func (a *asyncAPIDoc) payload(message string, node *yaml.Node) (*model.Schema, error) {
+	if node == nil {
+		return nil, nil
+	}
+	if ref := yamlScalar(node, "$ref"); strings.HasPrefix(ref, componentSchemaPrefix) && len(node.Content) == 2 {
+		return &model.Schema{Ref: ref}, nil
+	}
+	if name, ok := a.payloads[message]; ok {
+		return &model.Schema{Ref: componentSchemaPrefix + name}, nil
+	}
+
+	// AsyncAPI 3 multi-format schema objects wrap the payload in "schema"
+	if schema := yamlGet(node, "schema"); schema != nil && yamlGet(node, "schemaFormat") != nil {
+		node = schema
+	}
+	name := golang.PascalCase(message)
+	if yamlGet(a.schemas, name) != nil {
+		name += "Payload"
+	}
+	a.schemas.Content = append(a.schemas.Content, yamlString(name), node)
+	a.payloads[message] = name
+	return &model.Schema{Ref: componentSchemaPrefix + name}, nil
+}
+
+// resolve follows local $refs and returns the target node with the last
+// segment of the final reference, or "" when node was not a reference.
+func (a *asyncAPIDoc) resolve(node *yaml.Node) (*yaml.Node, string, error) {
+	var name string
+	for range 32 {
+		ref := yamlScalar(node, "$ref")
+		if ref == "" {
+			return node, name, nil
+		}
+		if !strings.HasPrefix(ref, "#/") {
+			return nil, "", fmt.Errorf("unsupported AsyncAPI reference %q: only local references are resolved", ref)
+		}
+		target := a.root
+		for _, segment := range strings.Split(ref[2:], "/") {
+			segment = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+			target = yamlGet(target, segment)
+			name = segment
+		}
+		if target == nil {
+			return nil, "", fmt.Errorf("unresolved AsyncAPI reference %q", ref)
+		}
+		node = target
+	}
+	return nil, "", fmt.Errorf("AsyncAPI reference cycle at %q", yamlScalar(node, "$ref"))
+}
+
+// schemaDocument renders the collected schemas as an OpenAPI 3.1 document.
+func (a *asyncAPIDoc) schemaDocument() []byte {
+	title := yamlScalar(yamlGet(a.root, "info"), "title")
+	doc := yamlMapping(
+		yamlString("openapi"), yamlString("3.1.0"),
+		yamlString("info"), yamlMapping(yamlString("title"), yamlString(title), yamlString("version"), yamlString("0.0.0")),
+		yamlString("paths"), yamlMapping(),
+		yamlString("components"), yamlMapping(yamlString("schemas"), a.schemas),
+	)
+	data, _ := yaml.Marshal(doc)
+	return data
+}
+
+// isJSONSchemaFormat reports whether a schemaFormat is JSON Schema or the
+// AsyncAPI schema dialect, which extends it.
+func isJSONSchemaFormat(format string) bool {
+	return strings.HasPrefix(format, "application/vnd.aai.asyncapi") ||
+		strings.HasPrefix(format, "application/schema+json") ||
+		strings.HasPrefix(format, "application/schema+yaml")
+}
+
+func yamlGet(node *yaml.Node, key string) *yaml.Node {
+	if node == nil {
+		return nil
+	}
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == key {
+				return node.Content[i+1]
+			}
+		}
+	case yaml.SequenceNode:
+		for i, item := range node.Content {
+			if fmt.Sprint(i) == key {
+				return item
+			}
+		}
+	}
+	return nil
+}
+
+func yamlScalar(node *yaml.Node, key string) string {
+	if v := yamlGet(node, key); v != nil && v.Kind == yaml.ScalarNode {
+		return v.Value
+	}
+	return ""
+}
+
+func yamlMapping(content ...*yaml.Node) *yaml.Node {
+	return &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map", Content: content}
+}
+
+func yamlString(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+}
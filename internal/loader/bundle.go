@@ -0,0 +1,229 @@
+package loader
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/pb33f/libopenapi/bundler"
+	"github.com/pb33f/libopenapi/json"
+	"go.yaml.in/yaml/v4"
+)
+
+// BundleOptions controls how Bundle renders a spec.
+type BundleOptions struct {
+	// FlattenAllOf merges allOf compositions into one object schema, as the
+	// flatten allof-strategy does for generated types.
+	FlattenAllOf bool
+	// JSON renders the bundle as JSON instead of YAML.
+	JSON bool
+}
+
+// Bundle renders the spec of result as a single self-contained document.
+// Objects referenced from other files are inlined where they are used, the
+// same way Transform reads them, while references within the spec are kept.
+func Bundle(result *Result, opts BundleOptions) ([]byte, error) {
+	data, err := bundler.BundleDocumentWithConfig(&result.Document.Model, &bundler.BundleInlineConfig{
+		ResolveDiscriminatorExternalRefs: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("bundling spec: %w", err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parsing bundled spec: %w", err)
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 {
+		return nil, fmt.Errorf("bundled spec is empty")
+	}
+	root := doc.Content[0]
+	if opts.FlattenAllOf {
+		f := &allOfFlattener{root: root, visiting: make(map[*yaml.Node]bool)}
+		f.walk(root)
+	}
+
+	if opts.JSON {
+		out, err := json.YAMLNodeToJSON(root, "  ")
+		if err != nil {
+			return nil, fmt.Errorf("rendering bundled spec: %w", err)
+		}
+		return append(out, '\n'), nil
+	}
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(root); err != nil {
+		return nil, fmt.Errorf("rendering bundled spec: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// allOfFlattener rewrites allOf compositions of a bundled document by the
+// rules of the flatten allof-strategy: members are merged in order, a
+// referenced member contributes the members of its own allOf first, the
+// first property of a name wins unless x-oink-allof-conflict is last,
+// required lists are joined and the first description is kept. Conflicting
+// properties a composition does not resolve are rejected before bundling,
+// see codegen.CheckAllOfConflicts.
+type allOfFlattener struct {
+	root     *yaml.Node
+	visiting map[*yaml.Node]bool // referenced schemas being merged, to stop cycles
+}
+
+func (f *allOfFlattener) walk(node *yaml.Node) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i].Value
+			// Example values and extensions are not schemas
+			if key == "example" || key == "examples" || strings.HasPrefix(key, "x-") {
+				continue
+			}
+			f.walk(node.Content[i+1])
+		}
+		if members := mappingValue(node, "allOf"); members != nil && members.Kind == yaml.SequenceNode {
+			f.flatten(node, members)
+		}
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			f.walk(item)
+		}
+	}
+}
+
+// flatten replaces the allOf of schema with the merged properties, required
+// list and description of its members. Other keywords of schema are kept,
+// except x-oink-allof-conflict which has no allOf left to apply to, and its
+// own properties follow those of the members.
+func (f *allOfFlattener) flatten(schema, members *yaml.Node) {
+	m := &allOfMerge{seen: make(map[string]int), seenRequired: make(map[string]bool)}
+	if conflict := mappingValue(schema, "x-oink-allof-conflict"); conflict != nil && conflict.Value == "last" {
+		m.keepLast = true
+	}
+	f.merge(m, members)
+	m.addProperties(mappingValue(schema, "properties"))
+	m.addRequired(mappingValue(schema, "required"))
+
+	var content []*yaml.Node
+	for i := 0; i+1 < len(schema.Content); i += 2 {
+		switch schema.Content[i].Value {
+		case "allOf", "properties", "required", "x-oink-allof-conflict":
+			continue
+		case "description":
+			m.description = nil
+		}
+		content = append(content, schema.Content[i], schema.Content[i+1])
+	}
+	if mappingValue(schema, "type") == nil {
+		content = append(content, scalarNode("type"), scalarNode("object"))
+	}
+	if m.description != nil {
+		content = append(content, scalarNode("description"), m.description)
+	}
+	if len(m.properties) > 0 {
+		content = append(content, scalarNode("properties"), &yaml.Node{Kind: yaml.MappingNode, Content: m.properties})
+	}
+	if len(m.required) > 0 {
+		content = append(content, scalarNode("required"), &yaml.Node{Kind: yaml.SequenceNode, Content: m.required})
+	}
+	schema.Content = content
+}
+
+func (f *allOfFlattener) merge(m *allOfMerge, members *yaml.Node) {
+	for _, member := range members.Content {
+		if member.Kind != yaml.MappingNode {
+			continue
+		}
+		if ref := mappingValue(member, "$ref"); ref != nil {
+			target := lookupNode(f.root, ref.Value)
+			if target == nil || target.Kind != yaml.MappingNode || f.visiting[target] {
+				continue
+			}
+			f.visiting[target] = true
+			if nested := mappingValue(target, "allOf"); nested != nil && nested.Kind == yaml.SequenceNode {
+				f.merge(m, nested)
+			}
+			f.visiting[target] = false
+			member = target
+		}
+		m.addProperties(mappingValue(member, "properties"))
+		m.addRequired(mappingValue(member, "required"))
+		if m.description == nil {
+			m.description = mappingValue(member, "description")
+		}
+	}
+}
+
+// allOfMerge collects the merged keywords of one allOf composition.
+type allOfMerge struct {
+	properties   []*yaml.Node // key and value pairs of the properties mapping
+	required     []*yaml.Node
+	description  *yaml.Node
+	seen         map[string]int // index of the value of each property in properties
+	seenRequired map[string]bool
+	keepLast     bool // a property declared again replaces the earlier declaration
+}
+
+func (m *allOfMerge) addProperties(props *yaml.Node) {
+	if props == nil || props.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(props.Content); i += 2 {
+		name := props.Content[i].Value
+		if at, ok := m.seen[name]; ok {
+			if m.keepLast {
+				m.properties[at] = props.Content[i+1]
+			}
+			continue
+		}
+		m.seen[name] = len(m.properties) + 1
+		m.properties = append(m.properties, props.Content[i], props.Content[i+1])
+	}
+}
+
+func (m *allOfMerge) addRequired(required *yaml.Node) {
+	if required == nil || required.Kind != yaml.SequenceNode {
+		return
+	}
+	for _, name := range required.Content {
+		if !m.seenRequired[name.Value] {
+			m.seenRequired[name.Value] = true
+			m.required = append(m.required, name)
+		}
+	}
+}
+
+// mappingValue returns the value of key in a mapping node, or nil.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// lookupNode returns the node a local reference such as
+// "#/components/schemas/Pet" points to, or nil.
+func lookupNode(root *yaml.Node, ref string) *yaml.Node {
+	rest, ok := strings.CutPrefix(ref, "#/")
+	if !ok {
+		return nil
+	}
+	node := root
+	for _, segment := range strings.Split(rest, "/") {
+		segment = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+		if _, node = positionChild(node, segment); node == nil {
+			return nil
+		}
+	}
+	return node
+}
+
+func scalarNode(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+}
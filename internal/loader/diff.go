@@ -0,0 +1,453 @@
+package loader
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/kolah/eugene/internal/model"
+	"go.yaml.in/yaml/v4"
+)
+
+// ChangeKind classifies a difference between two versions of a spec by its
+// effect on clients and generated code.
+type ChangeKind string
+
+const (
+	ChangeBreaking ChangeKind = "breaking" // existing callers or generated code stop working
+	ChangeAdditive ChangeKind = "additive" // new operations, optional fields or values
+	ChangeDocs     ChangeKind = "docs"     // descriptions, summaries and examples only
+)
+
+// Change is one difference between two versions of a spec.
+type Change struct {
+	Kind     ChangeKind
+	Location string // JSON pointer into the spec, e.g. "#/components/schemas/Pet"
+	Message  string
+}
+
+// DiffSpecs compares two transformed versions of a spec and returns their
+// differences in document order: info, operations by path and method,
+// component schemas by name, then security schemes.
+func DiffSpecs(base, next *model.Spec) []Change {
+	d := &differ{}
+	if base.Info.Title != next.Info.Title || base.Info.Description != next.Info.Description {
+		d.add(ChangeDocs, "#/info", "title or description changed")
+	}
+	d.operations(base.Operations, next.Operations)
+	d.schemas(base.Schemas, next.Schemas)
+	d.securitySchemes(base.Security, next.Security)
+	return d.changes
+}
+
+// HighestChange returns the most severe kind among changes, or "" when
+// there are none.
+func HighestChange(changes []Change) ChangeKind {
+	var highest ChangeKind
+	for _, c := range changes {
+		if changeRank(c.Kind) > changeRank(highest) {
+			highest = c.Kind
+		}
+	}
+	return highest
+}
+
+func changeRank(k ChangeKind) int {
+	switch k {
+	case ChangeBreaking:
+		return 3
+	case ChangeAdditive:
+		return 2
+	case ChangeDocs:
+		return 1
+	}
+	return 0
+}
+
+type differ struct {
+	changes []Change
+}
+
+func (d *differ) add(kind ChangeKind, loc, format string, args ...any) {
+	d.changes = append(d.changes, Change{Kind: kind, Location: loc, Message: fmt.Sprintf(format, args...)})
+}
+
+func operationPointer(op model.Operation) string {
+	return "#/paths/" + escapePointer(op.Path) + "/" + strings.ToLower(string(op.Method))
+}
+
+func (d *differ) operations(base, next []model.Operation) {
+	key := func(op model.Operation) string { return string(op.Method) + " " + op.Path }
+	for _, op := range base {
+		if !slices.ContainsFunc(next, func(o model.Operation) bool { return key(o) == key(op) }) {
+			d.add(ChangeBreaking, operationPointer(op), "operation %s %s removed", op.Method, op.Path)
+		}
+	}
+	for _, op := range next {
+		i := slices.IndexFunc(base, func(o model.Operation) bool { return key(o) == key(op) })
+		if i < 0 {
+			d.add(ChangeAdditive, operationPointer(op), "operation %s %s added", op.Method, op.Path)
+			continue
+		}
+		d.operation(base[i], op)
+	}
+}
+
+func (d *differ) operation(base, next model.Operation) {
+	loc := operationPointer(next)
+	if base.ID != next.ID {
+		d.add(ChangeBreaking, loc, "operationId changed from %s to %s", base.ID, next.ID)
+	}
+	if !base.Deprecated && next.Deprecated {
+		d.add(ChangeAdditive, loc, "operation deprecated")
+	}
+	if base.Summary != next.Summary || base.Description != next.Description {
+		d.add(ChangeDocs, loc, "summary or description changed")
+	}
+	if !reflect.DeepEqual(base.Security, next.Security) {
+		d.add(ChangeBreaking, loc, "security requirements changed")
+	}
+	d.parameters(loc, base.Parameters, next.Parameters)
+	d.requestBody(loc+"/requestBody", base.RequestBody, next.RequestBody)
+	d.responses(loc+"/responses", base.Responses, next.Responses)
+}
+
+func (d *differ) parameters(loc string, base, next []model.Parameter) {
+	same := func(a, b model.Parameter) bool { return a.In == b.In && a.Name == b.Name }
+	for i, p := range base {
+		if !slices.ContainsFunc(next, func(n model.Parameter) bool { return same(p, n) }) {
+			d.add(ChangeBreaking, loc+"/parameters/"+strconv.Itoa(i), "%s parameter %s removed", p.In, p.Name)
+		}
+	}
+	for i, p := range next {
+		pLoc := loc + "/parameters/" + strconv.Itoa(i)
+		j := slices.IndexFunc(base, func(b model.Parameter) bool { return same(b, p) })
+		switch {
+		case j < 0 && p.Required:
+			d.add(ChangeBreaking, pLoc, "required %s parameter %s added", p.In, p.Name)
+		case j < 0:
+			d.add(ChangeAdditive, pLoc, "%s parameter %s added", p.In, p.Name)
+		default:
+			old := base[j]
+			if old.Required != p.Required {
+				d.add(ChangeBreaking, pLoc, "%s parameter %s is %s", p.In, p.Name, requiredWord(p.Required))
+			}
+			if !old.Deprecated && p.Deprecated {
+				d.add(ChangeAdditive, pLoc, "%s parameter %s deprecated", p.In, p.Name)
+			}
+			if old.Description != p.Description {
+				d.add(ChangeDocs, pLoc, "%s parameter %s description changed", p.In, p.Name)
+			}
+			d.schema(pLoc+"/schema", old.Schema, p.Schema)
+		}
+	}
+}
+
+func (d *differ) requestBody(loc string, base, next *model.RequestBody) {
+	switch {
+	case base == nil && next == nil:
+		return
+	case base == nil && next.Required:
+		d.add(ChangeBreaking, loc, "required request body added")
+		return
+	case base == nil:
+		d.add(ChangeAdditive, loc, "request body added")
+		return
+	case next == nil:
+		d.add(ChangeBreaking, loc, "request body removed")
+		return
+	}
+	if base.Required != next.Required {
+		d.add(ChangeBreaking, loc, "request body is %s", requiredWord(next.Required))
+	}
+	if base.Description != next.Description {
+		d.add(ChangeDocs, loc, "description changed")
+	}
+	d.content(loc, base.Content, next.Content)
+}
+
+func (d *differ) responses(loc string, base, next []model.Response) {
+	for _, r := range base {
+		if !slices.ContainsFunc(next, func(n model.Response) bool { return n.StatusCode == r.StatusCode }) {
+			d.add(ChangeBreaking, loc+"/"+r.StatusCode, "response %s removed", r.StatusCode)
+		}
+	}
+	for _, r := range next {
+		rLoc := loc + "/" + r.StatusCode
+		j := slices.IndexFunc(base, func(b model.Response) bool { return b.StatusCode == r.StatusCode })
+		if j < 0 {
+			d.add(ChangeAdditive, rLoc, "response %s added", r.StatusCode)
+			continue
+		}
+		if base[j].Description != r.Description {
+			d.add(ChangeDocs, rLoc, "description changed")
+		}
+		d.content(rLoc, base[j].Content, r.Content)
+	}
+}
+
+func (d *differ) content(loc string, base, next []model.MediaTypeContent) {
+	for _, c := range base {
+		if !slices.ContainsFunc(next, func(n model.MediaTypeContent) bool { return n.MediaType == c.MediaType }) {
+			d.add(ChangeBreaking, loc+"/content/"+escapePointer(c.MediaType), "media type %s removed", c.MediaType)
+		}
+	}
+	for _, c := range next {
+		cLoc := loc + "/content/" + escapePointer(c.MediaType)
+		j := slices.IndexFunc(base, func(b model.MediaTypeContent) bool { return b.MediaType == c.MediaType })
+		if j < 0 {
+			d.add(ChangeAdditive, cLoc, "media type %s added", c.MediaType)
+			continue
+		}
+		if !sameValue(base[j].Example, c.Example) {
+			d.add(ChangeDocs, cLoc, "example changed")
+		}
+		d.schema(cLoc+"/schema", base[j].Schema, c.Schema)
+	}
+}
+
+func (d *differ) schemas(base, next []model.Schema) {
+	for i := range base {
+		name := base[i].Name
+		if !slices.ContainsFunc(next, func(s model.Schema) bool { return s.Name == name }) {
+			d.add(ChangeBreaking, "#/components/schemas/"+escapePointer(name), "schema %s removed", name)
+		}
+	}
+	for i := range next {
+		s := &next[i]
+		loc := "#/components/schemas/" + escapePointer(s.Name)
+		j := slices.IndexFunc(base, func(b model.Schema) bool { return b.Name == s.Name })
+		if j < 0 {
+			d.add(ChangeAdditive, loc, "schema %s added", s.Name)
+			continue
+		}
+		d.schema(loc, &base[j], s)
+	}
+}
+
+// schema compares two schemas at the same location. References are compared
+// by target only, since each component schema is compared on its own.
+func (d *differ) schema(loc string, base, next *model.Schema) {
+	switch {
+	case base == nil && next == nil:
+		return
+	case base == nil || next == nil:
+		d.add(ChangeBreaking, loc, "schema %s", addedOrRemoved(next != nil))
+		return
+	}
+	if base.Ref != next.Ref {
+		d.add(ChangeBreaking, loc, "type changed from %s to %s", schemaLabel(base), schemaLabel(next))
+		return
+	}
+	if base.Ref != "" {
+		return
+	}
+	if base.Type != next.Type || base.Format != next.Format {
+		d.add(ChangeBreaking, loc, "type changed from %s to %s", schemaLabel(base), schemaLabel(next))
+		return
+	}
+	if base.Nullable != next.Nullable {
+		d.add(ChangeBreaking, loc, "nullable changed to %t", next.Nullable)
+	}
+	if !reflect.DeepEqual(base.Extensions, next.Extensions) {
+		d.add(ChangeBreaking, loc, "x-oink extensions changed")
+	}
+	if !base.Deprecated && next.Deprecated {
+		d.add(ChangeAdditive, loc, "deprecated")
+	}
+	if base.Description != next.Description || !sameValue(base.Example, next.Example) ||
+		!sameValue(base.Default, next.Default) {
+		d.add(ChangeDocs, loc, "description, example or default changed")
+	}
+
+	d.enum(loc, base.Enum, next.Enum)
+	d.constraints(loc, base, next)
+	d.properties(loc, base, next)
+	d.schema(loc+"/items", base.Items, next.Items)
+	d.schema(loc+"/additionalProperties", base.AdditionalProperties, next.AdditionalProperties)
+	d.composition(loc, "allOf", base.AllOf, next.AllOf)
+	d.composition(loc, "oneOf", base.OneOf, next.OneOf)
+	d.composition(loc, "anyOf", base.AnyOf, next.AnyOf)
+	if !reflect.DeepEqual(base.Discriminator, next.Discriminator) {
+		d.add(ChangeBreaking, loc+"/discriminator", "discriminator changed")
+	}
+}
+
+func (d *differ) properties(loc string, base, next *model.Schema) {
+	for _, p := range base.Properties {
+		if !slices.ContainsFunc(next.Properties, func(n model.Property) bool { return n.Name == p.Name }) {
+			d.add(ChangeBreaking, loc+"/properties/"+escapePointer(p.Name), "property %s removed", p.Name)
+		}
+	}
+	for _, p := range next.Properties {
+		pLoc := loc + "/properties/" + escapePointer(p.Name)
+		required := slices.Contains(next.Required, p.Name)
+		j := slices.IndexFunc(base.Properties, func(b model.Property) bool { return b.Name == p.Name })
+		switch {
+		case j < 0 && required:
+			d.add(ChangeBreaking, pLoc, "required property %s added", p.Name)
+		case j < 0:
+			d.add(ChangeAdditive, pLoc, "property %s added", p.Name)
+		default:
+			// Required and optional properties generate different Go types
+			if slices.Contains(base.Required, p.Name) != required {
+				d.add(ChangeBreaking, pLoc, "property %s is %s", p.Name, requiredWord(required))
+			}
+			d.schema(pLoc, base.Properties[j].Schema, p.Schema)
+		}
+	}
+}
+
+func (d *differ) enum(loc string, base, next []any) {
+	if len(base) == 0 && len(next) > 0 {
+		d.add(ChangeBreaking, loc+"/enum", "values restricted to an enum")
+		return
+	}
+	for _, v := range base {
+		if !slices.ContainsFunc(next, func(n any) bool { return reflect.DeepEqual(n, v) }) {
+			d.add(ChangeBreaking, loc+"/enum", "enum value %v removed", v)
+		}
+	}
+	if len(base) == 0 {
+		return
+	}
+	for _, v := range next {
+		if !slices.ContainsFunc(base, func(b any) bool { return reflect.DeepEqual(b, v) }) {
+			d.add(ChangeAdditive, loc+"/enum", "enum value %v added", v)
+		}
+	}
+}
+
+func (d *differ) composition(loc, keyword string, base, next []*model.Schema) {
+	if len(base) != len(next) {
+		d.add(ChangeBreaking, loc+"/"+keyword, "%s changed from %d to %d schemas", keyword, len(base), len(next))
+		return
+	}
+	for i := range base {
+		d.schema(loc+"/"+keyword+"/"+strconv.Itoa(i), base[i], next[i])
+	}
+}
+
+// constraints reports tightened constraints as breaking, since values that
+// were valid before are rejected, and loosened ones as additive.
+func (d *differ) constraints(loc string, base, next *model.Schema) {
+	d.bound(loc, "minimum", base.Minimum, next.Minimum, true)
+	d.bound(loc, "maximum", base.Maximum, next.Maximum, false)
+	d.bound(loc, "minLength", base.MinLength, next.MinLength, true)
+	d.bound(loc, "maxLength", base.MaxLength, next.MaxLength, false)
+	d.bound(loc, "minItems", base.MinItems, next.MinItems, true)
+	d.bound(loc, "maxItems", base.MaxItems, next.MaxItems, false)
+	d.bound(loc, "minProperties", base.MinProperties, next.MinProperties, true)
+	d.bound(loc, "maxProperties", base.MaxProperties, next.MaxProperties, false)
+	if base.Pattern != next.Pattern {
+		d.add(ChangeBreaking, loc, "pattern changed")
+	}
+	if base.ExclusiveMinimum != next.ExclusiveMinimum || base.ExclusiveMaximum != next.ExclusiveMaximum ||
+		base.UniqueItems != next.UniqueItems {
+		d.add(ChangeBreaking, loc, "exclusive bounds or uniqueItems changed")
+	}
+}
+
+func (d *differ) bound(loc, keyword string, base, next any, lower bool) {
+	var tighter, changed bool
+	switch b := base.(type) {
+	case *float64:
+		tighter, changed = compareBound(b, next.(*float64), lower)
+	case *int64:
+		tighter, changed = compareBound(b, next.(*int64), lower)
+	}
+	switch {
+	case tighter:
+		d.add(ChangeBreaking, loc, "%s tightened", keyword)
+	case changed:
+		d.add(ChangeAdditive, loc, "%s loosened", keyword)
+	}
+}
+
+// compareBound reports whether next accepts fewer values than base, and
+// whether it differs at all. A lower bound tightens as it grows.
+func compareBound[T cmp.Ordered](base, next *T, lower bool) (tighter, changed bool) {
+	switch {
+	case base == nil && next == nil:
+		return false, false
+	case base == nil:
+		return true, true
+	case next == nil:
+		return false, true
+	case *base == *next:
+		return false, false
+	}
+	return (*next > *base) == lower, true
+}
+
+func (d *differ) securitySchemes(base, next []model.SecurityScheme) {
+	for _, s := range base {
+		i := slices.IndexFunc(next, func(n model.SecurityScheme) bool { return n.Name == s.Name })
+		loc := "#/components/securitySchemes/" + escapePointer(s.Name)
+		if i < 0 {
+			d.add(ChangeBreaking, loc, "security scheme %s removed", s.Name)
+			continue
+		}
+		n := next[i]
+		if s.Description != n.Description {
+			d.add(ChangeDocs, loc, "description changed")
+		}
+		s.Description, n.Description = "", ""
+		if !reflect.DeepEqual(s, n) {
+			d.add(ChangeBreaking, loc, "security scheme %s changed", s.Name)
+		}
+	}
+	for _, s := range next {
+		if !slices.ContainsFunc(base, func(b model.SecurityScheme) bool { return b.Name == s.Name }) {
+			d.add(ChangeAdditive, "#/components/securitySchemes/"+escapePointer(s.Name), "security scheme %s added", s.Name)
+		}
+	}
+}
+
+func schemaLabel(s *model.Schema) string {
+	if s.Ref != "" {
+		return componentName(s.Ref)
+	}
+	if s.Format != "" {
+		return string(s.Type) + "/" + s.Format
+	}
+	if s.Type == "" {
+		return "any"
+	}
+	return string(s.Type)
+}
+
+func requiredWord(required bool) string {
+	if required {
+		return "now required"
+	}
+	return "now optional"
+}
+
+func addedOrRemoved(added bool) string {
+	if added {
+		return "added"
+	}
+	return "removed"
+}
+
+// sameValue compares example and default values, decoding YAML nodes so
+// that a value moving to another line of the spec is not a change.
+func sameValue(a, b any) bool {
+	return reflect.DeepEqual(decodeValue(a), decodeValue(b))
+}
+
+func decodeValue(v any) any {
+	node, ok := v.(*yaml.Node)
+	if !ok || node == nil {
+		return v
+	}
+	var decoded any
+	if err := node.Decode(&decoded); err != nil {
+		return v
+	}
+	return decoded
+}
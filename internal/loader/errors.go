@@ -0,0 +1,135 @@
+package loader
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/pb33f/libopenapi/index"
+	"github.com/pb33f/libopenapi/utils"
+	"go.yaml.in/yaml/v4"
+)
+
+// SpecError is a problem with one node of a spec file, such as a $ref that
+// cannot be resolved.
+type SpecError struct {
+	File    string // spec file path, empty for documents not read from a file
+	Line    int    // 1-based, 0 when unknown
+	Column  int
+	Pointer string // JSON pointer of the node, e.g. "#/components/schemas/Pet"
+	Err     error
+}
+
+// Error formats the error like a compiler diagnostic:
+// "api.yaml:22:11: #/components/schemas/Pet/properties/owner: message".
+func (e *SpecError) Error() string {
+	var b strings.Builder
+	if e.File != "" {
+		b.WriteString(e.File)
+		if e.Line > 0 {
+			fmt.Fprintf(&b, ":%d:%d", e.Line, e.Column)
+		}
+		b.WriteString(": ")
+	}
+	if e.Pointer != "" {
+		b.WriteString(e.Pointer + ": ")
+	}
+	b.WriteString(e.Err.Error())
+	return b.String()
+}
+
+func (e *SpecError) Unwrap() error { return e.Err }
+
+// locateErrors turns the errors libopenapi reports against nodes of data
+// into SpecErrors carrying the position and JSON pointer of each node. A node
+// of a file pulled in through an external $ref is located in that file. An
+// error reported more than once for the same node is kept once.
+func locateErrors(file string, data []byte, rolodex *index.Rolodex, err error) error {
+	positions := NewPositions(data)
+	var located []error
+	type at struct {
+		file         string
+		line, column int
+	}
+	seen := make(map[at]bool)
+	for _, e := range utils.UnwrapErrors(err) {
+		var (
+			idxErr *index.IndexingError
+			resErr *index.ResolvingError
+			node   *yaml.Node
+			cause  = e
+		)
+		switch {
+		case errors.As(e, &idxErr) && idxErr.Node != nil:
+			node, cause = idxErr.Node, idxErr.Err
+		case errors.As(e, &resErr) && resErr.Node != nil:
+			node, cause = resErr.Node, resErr.ErrorRef
+		default:
+			located = append(located, e)
+			continue
+		}
+		specErr := &SpecError{File: file, Line: node.Line, Column: node.Column, Err: cause}
+		if path, root := externalFile(rolodex, node); root != nil {
+			specErr.File = displayPath(file, path)
+			specErr.Pointer = (&Positions{root: root}).Pointer(node.Line, node.Column)
+		} else {
+			specErr.Pointer = positions.Pointer(node.Line, node.Column)
+		}
+		if key := (at{specErr.File, specErr.Line, specErr.Column}); !seen[key] {
+			seen[key] = true
+			located = append(located, specErr)
+		}
+	}
+	return errors.Join(located...)
+}
+
+// externalFile returns the path and root node of the file, other than the
+// root document, that node belongs to, or a nil root for the root document.
+func externalFile(rolodex *index.Rolodex, node *yaml.Node) (string, *yaml.Node) {
+	if rolodex == nil {
+		return "", nil
+	}
+	for _, idx := range rolodex.GetIndexes() {
+		root := idx.GetRootNode()
+		if root == nil || idx == rolodex.GetRootIndex() {
+			continue
+		}
+		if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+			root = root.Content[0]
+		}
+		if containsNode(root, node) {
+			return idx.GetSpecAbsolutePath(), root
+		}
+	}
+	return "", nil
+}
+
+func containsNode(root, node *yaml.Node) bool {
+	if root == node {
+		return true
+	}
+	for _, child := range root.Content {
+		if containsNode(child, node) {
+			return true
+		}
+	}
+	return false
+}
+
+// displayPath reports the local file at path relative to the directory of
+// the root spec file, the way the root file itself was named.
+func displayPath(file, path string) string {
+	if !filepath.IsAbs(path) {
+		return path
+	}
+	dir, err := filepath.Abs(filepath.Dir(file))
+	if err != nil {
+		return path
+	}
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return path
+	}
+	return filepath.Join(filepath.Dir(file), rel)
+}
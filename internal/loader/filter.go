@@ -0,0 +1,130 @@
+package loader
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/kolah/eugene/internal/model"
+)
+
+// FilterTags keeps the operations that carry one of the include tags (any
+// operation when include is empty) and none of the exclude tags.
+func FilterTags(spec *model.Spec, include, exclude []string) {
+	if len(include) == 0 && len(exclude) == 0 {
+		return
+	}
+	spec.Operations = slices.DeleteFunc(spec.Operations, func(op model.Operation) bool {
+		if len(include) > 0 && !slices.ContainsFunc(op.Tags, func(tag string) bool { return slices.Contains(include, tag) }) {
+			return true
+		}
+		return slices.ContainsFunc(op.Tags, func(tag string) bool { return slices.Contains(exclude, tag) })
+	})
+}
+
+// PruneSchemas drops component schemas that no operation or event refers
+// to, directly or through other schemas, and returns their names.
+func PruneSchemas(spec *model.Spec) []string {
+	byRef := make(map[string]int, len(spec.Schemas))
+	for i, s := range spec.Schemas {
+		byRef[componentSchemaPrefix+s.Name] = i
+		byRef[componentSchemaPrefix+escapePointer(s.Name)] = i
+	}
+
+	r := &reachability{byRef: byRef, schemas: spec.Schemas, reached: make(map[int]bool)}
+	for _, op := range spec.Operations {
+		for _, p := range op.Parameters {
+			r.visit(p.Schema)
+		}
+		r.visitBody(op.RequestBody)
+		r.visitResponses(op.Responses)
+		if op.Streaming != nil {
+			r.visit(op.Streaming.EventSchema)
+		}
+		for _, cb := range op.Callbacks {
+			for _, cbOp := range cb.Operations {
+				r.visitBody(cbOp.RequestBody)
+				r.visitResponses(cbOp.Responses)
+			}
+		}
+	}
+	for _, ev := range spec.Events {
+		for _, msg := range ev.Messages {
+			r.visit(msg.Payload)
+		}
+	}
+
+	var kept []model.Schema
+	var removed []string
+	for i, s := range spec.Schemas {
+		if r.reached[i] {
+			kept = append(kept, s)
+		} else {
+			removed = append(removed, s.Name)
+		}
+	}
+	spec.Schemas = kept
+	return removed
+}
+
+type reachability struct {
+	byRef   map[string]int
+	schemas []model.Schema
+	reached map[int]bool
+}
+
+func (r *reachability) visitBody(body *model.RequestBody) {
+	if body == nil {
+		return
+	}
+	for _, c := range body.Content {
+		r.visit(c.Schema)
+	}
+}
+
+func (r *reachability) visitResponses(responses []model.Response) {
+	for _, resp := range responses {
+		for _, c := range resp.Content {
+			r.visit(c.Schema)
+		}
+		for _, h := range resp.Headers {
+			r.visit(h.Schema)
+		}
+	}
+}
+
+func (r *reachability) visit(s *model.Schema) {
+	if s == nil {
+		return
+	}
+	if s.Ref != "" {
+		r.reach(s.Ref)
+	}
+	for _, p := range s.Properties {
+		r.visit(p.Schema)
+	}
+	r.visit(s.Items)
+	r.visit(s.AdditionalProperties)
+	for _, list := range [][]*model.Schema{s.AllOf, s.OneOf, s.AnyOf} {
+		for _, item := range list {
+			r.visit(item)
+		}
+	}
+	if s.Discriminator != nil {
+		for _, ref := range s.Discriminator.Mapping {
+			r.reach(ref)
+		}
+	}
+}
+
+// reach marks the component behind ref and walks it the first time.
+func (r *reachability) reach(ref string) {
+	if !strings.HasPrefix(ref, componentSchemaPrefix) {
+		return
+	}
+	i, ok := r.byRef[ref]
+	if !ok || r.reached[i] {
+		return
+	}
+	r.reached[i] = true
+	r.visit(&r.schemas[i])
+}
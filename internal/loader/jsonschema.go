@@ -0,0 +1,143 @@
+package loader
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kolah/eugene/internal/golang"
+	"github.com/pb33f/libopenapi/datamodel"
+	"go.yaml.in/yaml/v4"
+)
+
+// LoadSchemaFile reads a bare JSON Schema document and wraps it in an
+// OpenAPI 3.1 document so it goes through Transform like a spec. The root
+// schema becomes a component named after its title, or the file name when
+// it has none; $defs and definitions become sibling components. A root that
+// only holds definitions is not generated itself.
+func LoadSchemaFile(path string) (*Result, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading schema file: %w", err)
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("resolving absolute path: %w", err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parsing JSON Schema document: %w", err)
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("parsing JSON Schema document: expected an object")
+	}
+	root := doc.Content[0]
+
+	title := yamlScalar(root, "title")
+	name := golang.PascalCase(title)
+	if name == "" {
+		name = golang.PascalCase(schemaFileStem(path))
+	}
+
+	schemas := yamlMapping()
+	var body []*yaml.Node
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+		switch key.Value {
+		case "$defs", "definitions":
+			if value.Kind == yaml.MappingNode {
+				schemas.Content = append(schemas.Content, value.Content...)
+			}
+		case "$schema", "$id":
+			// Dialect and base URI are not carried into the OpenAPI wrapper
+		default:
+			body = append(body, key, value)
+		}
+	}
+	if hasSchemaKeywords(body) {
+		schemas.Content = append([]*yaml.Node{yamlString(name), yamlMapping(body...)}, schemas.Content...)
+	}
+	rewriteSchemaRefs(schemas, name)
+
+	wrapper := yamlMapping(
+		yamlString("openapi"), yamlString("3.1.0"),
+		yamlString("info"), yamlMapping(yamlString("title"), yamlString(firstNonEmpty(title, name)), yamlString("version"), yamlString("0.0.0")),
+		yamlString("paths"), yamlMapping(),
+		yamlString("components"), yamlMapping(yamlString("schemas"), schemas),
+	)
+	wrapped, err := yaml.Marshal(wrapper)
+	if err != nil {
+		return nil, fmt.Errorf("wrapping JSON Schema document: %w", err)
+	}
+
+	result, err := loadWithConfig(wrapped, &datamodel.DocumentConfiguration{
+		BasePath:            filepath.Dir(absPath),
+		AllowFileReferences: true,
+	}, "")
+	if err != nil {
+		return nil, err
+	}
+	result.Version = "JSON Schema"
+	// Lock hashes and the spec target refer to the document as written
+	result.RawData = data
+	return result, nil
+}
+
+// hasSchemaKeywords reports whether the root carries anything beyond
+// annotations, i.e. whether it describes a type of its own.
+func hasSchemaKeywords(content []*yaml.Node) bool {
+	for i := 0; i < len(content); i += 2 {
+		switch content[i].Value {
+		case "title", "description", "$comment":
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// rewriteSchemaRefs points local references at the wrapper's components:
+// "#" at the root component and "#/$defs/X" or "#/definitions/X" at X.
+func rewriteSchemaRefs(node *yaml.Node, root string) {
+	if node.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if key.Value != "$ref" || value.Kind != yaml.ScalarNode {
+				continue
+			}
+			switch ref := value.Value; {
+			case ref == "#" || ref == "#/":
+				value.Value = componentSchemaPrefix + root
+			case strings.HasPrefix(ref, "#/$defs/"):
+				value.Value = componentSchemaPrefix + strings.TrimPrefix(ref, "#/$defs/")
+			case strings.HasPrefix(ref, "#/definitions/"):
+				value.Value = componentSchemaPrefix + strings.TrimPrefix(ref, "#/definitions/")
+			case strings.HasPrefix(ref, "#/"):
+				value.Value = componentSchemaPrefix + root + ref[1:]
+			}
+		}
+	}
+	for _, child := range node.Content {
+		rewriteSchemaRefs(child, root)
+	}
+}
+
+// schemaFileStem strips the directory and the .schema.json style suffixes.
+func schemaFileStem(path string) string {
+	base := filepath.Base(path)
+	for _, ext := range []string{".json", ".yaml", ".yml"} {
+		base = strings.TrimSuffix(base, ext)
+	}
+	return strings.TrimSuffix(base, ".schema")
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -1,6 +1,9 @@
 package loader
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -9,6 +12,8 @@ import (
 	"github.com/pb33f/libopenapi"
 	"github.com/pb33f/libopenapi/datamodel"
 	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/overlay"
+	"go.yaml.in/yaml/v4"
 )
 
 type Result struct {
@@ -16,28 +21,117 @@ type Result struct {
 	Version  string
 	Warnings []string
 	RawData  []byte
+	Path     string // spec file or name the positions in RawData refer to, empty when generated
 }
 
-func LoadFile(path string) (*Result, error) {
+// LoadFile loads the spec at path after applying the OpenAPI Overlay
+// documents in overlays, in order. Overlay warnings, such as targets that
+// match nothing, are returned in Result.Warnings.
+func LoadFile(path string, overlays ...string) (*Result, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("reading spec file: %w", err)
 	}
-
 	absPath, err := filepath.Abs(path)
 	if err != nil {
 		return nil, fmt.Errorf("resolving absolute path: %w", err)
 	}
+	return load(data, path, filepath.Dir(absPath), overlays)
+}
+
+// LoadData loads a spec that was not read from a file, such as one piped
+// to stdin. Relative external references resolve against the working
+// directory, and errors are reported against name. A format of "json" or
+// "yaml" checks that data is written in it; "" accepts either.
+func LoadData(data []byte, name, format string, overlays ...string) (*Result, error) {
+	if err := checkFormat(data, format); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	dir, err := os.Getwd()
+	if err != nil {
+		return nil, fmt.Errorf("resolving working directory: %w", err)
+	}
+	return load(data, name, dir, overlays)
+}
+
+// checkFormat reports data that is not valid in format, with the line of
+// the problem where the parser gives one.
+func checkFormat(data []byte, format string) error {
+	switch format {
+	case "json":
+		var v any
+		if err := json.Unmarshal(data, &v); err != nil {
+			var syntaxErr *json.SyntaxError
+			if errors.As(err, &syntaxErr) {
+				line := 1 + bytes.Count(data[:syntaxErr.Offset], []byte("\n"))
+				return fmt.Errorf("invalid JSON at line %d: %w", line, err)
+			}
+			return fmt.Errorf("invalid JSON: %w", err)
+		}
+	case "yaml":
+		var doc yaml.Node
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return fmt.Errorf("invalid YAML: %w", err)
+		}
+	}
+	return nil
+}
+
+func load(data []byte, path, baseDir string, overlays []string) (*Result, error) {
+	var warnings []string
+	for _, ovPath := range overlays {
+		var ovWarnings []string
+		var err error
+		data, ovWarnings, err = applyOverlay(data, ovPath)
+		if err != nil {
+			return nil, err
+		}
+		warnings = append(warnings, ovWarnings...)
+	}
 
 	config := &datamodel.DocumentConfiguration{
-		BasePath:            filepath.Dir(absPath),
+		BasePath:            baseDir,
 		AllowFileReferences: true,
 	}
 
-	return loadWithConfig(data, config)
+	// Positions in a patched document do not match the file on disk
+	file := path
+	if len(overlays) > 0 {
+		file = ""
+	}
+	result, err := loadWithConfig(data, config, file)
+	if err != nil {
+		return nil, err
+	}
+	result.Path = file
+	result.Warnings = append(warnings, result.Warnings...)
+	return result, nil
+}
+
+// applyOverlay applies the overlay document at path to spec data.
+func applyOverlay(data []byte, path string) ([]byte, []string, error) {
+	ovData, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading overlay file: %w", err)
+	}
+	ov, err := libopenapi.NewOverlayDocument(ovData)
+	if err != nil {
+		return nil, nil, fmt.Errorf("parsing overlay %s: %w", path, err)
+	}
+	result, err := overlay.Apply(data, ov)
+	if err != nil {
+		return nil, nil, fmt.Errorf("applying overlay %s: %w", path, err)
+	}
+	var warnings []string
+	for _, w := range result.Warnings {
+		warnings = append(warnings, fmt.Sprintf("%s: %s", filepath.Base(path), w))
+	}
+	return result.Bytes, warnings, nil
 }
 
-func loadWithConfig(data []byte, config *datamodel.DocumentConfiguration) (*Result, error) {
+// loadWithConfig builds the model of data. Errors against nodes of the
+// document are located in file, when it is set.
+func loadWithConfig(data []byte, config *datamodel.DocumentConfiguration, file string) (*Result, error) {
 	var doc libopenapi.Document
 	var err error
 
@@ -57,6 +151,9 @@ func loadWithConfig(data []byte, config *datamodel.DocumentConfiguration) (*Resu
 
 	model, err := doc.BuildV3Model()
 	if err != nil {
+		if file != "" {
+			err = locateErrors(file, data, doc.GetRolodex(), err)
+		}
 		return nil, fmt.Errorf("building OpenAPI model: %w", err)
 	}
 
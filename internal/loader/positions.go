@@ -0,0 +1,101 @@
+package loader
+
+import (
+	"strconv"
+	"strings"
+
+	"go.yaml.in/yaml/v4"
+)
+
+// Positions maps JSON pointers into a YAML or JSON document to the line and
+// column they start at.
+type Positions struct {
+	root *yaml.Node
+}
+
+// NewPositions parses data for position lookups. A document that does not
+// parse yields Positions that find nothing.
+func NewPositions(data []byte) *Positions {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil || doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 {
+		return &Positions{}
+	}
+	return &Positions{root: doc.Content[0]}
+}
+
+// Find returns the 1-based line and column of the node at pointer, such as
+// "#/components/schemas/Pet". Mapping entries are located at their key. When
+// the pointer does not resolve, the closest ancestor that does is returned,
+// and 0, 0 when not even the document root exists.
+func (p *Positions) Find(pointer string) (line, column int) {
+	if p.root == nil {
+		return 0, 0
+	}
+	node, at := p.root, p.root
+	rest := strings.TrimPrefix(strings.TrimPrefix(pointer, "#"), "/")
+	if rest != "" {
+		for _, segment := range strings.Split(rest, "/") {
+			segment = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+			key, value := positionChild(node, segment)
+			if value == nil {
+				break
+			}
+			node, at = value, key
+		}
+	}
+	return at.Line, at.Column
+}
+
+// positionChild returns the node to report for segment under node, the key
+// of a mapping entry or the item of a sequence, and the value to descend into.
+func positionChild(node *yaml.Node, segment string) (at, value *yaml.Node) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == segment {
+				return node.Content[i], node.Content[i+1]
+			}
+		}
+	case yaml.SequenceNode:
+		if i, err := strconv.Atoi(segment); err == nil && i >= 0 && i < len(node.Content) {
+			return node.Content[i], node.Content[i]
+		}
+	}
+	return nil, nil
+}
+
+// Pointer returns the JSON pointer of the outermost node that starts at line
+// and column, or "" when no node does.
+func (p *Positions) Pointer(line, column int) string {
+	if p.root == nil {
+		return ""
+	}
+	pointer, _ := pointerAt(p.root, "#", line, column)
+	return pointer
+}
+
+func pointerAt(node *yaml.Node, pointer string, line, column int) (string, bool) {
+	if node.Line == line && node.Column == column {
+		return pointer, true
+	}
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			child := pointer + "/" + escapePointer(key.Value)
+			if key.Line == line && key.Column == column {
+				return child, true
+			}
+			if found, ok := pointerAt(value, child, line, column); ok {
+				return found, true
+			}
+		}
+	case yaml.SequenceNode:
+		for i, item := range node.Content {
+			if found, ok := pointerAt(item, pointer+"/"+strconv.Itoa(i), line, column); ok {
+				return found, true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,221 @@
+package loader
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/kolah/eugene/internal/model"
+)
+
+// SharedSchemas returns the component schemas that two or more specs declare
+// under the same name with the same structure, in the order they are first
+// declared. A schema is only shared when every schema it refers to is shared
+// too. It is not shared when an unshared schema or an operation uses it as a
+// oneOf or anyOf variant, since variants carry a method of their union.
+func SharedSchemas(specs []*model.Spec) []model.Schema {
+	first := make(map[string]*model.Schema)
+	count := make(map[string]int)
+	differs := make(map[string]bool)
+	var order []string
+	for _, spec := range specs {
+		for i := range spec.Schemas {
+			s := &spec.Schemas[i]
+			prev, ok := first[s.Name]
+			if !ok {
+				first[s.Name] = s
+				order = append(order, s.Name)
+			} else if !sameSchema(reflect.ValueOf(*prev), reflect.ValueOf(*s)) {
+				differs[s.Name] = true
+			}
+			count[s.Name]++
+		}
+	}
+
+	shared := make(map[string]bool)
+	for _, name := range order {
+		if count[name] > 1 && !differs[name] {
+			shared[name] = true
+		}
+	}
+
+	// Operations never change, so their variants are excluded once
+	for _, spec := range specs {
+		for _, op := range spec.Operations {
+			for _, s := range operationSchemas(op) {
+				for _, name := range variantNames(s) {
+					delete(shared, name)
+				}
+			}
+		}
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for _, name := range order {
+			if !shared[name] {
+				continue
+			}
+			for _, ref := range refNames(first[name]) {
+				if !shared[ref] {
+					delete(shared, name)
+					changed = true
+					break
+				}
+			}
+		}
+		for _, spec := range specs {
+			for i := range spec.Schemas {
+				if shared[spec.Schemas[i].Name] {
+					continue
+				}
+				for _, name := range variantNames(&spec.Schemas[i]) {
+					if shared[name] {
+						delete(shared, name)
+						changed = true
+					}
+				}
+			}
+		}
+	}
+
+	var result []model.Schema
+	for _, name := range order {
+		if shared[name] {
+			result = append(result, *first[name])
+		}
+	}
+	return result
+}
+
+// sameSchema reports whether two schema values are deeply equal, comparing
+// examples and defaults by their decoded values the way sameValue does, so a
+// schema declared on other lines of another version is still the same.
+func sameSchema(a, b reflect.Value) bool {
+	if a.Kind() != b.Kind() {
+		return false
+	}
+	switch a.Kind() {
+	case reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		return sameValue(a.Interface(), b.Interface())
+	case reflect.Pointer:
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		return sameSchema(a.Elem(), b.Elem())
+	case reflect.Slice:
+		if a.Len() != b.Len() {
+			return false
+		}
+		for i := range a.Len() {
+			if !sameSchema(a.Index(i), b.Index(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Struct:
+		for i := range a.NumField() {
+			if !sameSchema(a.Field(i), b.Field(i)) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(a.Interface(), b.Interface())
+	}
+}
+
+// RemoveSchemas drops the named component schemas from spec.
+func RemoveSchemas(spec *model.Spec, names []string) {
+	spec.Schemas = slices.DeleteFunc(spec.Schemas, func(s model.Schema) bool {
+		return slices.Contains(names, s.Name)
+	})
+}
+
+func operationSchemas(op model.Operation) []*model.Schema {
+	var schemas []*model.Schema
+	for _, p := range op.Parameters {
+		schemas = append(schemas, p.Schema)
+	}
+	if op.RequestBody != nil {
+		for _, c := range op.RequestBody.Content {
+			schemas = append(schemas, c.Schema)
+		}
+	}
+	for _, resp := range op.Responses {
+		for _, c := range resp.Content {
+			schemas = append(schemas, c.Schema)
+		}
+	}
+	if op.Streaming != nil {
+		schemas = append(schemas, op.Streaming.EventSchema)
+	}
+	return schemas
+}
+
+// refNames returns the component names s refers to anywhere inside it.
+func refNames(s *model.Schema) []string {
+	var names []string
+	walkSchema(s, func(s *model.Schema) {
+		if s.Ref != "" {
+			names = append(names, componentName(s.Ref))
+		}
+		if s.Discriminator != nil {
+			for _, ref := range s.Discriminator.Mapping {
+				names = append(names, componentName(ref))
+			}
+		}
+	})
+	return names
+}
+
+// variantNames returns the components used as oneOf or anyOf variants
+// anywhere inside s.
+func variantNames(s *model.Schema) []string {
+	var names []string
+	walkSchema(s, func(s *model.Schema) {
+		for _, list := range [][]*model.Schema{s.OneOf, s.AnyOf} {
+			for _, item := range list {
+				if item != nil && item.Ref != "" {
+					names = append(names, componentName(item.Ref))
+				}
+			}
+		}
+		if s.Discriminator != nil {
+			for _, ref := range s.Discriminator.Mapping {
+				names = append(names, componentName(ref))
+			}
+		}
+	})
+	return names
+}
+
+func walkSchema(s *model.Schema, fn func(*model.Schema)) {
+	if s == nil {
+		return
+	}
+	fn(s)
+	for _, p := range s.Properties {
+		walkSchema(p.Schema, fn)
+	}
+	walkSchema(s.Items, fn)
+	walkSchema(s.AdditionalProperties, fn)
+	for _, list := range [][]*model.Schema{s.AllOf, s.OneOf, s.AnyOf} {
+		for _, item := range list {
+			walkSchema(item, fn)
+		}
+	}
+}
+
+// componentName returns the schema name of a component ref, or the ref
+// itself when it points elsewhere and so never names a shared schema.
+func componentName(ref string) string {
+	name, ok := strings.CutPrefix(ref, componentSchemaPrefix)
+	if !ok {
+		return ref
+	}
+	return strings.ReplaceAll(strings.ReplaceAll(name, "~1", "/"), "~0", "~")
+}
@@ -1,7 +1,11 @@
 package loader
 
 import (
+	"reflect"
+	"slices"
+	"strconv"
 	"strings"
+	"unicode"
 
 	"github.com/kolah/eugene/internal/model"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
@@ -12,6 +16,11 @@ import (
 
 type transformer struct {
 	componentSchemas map[*base.Schema]string
+	localRefs        map[string]*model.Schema // transformed local $ref targets, see transformLocalRef
+	warnings         []model.Warning
+	location         string                      // JSON pointer of the construct being transformed
+	inRef            int                         // depth of local $ref targets being transformed
+	security         []*base.SecurityRequirement // document-level requirements
 }
 
 func Transform(result *Result) (*model.Spec, error) {
@@ -19,6 +28,8 @@ func Transform(result *Result) (*model.Spec, error) {
 
 	t := &transformer{
 		componentSchemas: make(map[*base.Schema]string),
+		localRefs:        make(map[string]*model.Schema),
+		security:         doc.Security,
 	}
 
 	if doc.Components != nil && doc.Components.Schemas != nil {
@@ -35,14 +46,18 @@ func Transform(result *Result) (*model.Spec, error) {
 
 	if doc.Components != nil && doc.Components.Schemas != nil {
 		for name, schemaProxy := range doc.Components.Schemas.FromOldest() {
+			restore := t.at("#/components/schemas/" + escapePointer(name))
 			schema := t.transformSchema(name, schemaProxy.Schema())
+			restore()
 			spec.Schemas = append(spec.Schemas, *schema)
 		}
 	}
 
 	if doc.Components != nil && doc.Components.Responses != nil {
 		for name, resp := range doc.Components.Responses.FromOldest() {
+			restore := t.at("#/components/responses/" + escapePointer(name))
 			schema := t.extractResponseSchema(name, resp)
+			restore()
 			if schema != nil && !schemaExists(spec.Schemas, schema.Name) {
 				spec.Schemas = append(spec.Schemas, *schema)
 			}
@@ -63,6 +78,9 @@ func Transform(result *Result) (*model.Spec, error) {
 		}
 	}
 
+	t.checkAsyncOperations(spec.Operations)
+	t.checkEnvelopes(spec.Schemas)
+	spec.Warnings = t.warnings
 	return spec, nil
 }
 
@@ -80,10 +98,21 @@ func transformInfo(info *base.Info) model.Info {
 func transformServers(servers []*v3.Server) []model.Server {
 	var result []model.Server
 	for _, s := range servers {
-		result = append(result, model.Server{
+		server := model.Server{
 			URL:         s.URL,
 			Description: s.Description,
-		})
+		}
+		if s.Variables != nil {
+			for name, v := range s.Variables.FromOldest() {
+				server.Variables = append(server.Variables, model.ServerVariable{
+					Name:        name,
+					Default:     v.Default,
+					Description: v.Description,
+					Enum:        v.Enum,
+				})
+			}
+		}
+		result = append(result, server)
 	}
 	return result
 }
@@ -126,7 +155,9 @@ func (t *transformer) transformPath(pathStr string, pathItem *v3.PathItem) (mode
 		if m.op == nil {
 			continue
 		}
+		restore := t.at("#/paths/" + escapePointer(pathStr) + "/" + strings.ToLower(string(m.method)))
 		operation := t.transformOperation(m.method, pathStr, m.op)
+		restore()
 		ops = append(ops, operation)
 		path.Operations = append(path.Operations, operation)
 	}
@@ -145,17 +176,38 @@ func (t *transformer) transformOperation(method model.Method, path string, op *v
 		Deprecated:  boolPtr(op.Deprecated),
 	}
 
-	for _, p := range op.Parameters {
+	operation.Batchable = operationBatchable(op.Extensions)
+	operation.Async = t.operationAsync(op.Extensions)
+	operation.LoadWeight = t.operationLoadWeight(op.Extensions)
+	operation.MaxBody = t.operationMaxBody(op.Extensions)
+	// x-oink-go-name only names operations without an operationId: the
+	// operationId is what x-oink-async, links and operation keys refer to
+	if operation.ID == "" {
+		if name := operationGoName(op.Extensions); name != "" {
+			operation.ID = name
+		} else {
+			operation.ID = SynthesizeOperationID(method, path)
+			t.warn(model.WarningOperationID, "missing operationId; using %s", operation.ID)
+		}
+	}
+
+	for i, p := range op.Parameters {
+		restore := t.descend("parameters", strconv.Itoa(i))
 		operation.Parameters = append(operation.Parameters, t.transformParameter(p))
+		restore()
 	}
 
 	if op.RequestBody != nil {
+		restore := t.descend("requestBody")
 		operation.RequestBody = t.transformRequestBody(op.RequestBody)
+		restore()
 	}
 
 	if op.Responses != nil && op.Responses.Codes != nil {
 		for code, resp := range op.Responses.Codes.FromOldest() {
+			restore := t.descend("responses", code)
 			response := t.transformResponse(code, resp)
+			restore()
 			operation.Responses = append(operation.Responses, response)
 
 			// Detect SSE/streaming responses
@@ -178,8 +230,19 @@ func (t *transformer) transformOperation(method model.Method, path string, op *v
 			}
 		}
 	}
+	if op.Responses != nil && op.Responses.Default != nil {
+		restore := t.descend("responses", "default")
+		operation.Responses = append(operation.Responses, t.transformResponse("default", op.Responses.Default))
+		restore()
+	}
 
-	for _, secReq := range op.Security {
+	// Operations without a security list inherit the document's; an empty
+	// list (security: []) opts out.
+	requirements := op.Security
+	if requirements == nil {
+		requirements = t.security
+	}
+	for _, secReq := range requirements {
 		for name, scopes := range secReq.Requirements.FromOldest() {
 			operation.Security = append(operation.Security, model.SecurityRequirement{
 				Name:   name,
@@ -193,6 +256,230 @@ func (t *transformer) transformOperation(method model.Method, path string, op *v
 	return operation
 }
 
+// SynthesizeOperationID builds an operation ID from the method and path for
+// operations that have none. The method is title-cased, then every path
+// segment is split on non-alphanumeric characters and each word is
+// capitalized, so GET /pets/{petId} becomes GetPetsPetId and
+// POST /users/{user_id}/avatar.png becomes PostUsersUserIdAvatarPng.
+// The root path yields GetRoot.
+func SynthesizeOperationID(method model.Method, path string) string {
+	var b strings.Builder
+	m := strings.ToLower(string(method))
+	b.WriteString(strings.ToUpper(m[:1]) + m[1:])
+
+	words := strings.FieldsFunc(path, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		words = []string{"root"}
+	}
+	for _, w := range words {
+		r := []rune(w)
+		r[0] = unicode.ToUpper(r[0])
+		b.WriteString(string(r))
+	}
+	return b.String()
+}
+
+// operationGoName returns the x-oink-go-name of an operation, which names it
+// when the spec gives no operationId.
+func operationGoName(extensions *orderedmap.Map[string, *yaml.Node]) string {
+	if extensions == nil {
+		return ""
+	}
+	if node, ok := extensions.Get("x-oink-go-name"); ok && node.Kind == yaml.ScalarNode {
+		return node.Value
+	}
+	return ""
+}
+
+// operationBatchable reports whether an operation is marked x-oink-batchable.
+func operationBatchable(extensions *orderedmap.Map[string, *yaml.Node]) bool {
+	if extensions == nil {
+		return false
+	}
+	node, ok := extensions.Get("x-oink-batchable")
+	return ok && node.Kind == yaml.ScalarNode && node.Value == "true"
+}
+
+// operationAsync reads the x-oink-async extension of an operation:
+//
+//	x-oink-async:
+//	  status-operation: getJob
+//	  status-field: state
+//	  success: [succeeded]
+//	  failure: [failed, cancelled]
+func (t *transformer) operationAsync(extensions *orderedmap.Map[string, *yaml.Node]) *model.AsyncConfig {
+	if extensions == nil {
+		return nil
+	}
+	node, ok := extensions.Get("x-oink-async")
+	if !ok {
+		return nil
+	}
+	var ext struct {
+		StatusOperation string   `yaml:"status-operation"`
+		StatusField     string   `yaml:"status-field"`
+		Success         []string `yaml:"success"`
+		Failure         []string `yaml:"failure"`
+		LocationHeader  string   `yaml:"location-header"`
+	}
+	if err := node.Decode(&ext); err != nil {
+		t.warn(model.WarningExtension, "x-oink-async is ignored: %v", err)
+		return nil
+	}
+	if ext.StatusOperation == "" || len(ext.Success) == 0 {
+		t.warn(model.WarningExtension, "x-oink-async is ignored: status-operation and success are required")
+		return nil
+	}
+	cfg := &model.AsyncConfig{
+		StatusOperation: ext.StatusOperation,
+		StatusField:     ext.StatusField,
+		Success:         ext.Success,
+		Failure:         ext.Failure,
+		LocationHeader:  ext.LocationHeader,
+	}
+	if cfg.StatusField == "" {
+		cfg.StatusField = "status"
+	}
+	if cfg.LocationHeader == "" {
+		cfg.LocationHeader = "Location"
+	}
+	return cfg
+}
+
+// operationLoadWeight reads the x-oink-load-weight extension of an
+// operation, a non-negative integer.
+func (t *transformer) operationLoadWeight(extensions *orderedmap.Map[string, *yaml.Node]) *int {
+	if extensions == nil {
+		return nil
+	}
+	node, ok := extensions.Get("x-oink-load-weight")
+	if !ok {
+		return nil
+	}
+	var weight int
+	if err := node.Decode(&weight); err != nil || weight < 0 {
+		t.warn(model.WarningExtension, "x-oink-load-weight is ignored: must be a non-negative integer")
+		return nil
+	}
+	return &weight
+}
+
+// operationMaxBody reads the x-oink-max-body extension of an operation, a
+// non-negative number of bytes.
+func (t *transformer) operationMaxBody(extensions *orderedmap.Map[string, *yaml.Node]) *int64 {
+	if extensions == nil {
+		return nil
+	}
+	node, ok := extensions.Get("x-oink-max-body")
+	if !ok {
+		return nil
+	}
+	var limit int64
+	if err := node.Decode(&limit); err != nil || limit < 0 {
+		t.warn(model.WarningExtension, "x-oink-max-body is ignored: must be a non-negative number of bytes")
+		return nil
+	}
+	return &limit
+}
+
+// checkAsyncOperations drops x-oink-async declarations whose status operation
+// does not exist.
+func (t *transformer) checkAsyncOperations(ops []model.Operation) {
+	ids := make(map[string]bool)
+	for _, op := range ops {
+		ids[op.ID] = true
+	}
+	for i := range ops {
+		if async := ops[i].Async; async != nil && !ids[async.StatusOperation] {
+			restore := t.at("#/paths/" + escapePointer(ops[i].Path) + "/" + strings.ToLower(string(ops[i].Method)))
+			t.warn(model.WarningExtension, "x-oink-async is ignored: unknown status-operation %q", async.StatusOperation)
+			restore()
+			ops[i].Async = nil
+		}
+	}
+}
+
+// checkEnvelopes drops x-oink-envelope from component schemas without the
+// payload property or whose other properties differ from those of the first
+// envelope, since all envelopes share one generic type.
+func (t *transformer) checkEnvelopes(schemas []model.Schema) {
+	var first *model.Schema
+	for i := range schemas {
+		s := &schemas[i]
+		if s.Extensions == nil || s.Extensions.Envelope == "" {
+			continue
+		}
+		restore := t.at("#/components/schemas/" + escapePointer(s.Name))
+		switch {
+		case !slices.ContainsFunc(s.Properties, func(p model.Property) bool { return p.Name == s.Extensions.Envelope }):
+			t.warn(model.WarningExtension, "x-oink-envelope is ignored: no property %q", s.Extensions.Envelope)
+			s.Extensions.Envelope = ""
+		case first == nil:
+			first = s
+		case !reflect.DeepEqual(envelopeShape(first), envelopeShape(s)):
+			t.warn(model.WarningExtension, "x-oink-envelope is ignored: properties differ from envelope %s", first.Name)
+			s.Extensions.Envelope = ""
+		}
+		restore()
+	}
+}
+
+// envelopeShape returns what envelopes must agree on: the properties other
+// than the payload, and which properties are required.
+func envelopeShape(s *model.Schema) map[string]any {
+	type field struct {
+		Ref      string
+		Schema   *model.Schema
+		Required bool
+	}
+	shape := make(map[string]any, len(s.Properties))
+	for _, p := range s.Properties {
+		f := field{Required: slices.Contains(s.Required, p.Name)}
+		switch {
+		case p.Name == s.Extensions.Envelope:
+		case p.Schema != nil && p.Schema.Ref != "":
+			f.Ref = p.Schema.Ref
+		default:
+			f.Schema = withoutOrigins(p.Schema)
+		}
+		shape[p.Name] = f
+	}
+	return shape
+}
+
+// withoutOrigins returns a copy of s with the origins of its properties
+// cleared, so schemas of the same shape compare equal wherever they are
+// declared.
+func withoutOrigins(s *model.Schema) *model.Schema {
+	if s == nil {
+		return nil
+	}
+	c := *s
+	c.Properties = make([]model.Property, len(s.Properties))
+	for i, p := range s.Properties {
+		c.Properties[i] = model.Property{Name: p.Name, Schema: withoutOrigins(p.Schema)}
+	}
+	c.Items = withoutOrigins(s.Items)
+	c.AdditionalProperties = withoutOrigins(s.AdditionalProperties)
+	c.AllOf = withoutOriginsAll(s.AllOf)
+	c.OneOf = withoutOriginsAll(s.OneOf)
+	c.AnyOf = withoutOriginsAll(s.AnyOf)
+	return &c
+}
+
+func withoutOriginsAll(schemas []*model.Schema) []*model.Schema {
+	if schemas == nil {
+		return nil
+	}
+	out := make([]*model.Schema, len(schemas))
+	for i, s := range schemas {
+		out[i] = withoutOrigins(s)
+	}
+	return out
+}
+
 func (t *transformer) transformCallbacks(callbacks *orderedmap.Map[string, *v3.Callback]) []model.Callback {
 	if callbacks == nil {
 		return nil
@@ -201,8 +488,10 @@ func (t *transformer) transformCallbacks(callbacks *orderedmap.Map[string, *v3.C
 	for name, cb := range callbacks.FromOldest() {
 		callback := model.Callback{Name: name}
 		for expr, pathItem := range cb.Expression.FromOldest() {
+			restore := t.descend("callbacks", name, expr)
 			callback.Expression = expr
 			callback.Operations = append(callback.Operations, t.transformCallbackOperations(pathItem)...)
+			restore()
 		}
 		result = append(result, callback)
 	}
@@ -228,15 +517,26 @@ func (t *transformer) transformCallbackOperations(pathItem *v3.PathItem) []model
 		if m.op == nil {
 			continue
 		}
+		restore := t.descend(strings.ToLower(string(m.method)))
 		cbOp := model.CallbackOperation{Method: m.method}
 		if m.op.RequestBody != nil {
+			restoreBody := t.descend("requestBody")
 			cbOp.RequestBody = t.transformRequestBody(m.op.RequestBody)
+			restoreBody()
 		}
 		if m.op.Responses != nil && m.op.Responses.Codes != nil {
 			for code, resp := range m.op.Responses.Codes.FromOldest() {
+				restoreResp := t.descend("responses", code)
 				cbOp.Responses = append(cbOp.Responses, t.transformResponse(code, resp))
+				restoreResp()
 			}
 		}
+		if m.op.Responses != nil && m.op.Responses.Default != nil {
+			restoreResp := t.descend("responses", "default")
+			cbOp.Responses = append(cbOp.Responses, t.transformResponse("default", m.op.Responses.Default))
+			restoreResp()
+		}
+		restore()
 		ops = append(ops, cbOp)
 	}
 	return ops
@@ -250,9 +550,19 @@ func (t *transformer) transformParameter(p *v3.Parameter) model.Parameter {
 		Required:    boolPtr(p.Required),
 		Deprecated:  p.Deprecated,
 	}
+	if param.In == model.LocationQuery {
+		param.AllowReserved = p.AllowReserved
+		param.AllowEmptyValue = p.AllowEmptyValue
+	}
+	if example := exampleNode(p.Example, p.Examples); example != nil {
+		param.Example = example
+	}
 
 	if p.Schema != nil {
+		restore := t.descend("schema")
 		param.Schema = t.transformSchemaProxy(p.Schema)
+		t.checkOperationSchema(param.Schema)
+		restore()
 	} else if p.Content != nil {
 		// OpenAPI 3.2: querystring parameters use content instead of schema
 		for _, content := range p.Content.FromOldest() {
@@ -274,17 +584,77 @@ func (t *transformer) transformRequestBody(rb *v3.RequestBody) *model.RequestBod
 
 	if rb.Content != nil {
 		for mediaType, content := range rb.Content.FromOldest() {
+			restore := t.descend("content", mediaType, "schema")
 			mtc := model.MediaTypeContent{MediaType: mediaType}
 			if content.Schema != nil {
 				mtc.Schema = t.transformSchemaProxy(content.Schema)
+				t.checkOperationSchema(mtc.Schema)
+			}
+			mtc.Encoding = t.transformEncoding(content.Encoding)
+			if example := exampleNode(content.Example, content.Examples); example != nil {
+				mtc.Example = example
 			}
+			restore()
 			body.Content = append(body.Content, mtc)
 		}
+		t.checkMediaTypes(body.Content)
 	}
 
 	return body
 }
 
+// exampleNode returns the inline example, or else the value of the first
+// named example. External examples are not fetched.
+func exampleNode(example *yaml.Node, examples *orderedmap.Map[string, *base.Example]) *yaml.Node {
+	if example != nil {
+		return example
+	}
+	if examples == nil {
+		return nil
+	}
+	for _, ex := range examples.FromOldest() {
+		if ex == nil {
+			continue
+		}
+		if ex.DataValue != nil {
+			return ex.DataValue
+		}
+		if ex.Value != nil {
+			return ex.Value
+		}
+	}
+	return nil
+}
+
+func (t *transformer) transformEncoding(encoding *orderedmap.Map[string, *v3.Encoding]) map[string]*model.Encoding {
+	if encoding == nil || encoding.Len() == 0 {
+		return nil
+	}
+	result := make(map[string]*model.Encoding)
+	for prop, enc := range encoding.FromOldest() {
+		e := &model.Encoding{
+			ContentType: enc.ContentType,
+			Style:       enc.Style,
+			Explode:     enc.Explode,
+		}
+		if enc.Headers != nil {
+			for name, header := range enc.Headers.FromOldest() {
+				h := model.Header{
+					Name:        name,
+					Description: header.Description,
+					Required:    header.Required,
+				}
+				if header.Schema != nil {
+					h.Schema = t.transformSchemaProxy(header.Schema)
+				}
+				e.Headers = append(e.Headers, h)
+			}
+		}
+		result[prop] = e
+	}
+	return result
+}
+
 func (t *transformer) transformResponse(code string, resp *v3.Response) model.Response {
 	response := model.Response{
 		StatusCode:  code,
@@ -293,12 +663,19 @@ func (t *transformer) transformResponse(code string, resp *v3.Response) model.Re
 
 	if resp.Content != nil {
 		for mediaType, content := range resp.Content.FromOldest() {
+			restore := t.descend("content", mediaType, "schema")
 			mtc := model.MediaTypeContent{MediaType: mediaType}
 			if content.Schema != nil {
 				mtc.Schema = t.transformSchemaProxy(content.Schema)
+				t.checkOperationSchema(mtc.Schema)
+			}
+			if example := exampleNode(content.Example, content.Examples); example != nil {
+				mtc.Example = example
 			}
+			restore()
 			response.Content = append(response.Content, mtc)
 		}
+		t.checkMediaTypes(response.Content)
 	}
 
 	if resp.Headers != nil {
@@ -315,9 +692,34 @@ func (t *transformer) transformResponse(code string, resp *v3.Response) model.Re
 		}
 	}
 
+	if resp.Links != nil {
+		for name, link := range resp.Links.FromOldest() {
+			response.Links = append(response.Links, transformLink(name, link))
+		}
+	}
+
 	return response
 }
 
+func transformLink(name string, link *v3.Link) model.Link {
+	l := model.Link{
+		Name:         name,
+		OperationID:  link.OperationId,
+		OperationRef: link.OperationRef,
+		Description:  link.Description,
+		RequestBody:  link.RequestBody,
+	}
+	if link.Parameters != nil {
+		for param, expr := range link.Parameters.FromOldest() {
+			l.Parameters = append(l.Parameters, model.LinkParameter{
+				Name:       param,
+				Expression: expr,
+			})
+		}
+	}
+	return l
+}
+
 func (t *transformer) transformSchemaProxy(proxy *base.SchemaProxy) *model.Schema {
 	if proxy == nil {
 		return nil
@@ -330,13 +732,100 @@ func (t *transformer) transformSchemaProxy(proxy *base.SchemaProxy) *model.Schem
 		}
 	}
 
-	schema := t.transformSchema("", proxy.Schema())
+	var schema *model.Schema
+	if strings.HasPrefix(ref, "#/") {
+		schema = t.transformLocalRef(ref, proxy)
+	} else {
+		schema = t.transformSchema("", proxy.Schema())
+	}
 	if schema != nil && ref != "" {
 		schema.Ref = ref
+		schema.Extensions = mergeExtensions(schema.Extensions, parseRefSiblingExtensions(proxy.GetReferenceNode()))
 	}
 	return schema
 }
 
+// transformLocalRef transforms the target of a local $ref once and returns
+// a shallow copy per use, so large specs don't rebuild a schema for every
+// reference to it. A reference back to a schema that is still being
+// transformed is left unexpanded.
+func (t *transformer) transformLocalRef(ref string, proxy *base.SchemaProxy) *model.Schema {
+	target, ok := t.localRefs[ref]
+	if !ok {
+		t.localRefs[ref] = &model.Schema{Ref: ref}
+		t.inRef++
+		restore := t.at(ref)
+		target = t.transformSchema("", proxy.Schema())
+		restore()
+		t.inRef--
+		t.localRefs[ref] = target
+	}
+	if target == nil {
+		return nil
+	}
+	schema := *target
+	return &schema
+}
+
+// parseRefSiblingExtensions reads x-oink-* keys placed next to a $ref,
+// which OpenAPI 3.1 allows but libopenapi drops when resolving the target.
+func parseRefSiblingExtensions(node *yaml.Node) *model.SchemaExtensions {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+	siblings := orderedmap.New[string, *yaml.Node]()
+	for i := 0; i < len(node.Content)-1; i += 2 {
+		siblings.Set(node.Content[i].Value, node.Content[i+1])
+	}
+	return parseExtensions(siblings)
+}
+
+// mergeExtensions overlays the extensions set next to a $ref onto those of
+// the referenced schema.
+func mergeExtensions(base, overlay *model.SchemaExtensions) *model.SchemaExtensions {
+	if overlay == nil {
+		return base
+	}
+	if base == nil {
+		return overlay
+	}
+	merged := *base
+	if overlay.GoType != "" {
+		merged.GoType = overlay.GoType
+	}
+	if overlay.GoTypeImport != nil {
+		merged.GoTypeImport = overlay.GoTypeImport
+	}
+	if overlay.GoName != "" {
+		merged.GoName = overlay.GoName
+	}
+	if overlay.JSONName != "" {
+		merged.JSONName = overlay.JSONName
+	}
+	if overlay.ExtraTags != nil {
+		merged.ExtraTags = overlay.ExtraTags
+	}
+	if overlay.OmitEmpty != nil {
+		merged.OmitEmpty = overlay.OmitEmpty
+	}
+	if overlay.OmitZero != nil {
+		merged.OmitZero = overlay.OmitZero
+	}
+	if overlay.Marshal != "" {
+		merged.Marshal = overlay.Marshal
+	}
+	merged.JSONIgnore = merged.JSONIgnore || overlay.JSONIgnore
+	merged.Embed = merged.Embed || overlay.Embed
+	merged.Raw = merged.Raw || overlay.Raw
+	return &merged
+}
+
+// schemaTypes are the values the type keyword accepts.
+var schemaTypes = []model.SchemaType{
+	model.TypeString, model.TypeNumber, model.TypeInteger, model.TypeBoolean,
+	model.TypeArray, model.TypeObject, model.TypeNull,
+}
+
 func (t *transformer) transformSchema(name string, s *base.Schema) *model.Schema {
 	if s == nil {
 		return nil
@@ -357,6 +846,15 @@ func (t *transformer) transformSchema(name string, s *base.Schema) *model.Schema
 	if len(s.Type) > 0 {
 		schema.Type = model.SchemaType(s.Type[0])
 	}
+	for _, typ := range s.Type {
+		if !slices.Contains(schemaTypes, model.SchemaType(typ)) {
+			t.warn(model.WarningInvalidType, "invalid type %q; generated as any", typ)
+			if schema.Type == model.SchemaType(typ) {
+				schema.Type = ""
+			}
+		}
+	}
+	t.checkSchemaKeywords(s)
 
 	if s.Enum != nil {
 		for _, e := range s.Enum {
@@ -366,13 +864,16 @@ func (t *transformer) transformSchema(name string, s *base.Schema) *model.Schema
 
 	if s.Properties != nil {
 		for propName, propProxy := range s.Properties.FromOldest() {
+			restore := t.descend("properties", propName)
 			propSchema := t.transformSchemaProxy(propProxy)
+			restore()
 			if propSchema != nil && propSchema.Name == "" {
 				propSchema.Name = propName
 			}
 			prop := model.Property{
 				Name:   propName,
 				Schema: propSchema,
+				Origin: t.location,
 			}
 			schema.Properties = append(schema.Properties, prop)
 		}
@@ -381,21 +882,31 @@ func (t *transformer) transformSchema(name string, s *base.Schema) *model.Schema
 	schema.Required = s.Required
 
 	if s.Items != nil && s.Items.A != nil {
+		restore := t.descend("items")
 		schema.Items = t.transformSchemaProxy(s.Items.A)
+		restore()
 	}
 
 	if s.AdditionalProperties != nil && s.AdditionalProperties.A != nil {
+		restore := t.descend("additionalProperties")
 		schema.AdditionalProperties = t.transformSchemaProxy(s.AdditionalProperties.A)
+		restore()
 	}
 
-	for _, proxy := range s.AllOf {
+	for i, proxy := range s.AllOf {
+		restore := t.descend("allOf", strconv.Itoa(i))
 		schema.AllOf = append(schema.AllOf, t.transformSchemaProxy(proxy))
+		restore()
 	}
-	for _, proxy := range s.OneOf {
+	for i, proxy := range s.OneOf {
+		restore := t.descend("oneOf", strconv.Itoa(i))
 		schema.OneOf = append(schema.OneOf, t.transformSchemaProxy(proxy))
+		restore()
 	}
-	for _, proxy := range s.AnyOf {
+	for i, proxy := range s.AnyOf {
+		restore := t.descend("anyOf", strconv.Itoa(i))
 		schema.AnyOf = append(schema.AnyOf, t.transformSchemaProxy(proxy))
+		restore()
 	}
 
 	if s.Discriminator != nil {
@@ -452,6 +963,44 @@ func (t *transformer) transformSchema(name string, s *base.Schema) *model.Schema
 
 	// Parse x-oink-* extensions
 	schema.Extensions = parseExtensions(s.Extensions)
+	if ext := schema.Extensions; ext != nil && ext.Bitmask && (schema.Type != model.TypeInteger || len(schema.Enum) == 0) {
+		t.warn(model.WarningExtension, "x-oink-bitmask applies to integer enums only and is ignored")
+		ext.Bitmask = false
+	}
+	if ext := schema.Extensions; ext != nil && ext.MapKey != "" {
+		switch {
+		case schema.AdditionalProperties == nil:
+			t.warn(model.WarningExtension, "x-oink-map-key applies to additionalProperties maps only and is ignored")
+			ext.MapKey = ""
+		case ext.MapKey != "int" && ext.MapKey != "int32" && ext.MapKey != "int64" && ext.MapKey != "uuid":
+			t.warn(model.WarningExtension, "x-oink-map-key %q is ignored (valid: int, int32, int64, uuid)", ext.MapKey)
+			ext.MapKey = ""
+		}
+	}
+	if ext := schema.Extensions; ext != nil && ext.Raw && ext.GoType != "" {
+		t.warn(model.WarningExtension, "x-oink-raw is ignored: x-oink-go-type is set")
+		ext.Raw = false
+	}
+	if ext := schema.Extensions; ext != nil && ext.AllOfConflict != "" {
+		switch {
+		case len(schema.AllOf) == 0:
+			t.warn(model.WarningExtension, "x-oink-allof-conflict applies to allOf compositions only and is ignored")
+			ext.AllOfConflict = ""
+		case ext.AllOfConflict != "first" && ext.AllOfConflict != "last":
+			t.warn(model.WarningExtension, "x-oink-allof-conflict %q is ignored (valid: first, last)", ext.AllOfConflict)
+			ext.AllOfConflict = ""
+		}
+	}
+	if ext := schema.Extensions; ext != nil && ext.Ordered {
+		switch {
+		case schema.Type != model.TypeObject:
+			t.warn(model.WarningExtension, "x-oink-ordered applies to objects only and is ignored")
+			ext.Ordered = false
+		case ext.MapKey != "":
+			t.warn(model.WarningExtension, "x-oink-map-key is ignored: x-oink-ordered maps have string keys")
+			ext.MapKey = ""
+		}
+	}
 
 	return schema
 }
@@ -467,7 +1016,7 @@ func parseExtensions(extensions *orderedmap.Map[string, *yaml.Node]) *model.Sche
 		key := pair.Key()
 		node := pair.Value()
 
-		if !strings.HasPrefix(key, "x-oink-") {
+		if !strings.HasPrefix(key, "x-oink-") && key != "x-enum-varnames" {
 			continue
 		}
 
@@ -486,6 +1035,10 @@ func parseExtensions(extensions *orderedmap.Map[string, *yaml.Node]) *model.Sche
 			if node.Kind == yaml.ScalarNode {
 				ext.GoName = node.Value
 			}
+		case "x-oink-go-json-name":
+			if node.Kind == yaml.ScalarNode {
+				ext.JSONName = node.Value
+			}
 		case "x-oink-extra-tags":
 			ext.ExtraTags = parseExtraTags(node)
 		case "x-oink-omitempty":
@@ -502,6 +1055,50 @@ func parseExtensions(extensions *orderedmap.Map[string, *yaml.Node]) *model.Sche
 			if node.Kind == yaml.ScalarNode {
 				ext.JSONIgnore = node.Value == "true"
 			}
+		case "x-oink-marshal":
+			if node.Kind == yaml.ScalarNode {
+				ext.Marshal = node.Value
+			}
+		case "x-oink-embed":
+			if node.Kind == yaml.ScalarNode {
+				ext.Embed = node.Value == "true"
+			}
+		case "x-oink-map-key":
+			if node.Kind == yaml.ScalarNode {
+				ext.MapKey = node.Value
+			}
+		case "x-oink-envelope":
+			if node.Kind == yaml.ScalarNode {
+				switch node.Value {
+				case "true":
+					ext.Envelope = "data"
+				case "false":
+				default:
+					ext.Envelope = node.Value
+				}
+			}
+		case "x-oink-raw":
+			if node.Kind == yaml.ScalarNode {
+				ext.Raw = node.Value == "true"
+			}
+		case "x-oink-ordered":
+			if node.Kind == yaml.ScalarNode {
+				ext.Ordered = node.Value == "true"
+			}
+		case "x-oink-bitmask":
+			if node.Kind == yaml.ScalarNode {
+				ext.Bitmask = node.Value == "true"
+			}
+		case "x-oink-allof-conflict":
+			if node.Kind == yaml.ScalarNode {
+				ext.AllOfConflict = node.Value
+			}
+		case "x-enum-varnames":
+			if node.Kind == yaml.SequenceNode {
+				for _, item := range node.Content {
+					ext.EnumVarNames = append(ext.EnumVarNames, item.Value)
+				}
+			}
 		}
 	}
 
@@ -553,6 +1150,7 @@ func transformSecurityScheme(name string, scheme *v3.SecurityScheme) model.Secur
 		Type:         model.SecuritySchemeType(scheme.Type),
 		Description:  scheme.Description,
 		In:           scheme.In,
+		ParamName:    scheme.Name,
 		Scheme:       scheme.Scheme,
 		BearerFormat: scheme.BearerFormat,
 	}
@@ -571,11 +1169,41 @@ func transformSecurityScheme(name string, scheme *v3.SecurityScheme) model.Secur
 		if scheme.Flows.AuthorizationCode != nil {
 			ss.Flows.AuthorizationCode = transformOAuthFlow(scheme.Flows.AuthorizationCode)
 		}
+		ss.Flows.DeviceCode = deviceAuthorizationFlow(scheme.Flows)
 	}
 
 	return ss
 }
 
+// deviceAuthorizationFlow reads the OpenAPI 3.2 deviceAuthorization flow,
+// which libopenapi does not model, from the raw flows node. The "device" key
+// libopenapi reads instead is accepted too.
+func deviceAuthorizationFlow(flows *v3.OAuthFlows) *model.OAuthFlow {
+	var node *yaml.Node
+	if low := flows.GoLow(); low != nil {
+		node = yamlGet(low.RootNode, "deviceAuthorization")
+	}
+	if node == nil && flows.Device != nil && flows.Device.GoLow() != nil {
+		node = flows.Device.GoLow().RootNode
+	}
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	f := &model.OAuthFlow{
+		DeviceAuthURL: yamlScalar(node, "deviceAuthorizationUrl"),
+		TokenURL:      yamlScalar(node, "tokenUrl"),
+		RefreshURL:    yamlScalar(node, "refreshUrl"),
+		Scopes:        make(map[string]string),
+	}
+	if scopes := yamlGet(node, "scopes"); scopes != nil && scopes.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(scopes.Content); i += 2 {
+			f.Scopes[scopes.Content[i].Value] = scopes.Content[i+1].Value
+		}
+	}
+	return f
+}
+
 func transformOAuthFlow(flow *v3.OAuthFlow) *model.OAuthFlow {
 	f := &model.OAuthFlow{
 		AuthorizationURL: flow.AuthorizationUrl,
@@ -0,0 +1,124 @@
+package loader
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kolah/eugene/internal/model"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+)
+
+// warn records a construct that will degrade in generated code. Warnings are
+// not recorded while transforming a local $ref target, since the target is
+// reported at its own location.
+func (t *transformer) warn(kind model.WarningKind, format string, args ...any) {
+	if t.inRef > 0 {
+		return
+	}
+	t.warnings = append(t.warnings, model.Warning{
+		Kind:     kind,
+		Location: t.location,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// at moves the current location to loc and returns a func restoring it.
+func (t *transformer) at(loc string) func() {
+	prev := t.location
+	t.location = loc
+	return func() { t.location = prev }
+}
+
+// descend appends JSON pointer segments to the current location and returns
+// a func restoring it.
+func (t *transformer) descend(segments ...string) func() {
+	loc := t.location
+	for _, s := range segments {
+		loc += "/" + escapePointer(s)
+	}
+	return t.at(loc)
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+func escapePointer(s string) string {
+	return pointerEscaper.Replace(s)
+}
+
+// checkSchemaKeywords warns about JSON Schema keywords the generator ignores.
+func (t *transformer) checkSchemaKeywords(s *base.Schema) {
+	var ignored []string
+	if s.Not != nil {
+		ignored = append(ignored, "not")
+	}
+	if s.If != nil || s.Then != nil || s.Else != nil {
+		ignored = append(ignored, "if/then/else")
+	}
+	if s.PatternProperties != nil && s.PatternProperties.Len() > 0 {
+		ignored = append(ignored, "patternProperties")
+	}
+	if s.DependentSchemas != nil && s.DependentSchemas.Len() > 0 {
+		ignored = append(ignored, "dependentSchemas")
+	}
+	if len(s.PrefixItems) > 0 {
+		ignored = append(ignored, "prefixItems")
+	}
+	if s.Contains != nil {
+		ignored = append(ignored, "contains")
+	}
+	if s.PropertyNames != nil {
+		ignored = append(ignored, "propertyNames")
+	}
+	if s.UnevaluatedItems != nil {
+		ignored = append(ignored, "unevaluatedItems")
+	}
+	if s.UnevaluatedProperties != nil {
+		ignored = append(ignored, "unevaluatedProperties")
+	}
+	if s.Const != nil {
+		ignored = append(ignored, "const")
+	}
+	for _, kw := range ignored {
+		t.warn(model.WarningIgnoredKeyword, "%s is ignored", kw)
+	}
+
+	var types []string
+	for _, typ := range s.Type {
+		if typ != "null" {
+			types = append(types, typ)
+		}
+	}
+	if len(types) > 1 {
+		t.warn(model.WarningIgnoredKeyword, "type %v uses only %s", types, s.Type[0])
+	}
+}
+
+// checkOperationSchema warns when an inline operation schema is a
+// composition, which operation signatures render as any.
+func (t *transformer) checkOperationSchema(s *model.Schema) {
+	if s == nil || s.Ref != "" {
+		return
+	}
+	switch {
+	case len(s.OneOf) > 0:
+		t.warn(model.WarningComposition, "inline oneOf is mapped to any; move it to components/schemas")
+	case len(s.AnyOf) > 0:
+		t.warn(model.WarningComposition, "inline anyOf is mapped to any; move it to components/schemas")
+	case len(s.AllOf) > 0:
+		t.warn(model.WarningComposition, "inline allOf is mapped to any; move it to components/schemas")
+	}
+}
+
+// checkMediaTypes warns about media types beyond the first, which targets
+// do not generate. Event streams are handled separately and never dropped.
+func (t *transformer) checkMediaTypes(content []model.MediaTypeContent) {
+	if len(content) < 2 {
+		return
+	}
+	for _, c := range content[1:] {
+		if c.MediaType == "text/event-stream" {
+			continue
+		}
+		t.warn(model.WarningMediaType, "%s is not generated; only %s is used", c.MediaType, content[0].MediaType)
+	}
+}
@@ -0,0 +1,32 @@
+package model
+
+// EventAction says whether the application sends or receives the messages
+// of an event operation.
+type EventAction string
+
+const (
+	EventSend    EventAction = "send"
+	EventReceive EventAction = "receive"
+)
+
+// EventOperation is an AsyncAPI operation: messages the application sends to
+// or receives from a channel.
+type EventOperation struct {
+	ID          string
+	Action      EventAction
+	Channel     string // channel name; the address in AsyncAPI 2
+	Address     string // channel address, e.g. "user/signedup"
+	Summary     string
+	Description string
+	Messages    []EventMessage
+}
+
+// EventMessage is a message of an event operation. Payload references the
+// component schema generated for it.
+type EventMessage struct {
+	Name        string
+	Summary     string
+	ContentType string
+	Payload     *Schema
+	Inline      bool // the payload is declared in the message and named after it
+}
@@ -1,5 +1,7 @@
 package model
 
+import "strings"
+
 type Operation struct {
 	ID          string
 	Method      Method
@@ -14,6 +16,21 @@ type Operation struct {
 	Security    []SecurityRequirement
 	Streaming   *StreamingConfig // SSE/streaming response
 	Callbacks   []Callback
+	Batchable   bool // x-oink-batchable: generate a concurrent batch helper in the client
+	Async       *AsyncConfig
+	LoadWeight  *int   // x-oink-load-weight: share of the operation in load tests, 1 when nil; 0 leaves it out
+	MaxBody     *int64 // x-oink-max-body: most bytes servers read of the request body, the configured default when nil; 0 lifts the limit
+}
+
+// AsyncConfig describes a long-running operation declared with x-oink-async:
+// a 202 response carries a status URL that is polled with StatusOperation's
+// response shape until StatusField holds a terminal value.
+type AsyncConfig struct {
+	StatusOperation string   // operation ID whose success response is the status document
+	StatusField     string   // top-level status document field, "status" by default
+	Success         []string // terminal values meaning the operation succeeded
+	Failure         []string // terminal values meaning the operation failed
+	LocationHeader  string   // 202 response header with the status URL, "Location" by default
 }
 
 type Callback struct {
@@ -65,6 +82,11 @@ type Parameter struct {
 	Required    bool
 	Deprecated  bool
 	Schema      *Schema
+	Example     any // example value declared on the parameter, if any
+
+	// Query parameters only
+	AllowReserved   bool // reserved characters are sent without percent-encoding
+	AllowEmptyValue bool // an empty value is sent, and read, as a value
 }
 
 type RequestBody struct {
@@ -76,6 +98,51 @@ type RequestBody struct {
 type MediaTypeContent struct {
 	MediaType string
 	Schema    *Schema
+	Encoding  map[string]*Encoding // per-property encoding for multipart and form bodies
+	Example   any                  // example value declared on the media type, if any
+}
+
+// IsJSONMediaType reports whether mediaType is application/json or a media
+// type with the +json structured syntax suffix, such as application/hal+json
+// or application/vnd.acme.v1+json. Parameters like charset are ignored.
+func IsJSONMediaType(mediaType string) bool {
+	mt, _, _ := strings.Cut(mediaType, ";")
+	mt = strings.ToLower(strings.TrimSpace(mt))
+	return mt == "application/json" || strings.HasSuffix(mt, "+json")
+}
+
+// JSONContentType returns mediaType when it is a JSON media type and
+// application/json otherwise, for bodies that are always encoded as JSON.
+func JSONContentType(mediaType string) string {
+	if IsJSONMediaType(mediaType) {
+		return mediaType
+	}
+	return "application/json"
+}
+
+// IsTextMediaType reports whether mediaType is a text/* type other than
+// text/event-stream, such as text/plain or text/html. Text bodies are carried
+// as plain strings rather than encoded as JSON.
+func IsTextMediaType(mediaType string) bool {
+	mt, _, _ := strings.Cut(mediaType, ";")
+	mt = strings.ToLower(strings.TrimSpace(mt))
+	return strings.HasPrefix(mt, "text/") && mt != "text/event-stream"
+}
+
+// IsBinaryMediaType reports whether mediaType is application/octet-stream,
+// whose bodies are passed through as raw bytes.
+func IsBinaryMediaType(mediaType string) bool {
+	mt, _, _ := strings.Cut(mediaType, ";")
+	return strings.EqualFold(strings.TrimSpace(mt), "application/octet-stream")
+}
+
+// Encoding describes how a single property is serialized in a multipart or
+// application/x-www-form-urlencoded body.
+type Encoding struct {
+	ContentType string // comma-separated list of allowed media types
+	Headers     []Header
+	Style       string // form, spaceDelimited, pipeDelimited, deepObject
+	Explode     *bool
 }
 
 type Response struct {
@@ -83,6 +150,23 @@ type Response struct {
 	Description string
 	Content     []MediaTypeContent
 	Headers     []Header
+	Links       []Link
+}
+
+// Link describes a design-time relationship from a response to another operation.
+type Link struct {
+	Name         string
+	OperationID  string // target operationId
+	OperationRef string // target JSON reference, e.g. "#/paths/~1users~1{id}/get"
+	Description  string
+	Parameters   []LinkParameter
+	RequestBody  string // runtime expression or constant for the target request body
+}
+
+// LinkParameter maps a target operation parameter to a runtime expression.
+type LinkParameter struct {
+	Name       string // parameter name, optionally qualified with its location (e.g. "path.id")
+	Expression string // runtime expression (e.g. "$response.body#/id") or constant value
 }
 
 type Header struct {
@@ -60,6 +60,8 @@ type SchemaExtensions struct {
 	GoTypeImport *GoTypeImport
 	// GoName overrides the generated field/type name
 	GoName string
+	// JSONName overrides the JSON/YAML tag name without changing the Go name
+	JSONName string
 	// ExtraTags adds additional struct tags (e.g., {"validate": "required,email"})
 	ExtraTags map[string]string
 	// OmitEmpty forces the omitempty JSON tag option
@@ -68,6 +70,24 @@ type SchemaExtensions struct {
 	OmitZero *bool
 	// JSONIgnore excludes the field from JSON marshaling
 	JSONIgnore bool
+	// Marshal selects a user-implemented marshaler: "text", "binary" or "custom"
+	Marshal string
+	// Embed generates a $ref property as an embedded struct instead of a named field
+	Embed bool
+	// Bitmask generates an integer enum as bit flags with Has/Set/Clear helpers
+	Bitmask bool
+	// EnumVarNames names the enum values, in order (x-enum-varnames)
+	EnumVarNames []string
+	// MapKey sets the key type of an additionalProperties map: "int", "int32", "int64" or "uuid"
+	MapKey string
+	// Ordered keeps the key order of an additionalProperties map on the wire (x-oink-ordered)
+	Ordered bool
+	// Raw passes the value through undecoded as json.RawMessage (x-oink-raw)
+	Raw bool
+	// Envelope names the payload property of a schema generated as an alias of the generic Envelope[T] (x-oink-envelope)
+	Envelope string
+	// AllOfConflict picks the declaration the flatten allOf strategy keeps when members declare a property with different types: "first" or "last" (x-oink-allof-conflict)
+	AllOfConflict string
 }
 
 // GoTypeImport specifies an import for a custom Go type.
@@ -91,6 +111,8 @@ const (
 type Property struct {
 	Name   string
 	Schema *Schema
+	Origin string // JSON pointer of the schema declaring the property, e.g. "#/components/schemas/Pet"
+	Via    string // "allOf" when the property was merged in from an allOf member
 }
 
 type Discriminator struct {
@@ -103,6 +125,7 @@ type SecurityScheme struct {
 	Type         SecuritySchemeType
 	Description  string
 	In           string
+	ParamName    string // apiKey: name of the header, query parameter or cookie
 	Scheme       string
 	BearerFormat string
 	Flows        *OAuthFlows
@@ -1,6 +1,10 @@
 package model
 
-import "strings"
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
 
 type Spec struct {
 	Info       Info
@@ -10,6 +14,27 @@ type Spec struct {
 	Operations []Operation
 	Schemas    []Schema
 	Security   []SecurityScheme
+	Events     []EventOperation // from an AsyncAPI document, see loader.MergeEvents
+	Warnings   []Warning
+}
+
+// WarningKind classifies a construct that generation cannot represent faithfully.
+type WarningKind string
+
+const (
+	WarningComposition    WarningKind = "composition"     // oneOf/anyOf/allOf mapped to any
+	WarningIgnoredKeyword WarningKind = "ignored-keyword" // schema keyword with no effect on generated code
+	WarningMediaType      WarningKind = "media-type"      // additional media type that is not generated
+	WarningOperationID    WarningKind = "operation-id"    // operationId synthesized from method and path
+	WarningExtension      WarningKind = "extension"       // malformed x-oink-* extension that is ignored
+	WarningInvalidType    WarningKind = "invalid-type"    // type that is not a JSON Schema type, generated as any
+)
+
+// Warning is a construct in the spec that will degrade in generated code.
+type Warning struct {
+	Kind     WarningKind
+	Location string // JSON pointer into the spec, e.g. "#/components/schemas/Pet"
+	Message  string
 }
 
 // SchemaByRef returns a schema by its $ref path (e.g., "#/components/schemas/User").
@@ -37,6 +62,57 @@ type Info struct {
 type Server struct {
 	URL         string
 	Description string
+	Variables   []ServerVariable
+}
+
+type ServerVariable struct {
+	Name        string
+	Default     string
+	Description string
+	Enum        []string
+}
+
+// PathPrefix is the path of a server URL whose variables prefix every
+// operation path, like /tenants/{tenantId}.
+type PathPrefix struct {
+	Path      string
+	Variables []ServerVariable // in path order
+}
+
+// ServerPathPrefix returns the path of the first server URL with variables
+// in its path, or nil when no server has any. Variables must fill whole path
+// segments and be declared by the server.
+func (s *Spec) ServerPathPrefix() (*PathPrefix, error) {
+	for _, server := range s.Servers {
+		path := server.URL
+		if _, rest, ok := strings.Cut(path, "://"); ok {
+			path = ""
+			if i := strings.Index(rest, "/"); i >= 0 {
+				path = rest[i:]
+			}
+		}
+		path = strings.TrimSuffix(path, "/")
+		if !strings.Contains(path, "{") {
+			continue
+		}
+		prefix := &PathPrefix{Path: path}
+		for _, segment := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+			if !strings.ContainsAny(segment, "{}") {
+				continue
+			}
+			if !strings.HasPrefix(segment, "{") || !strings.HasSuffix(segment, "}") || strings.Count(segment, "{") > 1 {
+				return nil, fmt.Errorf("server URL %s: variable in path segment %q must fill the whole segment", server.URL, segment)
+			}
+			name := segment[1 : len(segment)-1]
+			i := slices.IndexFunc(server.Variables, func(v ServerVariable) bool { return v.Name == name })
+			if i < 0 {
+				return nil, fmt.Errorf("server URL %s: variable %s is not declared", server.URL, name)
+			}
+			prefix.Variables = append(prefix.Variables, server.Variables[i])
+		}
+		return prefix, nil
+	}
+	return nil, nil
 }
 
 type Tag struct {
@@ -1,17 +1,40 @@
 package client
 
 import (
+	"maps"
+	"reflect"
+	"slices"
+	"strconv"
 	"strings"
+	"unicode"
 
+	"github.com/kolah/eugene/internal/config"
 	"github.com/kolah/eugene/internal/golang"
 	"github.com/kolah/eugene/internal/model"
 	"github.com/kolah/eugene/internal/templates"
 )
 
-type Target struct{}
+type Target struct {
+	toolVersion string
 
-func New() *Target {
-	return &Target{}
+	// data caches the template data of the last spec, package and options
+	// so the client and tools templates are built from a single walk of the
+	// spec
+	data    *templateData
+	dataKey dataKey
+}
+
+// dataKey identifies the inputs template data was built from.
+type dataKey struct {
+	spec *model.Spec
+	pkg  string
+	opts config.OutputOptions
+}
+
+// New returns the client target. toolVersion is the eugene release recorded
+// in the generated DefaultUserAgent; it may be empty.
+func New(toolVersion string) *Target {
+	return &Target{toolVersion: toolVersion}
 }
 
 type clientFeatures struct {
@@ -20,13 +43,48 @@ type clientFeatures struct {
 	HasQueryString    bool // any operation uses querystring param (OpenAPI 3.2)
 	HasMultipart      bool // any operation uses multipart/form-data
 	HasFormUrlEncoded bool // any operation uses application/x-www-form-urlencoded
+	HasLinks          bool // any response declares links to other operations
+	HasPartEncoding   bool // any multipart part declares an encoding contentType or headers
+	HasBatch          bool // any operation is marked x-oink-batchable
+	HasAsync          bool // any operation declares x-oink-async polling
+	HasDeviceFlow     bool // any security scheme declares an OAuth device authorization flow
+	HasCompact        bool // any operation is sent by the table-driven core
 }
 
 type templateData struct {
-	Package    string
-	Operations []operationData
-	Tags       []tagData // OpenAPI 3.2: hierarchical tags
-	Features   clientFeatures
+	Package     string
+	UserAgent   string
+	Operations  []operationData
+	Tags        []tagData     // OpenAPI 3.2: hierarchical tags
+	Services    []serviceData // per-tag service fields, with output-options.client-services
+	DeviceFlows []deviceFlowData
+	Features    clientFeatures
+	Prefix      *prefixData // with output-options.prefix-variables
+}
+
+// prefixData is the server URL path the client sends every request under,
+// with a constructor option per variable.
+type prefixData struct {
+	Path      string
+	Variables []prefixVariableData
+	BaseURL   string // Go expression of the path, appended to the base URL
+}
+
+type prefixVariableData struct {
+	Name    string
+	Field   string
+	Option  string
+	Default string
+}
+
+// deviceFlowData describes the DeviceFlow variable of an OAuth 2.0 security
+// scheme with a deviceAuthorization flow (OpenAPI 3.2).
+type deviceFlowData struct {
+	Scheme                 string
+	GoName                 string
+	DeviceAuthorizationURL string
+	TokenURL               string
+	Scopes                 []string
 }
 
 type tagData struct {
@@ -47,7 +105,10 @@ type operationData struct {
 	HeaderParams     []parameterData
 	QueryStringParam *parameterData
 	RequestBody      *requestBodyData
+	Accept           string // Accept header listing the JSON response media types
 	Responses        []responseData
+	RangeResponses   []responseData // 1XX-5XX responses, matched after exact codes
+	DefaultResponse  *responseData
 	Streaming        *streamingData
 	ResponseTypeName string
 	RequestTypeName  string
@@ -60,6 +121,42 @@ type operationData struct {
 	IsStreaming      bool
 	IsMultipart      bool
 	IsFormUrlEncoded bool
+	IsBatchable      bool // x-oink-batchable: generate Batch<Op>
+	Compact          bool // sent by the table-driven core, with output-options.compact-client
+	Async            *asyncData
+	Links            []linkData
+	HasLinks         bool
+}
+
+// asyncData describes a WaitFor<Op>Completion helper from x-oink-async.
+type asyncData struct {
+	StatusOperation string // Go name of the status operation, used as its Breaker key
+	StatusType      string // Go type of the status document
+	StatusField     string
+	Success         []string
+	Failure         []string
+	LocationHeader  string
+}
+
+// linkData describes a Follow helper generated from a response link.
+type linkData struct {
+	Name                   string
+	GoName                 string
+	Description            string
+	TargetID               string
+	TargetResponseTypeName string
+	TargetParamsTypeName   string
+	TargetHasQueryParams   bool
+	PathArgs               []linkArgData
+	QueryArgs              []linkArgData
+}
+
+type linkArgData struct {
+	Name       string
+	GoName     string
+	Type       string
+	Required   bool
+	Expression string
 }
 
 type streamingData struct {
@@ -67,38 +164,70 @@ type streamingData struct {
 }
 
 type parameterData struct {
-	Name     string
-	GoName   string
-	Type     string
-	Required bool
+	Name            string
+	GoName          string
+	Type            string
+	Required        bool
+	AllowReserved   bool
+	AllowEmptyValue bool
 }
 
 type requestBodyData struct {
 	Required         bool
 	MediaType        string
+	ContentType      string // Content-Type sent for JSON-encoded bodies
 	Type             string
+	IsText           bool // text/* body sent as a string
 	IsMultipart      bool
 	IsFormUrlEncoded bool
 	MultipartFields  []multipartFieldData
 }
 
 type multipartFieldData struct {
-	Name     string
-	GoName   string
-	Type     string // "io.Reader", "string", "[]string"
-	IsFile   bool
-	IsArray  bool
-	Required bool
+	Name        string
+	GoName      string
+	Type        string // "io.Reader", "string", "[]string"
+	IsFile      bool
+	IsArray     bool
+	Required    bool
+	ContentType string   // concrete content type sent for the part (from encoding)
+	Headers     []string // encoding headers declared for the part
+	HasEncoding bool     // part is written with explicit headers
+	Delimiter   string   // separator for non-exploded form arrays
 }
 
 type responseData struct {
 	StatusCode string
+	Name       string // field suffix: "200", "2XX" or "Default"
+	Field      string // result field: "JSON200" or "Text200" for text/* bodies
+	Class      int    // status class for range codes like 2XX, 0 otherwise
+	IsDefault  bool
 	MediaType  string
 	Type       string
+	IsText     bool
+}
+
+func (t *Target) Generate(engine templates.Engine, spec *model.Spec, pkg string, opts *config.OutputOptions) (string, error) {
+	return engine.Execute("go/client.tmpl", t.buildData(spec, pkg, opts))
+}
+
+// buildData returns the template data of spec, built once for the same
+// spec, package and options. Its slices are shared between calls, so a
+// caller changing one copies it first.
+func (t *Target) buildData(spec *model.Spec, pkg string, opts *config.OutputOptions) templateData {
+	key := dataKey{spec: spec, pkg: pkg}
+	if opts != nil {
+		key.opts = *opts
+	}
+	if t.data == nil || !reflect.DeepEqual(t.dataKey, key) {
+		data := t.collectData(spec, pkg, opts)
+		t.data, t.dataKey = &data, key
+	}
+	return *t.data
 }
 
-func (t *Target) Generate(engine templates.Engine, spec *model.Spec, pkg string) (string, error) {
-	data := templateData{Package: pkg}
+func (t *Target) collectData(spec *model.Spec, pkg string, opts *config.OutputOptions) templateData {
+	data := templateData{Package: pkg, UserAgent: userAgent(spec.Info, t.toolVersion)}
 
 	schemaNames := make(map[string]bool)
 	for _, s := range spec.Schemas {
@@ -127,6 +256,7 @@ func (t *Target) Generate(engine templates.Engine, spec *model.Spec, pkg string)
 			Path:             op.Path,
 			Summary:          op.Summary,
 			IsStreaming:      op.Streaming != nil,
+			IsBatchable:      op.Batchable && op.Streaming == nil,
 			ResponseTypeName: responseTypeName,
 			RequestTypeName:  requestTypeName,
 			ParamsTypeName:   paramsTypeName,
@@ -144,6 +274,9 @@ func (t *Target) Generate(engine templates.Engine, spec *model.Spec, pkg string)
 				GoName:   golang.PascalCase(p.Name),
 				Type:     schemaToGoType(p.Schema),
 				Required: p.Required,
+
+				AllowReserved:   p.AllowReserved,
+				AllowEmptyValue: p.AllowEmptyValue,
 			}
 
 			switch p.In {
@@ -169,31 +302,61 @@ func (t *Target) Generate(engine templates.Engine, spec *model.Spec, pkg string)
 			if len(op.RequestBody.Content) > 0 {
 				content := op.RequestBody.Content[0]
 				rb.MediaType = content.MediaType
+				rb.ContentType = model.JSONContentType(content.MediaType)
 				rb.Type = schemaToGoType(content.Schema)
+				if model.IsTextMediaType(content.MediaType) {
+					rb.IsText = true
+					rb.Type = "string"
+				}
 
 				if content.MediaType == "multipart/form-data" {
 					rb.IsMultipart = true
 					opData.IsMultipart = true
 					data.Features.HasMultipart = true
-					rb.MultipartFields = extractMultipartFields(content.Schema, op.RequestBody.Required)
+					rb.MultipartFields = extractMultipartFields(content.Schema, content.Encoding, op.RequestBody.Required)
+					for _, f := range rb.MultipartFields {
+						if f.HasEncoding {
+							data.Features.HasPartEncoding = true
+						}
+					}
 				} else if content.MediaType == "application/x-www-form-urlencoded" {
 					rb.IsFormUrlEncoded = true
 					opData.IsFormUrlEncoded = true
 					data.Features.HasFormUrlEncoded = true
-					rb.MultipartFields = extractFormUrlEncodedFields(content.Schema, op.RequestBody.Required)
+					rb.MultipartFields = extractFormUrlEncodedFields(content.Schema, content.Encoding, op.RequestBody.Required)
 				}
 			}
 			opData.RequestBody = rb
 		}
 
 		for _, r := range op.Responses {
-			rd := responseData{StatusCode: r.StatusCode}
+			rd := responseData{
+				StatusCode: r.StatusCode,
+				Name:       golang.StatusCodeName(r.StatusCode),
+				Class:      golang.StatusCodeClass(r.StatusCode),
+				IsDefault:  r.StatusCode == "default",
+			}
+			rd.Field = "JSON" + rd.Name
 			if len(r.Content) > 0 {
 				rd.MediaType = r.Content[0].MediaType
 				rd.Type = schemaToGoType(r.Content[0].Schema)
+				if model.IsTextMediaType(rd.MediaType) {
+					rd.IsText = true
+					rd.Type = "string"
+					rd.Field = "Text" + rd.Name
+				}
 			}
 			opData.Responses = append(opData.Responses, rd)
+			switch {
+			case rd.IsDefault:
+				opData.DefaultResponse = &rd
+			case rd.Class != 0:
+				opData.RangeResponses = append(opData.RangeResponses, rd)
+			}
 		}
+		opData.Accept = acceptHeader(op.Responses)
+		// Streaming, multipart and form bodies keep their dedicated code
+		opData.Compact = opts != nil && opts.CompactClient && !opData.IsStreaming && !opData.IsMultipart && !opData.IsFormUrlEncoded
 
 		data.Operations = append(data.Operations, opData)
 
@@ -207,12 +370,304 @@ func (t *Target) Generate(engine templates.Engine, spec *model.Spec, pkg string)
 		if opData.HasQueryString {
 			data.Features.HasQueryString = true
 		}
+		if opData.IsBatchable {
+			data.Features.HasBatch = true
+		}
+		if opData.Compact {
+			data.Features.HasCompact = true
+		}
+	}
+
+	resolveLinks(spec, data.Operations)
+	resolveAsync(spec, data.Operations)
+	for _, op := range data.Operations {
+		if op.Async != nil {
+			data.Features.HasAsync = true
+			break
+		}
+	}
+	for _, op := range data.Operations {
+		if op.HasLinks {
+			data.Features.HasLinks = true
+			break
+		}
 	}
 
 	// Build hierarchical tag data
 	data.Tags = buildTagData(spec.Tags)
 
-	return engine.Execute("go/client.tmpl", data)
+	data.DeviceFlows = buildDeviceFlows(spec.Security)
+	data.Features.HasDeviceFlow = len(data.DeviceFlows) > 0
+
+	if opts != nil && opts.ClientServices {
+		data.Services = buildServices(spec, data.Operations)
+	}
+
+	if opts != nil && opts.PrefixVariables {
+		// validated by the generator
+		if prefix, _ := spec.ServerPathPrefix(); prefix != nil {
+			data.Prefix = buildPrefix(prefix)
+		}
+	}
+
+	return data
+}
+
+// buildPrefix returns the server URL path of prefix-variables, appended to
+// the base URL with each variable path-escaped.
+func buildPrefix(prefix *model.PathPrefix) *prefixData {
+	data := &prefixData{Path: prefix.Path}
+	fields := make(map[string]string)
+	for _, v := range prefix.Variables {
+		name := golang.PascalCase(v.Name)
+		fields[v.Name] = "prefix" + name
+		data.Variables = append(data.Variables, prefixVariableData{
+			Name:    v.Name,
+			Field:   "prefix" + name,
+			Option:  "With" + name,
+			Default: v.Default,
+		})
+	}
+	var parts []string
+	literal := ""
+	for _, segment := range strings.Split(strings.TrimPrefix(prefix.Path, "/"), "/") {
+		if field, ok := fields[strings.Trim(segment, "{}")]; ok && strings.HasPrefix(segment, "{") {
+			parts = append(parts, strconv.Quote(literal+"/"), "url.PathEscape(c."+field+")")
+			literal = ""
+			continue
+		}
+		literal += "/" + segment
+	}
+	if literal != "" {
+		parts = append(parts, strconv.Quote(literal))
+	}
+	data.BaseURL = strings.Join(parts, " + ")
+	return data
+}
+
+// resolveLinks attaches Follow helpers to operations whose responses declare links.
+// Links are skipped when the target cannot be called from parameters alone
+// (request bodies, streaming, querystring) or a path parameter has no value source.
+func resolveLinks(spec *model.Spec, ops []operationData) {
+	byID := make(map[string]int)
+	byPath := make(map[string]int)
+	for i, op := range ops {
+		byID[op.ID] = i
+		byPath[op.Method+" "+op.Path] = i
+	}
+
+	for i, op := range spec.Operations {
+		if ops[i].IsStreaming {
+			continue
+		}
+		seen := make(map[string]bool)
+		for _, r := range op.Responses {
+			for _, link := range r.Links {
+				goName := "Follow" + golang.PascalCase(link.Name)
+				if seen[goName] {
+					continue
+				}
+				idx, ok := byID[link.OperationID]
+				if !ok && link.OperationRef != "" {
+					idx, ok = byPath[operationRefKey(link.OperationRef)]
+				}
+				if !ok {
+					continue
+				}
+				target := ops[idx]
+				if target.HasBody || target.IsStreaming || target.HasQueryString {
+					continue
+				}
+				ld, ok := buildLinkData(link, goName, target, ops[i])
+				if !ok {
+					continue
+				}
+				seen[goName] = true
+				ops[i].Links = append(ops[i].Links, ld)
+				ops[i].HasLinks = true
+			}
+		}
+	}
+}
+
+// userAgent builds the default User-Agent from the API title and version and
+// the eugene release, e.g. "petstore-api/1.2.0 eugene/1.0.0".
+func userAgent(info model.Info, toolVersion string) string {
+	var products []string
+	if name := userAgentToken(info.Title); name != "" {
+		if info.Version != "" {
+			name += "/" + userAgentToken(info.Version)
+		}
+		products = append(products, name)
+	}
+	tool := "eugene"
+	if toolVersion != "" {
+		tool += "/" + userAgentToken(toolVersion)
+	}
+	return strings.Join(append(products, tool), " ")
+}
+
+// userAgentToken lowercases s and replaces characters not allowed in a
+// product token with dashes.
+func userAgentToken(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if r < 0x80 && (unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune(".-_+", r)) {
+			b.WriteRune(r)
+		} else {
+			b.WriteByte('-')
+		}
+	}
+	return strings.Trim(b.String(), "-")
+}
+
+// resolveAsync attaches WaitFor<Op>Completion helpers to operations declaring
+// x-oink-async. The status document type is the status operation's first
+// 2xx response body.
+func resolveAsync(spec *model.Spec, ops []operationData) {
+	byID := make(map[string]int)
+	for i, op := range spec.Operations {
+		byID[op.ID] = i
+	}
+	for i, op := range spec.Operations {
+		if op.Async == nil || op.Streaming != nil {
+			continue
+		}
+		idx, ok := byID[op.Async.StatusOperation]
+		if !ok {
+			continue
+		}
+		statusType := "any"
+		for _, r := range ops[idx].Responses {
+			if r.Type != "" && (r.Class == 2 || strings.HasPrefix(r.StatusCode, "2")) {
+				statusType = r.Type
+				break
+			}
+		}
+		ops[i].Async = &asyncData{
+			StatusOperation: golang.PascalCase(ops[idx].ID),
+			StatusType:      statusType,
+			StatusField:     op.Async.StatusField,
+			Success:         op.Async.Success,
+			Failure:         op.Async.Failure,
+			LocationHeader:  op.Async.LocationHeader,
+		}
+	}
+}
+
+// acceptHeader lists the distinct JSON and text media types the responses
+// declare, falling back to application/json.
+func acceptHeader(responses []model.Response) string {
+	var types []string
+	seen := make(map[string]bool)
+	for _, r := range responses {
+		if len(r.Content) == 0 {
+			continue
+		}
+		mt := r.Content[0].MediaType
+		if (model.IsJSONMediaType(mt) || model.IsTextMediaType(mt)) && !seen[mt] {
+			seen[mt] = true
+			types = append(types, mt)
+		}
+	}
+	if len(types) == 0 {
+		return "application/json"
+	}
+	return strings.Join(types, ", ")
+}
+
+func buildLinkData(link model.Link, goName string, target, source operationData) (linkData, bool) {
+	exprs := make(map[string]string)
+	for _, p := range link.Parameters {
+		name := p.Name
+		for _, loc := range []string{"path.", "query.", "header.", "cookie."} {
+			name = strings.TrimPrefix(name, loc)
+		}
+		exprs[name] = p.Expression
+	}
+
+	ld := linkData{
+		Name:                   link.Name,
+		GoName:                 goName,
+		Description:            link.Description,
+		TargetID:               golang.PascalCase(target.ID),
+		TargetResponseTypeName: target.ResponseTypeName,
+		TargetParamsTypeName:   target.ParamsTypeName,
+		TargetHasQueryParams:   target.HasQueryParams,
+	}
+
+	for _, p := range target.PathParams {
+		expr, ok := exprs[p.Name]
+		if !ok {
+			// Fall back to the same-named path parameter of the source request
+			if !hasParam(source.PathParams, p.Name) {
+				return linkData{}, false
+			}
+			expr = "$request.path." + p.Name
+		}
+		ld.PathArgs = append(ld.PathArgs, linkArgData{
+			Name:       p.Name,
+			GoName:     p.GoName,
+			Type:       p.Type,
+			Required:   true,
+			Expression: expr,
+		})
+	}
+
+	for _, p := range target.QueryParams {
+		expr, ok := exprs[p.Name]
+		if !ok {
+			continue
+		}
+		ld.QueryArgs = append(ld.QueryArgs, linkArgData{
+			Name:       p.Name,
+			GoName:     p.GoName,
+			Type:       p.Type,
+			Required:   p.Required,
+			Expression: expr,
+		})
+	}
+
+	for _, arg := range append(ld.PathArgs, ld.QueryArgs...) {
+		if !isLinkArgType(arg.Type) {
+			return linkData{}, false
+		}
+	}
+
+	return ld, true
+}
+
+// operationRefKey converts a local operationRef ("#/paths/~1items~1{id}/get")
+// into a "METHOD /path" lookup key.
+func operationRefKey(ref string) string {
+	rest, ok := strings.CutPrefix(ref, "#/paths/")
+	if !ok {
+		return ""
+	}
+	idx := strings.LastIndex(rest, "/")
+	if idx < 0 {
+		return ""
+	}
+	path := strings.NewReplacer("~1", "/", "~0", "~").Replace(rest[:idx])
+	return strings.ToUpper(rest[idx+1:]) + " " + path
+}
+
+func hasParam(params []parameterData, name string) bool {
+	for _, p := range params {
+		if p.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func isLinkArgType(t string) bool {
+	switch t {
+	case "string", "int", "int32", "int64", "float64", "bool":
+		return true
+	default:
+		return false
+	}
 }
 
 func buildTagData(tags []model.Tag) []tagData {
@@ -285,7 +740,7 @@ func schemaToGoType(s *model.Schema) string {
 	}
 }
 
-func extractMultipartFields(schema *model.Schema, bodyRequired bool) []multipartFieldData {
+func extractMultipartFields(schema *model.Schema, encoding map[string]*model.Encoding, bodyRequired bool) []multipartFieldData {
 	if schema == nil {
 		return nil
 	}
@@ -322,13 +777,36 @@ func extractMultipartFields(schema *model.Schema, bodyRequired bool) []multipart
 			field.Type = "string"
 		}
 
+		if enc := encoding[prop.Name]; enc != nil {
+			field.ContentType = partContentType(enc.ContentType, field.IsFile)
+			for _, h := range enc.Headers {
+				field.Headers = append(field.Headers, h.Name)
+			}
+			field.HasEncoding = field.ContentType != "" || len(field.Headers) > 0
+		}
+
 		fields = append(fields, field)
 	}
 
 	return fields
 }
 
-func extractFormUrlEncodedFields(schema *model.Schema, bodyRequired bool) []multipartFieldData {
+// partContentType picks the media type to send for a multipart part from an
+// encoding contentType list. Wildcard ranges cannot be sent as-is, so files fall
+// back to application/octet-stream and other parts to the multipart default.
+func partContentType(contentType string, isFile bool) string {
+	first, _, _ := strings.Cut(contentType, ",")
+	first = strings.TrimSpace(first)
+	if strings.Contains(first, "*") {
+		if isFile {
+			return "application/octet-stream"
+		}
+		return ""
+	}
+	return first
+}
+
+func extractFormUrlEncodedFields(schema *model.Schema, encoding map[string]*model.Encoding, bodyRequired bool) []multipartFieldData {
 	if schema == nil {
 		return nil
 	}
@@ -353,8 +831,32 @@ func extractFormUrlEncodedFields(schema *model.Schema, bodyRequired bool) []mult
 			field.Type = "string"
 		}
 
+		if enc := encoding[prop.Name]; enc != nil && field.IsArray {
+			field.Delimiter = golang.FormArrayDelimiter(enc.Style, enc.Explode)
+		}
+
 		fields = append(fields, field)
 	}
 
 	return fields
 }
+
+// buildDeviceFlows collects the device authorization flows of the OAuth 2.0
+// security schemes, with their scopes sorted.
+func buildDeviceFlows(schemes []model.SecurityScheme) []deviceFlowData {
+	var flows []deviceFlowData
+	for _, scheme := range schemes {
+		if scheme.Flows == nil || scheme.Flows.DeviceCode == nil {
+			continue
+		}
+		flow := scheme.Flows.DeviceCode
+		flows = append(flows, deviceFlowData{
+			Scheme:                 scheme.Name,
+			GoName:                 golang.PascalCase(scheme.Name),
+			DeviceAuthorizationURL: flow.DeviceAuthURL,
+			TokenURL:               flow.TokenURL,
+			Scopes:                 slices.Sorted(maps.Keys(flow.Scopes)),
+		})
+	}
+	return flows
+}
@@ -0,0 +1,190 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/kolah/eugene/internal/config"
+	"github.com/kolah/eugene/internal/golang"
+	"github.com/kolah/eugene/internal/model"
+	"github.com/kolah/eugene/internal/templates"
+)
+
+// defaultExampleURL is used when the spec declares no absolute server URL.
+const defaultExampleURL = "https://api.example.com"
+
+type examplesTemplateData struct {
+	Package   string
+	ServerURL string
+	Op        *exampleOpData
+}
+
+// exampleOpData describes the call made by the operation example.
+type exampleOpData struct {
+	Method   string
+	BodyType string // set when the example declares a JSON body variable
+	BodyJSON string // Go string literal decoded into the body, if the spec has an example
+	Args     []string
+}
+
+// GenerateExamples renders Example functions showing how to construct the
+// client and call a representative operation: the first GET, or else the
+// first operation, that can be called without streaming, multipart, form or
+// querystring arguments. Argument values come from spec examples when present.
+func (t *Target) GenerateExamples(engine templates.Engine, spec *model.Spec, pkg string, opts *config.OutputOptions) (string, error) {
+	data := t.buildData(spec, pkg, opts)
+	schemas := make(map[string]*model.Schema, len(spec.Schemas))
+	for i := range spec.Schemas {
+		schemas[spec.Schemas[i].Name] = &spec.Schemas[i]
+	}
+
+	out := examplesTemplateData{Package: pkg, ServerURL: strconv.Quote(exampleServerURL(spec.Servers))}
+	if i := exampleOperation(data.Operations); i >= 0 {
+		op, err := buildExampleOp(spec.Operations[i], data.Operations[i], schemas)
+		if err != nil {
+			return "", fmt.Errorf("building example for %s: %w", spec.Operations[i].ID, err)
+		}
+		out.Op = &op
+	}
+
+	return engine.Execute("go/examples_client.tmpl", out)
+}
+
+func exampleServerURL(servers []model.Server) string {
+	for _, s := range servers {
+		if (strings.HasPrefix(s.URL, "http://") || strings.HasPrefix(s.URL, "https://")) && !strings.Contains(s.URL, "{") {
+			return s.URL
+		}
+	}
+	return defaultExampleURL
+}
+
+func exampleOperation(ops []operationData) int {
+	first := -1
+	for i, op := range ops {
+		if op.IsStreaming || op.IsMultipart || op.IsFormUrlEncoded || op.HasQueryString {
+			continue
+		}
+		if op.Method == "GET" {
+			return i
+		}
+		if first < 0 {
+			first = i
+		}
+	}
+	return first
+}
+
+func buildExampleOp(op model.Operation, od operationData, schemas map[string]*model.Schema) (exampleOpData, error) {
+	ex := exampleOpData{Method: golang.PascalCase(op.ID)}
+	for _, p := range op.Parameters {
+		if p.In == model.LocationPath {
+			ex.Args = append(ex.Args, exampleLiteral(p.Schema, schemas, defaultValue(p.Example)))
+		}
+	}
+
+	if od.HasBody && od.RequestBody.Type != "" {
+		content := op.RequestBody.Content[0]
+		value := defaultValue(content.Example)
+		if value == nil {
+			value = schemaExample(content.Schema, schemas)
+		}
+		switch {
+		case od.RequestBody.IsText:
+			ex.Args = append(ex.Args, exampleLiteral(&model.Schema{Type: model.TypeString}, schemas, value))
+		default:
+			ex.BodyType = od.RequestBody.Type
+			if value != nil {
+				raw, err := json.Marshal(value)
+				if err != nil {
+					return exampleOpData{}, err
+				}
+				ex.BodyJSON = goStringLiteral(string(raw))
+			}
+			ex.Args = append(ex.Args, "body")
+		}
+	}
+
+	if od.HasQueryParams {
+		ex.Args = append(ex.Args, "nil")
+	}
+	return ex, nil
+}
+
+// exampleLiteral returns a Go expression of the schema's type, built from
+// value or the schema's own example, and the zero value otherwise.
+func exampleLiteral(s *model.Schema, schemas map[string]*model.Schema, value any) string {
+	resolved := resolveRef(s, schemas)
+	if value == nil {
+		value = schemaExample(s, schemas)
+	}
+	if resolved == nil {
+		return "nil"
+	}
+	switch resolved.Type {
+	case model.TypeString:
+		if value != nil {
+			return strconv.Quote(fmt.Sprint(value))
+		}
+		return `""`
+	case model.TypeInteger:
+		switch v := value.(type) {
+		case int:
+			return strconv.Itoa(v)
+		case float64:
+			return strconv.FormatInt(int64(v), 10)
+		}
+		return "0"
+	case model.TypeNumber:
+		switch v := value.(type) {
+		case int:
+			return strconv.Itoa(v)
+		case float64:
+			return strconv.FormatFloat(v, 'g', -1, 64)
+		}
+		return "0"
+	case model.TypeBoolean:
+		if v, ok := value.(bool); ok {
+			return strconv.FormatBool(v)
+		}
+		return "false"
+	case model.TypeObject:
+		if s.Ref != "" {
+			return schemaToGoType(s) + "{}"
+		}
+	}
+	return "nil"
+}
+
+// schemaExample returns the decoded example of s, following a $ref to its component.
+func schemaExample(s *model.Schema, schemas map[string]*model.Schema) any {
+	if s == nil {
+		return nil
+	}
+	if v := defaultValue(s.Example); v != nil {
+		return v
+	}
+	if s.Ref != "" {
+		if target := resolveRef(s, schemas); target != nil && target != s {
+			return defaultValue(target.Example)
+		}
+	}
+	return nil
+}
+
+func resolveRef(s *model.Schema, schemas map[string]*model.Schema) *model.Schema {
+	if s == nil || s.Ref == "" {
+		return s
+	}
+	return schemas[s.Ref[strings.LastIndex(s.Ref, "/")+1:]]
+}
+
+// goStringLiteral quotes s as a raw string when it can, keeping JSON readable.
+func goStringLiteral(s string) string {
+	if strings.Contains(s, "`") {
+		return strconv.Quote(s)
+	}
+	return "`" + s + "`"
+}
@@ -0,0 +1,13 @@
+package client
+
+import (
+	"github.com/kolah/eugene/internal/config"
+	"github.com/kolah/eugene/internal/model"
+	"github.com/kolah/eugene/internal/templates"
+)
+
+// GenerateMock renders ClientInterface, the method set of Client, and
+// ClientMock, an implementation of it with a function field per operation.
+func (t *Target) GenerateMock(engine templates.Engine, spec *model.Spec, pkg string, opts *config.OutputOptions) (string, error) {
+	return engine.Execute("go/client_mock.tmpl", t.buildData(spec, pkg, opts))
+}
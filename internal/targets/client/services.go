@@ -0,0 +1,114 @@
+package client
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/kolah/eugene/internal/golang"
+	"github.com/kolah/eugene/internal/model"
+)
+
+// serviceData is a per-tag service struct grouping client operations, so
+// callers can write client.Pets.Get(ctx, id).
+type serviceData struct {
+	Name        string // service type, e.g. PetsService
+	Field       string // Client field holding the service, e.g. Pets
+	Tag         string
+	Description string
+	Methods     []serviceMethodData
+}
+
+type serviceMethodData struct {
+	Name string // method name with the tag word removed, e.g. Get
+	Op   operationData
+}
+
+// buildServices groups operations by their first tag. Untagged operations
+// are only available on the Client itself.
+func buildServices(spec *model.Spec, ops []operationData) []serviceData {
+	descriptions := make(map[string]string)
+	for _, t := range spec.Tags {
+		descriptions[t.Name] = t.Description
+	}
+
+	clientMethods := make(map[string]bool)
+	for _, op := range ops {
+		clientMethods[golang.PascalCase(op.ID)] = true
+	}
+
+	var services []serviceData
+	byTag := make(map[string]int)
+	for i, op := range spec.Operations {
+		if len(op.Tags) == 0 {
+			continue
+		}
+		tag := op.Tags[0]
+		idx, ok := byTag[tag]
+		if !ok {
+			field := golang.PascalCase(tag)
+			svc := serviceData{
+				Name:        field + "Service",
+				Field:       field,
+				Tag:         tag,
+				Description: descriptions[tag],
+			}
+			if clientMethods[field] {
+				svc.Field = svc.Name
+			}
+			idx = len(services)
+			byTag[tag] = idx
+			services = append(services, svc)
+		}
+		services[idx].Methods = append(services[idx].Methods, serviceMethodData{Op: ops[i]})
+	}
+
+	for i := range services {
+		nameMethods(&services[i])
+	}
+	return services
+}
+
+// nameMethods strips the tag word from each operation name ("GetPet" in
+// "pets" becomes "Get"), keeping the full name when stripping leaves nothing
+// or two methods would collide.
+func nameMethods(svc *serviceData) {
+	short := make([]string, len(svc.Methods))
+	counts := make(map[string]int)
+	for i, m := range svc.Methods {
+		short[i] = trimTagWord(golang.PascalCase(m.Op.ID), golang.PascalCase(svc.Tag))
+		counts[short[i]]++
+	}
+	for i := range svc.Methods {
+		name := short[i]
+		if counts[name] > 1 {
+			name = golang.PascalCase(svc.Methods[i].Op.ID)
+		}
+		svc.Methods[i].Name = name
+	}
+}
+
+// trimTagWord removes the first whole-word occurrence of tag, or its
+// singular form, from name.
+func trimTagWord(name, tag string) string {
+	candidates := []string{tag}
+	if singular := strings.TrimSuffix(tag, "s"); singular != tag && singular != "" {
+		candidates = append(candidates, singular)
+	}
+	for _, word := range candidates {
+		for start := 0; start < len(name); {
+			i := strings.Index(name[start:], word)
+			if i < 0 {
+				break
+			}
+			i += start
+			end := i + len(word)
+			if end == len(name) || !unicode.IsLower(rune(name[end])) {
+				if trimmed := name[:i] + name[end:]; trimmed != "" && unicode.IsUpper(rune(trimmed[0])) {
+					return trimmed
+				}
+			}
+			start = i + 1
+		}
+	}
+	return name
+}
@@ -0,0 +1,27 @@
+package client
+
+import (
+	"github.com/kolah/eugene/internal/config"
+	"github.com/kolah/eugene/internal/model"
+	"github.com/kolah/eugene/internal/templates"
+)
+
+type testingTemplateData struct {
+	templateData
+	Framework string
+	Server    bool // NewTestServer for ServerInterface
+	Strict    bool // NewStrictTestServer for StrictServerInterface
+}
+
+// GenerateTestHelpers renders helpers for tests of the generated server
+// and client: test servers wired to a Client, a RequestBuilder returning the
+// requests the client sends, and Require<Operation><Status> assertions on
+// client responses.
+func (t *Target) GenerateTestHelpers(engine templates.Engine, spec *model.Spec, pkg string, opts *config.OutputOptions, framework string, server, strict bool) (string, error) {
+	return engine.Execute("go/testing.tmpl", testingTemplateData{
+		templateData: t.buildData(spec, pkg, opts),
+		Framework:    framework,
+		Server:       server,
+		Strict:       strict,
+	})
+}
@@ -0,0 +1,276 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kolah/eugene/internal/config"
+	"github.com/kolah/eugene/internal/golang"
+	"github.com/kolah/eugene/internal/model"
+	"github.com/kolah/eugene/internal/templates"
+	"go.yaml.in/yaml/v4"
+)
+
+type toolsTemplateData struct {
+	Package string
+	Tools   []toolData
+}
+
+// toolData exposes one client operation as an LLM tool: a name, a JSON Schema
+// for its arguments and an args struct decoded before calling the client.
+type toolData struct {
+	Name        string
+	Description string
+	InputSchema string // JSON Schema of the arguments object
+	ArgsType    string
+	Fields      []toolFieldData
+	Op          operationData
+}
+
+type toolFieldData struct {
+	GoName   string
+	Type     string
+	JSONName string
+	Required bool
+}
+
+// GenerateTools renders the tool manifest and the Client.CallTool dispatcher.
+// Operations that cannot be called from a JSON object of arguments
+// (streaming, multipart, form and querystring operations) are left out.
+func (t *Target) GenerateTools(engine templates.Engine, spec *model.Spec, pkg string, opts *config.OutputOptions) (string, error) {
+	data := t.buildData(spec, pkg, opts)
+	schemas := make(map[string]*model.Schema, len(spec.Schemas))
+	for i := range spec.Schemas {
+		schemas[spec.Schemas[i].Name] = &spec.Schemas[i]
+	}
+
+	out := toolsTemplateData{Package: pkg}
+	for i, op := range spec.Operations {
+		od := data.Operations[i]
+		if od.IsStreaming || od.IsMultipart || od.IsFormUrlEncoded || od.HasQueryString {
+			continue
+		}
+		tool, err := buildTool(op, od, schemas)
+		if err != nil {
+			return "", fmt.Errorf("building tool %s: %w", op.ID, err)
+		}
+		out.Tools = append(out.Tools, tool)
+	}
+
+	return engine.Execute("go/tools.tmpl", out)
+}
+
+func buildTool(op model.Operation, od operationData, schemas map[string]*model.Schema) (toolData, error) {
+	tool := toolData{
+		Name:        toolName(op.ID),
+		Description: strings.TrimSpace(op.Summary + "\n\n" + op.Description),
+		ArgsType:    golang.CamelCase(op.ID) + "ToolArgs",
+		Op:          od,
+	}
+
+	js := newToolSchemaBuilder(schemas)
+	properties := make(map[string]any)
+	var required []string
+	for _, p := range op.Parameters {
+		var field toolFieldData
+		switch p.In {
+		case model.LocationPath:
+			field = toolFieldData{GoName: golang.PascalCase(p.Name), Type: schemaToGoType(p.Schema), Required: true}
+		case model.LocationQuery:
+			field = toolFieldData{GoName: golang.PascalCase(p.Name), Type: schemaToGoType(p.Schema), Required: p.Required}
+		default:
+			continue
+		}
+		field.JSONName = p.Name
+		if !field.Required {
+			field.Type = "*" + field.Type
+		}
+		tool.Fields = append(tool.Fields, field)
+
+		prop := js.schema(p.Schema)
+		if p.Description != "" {
+			prop["description"] = p.Description
+		}
+		properties[p.Name] = prop
+		if field.Required {
+			required = append(required, p.Name)
+		}
+	}
+
+	if od.HasBody {
+		tool.Fields = append(tool.Fields, toolFieldData{GoName: "Body", Type: od.RequestBody.Type, JSONName: "body", Required: od.RequestBody.Required})
+		var bodySchema *model.Schema
+		if len(op.RequestBody.Content) > 0 {
+			bodySchema = op.RequestBody.Content[0].Schema
+		}
+		properties["body"] = js.schema(bodySchema)
+		if od.RequestBody.Required {
+			required = append(required, "body")
+		}
+	}
+
+	input := map[string]any{
+		"type":       "object",
+		"properties": properties,
+	}
+	if len(required) > 0 {
+		input["required"] = required
+	}
+	if len(js.defs) > 0 {
+		input["$defs"] = js.defs
+	}
+	encoded, err := json.Marshal(input)
+	if err != nil {
+		return toolData{}, err
+	}
+	tool.InputSchema = string(encoded)
+	return tool, nil
+}
+
+// toolName keeps the characters tool-calling APIs accept in names.
+func toolName(id string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == '-' {
+			return r
+		}
+		return '_'
+	}, id)
+}
+
+// toolSchemaBuilder converts model schemas to JSON Schema, collecting
+// component references under $defs.
+type toolSchemaBuilder struct {
+	components map[string]*model.Schema
+	defs       map[string]any
+}
+
+func newToolSchemaBuilder(components map[string]*model.Schema) *toolSchemaBuilder {
+	return &toolSchemaBuilder{components: components, defs: make(map[string]any)}
+}
+
+func (b *toolSchemaBuilder) schema(s *model.Schema) map[string]any {
+	if s == nil {
+		return map[string]any{}
+	}
+	if name, ok := strings.CutPrefix(s.Ref, "#/components/schemas/"); ok {
+		if _, seen := b.defs[name]; !seen {
+			target := b.components[name]
+			if target == nil {
+				resolved := *s
+				resolved.Ref = ""
+				target = &resolved
+			}
+			b.defs[name] = map[string]any{} // placeholder for recursive references
+			b.defs[name] = b.schema(target)
+		}
+		return map[string]any{"$ref": "#/$defs/" + name}
+	}
+
+	out := make(map[string]any)
+	if s.Type != "" {
+		if s.Nullable {
+			out["type"] = []string{string(s.Type), "null"}
+		} else {
+			out["type"] = string(s.Type)
+		}
+	}
+	if s.Format != "" {
+		out["format"] = s.Format
+	}
+	if s.Description != "" {
+		out["description"] = s.Description
+	}
+	if len(s.Enum) > 0 {
+		out["enum"] = s.Enum
+	}
+	if def := defaultValue(s.Default); def != nil {
+		out["default"] = def
+	}
+
+	if len(s.Properties) > 0 {
+		props := make(map[string]any, len(s.Properties))
+		for _, p := range s.Properties {
+			if p.Schema != nil && p.Schema.Extensions != nil && p.Schema.Extensions.JSONIgnore {
+				continue
+			}
+			name := p.Name
+			if p.Schema != nil && p.Schema.Extensions != nil && p.Schema.Extensions.JSONName != "" {
+				name = p.Schema.Extensions.JSONName
+			}
+			props[name] = b.schema(p.Schema)
+		}
+		out["properties"] = props
+	}
+	if len(s.Required) > 0 {
+		out["required"] = s.Required
+	}
+	if s.Items != nil {
+		out["items"] = b.schema(s.Items)
+	}
+	if s.AdditionalProperties != nil {
+		out["additionalProperties"] = b.schema(s.AdditionalProperties)
+	}
+
+	for key, list := range map[string][]*model.Schema{"allOf": s.AllOf, "oneOf": s.OneOf, "anyOf": s.AnyOf} {
+		if len(list) == 0 {
+			continue
+		}
+		items := make([]any, len(list))
+		for i, item := range list {
+			items[i] = b.schema(item)
+		}
+		out[key] = items
+	}
+
+	if s.Minimum != nil {
+		if s.ExclusiveMinimum {
+			out["exclusiveMinimum"] = *s.Minimum
+		} else {
+			out["minimum"] = *s.Minimum
+		}
+	}
+	if s.Maximum != nil {
+		if s.ExclusiveMaximum {
+			out["exclusiveMaximum"] = *s.Maximum
+		} else {
+			out["maximum"] = *s.Maximum
+		}
+	}
+	if s.MinLength != nil {
+		out["minLength"] = *s.MinLength
+	}
+	if s.MaxLength != nil {
+		out["maxLength"] = *s.MaxLength
+	}
+	if s.Pattern != "" {
+		out["pattern"] = s.Pattern
+	}
+	if s.MinItems != nil {
+		out["minItems"] = *s.MinItems
+	}
+	if s.MaxItems != nil {
+		out["maxItems"] = *s.MaxItems
+	}
+	if s.UniqueItems {
+		out["uniqueItems"] = true
+	}
+	return out
+}
+
+// defaultValue decodes a schema default, which the loader keeps as the raw
+// YAML node.
+func defaultValue(v any) any {
+	node, ok := v.(*yaml.Node)
+	if !ok {
+		return v
+	}
+	if node == nil {
+		return nil
+	}
+	var decoded any
+	if err := node.Decode(&decoded); err != nil {
+		return nil
+	}
+	return decoded
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"net/http"
+	"slices"
+
+	"github.com/kolah/eugene/internal/config"
+	"github.com/kolah/eugene/internal/model"
+	"github.com/kolah/eugene/internal/templates"
+)
+
+type vcrTemplateData struct {
+	Package      string
+	Routes       []vcrRouteData
+	ScrubHeaders []string // canonical names of headers carrying credentials
+	ScrubQuery   []string // query parameters carrying credentials
+}
+
+// vcrRouteData maps requests to the operation they are recorded under.
+type vcrRouteData struct {
+	ID     string
+	Method string
+	Path   string
+}
+
+// GenerateVCR renders VCRTransport, an http.RoundTripper recording API
+// responses to golden files and replaying them. The credentials of the
+// spec's security schemes are redacted from the recordings.
+func (t *Target) GenerateVCR(engine templates.Engine, spec *model.Spec, pkg string, opts *config.OutputOptions) (string, error) {
+	data := vcrTemplateData{Package: pkg}
+	for _, op := range t.buildData(spec, pkg, opts).Operations {
+		data.Routes = append(data.Routes, vcrRouteData{ID: op.ID, Method: op.Method, Path: op.Path})
+	}
+	data.ScrubHeaders, data.ScrubQuery = vcrCredentials(spec.Security)
+	return engine.Execute("go/vcr.tmpl", data)
+}
+
+// vcrCredentials returns the headers and query parameters the security
+// schemes send credentials in.
+func vcrCredentials(schemes []model.SecurityScheme) (headers, query []string) {
+	for _, scheme := range schemes {
+		switch scheme.Type {
+		case model.SecurityTypeAPIKey:
+			switch scheme.In {
+			case "header":
+				headers = append(headers, http.CanonicalHeaderKey(scheme.ParamName))
+			case "query":
+				query = append(query, scheme.ParamName)
+			case "cookie":
+				headers = append(headers, "Cookie", "Set-Cookie")
+			}
+		case model.SecurityTypeHTTP, model.SecurityTypeOAuth2, model.SecurityTypeOpenIDConnect:
+			headers = append(headers, "Authorization")
+		}
+	}
+	slices.Sort(headers)
+	slices.Sort(query)
+	return slices.Compact(headers), slices.Compact(query)
+}
@@ -0,0 +1,109 @@
+package doc
+
+import (
+	"strings"
+
+	"github.com/kolah/eugene/internal/model"
+	"github.com/kolah/eugene/internal/templates"
+)
+
+type Target struct{}
+
+func New() *Target {
+	return &Target{}
+}
+
+type templateData struct {
+	Package     string
+	Parent      string // package of the shared types, for a split-by-tag subpackage
+	Title       string
+	Version     string
+	Description string
+	Servers     []serverData
+	Tags        []tagData
+}
+
+type serverData struct {
+	URL         string
+	Description string
+}
+
+type tagData struct {
+	Name        string
+	Summary     string // one line, for the tag overview
+	Description string
+}
+
+// Generate renders the package documentation of pkg: the title, version and
+// description of the spec, its servers, and an overview of the tags of its
+// operations. A split-by-tag subpackage, whose shared types live in parent,
+// is documented by its tags instead.
+func (t *Target) Generate(engine templates.Engine, spec *model.Spec, pkg, parent string) (string, error) {
+	data := templateData{
+		Package:     pkg,
+		Parent:      parent,
+		Title:       strings.TrimSpace(spec.Info.Title),
+		Version:     strings.TrimSpace(spec.Info.Version),
+		Description: strings.TrimSpace(spec.Info.Description),
+		Tags:        operationTags(spec),
+	}
+	for _, s := range spec.Servers {
+		data.Servers = append(data.Servers, serverData{
+			URL:         s.URL,
+			Description: oneLine(s.Description),
+		})
+	}
+	return engine.Execute("go/doc.tmpl", data)
+}
+
+// operationTags returns the tags the operations of spec use, declared tags
+// first in spec order, then the undeclared ones as the operations name them.
+func operationTags(spec *model.Spec) []tagData {
+	used := make(map[string]bool)
+	var undeclared []string
+	for _, op := range spec.Operations {
+		for _, name := range op.Tags {
+			if !used[name] {
+				used[name] = true
+				undeclared = append(undeclared, name)
+			}
+		}
+	}
+
+	var tags []tagData
+	declared := make(map[string]bool)
+	for _, tag := range spec.Tags {
+		if !used[tag.Name] || declared[tag.Name] {
+			continue
+		}
+		declared[tag.Name] = true
+		summary := oneLine(tag.Summary)
+		if summary == "" {
+			summary = oneLine(firstParagraph(tag.Description))
+		}
+		tags = append(tags, tagData{
+			Name:        tag.Name,
+			Summary:     summary,
+			Description: strings.TrimSpace(tag.Description),
+		})
+	}
+	for _, name := range undeclared {
+		if !declared[name] {
+			tags = append(tags, tagData{Name: name})
+		}
+	}
+	return tags
+}
+
+func firstParagraph(s string) string {
+	s = strings.TrimSpace(s)
+	if i := strings.Index(s, "\n\n"); i >= 0 {
+		return s[:i]
+	}
+	return s
+}
+
+// oneLine joins the lines of s with spaces, for a list item.
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,131 @@
+package events
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/kolah/eugene/internal/golang"
+	"github.com/kolah/eugene/internal/model"
+	"github.com/kolah/eugene/internal/templates"
+)
+
+type Target struct{}
+
+func New() *Target {
+	return &Target{}
+}
+
+type templateData struct {
+	Package     string
+	Channels    []channelData
+	Publishers  []methodData
+	Subscribers []methodData
+	Dispatch    []methodData // received messages that are alone on their channel
+	HasRawJSON  bool         // some message has no payload schema
+}
+
+type channelData struct {
+	Name    string // constant name, e.g. ChannelUserSignedUp
+	Address string
+}
+
+type methodData struct {
+	Name        string // e.g. PublishUserSignedUp
+	OperationID string
+	Message     string
+	Type        string // Go type of the payload
+	Channel     string // channel constant
+	Address     string
+	Summary     string
+}
+
+func (t *Target) Generate(engine templates.Engine, spec *model.Spec, pkg string) (string, error) {
+	data := templateData{Package: pkg}
+
+	channels := make(map[string]string)
+	channel := func(op model.EventOperation) string {
+		if name, ok := channels[op.Address]; ok {
+			return name
+		}
+		name := "Channel" + identifier(op.Channel)
+		channels[op.Address] = name
+		data.Channels = append(data.Channels, channelData{Name: name, Address: op.Address})
+		return name
+	}
+
+	received := make(map[string]int)
+	for _, op := range spec.Events {
+		constant := channel(op)
+		for _, msg := range op.Messages {
+			m := methodData{
+				OperationID: op.ID,
+				Message:     msg.Name,
+				Type:        payloadType(msg.Payload),
+				Channel:     constant,
+				Address:     op.Address,
+				Summary:     firstNonEmpty(msg.Summary, op.Summary),
+			}
+			if m.Type == "json.RawMessage" {
+				data.HasRawJSON = true
+			}
+			if op.Action == model.EventSend {
+				data.Publishers = append(data.Publishers, m)
+			} else {
+				data.Subscribers = append(data.Subscribers, m)
+				received[op.Address]++
+			}
+		}
+	}
+
+	nameMethods(data.Publishers, "Publish", "To")
+	nameMethods(data.Subscribers, "Handle", "From")
+	for _, m := range data.Subscribers {
+		if received[m.Address] == 1 {
+			data.Dispatch = append(data.Dispatch, m)
+		}
+	}
+
+	return engine.Execute("go/events.tmpl", data)
+}
+
+// nameMethods names each method after its message, adding the channel when
+// the same message is sent or received on several channels.
+func nameMethods(methods []methodData, verb, preposition string) {
+	counts := make(map[string]int)
+	for _, m := range methods {
+		counts[golang.PascalCase(m.Message)]++
+	}
+	for i := range methods {
+		name := golang.PascalCase(methods[i].Message)
+		if counts[name] > 1 {
+			name += preposition + strings.TrimPrefix(methods[i].Channel, "Channel")
+		}
+		methods[i].Name = verb + name
+	}
+}
+
+// identifier turns a channel name or address such as "user/{id}/events"
+// into a Go identifier suffix.
+func identifier(s string) string {
+	words := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return golang.ToGoIdentifier(strings.Join(words, "_"))
+}
+
+// payloadType returns the Go type generated for a message payload.
+func payloadType(s *model.Schema) string {
+	if s == nil || s.Ref == "" {
+		return "json.RawMessage"
+	}
+	return golang.PascalCase(s.Ref[strings.LastIndex(s.Ref, "/")+1:])
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,61 @@
+// Package jvmtypes renders the jvm types target: the component schemas as
+// Kotlin data classes in Types.kt, or as Java records with a file each.
+package jvmtypes
+
+import (
+	"slices"
+
+	"github.com/kolah/eugene/internal/jvm"
+	"github.com/kolah/eugene/internal/model"
+	"github.com/kolah/eugene/internal/templates"
+)
+
+type Target struct{}
+
+func New() *Target {
+	return &Target{}
+}
+
+// File is a rendered source file, named relative to the output directory.
+type File struct {
+	Filename string
+	Content  string
+}
+
+type templateData struct {
+	Package      string
+	Imports      []string
+	Declarations []jvm.Declaration
+}
+
+// Generate renders the declarations of spec in package pkg, serialized with
+// kotlinx.serialization for Kotlin and Jackson for Java.
+func (t *Target) Generate(engine templates.Engine, spec *model.Spec, dialect jvm.Dialect, pkg string) ([]File, error) {
+	decls := jvm.Declarations(dialect, spec)
+	if dialect == jvm.Java {
+		files := make([]File, 0, len(decls))
+		for _, d := range decls {
+			content, err := engine.Execute("jvm/java.tmpl", templateData{Package: pkg, Imports: d.Imports, Declarations: []jvm.Declaration{d}})
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, File{Filename: d.Name + ".java", Content: content})
+		}
+		return files, nil
+	}
+
+	data := templateData{Package: pkg, Declarations: decls}
+	for _, d := range decls {
+		data.Imports = append(data.Imports, d.Imports...)
+		if d.Kind != jvm.KindAlias {
+			data.Imports = append(data.Imports, "kotlinx.serialization.Serializable")
+		}
+	}
+	slices.Sort(data.Imports)
+	data.Imports = slices.Compact(data.Imports)
+	content, err := engine.Execute("jvm/kotlin.tmpl", data)
+	if err != nil {
+		return nil, err
+	}
+	return []File{{Filename: "Types.kt", Content: content}}, nil
+}
@@ -0,0 +1,236 @@
+package loadtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/kolah/eugene/internal/model"
+	"github.com/kolah/eugene/internal/templates"
+	"go.yaml.in/yaml/v4"
+)
+
+type Target struct{}
+
+func New() *Target {
+	return &Target{}
+}
+
+type templateData struct {
+	Package string
+	Targets []targetData
+}
+
+// targetData is one request of the scenario, as Go literals.
+type targetData struct {
+	OperationID string
+	Method      string
+	Path        string // quoted path with the parameters filled in, and the query
+	Header      []headerData
+	Body        string // Go string literal of the body, empty without one
+	Weight      int
+}
+
+type headerData struct {
+	Name   string // quoted canonical name
+	Values []string
+}
+
+// Generate renders the load-test scenario: a request per operation whose
+// parameters and request body can be filled from the spec's examples,
+// weighted by x-oink-load-weight. Operations weighted 0, streaming
+// operations and operations with a required input that has no example are
+// left out.
+func (t *Target) Generate(engine templates.Engine, spec *model.Spec, pkg string) (string, error) {
+	data := templateData{Package: pkg}
+	for _, op := range spec.Operations {
+		target, ok, err := buildTarget(spec, op)
+		if err != nil {
+			return "", fmt.Errorf("building load target for %s: %w", op.ID, err)
+		}
+		if ok {
+			data.Targets = append(data.Targets, target)
+		}
+	}
+	return engine.Execute("go/loadtest.tmpl", data)
+}
+
+func buildTarget(spec *model.Spec, op model.Operation) (targetData, bool, error) {
+	weight := 1
+	if op.LoadWeight != nil {
+		weight = *op.LoadWeight
+	}
+	if weight == 0 || op.Streaming != nil {
+		return targetData{}, false, nil
+	}
+
+	path := op.Path
+	query := url.Values{}
+	header := http.Header{}
+	for _, p := range op.Parameters {
+		values, ok := parameterValues(spec, p)
+		if !ok {
+			if p.Required || p.In == model.LocationPath {
+				return targetData{}, false, nil
+			}
+			continue
+		}
+		switch p.In {
+		case model.LocationPath:
+			path = strings.ReplaceAll(path, "{"+p.Name+"}", url.PathEscape(values[0]))
+		case model.LocationQuery:
+			query[p.Name] = values
+		case model.LocationHeader:
+			header[http.CanonicalHeaderKey(p.Name)] = values
+		default:
+			// Cookies and querystring parameters are not filled in
+			if p.Required {
+				return targetData{}, false, nil
+			}
+		}
+	}
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	target := targetData{
+		OperationID: op.ID,
+		Method:      string(op.Method),
+		Path:        strconv.Quote(path),
+		Weight:      weight,
+	}
+	if op.RequestBody != nil && len(op.RequestBody.Content) > 0 {
+		content := op.RequestBody.Content[0]
+		body, ok, err := bodyExample(spec, content)
+		if err != nil {
+			return targetData{}, false, err
+		}
+		switch {
+		case ok:
+			header.Set("Content-Type", content.MediaType)
+			target.Body = goStringLiteral(body)
+		case op.RequestBody.Required:
+			return targetData{}, false, nil
+		}
+	}
+	for name, values := range header {
+		hd := headerData{Name: strconv.Quote(name)}
+		for _, v := range values {
+			hd.Values = append(hd.Values, strconv.Quote(v))
+		}
+		target.Header = append(target.Header, hd)
+	}
+	slices.SortFunc(target.Header, func(a, b headerData) int { return strings.Compare(a.Name, b.Name) })
+	return target, true, nil
+}
+
+// parameterValues returns the example of a parameter as query values: the
+// items of an array, or the one scalar.
+func parameterValues(spec *model.Spec, p model.Parameter) ([]string, bool) {
+	value := exampleValue(p.Example)
+	if value == nil {
+		value = schemaExample(spec, p.Schema)
+	}
+	if items, ok := value.([]any); ok {
+		if p.In == model.LocationPath || len(items) == 0 {
+			return nil, false
+		}
+		values := make([]string, 0, len(items))
+		for _, item := range items {
+			s, ok := scalarString(item)
+			if !ok {
+				return nil, false
+			}
+			values = append(values, s)
+		}
+		return values, true
+	}
+	s, ok := scalarString(value)
+	if !ok {
+		return nil, false
+	}
+	return []string{s}, true
+}
+
+func scalarString(v any) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case int:
+		return strconv.Itoa(v), true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case bool:
+		return strconv.FormatBool(v), true
+	}
+	return "", false
+}
+
+// bodyExample returns the example of a JSON or text request body.
+func bodyExample(spec *model.Spec, content model.MediaTypeContent) (string, bool, error) {
+	value := exampleValue(content.Example)
+	if value == nil {
+		value = schemaExample(spec, content.Schema)
+	}
+	if value == nil {
+		return "", false, nil
+	}
+	switch {
+	case model.IsJSONMediaType(content.MediaType):
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return "", false, err
+		}
+		return string(raw), true, nil
+	case strings.HasPrefix(content.MediaType, "text/"):
+		s, ok := scalarString(value)
+		return s, ok, nil
+	}
+	return "", false, nil
+}
+
+// schemaExample returns the example of s, or of the component it refers to,
+// falling back to its default.
+func schemaExample(spec *model.Spec, s *model.Schema) any {
+	if s == nil {
+		return nil
+	}
+	if s.Ref != "" {
+		if target := spec.SchemaByRef(s.Ref); target != nil {
+			s = target
+		}
+	}
+	if v := exampleValue(s.Example); v != nil {
+		return v
+	}
+	return exampleValue(s.Default)
+}
+
+// exampleValue returns an example as decoded JSON would hold it, or nil
+// when there is none.
+func exampleValue(v any) any {
+	node, ok := v.(*yaml.Node)
+	if !ok {
+		return v
+	}
+	if node == nil {
+		return nil
+	}
+	var decoded any
+	if err := node.Decode(&decoded); err != nil {
+		return nil
+	}
+	return decoded
+}
+
+// goStringLiteral quotes s as a raw string when it can, keeping JSON readable.
+func goStringLiteral(s string) string {
+	if strings.Contains(s, "`") || strings.Contains(s, "\r") {
+		return strconv.Quote(s)
+	}
+	return "`" + s + "`"
+}
@@ -0,0 +1,118 @@
+package server
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/kolah/eugene/internal/model"
+	"github.com/kolah/eugene/internal/templates"
+)
+
+type corsTemplateData struct {
+	Package string
+	Paths   []corsPathData
+}
+
+type corsPathData struct {
+	Path    string // spec path, for the doc comment of the entry
+	Pattern string // regexp matching request paths, without anchors
+	Methods []string
+	Headers []string // request headers the operations read
+}
+
+// corsParam matches the path parameters of the spec.
+var corsParam = regexp.MustCompile(`\{[^}]*\}`)
+
+// GenerateCORS renders the CORS middleware, with the methods and request
+// headers of the operations at each path of the spec. prefix is the server
+// URL path the operations are served under, empty without prefix-variables.
+func GenerateCORS(engine templates.Engine, spec *model.Spec, pkg, prefix string) (string, error) {
+	data := corsTemplateData{Package: pkg}
+	index := make(map[string]int)
+	for _, op := range spec.Operations {
+		path := prefix + op.Path
+		i, ok := index[path]
+		if !ok {
+			i = len(data.Paths)
+			index[path] = i
+			data.Paths = append(data.Paths, corsPathData{Path: path, Pattern: corsPattern(path)})
+		}
+		p := &data.Paths[i]
+		if !slices.Contains(p.Methods, string(op.Method)) {
+			p.Methods = append(p.Methods, string(op.Method))
+		}
+		for _, h := range corsHeaders(spec, op) {
+			if !slices.ContainsFunc(p.Headers, func(s string) bool { return strings.EqualFold(s, h) }) {
+				p.Headers = append(p.Headers, h)
+			}
+		}
+	}
+	// The middleware takes the first pattern matching a request, so literal
+	// segments go before parameters, as routers prefer them: /pets/mine
+	// before /pets/{id}
+	slices.SortStableFunc(data.Paths, func(a, b corsPathData) int {
+		return compareCORSPaths(a.Path, b.Path)
+	})
+	return engine.Execute("go/server/cors.tmpl", data)
+}
+
+// compareCORSPaths orders a before b when, at the first segment where one
+// has a parameter and the other not, a has the literal segment.
+func compareCORSPaths(a, b string) int {
+	as, bs := strings.Split(a, "/"), strings.Split(b, "/")
+	for i := range min(len(as), len(bs)) {
+		aParam, bParam := strings.Contains(as[i], "{"), strings.Contains(bs[i], "{")
+		switch {
+		case aParam && !bParam:
+			return 1
+		case !aParam && bParam:
+			return -1
+		}
+	}
+	return 0
+}
+
+// corsPattern turns a spec path into a regexp in which each parameter
+// matches a path segment, or the part of one it takes up.
+func corsPattern(path string) string {
+	var b strings.Builder
+	last := 0
+	for _, loc := range corsParam.FindAllStringIndex(path, -1) {
+		b.WriteString(regexp.QuoteMeta(path[last:loc[0]]))
+		b.WriteString("[^/]+")
+		last = loc[1]
+	}
+	b.WriteString(regexp.QuoteMeta(path[last:]))
+	return b.String()
+}
+
+// corsHeaders returns the request headers op reads that browsers ask a
+// preflight for: its header parameters, the Content-Type of its body and
+// the headers its security schemes are sent in.
+func corsHeaders(spec *model.Spec, op model.Operation) []string {
+	var headers []string
+	for _, p := range op.Parameters {
+		if p.In == model.LocationHeader {
+			headers = append(headers, p.Name)
+		}
+	}
+	if op.RequestBody != nil {
+		headers = append(headers, "Content-Type")
+	}
+	for _, req := range op.Security {
+		i := slices.IndexFunc(spec.Security, func(s model.SecurityScheme) bool { return s.Name == req.Name })
+		if i < 0 {
+			continue
+		}
+		switch scheme := spec.Security[i]; scheme.Type {
+		case model.SecurityTypeAPIKey:
+			if scheme.In == "header" {
+				headers = append(headers, scheme.ParamName)
+			}
+		case model.SecurityTypeHTTP, model.SecurityTypeOAuth2, model.SecurityTypeOpenIDConnect:
+			headers = append(headers, "Authorization")
+		}
+	}
+	return headers
+}
@@ -0,0 +1,94 @@
+package server
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/kolah/eugene/internal/model"
+	"github.com/kolah/eugene/internal/templates"
+	"go.yaml.in/yaml/v4"
+)
+
+type exampleChecksTemplateData struct {
+	Package    string
+	Operations []exampleOpData
+}
+
+// exampleOpData lists the JSON response examples of one operation.
+type exampleOpData struct {
+	ID       string // operationId as written in the spec, as OperationID reports it
+	Examples []responseExampleData
+}
+
+type responseExampleData struct {
+	Status string // status code, range such as "2XX", or "default"
+	JSON   string // quoted JSON of the example
+}
+
+// GenerateExampleChecks renders CheckExamples, middleware that compares the
+// shape of JSON responses with the examples the spec declares for them. The
+// example of a response is that of its first JSON media type, or else the
+// example of its schema.
+func GenerateExampleChecks(engine templates.Engine, spec *model.Spec, pkg string) (string, error) {
+	data := exampleChecksTemplateData{Package: pkg}
+	for _, op := range spec.Operations {
+		od := exampleOpData{ID: op.ID}
+		for _, resp := range op.Responses {
+			value := responseExample(spec, resp)
+			if value == nil {
+				continue
+			}
+			raw, err := json.Marshal(value)
+			if err != nil {
+				continue
+			}
+			od.Examples = append(od.Examples, responseExampleData{
+				Status: strings.ToUpper(resp.StatusCode),
+				JSON:   strconv.Quote(string(raw)),
+			})
+		}
+		if len(od.Examples) > 0 {
+			data.Operations = append(data.Operations, od)
+		}
+	}
+	return engine.Execute("go/example_checks.tmpl", data)
+}
+
+func responseExample(spec *model.Spec, resp model.Response) any {
+	for _, content := range resp.Content {
+		if !model.IsJSONMediaType(content.MediaType) {
+			continue
+		}
+		if v := decodeExample(content.Example); v != nil {
+			return v
+		}
+		if s := content.Schema; s != nil {
+			if v := decodeExample(s.Example); v != nil {
+				return v
+			}
+			if target := spec.SchemaByRef(s.Ref); s.Ref != "" && target != nil {
+				return decodeExample(target.Example)
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
+// decodeExample returns the value of an example as decoded JSON would hold
+// it, or nil when there is none.
+func decodeExample(v any) any {
+	node, ok := v.(*yaml.Node)
+	if !ok {
+		return v
+	}
+	if node == nil {
+		return nil
+	}
+	var decoded any
+	if err := node.Decode(&decoded); err != nil {
+		return nil
+	}
+	return decoded
+}
@@ -0,0 +1,110 @@
+package server
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/kolah/eugene/internal/golang"
+	"github.com/kolah/eugene/internal/model"
+	"github.com/kolah/eugene/internal/templates"
+)
+
+type permissionsTemplateData struct {
+	Package    string
+	Scopes     []scopeData
+	Operations []operationScopesData
+}
+
+type scopeData struct {
+	Const       string
+	Value       string // quoted scope
+	Description string
+}
+
+// operationScopesData lists the security requirements of an operation.
+type operationScopesData struct {
+	ID           string // operationId as written in the spec, as OperationID reports it
+	Requirements []requirementData
+}
+
+// requirementData is one alternative security requirement: a scheme and the
+// scope constants it names.
+type requirementData struct {
+	Scheme string // quoted scheme name
+	Scopes []string
+}
+
+// GeneratePermissions renders a constant per scope string of the spec, from
+// the flows of the security schemes and the security requirements, and
+// Permissions, mapping each operationId to its alternative requirements.
+func GeneratePermissions(engine templates.Engine, spec *model.Spec, pkg string) (string, error) {
+	descriptions := make(map[string]string)
+	for _, scheme := range spec.Security {
+		if scheme.Flows == nil {
+			continue
+		}
+		for _, flow := range []*model.OAuthFlow{scheme.Flows.Implicit, scheme.Flows.Password, scheme.Flows.ClientCredentials, scheme.Flows.AuthorizationCode, scheme.Flows.DeviceCode} {
+			if flow == nil {
+				continue
+			}
+			for scope, description := range flow.Scopes {
+				if descriptions[scope] == "" {
+					descriptions[scope] = description
+				}
+			}
+		}
+	}
+	for _, op := range spec.Operations {
+		for _, req := range op.Security {
+			for _, scope := range req.Scopes {
+				if _, ok := descriptions[scope]; !ok {
+					descriptions[scope] = ""
+				}
+			}
+		}
+	}
+
+	data := permissionsTemplateData{Package: pkg}
+	consts := make(map[string]string)
+	taken := make(map[string]bool)
+	for _, scope := range slices.Sorted(maps.Keys(descriptions)) {
+		name := scopeConstName(scope)
+		for i := 2; taken[name]; i++ {
+			name = scopeConstName(scope) + strconv.Itoa(i)
+		}
+		taken[name] = true
+		consts[scope] = name
+		data.Scopes = append(data.Scopes, scopeData{
+			Const:       name,
+			Value:       strconv.Quote(scope),
+			Description: strings.Join(strings.Fields(descriptions[scope]), " "),
+		})
+	}
+
+	for _, op := range spec.Operations {
+		od := operationScopesData{ID: op.ID}
+		for _, req := range op.Security {
+			rd := requirementData{Scheme: strconv.Quote(req.Name)}
+			for _, scope := range req.Scopes {
+				if !slices.Contains(rd.Scopes, consts[scope]) {
+					rd.Scopes = append(rd.Scopes, consts[scope])
+				}
+			}
+			od.Requirements = append(od.Requirements, rd)
+		}
+		data.Operations = append(data.Operations, od)
+	}
+	return engine.Execute("go/server/permissions.tmpl", data)
+}
+
+// scopeConstName turns a scope such as "read:pets" into the name of its
+// constant, ScopeReadPets.
+func scopeConstName(scope string) string {
+	words := strings.FieldsFunc(scope, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return "Scope" + golang.PascalCase(strings.Join(words, "_"))
+}
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"github.com/kolah/eugene/internal/golang"
+	"github.com/kolah/eugene/internal/model"
+	"github.com/kolah/eugene/internal/templates"
+)
+
+type prefixTemplateData struct {
+	Package   string
+	Framework string
+	Path      string
+	Variables []prefixVariableData
+}
+
+type prefixVariableData struct {
+	Name        string
+	Func        string // accessor reading the value from a request context
+	Description string
+}
+
+// GeneratePrefix renders PathPrefix and the accessors of its variables,
+// which the handlers record in the request context before calling the
+// implementation.
+func GeneratePrefix(engine templates.Engine, prefix *model.PathPrefix, pkg, framework string) (string, error) {
+	data := prefixTemplateData{Package: pkg, Framework: framework, Path: prefix.Path}
+	for _, v := range prefix.Variables {
+		data.Variables = append(data.Variables, prefixVariableData{
+			Name:        v.Name,
+			Func:        golang.PascalCase(v.Name) + "FromContext",
+			Description: v.Description,
+		})
+	}
+	return engine.Execute("go/server/prefix.tmpl", data)
+}
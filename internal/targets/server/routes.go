@@ -0,0 +1,31 @@
+package server
+
+import (
+	"github.com/kolah/eugene/internal/model"
+	"github.com/kolah/eugene/internal/templates"
+)
+
+type routesTemplateData struct {
+	Package         string
+	Framework       string
+	HealthEndpoints bool
+	Routes          []routeData
+}
+
+type routeData struct {
+	Method string
+	Path   string // spec path, under the server URL prefix with prefix-variables
+	ID     string // operationId as written in the spec
+}
+
+// GenerateRoutes renders SpecRoutes, the method and path of each operation,
+// and CheckRoutes, which compares them with the routes an echo or chi
+// router serves. prefix is the server URL path the operations are served
+// under, empty without prefix-variables.
+func GenerateRoutes(engine templates.Engine, spec *model.Spec, pkg, framework, prefix string, healthEndpoints bool) (string, error) {
+	data := routesTemplateData{Package: pkg, Framework: framework, HealthEndpoints: healthEndpoints}
+	for _, op := range spec.Operations {
+		data.Routes = append(data.Routes, routeData{Method: string(op.Method), Path: prefix + op.Path, ID: op.ID})
+	}
+	return engine.Execute("go/server/routes.tmpl", data)
+}
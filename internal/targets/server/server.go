@@ -2,6 +2,8 @@ package server
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/kolah/eugene/internal/config"
 	"github.com/kolah/eugene/internal/golang"
@@ -34,6 +36,11 @@ func New(frameworkName string) (*Target, error) {
 	return &Target{framework: fw}, nil
 }
 
+// RoutePath returns the route pattern the framework registers for an OpenAPI path.
+func (t *Target) RoutePath(openAPIPath string) string {
+	return t.framework.ConvertPath(openAPIPath)
+}
+
 type serverFeatures struct {
 	HasStreaming      bool // any operation uses SSE
 	HasQueryString    bool // any operation uses querystring param (OpenAPI 3.2)
@@ -41,6 +48,8 @@ type serverFeatures struct {
 	HasCallbacks      bool // any operation defines callbacks
 	HasMultipart      bool // any operation uses multipart/form-data
 	HasFormUrlEncoded bool // any operation uses application/x-www-form-urlencoded
+	HasPartMediaTypes bool // any multipart file part restricts its content type
+	HasDelimitedForm  bool // any form array uses a non-exploded encoding style
 }
 
 type templateData struct {
@@ -48,16 +57,15 @@ type templateData struct {
 	Operations  []operationData
 	Framework   string
 	Tags        []tagData // OpenAPI 3.2: hierarchical tags
+	Routers     []routerData
+	HasUntagged bool // some operation has no tag and is registered outside the routers
 	Features    serverFeatures
 	Callbacks   []callbackData
 	UUIDImport  string
 	TimeImport  bool
-	InlineEnums []inlineEnumData
-}
 
-type inlineEnumData struct {
-	Name   string
-	Values []string
+	// HealthEndpoints adds the routes of health.eugene.go to the handlers
+	HealthEndpoints bool
 }
 
 type callbackData struct {
@@ -80,8 +88,17 @@ type tagData struct {
 	Children    []string
 }
 
+// routerData groups the operations sharing a first tag so chi can mount
+// them on their own.
+type routerData struct {
+	Name       string // PascalCase tag, prefixing the handler interface and mount function
+	Tag        string
+	Operations []operationData
+}
+
 type operationData struct {
 	ID               string
+	Router           string // routerData.Name of the operation's first tag, if any
 	Method           string
 	Path             string
 	FramePath        string
@@ -98,6 +115,9 @@ type operationData struct {
 	IsStreaming      bool
 	IsMultipart      bool
 	IsFormUrlEncoded bool
+	HasTypedPath     bool // a path parameter is not a plain string and is decoded by the binder
+	HasPrefix        bool   // served under the variables of the server URL, see prefix-variables
+	MaxBody          string // body limit passed to limitBody: MaxBodySize or x-oink-max-body
 }
 
 type streamingData struct {
@@ -110,6 +130,8 @@ type parameterData struct {
 	GoName      string
 	Required    bool
 	Type        string
+	Format      string // time layout for format: date parameters, read by echo's binder
+	AllowEmpty  bool   // allowEmptyValue: a query parameter sent empty is set to ""
 }
 
 type querystringData struct {
@@ -121,19 +143,24 @@ type querystringData struct {
 type requestBodyData struct {
 	Required        bool
 	MediaType       string
+	ContentType     string // Content-Type sent by callback clients
 	Type            string
+	IsText          bool // text/* callback body sent as a string
 	IsMultipart     bool
 	IsFormUrlEncoded bool
 	MultipartFields []multipartFieldData
 }
 
 type multipartFieldData struct {
-	Name     string
-	GoName   string
-	Type     string // "*multipart.FileHeader", "string", "[]string"
-	IsFile   bool
-	IsArray  bool
-	Required bool
+	Name        string
+	GoName      string
+	Type        string // "*multipart.FileHeader", "string", "[]string"
+	IsFile      bool
+	IsArray     bool
+	Required    bool
+	ContentType string   // allowed media types for the part (from encoding)
+	Headers     []string // encoding headers declared for the part
+	Delimiter   string   // separator for non-exploded form arrays
 }
 
 type responseData struct {
@@ -141,12 +168,21 @@ type responseData struct {
 	Type        string
 }
 
-func (t *Target) Generate(engine templates.Engine, spec *model.Spec, pkg string, cfg *config.TypesConfig, registry *golang.EnumRegistry) (string, error) {
+func (t *Target) Generate(engine templates.Engine, spec *model.Spec, pkg string, cfg *config.TypesConfig, opts *config.OutputOptions, registry *golang.EnumRegistry) (string, error) {
 	resolver := golang.NewTypeResolverWithRegistry(cfg, nil, registry)
 	data := templateData{
-		Package:    pkg,
-		Framework:  t.framework.Name(),
-		UUIDImport: resolver.UUIDImport(),
+		Package:         pkg,
+		Framework:       t.framework.Name(),
+		UUIDImport:      resolver.UUIDImport(),
+		HealthEndpoints: opts.HealthEndpoints,
+	}
+
+	var prefix string
+	if opts.PrefixVariables {
+		// validated by the generator
+		if p, _ := spec.ServerPathPrefix(); p != nil {
+			prefix = p.Path
+		}
 	}
 
 	for _, op := range spec.Operations {
@@ -154,10 +190,15 @@ func (t *Target) Generate(engine templates.Engine, spec *model.Spec, pkg string,
 			ID:          op.ID,
 			Method:      string(op.Method),
 			Path:        op.Path,
-			FramePath:   t.framework.ConvertPath(op.Path),
+			FramePath:   t.framework.ConvertPath(prefix + op.Path),
 			Summary:     op.Summary,
 			HasBody:     op.RequestBody != nil,
 			IsStreaming: op.Streaming != nil,
+			HasPrefix:   prefix != "",
+			MaxBody:     maxBody(op),
+		}
+		if len(op.Tags) > 0 {
+			opData.Router = golang.PascalCase(op.Tags[0])
 		}
 
 		if op.Streaming != nil {
@@ -168,12 +209,17 @@ func (t *Target) Generate(engine templates.Engine, spec *model.Spec, pkg string,
 		}
 
 		for _, p := range op.Parameters {
-			paramType := schemaToGoType(p.Schema, resolver, op.ID, p.Name)
+			paramType := resolver.ResolveParamType(p.Schema, op.ID, p.Name)
 			pd := parameterData{
 				Name:     p.Name,
 				GoName:   golang.PascalCase(p.Name),
 				Required: p.Required,
 				Type:     paramType,
+
+				AllowEmpty: p.AllowEmptyValue,
+			}
+			if paramType == "time.Time" && p.Schema != nil && p.Schema.Format == "date" {
+				pd.Format = time.DateOnly
 			}
 
 			switch p.In {
@@ -190,6 +236,7 @@ func (t *Target) Generate(engine templates.Engine, spec *model.Spec, pkg string,
 				data.Features.HasQueryParams = true
 			case model.LocationPath:
 				opData.Parameters = append(opData.Parameters, pd)
+				opData.HasTypedPath = opData.HasTypedPath || paramType != "string"
 			}
 		}
 
@@ -198,18 +245,28 @@ func (t *Target) Generate(engine templates.Engine, spec *model.Spec, pkg string,
 			if len(op.RequestBody.Content) > 0 {
 				content := op.RequestBody.Content[0]
 				rb.MediaType = content.MediaType
-				rb.Type = schemaToGoType(content.Schema, resolver, "", "")
+				rb.Type = resolver.ResolveParamType(content.Schema, "", "")
 
 				if content.MediaType == "multipart/form-data" {
 					rb.IsMultipart = true
 					opData.IsMultipart = true
 					data.Features.HasMultipart = true
-					rb.MultipartFields = extractMultipartFields(content.Schema, op.RequestBody.Required, resolver)
+					rb.MultipartFields = extractMultipartFields(content.Schema, content.Encoding, op.RequestBody.Required, resolver)
+					for _, f := range rb.MultipartFields {
+						if f.IsFile && f.ContentType != "" {
+							data.Features.HasPartMediaTypes = true
+						}
+					}
 				} else if content.MediaType == "application/x-www-form-urlencoded" {
 					rb.IsFormUrlEncoded = true
 					opData.IsFormUrlEncoded = true
 					data.Features.HasFormUrlEncoded = true
-					rb.MultipartFields = extractFormUrlEncodedFields(content.Schema, op.RequestBody.Required, resolver)
+					rb.MultipartFields = extractFormUrlEncodedFields(content.Schema, content.Encoding, op.RequestBody.Required, resolver)
+					for _, f := range rb.MultipartFields {
+						if f.Delimiter != "" {
+							data.Features.HasDelimitedForm = true
+						}
+					}
 				}
 			}
 			opData.RequestBody = rb
@@ -220,7 +277,7 @@ func (t *Target) Generate(engine templates.Engine, spec *model.Spec, pkg string,
 				StatusCode: r.StatusCode,
 			}
 			if len(r.Content) > 0 {
-				rd.Type = schemaToGoType(r.Content[0].Schema, resolver, "", "")
+				rd.Type = resolver.ResolveParamType(r.Content[0].Schema, "", "")
 			}
 			opData.Responses = append(opData.Responses, rd)
 		}
@@ -247,9 +304,15 @@ func (t *Target) Generate(engine templates.Engine, spec *model.Spec, pkg string,
 				}
 				if cbOp.RequestBody != nil && len(cbOp.RequestBody.Content) > 0 {
 					cbOpData.RequestBody = &requestBodyData{
-						Required:  cbOp.RequestBody.Required,
-						MediaType: cbOp.RequestBody.Content[0].MediaType,
-						Type:      schemaToGoType(cbOp.RequestBody.Content[0].Schema, resolver, "", ""),
+						Required:    cbOp.RequestBody.Required,
+						MediaType:   cbOp.RequestBody.Content[0].MediaType,
+						ContentType: model.JSONContentType(cbOp.RequestBody.Content[0].MediaType),
+						Type:        resolver.ResolveParamType(cbOp.RequestBody.Content[0].Schema, "", ""),
+					}
+					if mt := cbOp.RequestBody.Content[0].MediaType; model.IsTextMediaType(mt) {
+						cbOpData.RequestBody.IsText = true
+						cbOpData.RequestBody.ContentType = mt
+						cbOpData.RequestBody.Type = "string"
 					}
 				}
 				for _, r := range cbOp.Responses {
@@ -257,7 +320,7 @@ func (t *Target) Generate(engine templates.Engine, spec *model.Spec, pkg string,
 						StatusCode: r.StatusCode,
 					}
 					if len(r.Content) > 0 {
-						rd.Type = schemaToGoType(r.Content[0].Schema, resolver, "", "")
+						rd.Type = resolver.ResolveParamType(r.Content[0].Schema, "", "")
 					}
 					cbOpData.Responses = append(cbOpData.Responses, rd)
 				}
@@ -270,21 +333,9 @@ func (t *Target) Generate(engine templates.Engine, spec *model.Spec, pkg string,
 
 	// Build hierarchical tag data
 	data.Tags = buildTagData(spec.Tags)
-
-	// Collect nested types (inline enums) from resolver
-	for _, nested := range resolver.NestedTypes() {
-		if nested.IsEnum && nested.Schema != nil {
-			var values []string
-			for _, v := range nested.Schema.Enum {
-				if s, ok := v.(string); ok {
-					values = append(values, s)
-				}
-			}
-			data.InlineEnums = append(data.InlineEnums, inlineEnumData{
-				Name:   nested.Name,
-				Values: values,
-			})
-		}
+	data.Routers = buildRouters(spec.Operations, data.Operations)
+	for _, op := range data.Operations {
+		data.HasUntagged = data.HasUntagged || op.Router == ""
 	}
 
 	// Check if time import is needed
@@ -303,59 +354,24 @@ func (t *Target) Generate(engine templates.Engine, spec *model.Spec, pkg string,
 	return engine.Execute(t.framework.TemplateName(), data)
 }
 
-func schemaToGoType(s *model.Schema, resolver *golang.TypeResolver, operationID, paramName string) string {
-	if s == nil {
-		return "any"
-	}
-	if s.Ref != "" {
-		parts := splitRef(s.Ref)
-		if len(parts) > 0 {
-			return golang.PascalCase(parts[len(parts)-1])
-		}
-	}
-	// Handle inline enums - generate type name from operation+param
-	if len(s.Enum) > 0 && operationID != "" && paramName != "" {
-		return resolver.ResolveType(s, golang.PascalCase(operationID), paramName)
-	}
-	switch s.Type {
-	case model.TypeString:
-		return resolver.ResolveType(s, "", "")
-	case model.TypeInteger:
-		if s.Format == "int64" {
-			return "int64"
+// buildRouters groups operations by their first tag, in the order tags are
+// first used. Untagged operations belong to no router.
+func buildRouters(ops []model.Operation, opData []operationData) []routerData {
+	var routers []routerData
+	byName := make(map[string]int)
+	for i, od := range opData {
+		if od.Router == "" {
+			continue
 		}
-		if s.Format == "int32" {
-			return "int32"
+		idx, ok := byName[od.Router]
+		if !ok {
+			idx = len(routers)
+			byName[od.Router] = idx
+			routers = append(routers, routerData{Name: od.Router, Tag: ops[i].Tags[0]})
 		}
-		return "int"
-	case model.TypeNumber:
-		return "float64"
-	case model.TypeBoolean:
-		return "bool"
-	case model.TypeArray:
-		return "[]" + schemaToGoType(s.Items, resolver, "", "")
-	default:
-		return "any"
+		routers[idx].Operations = append(routers[idx].Operations, od)
 	}
-}
-
-func splitRef(ref string) []string {
-	var parts []string
-	current := ""
-	for _, c := range ref {
-		if c == '/' {
-			if current != "" {
-				parts = append(parts, current)
-			}
-			current = ""
-		} else {
-			current += string(c)
-		}
-	}
-	if current != "" {
-		parts = append(parts, current)
-	}
-	return parts
+	return routers
 }
 
 func buildTagData(tags []model.Tag) []tagData {
@@ -393,7 +409,7 @@ func buildTagData(tags []model.Tag) []tagData {
 	return result
 }
 
-func extractMultipartFields(schema *model.Schema, bodyRequired bool, resolver *golang.TypeResolver) []multipartFieldData {
+func extractMultipartFields(schema *model.Schema, encoding map[string]*model.Encoding, bodyRequired bool, resolver *golang.TypeResolver) []multipartFieldData {
 	if schema == nil {
 		return nil
 	}
@@ -430,13 +446,20 @@ func extractMultipartFields(schema *model.Schema, bodyRequired bool, resolver *g
 			field.Type = "string"
 		}
 
+		if enc := encoding[prop.Name]; enc != nil {
+			field.ContentType = enc.ContentType
+			for _, h := range enc.Headers {
+				field.Headers = append(field.Headers, h.Name)
+			}
+		}
+
 		fields = append(fields, field)
 	}
 
 	return fields
 }
 
-func extractFormUrlEncodedFields(schema *model.Schema, bodyRequired bool, resolver *golang.TypeResolver) []multipartFieldData {
+func extractFormUrlEncodedFields(schema *model.Schema, encoding map[string]*model.Encoding, bodyRequired bool, resolver *golang.TypeResolver) []multipartFieldData {
 	if schema == nil {
 		return nil
 	}
@@ -461,8 +484,21 @@ func extractFormUrlEncodedFields(schema *model.Schema, bodyRequired bool, resolv
 			field.Type = "string"
 		}
 
+		if enc := encoding[prop.Name]; enc != nil && field.IsArray {
+			field.Delimiter = golang.FormArrayDelimiter(enc.Style, enc.Explode)
+		}
+
 		fields = append(fields, field)
 	}
 
 	return fields
 }
+
+// maxBody returns the body limit of op that its handler passes to
+// limitBody.
+func maxBody(op model.Operation) string {
+	if op.MaxBody != nil {
+		return strconv.FormatInt(*op.MaxBody, 10)
+	}
+	return "MaxBodySize"
+}
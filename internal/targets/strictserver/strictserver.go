@@ -2,6 +2,9 @@ package strictserver
 
 import (
 	"fmt"
+	"net/textproto"
+	"reflect"
+	"strconv"
 	"strings"
 
 	"github.com/kolah/eugene/internal/config"
@@ -19,6 +22,20 @@ type Framework interface {
 
 type Target struct {
 	framework Framework
+
+	// data caches the template data of the last spec, package and types
+	// options, shared by GenerateTypes, GenerateAdapter and
+	// GenerateValidation
+	data    *templateData
+	dataKey dataKey
+}
+
+// dataKey identifies the inputs template data was built from.
+type dataKey struct {
+	spec     *model.Spec
+	pkg      string
+	cfg      config.TypesConfig
+	registry *golang.EnumRegistry
 }
 
 func New(frameworkName string) (*Target, error) {
@@ -37,23 +54,22 @@ func New(frameworkName string) (*Target, error) {
 }
 
 type templateData struct {
-	Package        string
-	Operations     []operationData
-	Framework      string
-	HasQueryParams bool
-	HasQueryString bool // OpenAPI 3.2: any operation uses in: querystring
-	UUIDImport     string
-	TimeImport     bool
-	InlineEnums    []inlineEnumData
-}
-
-type inlineEnumData struct {
-	Name   string
-	Values []string
+	Package           string
+	Operations        []operationData
+	Framework         string
+	HasQueryParams    bool
+	HasQueryString    bool // OpenAPI 3.2: any operation uses in: querystring
+	HasHeaderParams   bool
+	HasVariableStatus bool // any default or range (2XX) response
+	HasBinaryBody     bool // any application/octet-stream request body
+	UUIDImport        string
+	TimeImport        bool
+	HealthEndpoints   bool // RegisterStrictHandlers adds the routes of health.eugene.go
 }
 
 type operationData struct {
 	ID             string
+	OperationID    string // operationId as written in the spec
 	Method         string
 	Path           string
 	FramePath      string
@@ -66,6 +82,8 @@ type operationData struct {
 	RequestBody    *requestBodyData
 	Responses      []responseData
 	IsStreaming    bool
+	HasPrefix      bool   // served under the variables of the server URL, see prefix-variables
+	MaxBody        string // body limit passed to limitBody: MaxBodySize or x-oink-max-body
 }
 
 type querystringData struct {
@@ -75,20 +93,29 @@ type querystringData struct {
 }
 
 type parameterData struct {
-	Name     string
-	GoName   string
-	Type     string
-	Required bool
+	Name       string
+	GoName     string
+	Type       string
+	Required   bool
+	HeaderName string // canonical MIME header key, header parameters only
+	AllowEmpty bool   // allowEmptyValue: a query parameter sent empty is set to ""
 }
 
 type requestBodyData struct {
 	Required bool
 	Type     string
+	IsText   bool // text/* body read as a string
+	IsBinary bool // application/octet-stream body streamed as an io.Reader
 }
 
 type responseData struct {
-	StatusCode  string
-	Type        string
+	StatusCode string
+	Name       string // type name part: "200", "2XX" or "Default"
+	Class      int    // status class for range codes like 2XX, 0 otherwise
+	IsDefault  bool   // default response; the handler picks the status code
+	MediaType  string // Content-Type written for the response
+	Type       string
+	IsText     bool
 }
 
 func (t *Target) GenerateTypes(engine templates.Engine, spec *model.Spec, pkg string, cfg *config.TypesConfig, registry *golang.EnumRegistry) (string, error) {
@@ -96,35 +123,71 @@ func (t *Target) GenerateTypes(engine templates.Engine, spec *model.Spec, pkg st
 	return engine.Execute(t.framework.TypesTemplateName(), data)
 }
 
-func (t *Target) GenerateAdapter(engine templates.Engine, spec *model.Spec, pkg string, cfg *config.TypesConfig, registry *golang.EnumRegistry) (string, error) {
+func (t *Target) GenerateAdapter(engine templates.Engine, spec *model.Spec, pkg string, cfg *config.TypesConfig, opts *config.OutputOptions, registry *golang.EnumRegistry) (string, error) {
 	data := t.buildTemplateData(spec, pkg, cfg, registry)
+	data.HealthEndpoints = opts.HealthEndpoints
+	if opts.PrefixVariables {
+		// validated by the generator
+		if prefix, _ := spec.ServerPathPrefix(); prefix != nil {
+			ops := make([]operationData, len(data.Operations))
+			for i, op := range data.Operations {
+				op.FramePath = t.framework.ConvertPath(prefix.Path + op.Path)
+				op.HasPrefix = true
+				ops[i] = op
+			}
+			data.Operations = ops
+		}
+	}
 	return engine.Execute(t.framework.AdapterTemplateName(), data)
 }
 
+// buildTemplateData returns the template data of spec, built once for the
+// same spec, package, types options and registry. Its slices are shared
+// between calls, so a caller changing one copies it first, as
+// GenerateAdapter does.
 func (t *Target) buildTemplateData(spec *model.Spec, pkg string, cfg *config.TypesConfig, registry *golang.EnumRegistry) templateData {
+	key := dataKey{spec: spec, pkg: pkg, registry: registry}
+	if cfg != nil {
+		key.cfg = *cfg
+	}
+	if t.data == nil || !reflect.DeepEqual(t.dataKey, key) {
+		data := t.collectTemplateData(spec, pkg, cfg, registry)
+		t.data, t.dataKey = &data, key
+	}
+	return *t.data
+}
+
+func (t *Target) collectTemplateData(spec *model.Spec, pkg string, cfg *config.TypesConfig, registry *golang.EnumRegistry) templateData {
 	resolver := golang.NewTypeResolverWithRegistry(cfg, nil, registry)
 	var ops []operationData
 	hasQueryParams := false
 	hasQueryString := false
+	hasHeaderParams := false
+	hasVariableStatus := false
+	hasBinaryBody := false
 	timeImport := false
 
 	for _, op := range spec.Operations {
 		opData := operationData{
 			ID:          golang.PascalCase(op.ID),
+			OperationID: op.ID,
 			Method:      string(op.Method),
 			Path:        op.Path,
 			FramePath:   t.framework.ConvertPath(op.Path),
 			Summary:     op.Summary,
 			IsStreaming: op.Streaming != nil,
+			MaxBody:     maxBody(op),
 		}
 
 		for _, p := range op.Parameters {
-			paramType := schemaToGoType(p.Schema, resolver, op.ID, p.Name)
+			paramType := resolver.ResolveParamType(p.Schema, op.ID, p.Name)
 			pd := parameterData{
 				Name:     p.Name,
 				GoName:   golang.PascalCase(p.Name),
 				Type:     paramType,
 				Required: p.Required,
+
+				AllowEmpty: p.AllowEmptyValue,
 			}
 			if paramType == "time.Time" {
 				timeImport = true
@@ -137,7 +200,9 @@ func (t *Target) buildTemplateData(spec *model.Spec, pkg string, cfg *config.Typ
 				opData.QueryParams = append(opData.QueryParams, pd)
 				hasQueryParams = true
 			case model.LocationHeader:
+				pd.HeaderName = textproto.CanonicalMIMEHeaderKey(p.Name)
 				opData.HeaderParams = append(opData.HeaderParams, pd)
+				hasHeaderParams = true
 			case model.LocationQueryString:
 				opData.QueryString = &querystringData{
 					Name:   p.Name,
@@ -152,7 +217,16 @@ func (t *Target) buildTemplateData(spec *model.Spec, pkg string, cfg *config.Typ
 		if op.RequestBody != nil {
 			rb := &requestBodyData{Required: op.RequestBody.Required}
 			if len(op.RequestBody.Content) > 0 {
-				rb.Type = schemaToGoType(op.RequestBody.Content[0].Schema, resolver, "", "")
+				rb.Type = resolver.ResolveParamType(op.RequestBody.Content[0].Schema, "", "")
+				switch mt := op.RequestBody.Content[0].MediaType; {
+				case model.IsTextMediaType(mt):
+					rb.IsText = true
+					rb.Type = "string"
+				case model.IsBinaryMediaType(mt):
+					rb.IsBinary = true
+					rb.Type = "io.Reader"
+					hasBinaryBody = true
+				}
 			}
 			opData.RequestBody = rb
 		}
@@ -160,9 +234,19 @@ func (t *Target) buildTemplateData(spec *model.Spec, pkg string, cfg *config.Typ
 		for _, r := range op.Responses {
 			rd := responseData{
 				StatusCode: r.StatusCode,
+				Name:       golang.StatusCodeName(r.StatusCode),
+				Class:      golang.StatusCodeClass(r.StatusCode),
+				IsDefault:  r.StatusCode == "default",
 			}
+			hasVariableStatus = hasVariableStatus || rd.IsDefault || rd.Class != 0
 			if len(r.Content) > 0 {
-				rd.Type = schemaToGoType(r.Content[0].Schema, resolver, "", "")
+				rd.MediaType = model.JSONContentType(r.Content[0].MediaType)
+				rd.Type = resolver.ResolveParamType(r.Content[0].Schema, "", "")
+				if model.IsTextMediaType(r.Content[0].MediaType) {
+					rd.MediaType = r.Content[0].MediaType
+					rd.Type = "string"
+					rd.IsText = true
+				}
 			}
 			opData.Responses = append(opData.Responses, rd)
 		}
@@ -170,69 +254,17 @@ func (t *Target) buildTemplateData(spec *model.Spec, pkg string, cfg *config.Typ
 		ops = append(ops, opData)
 	}
 
-	// Collect inline enums from resolver
-	var inlineEnums []inlineEnumData
-	for _, nested := range resolver.NestedTypes() {
-		if nested.IsEnum && nested.Schema != nil {
-			var values []string
-			for _, v := range nested.Schema.Enum {
-				if s, ok := v.(string); ok {
-					values = append(values, s)
-				}
-			}
-			inlineEnums = append(inlineEnums, inlineEnumData{
-				Name:   nested.Name,
-				Values: values,
-			})
-		}
-	}
-
 	return templateData{
-		Package:        pkg,
-		Operations:     ops,
-		Framework:      t.framework.Name(),
-		HasQueryParams: hasQueryParams,
-		HasQueryString: hasQueryString,
-		UUIDImport:     resolver.UUIDImport(),
-		TimeImport:     timeImport,
-		InlineEnums:    inlineEnums,
-	}
-}
-
-
-func schemaToGoType(s *model.Schema, resolver *golang.TypeResolver, operationID, paramName string) string {
-	if s == nil {
-		return "any"
-	}
-	if s.Ref != "" {
-		parts := splitRef(s.Ref)
-		if len(parts) > 0 {
-			return golang.PascalCase(parts[len(parts)-1])
-		}
-	}
-	// Handle inline enums - generate type name from operation+param
-	if len(s.Enum) > 0 && operationID != "" && paramName != "" {
-		return resolver.ResolveType(s, golang.PascalCase(operationID), paramName)
-	}
-	switch s.Type {
-	case model.TypeString:
-		return resolver.ResolveType(s, "", "")
-	case model.TypeInteger:
-		if s.Format == "int64" {
-			return "int64"
-		}
-		if s.Format == "int32" {
-			return "int32"
-		}
-		return "int"
-	case model.TypeNumber:
-		return "float64"
-	case model.TypeBoolean:
-		return "bool"
-	case model.TypeArray:
-		return "[]" + schemaToGoType(s.Items, resolver, "", "")
-	default:
-		return "any"
+		Package:           pkg,
+		Operations:        ops,
+		Framework:         t.framework.Name(),
+		HasQueryParams:    hasQueryParams,
+		HasQueryString:    hasQueryString,
+		HasHeaderParams:   hasHeaderParams,
+		HasVariableStatus: hasVariableStatus,
+		HasBinaryBody:     hasBinaryBody,
+		UUIDImport:        resolver.UUIDImport(),
+		TimeImport:        timeImport,
 	}
 }
 
@@ -258,9 +290,9 @@ func splitRef(ref string) []string {
 // Echo Framework
 type EchoFramework struct{}
 
-func (f *EchoFramework) Name() string                      { return "echo" }
-func (f *EchoFramework) TypesTemplateName() string         { return "go/strict_types.tmpl" }
-func (f *EchoFramework) AdapterTemplateName() string       { return "go/server/strict_echo.tmpl" }
+func (f *EchoFramework) Name() string                { return "echo" }
+func (f *EchoFramework) TypesTemplateName() string   { return "go/strict_types.tmpl" }
+func (f *EchoFramework) AdapterTemplateName() string { return "go/server/strict_echo.tmpl" }
 func (f *EchoFramework) ConvertPath(path string) string {
 	// Convert {id} to :id
 	var result strings.Builder
@@ -279,15 +311,24 @@ func (f *EchoFramework) ConvertPath(path string) string {
 // Chi Framework
 type ChiFramework struct{}
 
-func (f *ChiFramework) Name() string                      { return "chi" }
-func (f *ChiFramework) TypesTemplateName() string         { return "go/strict_types.tmpl" }
-func (f *ChiFramework) AdapterTemplateName() string       { return "go/server/strict_chi.tmpl" }
-func (f *ChiFramework) ConvertPath(path string) string    { return path } // Chi uses {id} syntax
+func (f *ChiFramework) Name() string                   { return "chi" }
+func (f *ChiFramework) TypesTemplateName() string      { return "go/strict_types.tmpl" }
+func (f *ChiFramework) AdapterTemplateName() string    { return "go/server/strict_chi.tmpl" }
+func (f *ChiFramework) ConvertPath(path string) string { return path } // Chi uses {id} syntax
 
 // Stdlib Framework
 type StdlibFramework struct{}
 
-func (f *StdlibFramework) Name() string                      { return "stdlib" }
-func (f *StdlibFramework) TypesTemplateName() string         { return "go/strict_types.tmpl" }
-func (f *StdlibFramework) AdapterTemplateName() string       { return "go/server/strict_stdlib.tmpl" }
-func (f *StdlibFramework) ConvertPath(path string) string    { return path } // stdlib uses {id} syntax
+func (f *StdlibFramework) Name() string                   { return "stdlib" }
+func (f *StdlibFramework) TypesTemplateName() string      { return "go/strict_types.tmpl" }
+func (f *StdlibFramework) AdapterTemplateName() string    { return "go/server/strict_stdlib.tmpl" }
+func (f *StdlibFramework) ConvertPath(path string) string { return path } // stdlib uses {id} syntax
+
+// maxBody returns the body limit of op that its handler passes to
+// limitBody.
+func maxBody(op model.Operation) string {
+	if op.MaxBody != nil {
+		return strconv.FormatInt(*op.MaxBody, 10)
+	}
+	return "MaxBodySize"
+}
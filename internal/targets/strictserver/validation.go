@@ -0,0 +1,369 @@
+package strictserver
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/kolah/eugene/internal/config"
+	"github.com/kolah/eugene/internal/golang"
+	"github.com/kolah/eugene/internal/model"
+	"github.com/kolah/eugene/internal/templates"
+)
+
+type validationTemplateData struct {
+	Package    string
+	Operations []validationOpData
+	Patterns   []patternData
+	UsesSlices bool
+	UsesUTF8   bool
+	UsesRegexp bool
+}
+
+// validationOpData lists the checks run on one operation's request object.
+type validationOpData struct {
+	ID         string
+	Checks     []checkData
+	BodyChecks []checkData
+	BodyGuard  bool // body checks run only when the optional body was sent
+}
+
+// checkData validates one request value. Rules are Go conditions on the
+// value that report a failure when true.
+type checkData struct {
+	Field   string // quoted field label, like "query.limit" or "body.name"
+	Expr    string // Go expression of the value
+	Pointer bool   // optional value, checked only when set
+	Rules   []ruleData
+}
+
+type ruleData struct {
+	Cond    string
+	Message string // quoted
+}
+
+type patternData struct {
+	Name    string
+	Pattern string // quoted
+}
+
+// validationBuilder collects the checks of a spec and the helpers they use.
+type validationBuilder struct {
+	cfg      *config.TypesConfig
+	schemas  map[string]*model.Schema
+	out      *validationTemplateData
+	patterns map[string]string // variable name to the pattern it compiles
+	err      error
+}
+
+// validationTypes are the exported declarations of strict_validation.tmpl.
+var validationTypes = []string{"FieldError", "RequestValidationError", "NewValidatingStrictServer"}
+
+// GenerateValidation renders NewValidatingStrictServer, which checks
+// required bodies, enum membership and parameter and body constraints
+// before calling the handler and answers failures with a 400
+// RequestValidationError. Body checks cover the top-level fields of
+// object bodies.
+func (t *Target) GenerateValidation(engine templates.Engine, spec *model.Spec, pkg string, cfg *config.TypesConfig, registry *golang.EnumRegistry) (string, error) {
+	data := t.buildTemplateData(spec, pkg, cfg, registry)
+	out := validationTemplateData{Package: pkg}
+	b := &validationBuilder{
+		cfg:      cfg,
+		schemas:  make(map[string]*model.Schema, len(spec.Schemas)),
+		out:      &out,
+		patterns: make(map[string]string),
+	}
+	for i := range spec.Schemas {
+		s := &spec.Schemas[i]
+		b.schemas[s.Name] = s
+		// the declarations would not compile next to the schema's type
+		if name := golang.PascalCase(s.Name); slices.Contains(validationTypes, name) {
+			return "", fmt.Errorf("strict-validation: %s clashes with type %s of schema %s; rename the schema", name, name, s.Name)
+		}
+	}
+
+	for i, op := range spec.Operations {
+		if v := b.operation(op, data.Operations[i]); len(v.Checks)+len(v.BodyChecks) > 0 {
+			out.Operations = append(out.Operations, v)
+		}
+	}
+	if b.err != nil {
+		return "", b.err
+	}
+
+	return engine.Execute("go/strict_validation.tmpl", out)
+}
+
+func (b *validationBuilder) operation(op model.Operation, od operationData) validationOpData {
+	v := validationOpData{ID: od.ID}
+	params := make(map[string]model.Parameter, len(op.Parameters))
+	for _, p := range op.Parameters {
+		params[string(p.In)+"."+p.Name] = p
+	}
+
+	for _, p := range od.PathParams {
+		v.Checks = b.appendParam(v.Checks, od.ID, "path", p, params["path."+p.Name], true)
+	}
+	for _, p := range od.QueryParams {
+		v.Checks = b.appendParam(v.Checks, od.ID, "query", p, params["query."+p.Name], p.Required)
+	}
+	for _, p := range od.HeaderParams {
+		v.Checks = b.appendParam(v.Checks, od.ID, "header", p, params["header."+p.Name], p.Required)
+	}
+
+	if rb := od.RequestBody; rb != nil && op.RequestBody != nil && len(op.RequestBody.Content) > 0 {
+		switch {
+		case rb.IsBinary:
+			if rb.Required {
+				v.Checks = append(v.Checks, checkData{Field: strconv.Quote("body"), Expr: "request.ContentLength", Rules: []ruleData{requiredRule("request.ContentLength == 0")}})
+			}
+		case rb.IsText:
+			if rb.Required {
+				v.Checks = append(v.Checks, checkData{Field: strconv.Quote("body"), Expr: "request.Body", Rules: []ruleData{requiredRule(`request.Body == ""`)}})
+			}
+		default:
+			if rb.Required && (rb.Type == "any" || strings.HasPrefix(rb.Type, "[]") || strings.HasPrefix(rb.Type, "map[")) {
+				v.Checks = append(v.Checks, checkData{Field: strconv.Quote("body"), Expr: "request.Body", Rules: []ruleData{requiredRule("request.Body == nil")}})
+			}
+			v.BodyChecks = b.bodyChecks(od.ID, op.RequestBody.Content[0].Schema)
+			v.BodyGuard = !rb.Required
+		}
+	}
+	return v
+}
+
+func requiredRule(cond string) ruleData {
+	return ruleData{Cond: cond, Message: strconv.Quote("is required")}
+}
+
+// appendParam adds the checks of a path, query or header parameter.
+func (b *validationBuilder) appendParam(checks []checkData, opID, in string, p parameterData, param model.Parameter, required bool) []checkData {
+	s := b.resolve(param.Schema)
+	if s == nil {
+		return checks
+	}
+	c := checkData{
+		Field:   strconv.Quote(in + "." + p.Name),
+		Expr:    "request." + p.GoName,
+		Pointer: !required,
+	}
+	value := c.Expr
+	if c.Pointer {
+		value = "*v"
+	}
+	if required && in != "path" && p.Type == "string" {
+		c.Rules = append(c.Rules, requiredRule(value+` == ""`))
+	}
+	c.Rules = append(c.Rules, b.rules(s, value, golang.CamelCase(opID)+p.GoName)...)
+	if len(c.Rules) == 0 {
+		return checks
+	}
+	return append(checks, c)
+}
+
+// bodyChecks returns the checks of the top-level fields of an object body.
+// Bodies whose Go type is not a plain struct of the schema's properties are
+// not checked.
+func (b *validationBuilder) bodyChecks(opID string, body *model.Schema) []checkData {
+	if body == nil || body.Ref == "" {
+		return nil
+	}
+	s := b.resolve(body)
+	if s == nil || len(s.Properties) == 0 || len(s.AllOf)+len(s.OneOf)+len(s.AnyOf) > 0 {
+		return nil
+	}
+	if s.AdditionalProperties != nil && !golang.IsOrderedStruct(s) {
+		return nil
+	}
+	if golang.GoTypeWithExtension(s) != "" || golang.HasCustomJSON(s) || golang.IsRaw(s) || golang.IsEnvelope(s) || golang.IsOrderedMap(s) {
+		return nil
+	}
+
+	var checks []checkData
+	for _, prop := range s.Properties {
+		ps := prop.Schema
+		if ps == nil || golang.GoTypeWithExtension(ps) != "" || golang.HasCustomJSON(ps) || golang.IsJSONIgnored(ps) || golang.IsEmbedded(ps) || golang.IsRaw(ps) {
+			continue
+		}
+		pointer := golang.NeedsPointer(ps, s.Required)
+		if pointer && b.cfg != nil && b.cfg.NullableStrategy == "nullable" {
+			continue
+		}
+		goName := golang.GoNameWithExtension(ps, prop.Name)
+		c := checkData{
+			Field:   strconv.Quote("body." + jsonName(prop)),
+			Expr:    "request.Body." + goName,
+			Pointer: pointer,
+		}
+		value := c.Expr
+		if pointer {
+			value = "*v"
+		}
+		c.Rules = b.rules(b.resolve(ps), value, golang.CamelCase(opID)+"Body"+goName)
+		if len(c.Rules) > 0 {
+			checks = append(checks, c)
+		}
+	}
+	return checks
+}
+
+func jsonName(prop model.Property) string {
+	if prop.Schema != nil && prop.Schema.Extensions != nil && prop.Schema.Extensions.JSONName != "" {
+		return prop.Schema.Extensions.JSONName
+	}
+	return prop.Name
+}
+
+// rules returns the failure conditions of s applied to value. String rules
+// apply only to schemas generated as Go strings or string enums.
+func (b *validationBuilder) rules(s *model.Schema, value, name string) []ruleData {
+	if s == nil || golang.GoTypeWithExtension(s) != "" || golang.IsBitmask(s) {
+		return nil
+	}
+	var rules []ruleData
+	switch s.Type {
+	case model.TypeString:
+		if !isPlainString(s.Format) {
+			return nil
+		}
+		if values, ok := stringEnum(s.Enum); ok && b.cfg != nil && b.cfg.EnumStrategy != "struct" {
+			quoted := make([]string, len(values))
+			for i, v := range values {
+				quoted[i] = strconv.Quote(v)
+			}
+			rules = append(rules, ruleData{
+				Cond:    fmt.Sprintf("!slices.Contains([]string{%s}, string(%s))", strings.Join(quoted, ", "), value),
+				Message: strconv.Quote("must be one of " + strings.Join(values, ", ")),
+			})
+			b.out.UsesSlices = true
+		}
+		if s.MinLength != nil {
+			rules = append(rules, ruleData{
+				Cond:    fmt.Sprintf("utf8.RuneCountInString(string(%s)) < %d", value, *s.MinLength),
+				Message: strconv.Quote("must be at least " + count(*s.MinLength, "character")),
+			})
+			b.out.UsesUTF8 = true
+		}
+		if s.MaxLength != nil {
+			rules = append(rules, ruleData{
+				Cond:    fmt.Sprintf("utf8.RuneCountInString(string(%s)) > %d", value, *s.MaxLength),
+				Message: strconv.Quote("must be at most " + count(*s.MaxLength, "character")),
+			})
+			b.out.UsesUTF8 = true
+		}
+		if s.Pattern != "" {
+			if _, err := regexp.Compile(s.Pattern); err == nil {
+				rules = append(rules, ruleData{
+					Cond:    fmt.Sprintf("!%s.MatchString(string(%s))", b.pattern(name, s.Pattern), value),
+					Message: strconv.Quote("must match " + s.Pattern),
+				})
+			}
+		}
+	case model.TypeInteger, model.TypeNumber:
+		if len(s.Enum) > 0 && b.cfg != nil && b.cfg.EnumStrategy == "struct" {
+			return nil
+		}
+		if s.Minimum != nil {
+			op, msg := "<", ">="
+			if s.ExclusiveMinimum {
+				op, msg = "<=", ">"
+			}
+			bound := formatBound(*s.Minimum)
+			rules = append(rules, ruleData{
+				Cond:    fmt.Sprintf("float64(%s) %s %s", value, op, bound),
+				Message: strconv.Quote(fmt.Sprintf("must be %s %s", msg, bound)),
+			})
+		}
+		if s.Maximum != nil {
+			op, msg := ">", "<="
+			if s.ExclusiveMaximum {
+				op, msg = ">=", "<"
+			}
+			bound := formatBound(*s.Maximum)
+			rules = append(rules, ruleData{
+				Cond:    fmt.Sprintf("float64(%s) %s %s", value, op, bound),
+				Message: strconv.Quote(fmt.Sprintf("must be %s %s", msg, bound)),
+			})
+		}
+	case model.TypeArray:
+		if s.MinItems != nil {
+			rules = append(rules, ruleData{
+				Cond:    fmt.Sprintf("len(%s) < %d", value, *s.MinItems),
+				Message: strconv.Quote("must have at least " + count(*s.MinItems, "item")),
+			})
+		}
+		if s.MaxItems != nil {
+			rules = append(rules, ruleData{
+				Cond:    fmt.Sprintf("len(%s) > %d", value, *s.MaxItems),
+				Message: strconv.Quote("must have at most " + count(*s.MaxItems, "item")),
+			})
+		}
+	}
+	return rules
+}
+
+// pattern registers a package-level compiled regexp and returns its name.
+// Two values whose names run together, as a body field name and a
+// parameter bodyName of one operation do, may not use different patterns.
+func (b *validationBuilder) pattern(name, pattern string) string {
+	name += "Pattern"
+	prev, ok := b.patterns[name]
+	switch {
+	case !ok:
+		b.patterns[name] = pattern
+		b.out.Patterns = append(b.out.Patterns, patternData{Name: name, Pattern: strconv.Quote(pattern)})
+		b.out.UsesRegexp = true
+	case prev != pattern && b.err == nil:
+		b.err = fmt.Errorf("strict-validation: pattern variable %s would compile both %q and %q; rename the body field with x-oink-go-name", name, prev, pattern)
+	}
+	return name
+}
+
+func (b *validationBuilder) resolve(s *model.Schema) *model.Schema {
+	if s == nil || s.Ref == "" {
+		return s
+	}
+	parts := splitRef(s.Ref)
+	if len(parts) == 0 {
+		return nil
+	}
+	return b.schemas[parts[len(parts)-1]]
+}
+
+// isPlainString reports whether a string format is generated as a Go string.
+func isPlainString(format string) bool {
+	switch format {
+	case "date-time", "date", "uuid", "byte", "binary":
+		return false
+	}
+	return true
+}
+
+func stringEnum(values []any) ([]string, bool) {
+	if len(values) == 0 {
+		return nil, false
+	}
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		s, ok := v.(string)
+		if !ok {
+			return nil, false
+		}
+		out = append(out, s)
+	}
+	return out, true
+}
+
+// count formats n with word, pluralized unless n is 1.
+func count(n int64, word string) string {
+	if n == 1 {
+		return "1 " + word
+	}
+	return strconv.FormatInt(n, 10) + " " + word + "s"
+}
+
+func formatBound(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
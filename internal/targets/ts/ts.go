@@ -0,0 +1,235 @@
+// Package ts renders the TypeScript targets: types.ts with the component
+// schemas as interfaces and type aliases, and client.ts with a fetch-based
+// client whose methods mirror the operations of the Go client.
+package ts
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/kolah/eugene/internal/model"
+	"github.com/kolah/eugene/internal/templates"
+	"github.com/kolah/eugene/internal/typescript"
+)
+
+type Target struct{}
+
+func New() *Target {
+	return &Target{}
+}
+
+type typesData struct {
+	Title        string
+	Version      string
+	Declarations []declarationData
+}
+
+// declarationData is a component schema: an interface when it is a plain
+// object, a type alias otherwise.
+type declarationData struct {
+	Doc       string
+	Name      string
+	Interface bool
+	Type      string // object literal of an interface, aliased type otherwise
+}
+
+type clientData struct {
+	Title        string
+	ImportTypes  bool              // any operation refers to a component schema
+	Declarations []declarationData // parameters, and inline bodies and results, of the operations
+	Operations   []operationData
+	UsesQuery    bool // any operation sends query parameters or a form body
+	UsesForm     bool // any operation sends an application/x-www-form-urlencoded body
+}
+
+type operationData struct {
+	Doc            string
+	Name           string
+	Method         string
+	Path           string // template literal, with path parameters substituted
+	ParamsName     string // interface of the parameters, empty without any
+	ParamsOptional bool   // no parameter is required
+	Query          []paramData
+	Headers        []paramData
+	Body           *bodyData
+	Result         string // type the method resolves to
+	ResultKind     string // json, text, blob, response or empty for void
+}
+
+type paramData struct {
+	Name   string // as sent
+	Access string // expression reading it from params
+}
+
+type bodyData struct {
+	Type        string
+	ContentType string
+	Kind        string // json, text, blob, multipart or form
+	Required    bool
+}
+
+// GenerateTypes renders types.ts.
+func (t *Target) GenerateTypes(engine templates.Engine, spec *model.Spec) (string, error) {
+	data := typesData{Title: spec.Info.Title, Version: spec.Info.Version}
+	types := &typescript.Types{}
+	for i := range spec.Schemas {
+		s := &spec.Schemas[i]
+		decl := declarationData{
+			Doc:  typescript.Doc(s.Description, s.Deprecated),
+			Name: typescript.TypeName(s.Name),
+		}
+		if isInterface(s) {
+			decl.Interface = true
+			decl.Type = types.ObjectType(s, 0)
+		} else {
+			decl.Type = types.Type(s)
+		}
+		data.Declarations = append(data.Declarations, decl)
+	}
+	return engine.Execute("ts/types.tmpl", data)
+}
+
+// isInterface reports whether s declares an object shape an interface can
+// hold on its own.
+func isInterface(s *model.Schema) bool {
+	return s.Type == model.TypeObject && len(s.Properties) > 0 && !s.Nullable &&
+		s.Ref == "" && len(s.Enum) == 0 && len(s.AllOf)+len(s.OneOf)+len(s.AnyOf) == 0
+}
+
+// GenerateClient renders client.ts, which imports the types of types.ts.
+func (t *Target) GenerateClient(engine templates.Engine, spec *model.Spec) (string, error) {
+	data := clientData{Title: spec.Info.Title}
+	types := &typescript.Types{Qualifier: "types."}
+	for _, op := range spec.Operations {
+		od := operationData{
+			Doc:    typescript.Doc(operationDoc(op), op.Deprecated),
+			Name:   typescript.MemberName(op.ID),
+			Method: string(op.Method),
+			Path:   op.Path,
+		}
+
+		var params []model.Property
+		required := false
+		for _, p := range op.Parameters {
+			access := "params." + p.Name
+			if typescript.PropertyName(p.Name) != p.Name {
+				access = "params[" + typescript.String(p.Name) + "]"
+			}
+			switch p.In {
+			case model.LocationPath:
+				od.Path = strings.ReplaceAll(od.Path, "{"+p.Name+"}", "${encodeURIComponent(String("+access+"))}")
+			case model.LocationQuery:
+				od.Query = append(od.Query, paramData{Name: p.Name, Access: access})
+			case model.LocationHeader:
+				od.Headers = append(od.Headers, paramData{Name: p.Name, Access: access})
+			default:
+				continue // browsers own the Cookie header
+			}
+			var schema model.Schema
+			if p.Schema != nil {
+				schema = *p.Schema
+			}
+			schema.Description = p.Description
+			schema.Deprecated = schema.Deprecated || p.Deprecated
+			params = append(params, model.Property{Name: p.Name, Schema: &schema})
+			required = required || p.Required || p.In == model.LocationPath
+		}
+		if len(params) > 0 {
+			obj := &model.Schema{Type: model.TypeObject, Properties: params}
+			for _, p := range op.Parameters {
+				if p.Required || p.In == model.LocationPath {
+					obj.Required = append(obj.Required, p.Name)
+				}
+			}
+			od.ParamsName = typescript.TypeName(op.ID) + "Params"
+			od.ParamsOptional = !required
+			data.Declarations = append(data.Declarations, declarationData{
+				Name:      od.ParamsName,
+				Interface: true,
+				Type:      types.ObjectType(obj, 0),
+			})
+		}
+
+		if op.RequestBody != nil && len(op.RequestBody.Content) > 0 {
+			od.Body = requestBody(types, op.RequestBody)
+			od.Body.Type = data.declare(typescript.TypeName(op.ID)+"Body", od.Body.Type)
+			data.UsesForm = data.UsesForm || od.Body.Kind == "form"
+		}
+		od.Result, od.ResultKind = result(types, op)
+		od.Result = data.declare(typescript.TypeName(op.ID)+"Result", od.Result)
+
+		data.UsesQuery = data.UsesQuery || len(od.Query) > 0 || data.UsesForm
+		data.Operations = append(data.Operations, od)
+	}
+	data.ImportTypes = types.Used()
+	return engine.Execute("ts/client.tmpl", data)
+}
+
+// declare returns typ, or the name of an alias declared for it when it is an
+// object literal spanning lines, which would not read well inline.
+func (d *clientData) declare(name, typ string) string {
+	if !strings.Contains(typ, "\n") {
+		return typ
+	}
+	d.Declarations = append(d.Declarations, declarationData{Name: name, Type: typ})
+	return name
+}
+
+func operationDoc(op model.Operation) string {
+	switch {
+	case op.Summary != "" && op.Description != "":
+		return op.Summary + "\n\n" + op.Description
+	case op.Summary != "":
+		return op.Summary
+	}
+	return op.Description
+}
+
+// requestBody returns how the first media type of body is sent.
+func requestBody(types *typescript.Types, body *model.RequestBody) *bodyData {
+	content := body.Content[0]
+	b := &bodyData{ContentType: content.MediaType, Required: body.Required}
+	mediaType := strings.ToLower(content.MediaType)
+	switch {
+	case model.IsJSONMediaType(mediaType):
+		b.Kind, b.Type = "json", types.Type(content.Schema)
+	case strings.HasPrefix(mediaType, "multipart/"):
+		// fetch writes the boundary into the Content-Type itself
+		b.Kind, b.Type, b.ContentType = "multipart", "FormData", ""
+	case strings.HasPrefix(mediaType, "application/x-www-form-urlencoded"):
+		b.Kind, b.Type = "form", types.Type(content.Schema)
+	case model.IsTextMediaType(mediaType):
+		b.Kind, b.Type = "text", "string"
+	default:
+		b.Kind, b.Type = "blob", "Blob"
+	}
+	return b
+}
+
+var successStatus = regexp.MustCompile(`^2[0-9X]{2}$`)
+
+// result returns the type the method of op resolves to, read from its first
+// successful response, and how the body is read.
+func result(types *typescript.Types, op model.Operation) (string, string) {
+	if op.Streaming != nil {
+		return "Response", "response" // the caller reads the event stream
+	}
+	for _, r := range op.Responses {
+		if !successStatus.MatchString(strings.ToUpper(r.StatusCode)) {
+			continue
+		}
+		if len(r.Content) == 0 {
+			return "void", ""
+		}
+		content := r.Content[0]
+		switch {
+		case model.IsJSONMediaType(content.MediaType):
+			return types.Type(content.Schema), "json"
+		case model.IsTextMediaType(content.MediaType):
+			return "string", "text"
+		default:
+			return "Blob", "blob"
+		}
+	}
+	return "void", ""
+}
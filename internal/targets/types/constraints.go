@@ -0,0 +1,127 @@
+package types
+
+import (
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strconv"
+
+	"github.com/kolah/eugene/internal/golang"
+	"github.com/kolah/eugene/internal/model"
+	"github.com/kolah/eugene/internal/templates"
+)
+
+type constraintsData struct {
+	Package   string
+	Constants []constraintGroup
+	Patterns  []constraintValue
+}
+
+// constraintGroup holds the constants of one schema or property.
+type constraintGroup struct {
+	Path   string // spec location, like Pet or Pet.name
+	Values []constraintValue
+}
+
+type constraintValue struct {
+	Path  string
+	Name  string
+	Value string // Go literal
+}
+
+// GenerateConstraints renders a constant for each length, bound and size
+// constraint of the component schemas and their properties, and a compiled
+// regexp for each pattern, named after the type and field they constrain:
+// PetNameMaxLength, PetNamePattern. It returns an empty string when no
+// schema declares a constraint.
+func (t *Target) GenerateConstraints(engine templates.Engine, spec *model.Spec, pkg string) (string, error) {
+	data := constraintsData{Package: pkg}
+	for i := range spec.Schemas {
+		s := &spec.Schemas[i]
+		typeName := golang.PascalCase(s.Name)
+		data.addConstraints(s.Name, typeName, s)
+		for _, prop := range s.Properties {
+			if prop.Schema == nil || prop.Schema.Ref != "" {
+				continue // a referenced schema has constants of its own
+			}
+			name := typeName + golang.GoNameWithExtension(prop.Schema, prop.Name)
+			data.addConstraints(s.Name+"."+prop.Name, name, prop.Schema)
+		}
+	}
+	if len(data.Constants)+len(data.Patterns) == 0 {
+		return "", nil
+	}
+	if err := data.checkNames(spec); err != nil {
+		return "", err
+	}
+
+	return engine.Execute("go/constraints.tmpl", data)
+}
+
+// checkNames reports a constant named like another constant or a schema
+// type, as a property Pet.name and a schema PetName both with a maxLength
+// would be, since the file would not compile.
+func (d *constraintsData) checkNames(spec *model.Spec) error {
+	declared := make(map[string]string) // Go name to what declares it
+	for i := range spec.Schemas {
+		declared[golang.PascalCase(spec.Schemas[i].Name)] = "type " + golang.PascalCase(spec.Schemas[i].Name)
+	}
+	check := func(path string, v constraintValue) error {
+		if prev, ok := declared[v.Name]; ok {
+			return fmt.Errorf("constraint constant %s of %s clashes with %s; rename the property with x-oink-go-name", v.Name, path, prev)
+		}
+		declared[v.Name] = "the constant of " + path
+		return nil
+	}
+	for _, g := range d.Constants {
+		for _, v := range g.Values {
+			if err := check(g.Path, v); err != nil {
+				return err
+			}
+		}
+	}
+	for _, v := range d.Patterns {
+		if err := check(v.Path, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *constraintsData) addConstraints(path, name string, s *model.Schema) {
+	g := constraintGroup{Path: path}
+	addInt := func(suffix string, v *int64) {
+		if v != nil {
+			g.Values = append(g.Values, constraintValue{Name: name + suffix, Value: strconv.FormatInt(*v, 10)})
+		}
+	}
+	addBound := func(suffix string, v *float64, exclusive bool) {
+		if v == nil {
+			return
+		}
+		if exclusive {
+			suffix = "Exclusive" + suffix
+		}
+		g.Values = append(g.Values, constraintValue{Name: name + suffix, Value: strconv.FormatFloat(*v, 'f', -1, 64)})
+	}
+
+	addInt("MinLength", s.MinLength)
+	addInt("MaxLength", s.MaxLength)
+	addBound("Minimum", s.Minimum, s.ExclusiveMinimum)
+	addBound("Maximum", s.Maximum, s.ExclusiveMaximum)
+	addInt("MinItems", s.MinItems)
+	addInt("MaxItems", s.MaxItems)
+	addInt("MinProperties", s.MinProperties)
+	addInt("MaxProperties", s.MaxProperties)
+	if len(g.Values) > 0 {
+		d.Constants = append(d.Constants, g)
+	}
+
+	if s.Pattern != "" {
+		if _, err := regexp.Compile(s.Pattern); err != nil {
+			slog.Warn("skipping pattern constant, Go regexp cannot compile it", "schema", path, "pattern", s.Pattern, "error", err)
+			return
+		}
+		d.Patterns = append(d.Patterns, constraintValue{Path: path, Name: name + "Pattern", Value: strconv.Quote(s.Pattern)})
+	}
+}
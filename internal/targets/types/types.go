@@ -17,12 +17,20 @@ type templateData struct {
 	Package          string
 	Schemas          []model.Schema
 	NestedTypes      []golang.ResolvedType
+	ParameterEnums   []golang.ResolvedType // inline enums of operation parameters
 	NeedsTime        bool
 	NeedsJSON        bool
+	StructEnums      bool          // struct enums need cmp for Compare
+	OrderedMaps      bool          // x-oink-ordered maps need the OrderedMap helper
+	Envelope         *model.Schema // first x-oink-envelope schema, the shape of Envelope[T]
 	UUIDImport       string
 	EnumStrategy     string
+	EnumUnknown      string
+	UnknownFields    map[string]*unknownFieldsType // structs rejecting or capturing unknown members
+	CaptureUnknown   bool                          // unknown-fields: capture needs the marshal helper
 	UseNullable      bool
 	EnableYAMLTags   bool
+	FieldProvenance  bool // comment fields with the schema declaring them
 	ExtensionImports []model.GoTypeImport
 	MappedImports    []string
 }
@@ -36,12 +44,25 @@ func (t *Target) Generate(engine templates.Engine, spec *model.Spec, pkg string,
 	// Process all schemas to resolve types and collect nested types
 	for _, s := range spec.Schemas {
 		schema := s
-		if len(schema.OneOf) > 0 || len(schema.AnyOf) > 0 || len(schema.AllOf) > 0 {
-			resolver.ResolveType(&schema, "", schema.Name)
-			continue
+		resolver.ResolveSchema(&schema)
+	}
+
+	// Inline enums of operation parameters are declared here too, so the
+	// server targets reference them through the registry rather than
+	// declaring their own. They are plain string types whatever the enum
+	// strategy, since the servers convert parameter values to them.
+	paramResolver := golang.NewTypeResolverWithSchemaLookup(cfg, importMapping, registry, schemaLookup)
+	for _, op := range spec.Operations {
+		for _, p := range op.Parameters {
+			if golang.IsParameterEnum(p.Schema) {
+				paramResolver.ResolveType(p.Schema, golang.PascalCase(op.ID), p.Name)
+			}
 		}
-		for _, prop := range schema.Properties {
-			resolver.ResolveType(prop.Schema, schema.Name, prop.Name)
+	}
+	var parameterEnums []golang.ResolvedType
+	for _, nested := range paramResolver.NestedTypes() {
+		if nested.IsEnum {
+			parameterEnums = append(parameterEnums, nested)
 		}
 	}
 
@@ -68,18 +89,56 @@ func (t *Target) Generate(engine templates.Engine, spec *model.Spec, pkg string,
 		enumStrategy = cfg.EnumStrategy
 	}
 
-	// struct enum strategy needs JSON for marshal/unmarshal
-	if enumStrategy == "struct" {
-		for _, s := range spec.Schemas {
-			if len(s.Enum) > 0 {
-				needsJSON = true
-				break
-			}
+	enumUnknown := "passthrough"
+	if cfg != nil && cfg.EnumUnknown != "" {
+		enumUnknown = cfg.EnumUnknown
+	}
+
+	hasEnums := false
+	for _, s := range spec.Schemas {
+		if len(s.Enum) > 0 {
+			hasEnums = true
+			break
+		}
+	}
+	for _, nested := range resolver.NestedTypes() {
+		if nested.IsEnum {
+			hasEnums = true
+			break
+		}
+	}
+	// struct enum strategy needs JSON for marshal/unmarshal, and so do
+	// enums that check values on unmarshal
+	structEnums := hasEnums && enumStrategy == "struct"
+	if structEnums || hasEnums && enumUnknown != "passthrough" || hasBitmask(spec.Schemas, resolver.NestedTypes()) {
+		needsJSON = true
+	}
+
+	var unknownPolicy string
+	if cfg != nil {
+		unknownPolicy = cfg.UnknownFields
+	}
+	unknownFields := unknownFieldsTypes(unknownPolicy, spec, resolver.NestedTypes())
+	if len(unknownFields) > 0 {
+		needsJSON = true
+	}
+
+	orderedMaps := golang.UsesOrderedMap(spec.Schemas)
+	if orderedMaps || golang.UsesRawJSON(spec.Schemas) {
+		needsJSON = true
+	}
+
+	var envelope *model.Schema
+	for i := range spec.Schemas {
+		if golang.IsEnvelope(&spec.Schemas[i]) {
+			envelope = &spec.Schemas[i]
+			break
 		}
 	}
 
 	useNullable := cfg != nil && cfg.NullableStrategy == "nullable"
 	enableYAMLTags := opts != nil && opts.EnableYAMLTags
+	fieldProvenance := opts != nil && opts.FieldProvenance
 
 	// Collect custom imports from x-oink-go-type-import extensions
 	extensionImports := golang.CollectExtensionImports(spec.Schemas)
@@ -88,15 +147,68 @@ func (t *Target) Generate(engine templates.Engine, spec *model.Spec, pkg string,
 		Package:          pkg,
 		Schemas:          spec.Schemas,
 		NestedTypes:      resolver.NestedTypes(),
+		ParameterEnums:   parameterEnums,
 		NeedsTime:        needsTime,
 		NeedsJSON:        needsJSON,
+		StructEnums:      structEnums,
+		OrderedMaps:      orderedMaps,
+		Envelope:         envelope,
 		UUIDImport:       resolver.UUIDImport(),
 		EnumStrategy:     enumStrategy,
+		EnumUnknown:      enumUnknown,
+		UnknownFields:    unknownFields,
+		CaptureUnknown:   len(unknownFields) > 0 && unknownPolicy == "capture",
 		UseNullable:      useNullable,
 		EnableYAMLTags:   enableYAMLTags,
+		FieldProvenance:  fieldProvenance,
 		ExtensionImports: extensionImports,
 		MappedImports:    resolver.MappedImports(),
 	}
 
 	return engine.Execute("go/types.tmpl", data)
 }
+
+type marshalData struct {
+	Package string
+	Types   []marshalType
+}
+
+type marshalType struct {
+	Name string
+	Mode string
+}
+
+// GenerateMarshal renders the companion file with marshaler stubs for schemas
+// using x-oink-marshal. It returns an empty string when no schema needs one.
+func (t *Target) GenerateMarshal(engine templates.Engine, spec *model.Spec, pkg string) (string, error) {
+	var types []marshalType
+	for i := range spec.Schemas {
+		s := &spec.Schemas[i]
+		mode := golang.MarshalMode(s)
+		if mode == "" {
+			continue
+		}
+		types = append(types, marshalType{Name: golang.PascalCase(s.Name), Mode: mode})
+	}
+	if len(types) == 0 {
+		return "", nil
+	}
+
+	return engine.Execute("go/marshal.tmpl", marshalData{Package: pkg, Types: types})
+}
+
+// hasBitmask reports whether any enum is generated as bit flags, which
+// marshal to JSON arrays of names.
+func hasBitmask(schemas []model.Schema, nested []golang.ResolvedType) bool {
+	for i := range schemas {
+		if golang.IsBitmask(&schemas[i]) {
+			return true
+		}
+	}
+	for _, n := range nested {
+		if n.IsEnum && golang.IsBitmask(n.Schema) {
+			return true
+		}
+	}
+	return false
+}
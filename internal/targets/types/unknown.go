@@ -0,0 +1,138 @@
+package types
+
+import (
+	"slices"
+
+	"github.com/kolah/eugene/internal/golang"
+	"github.com/kolah/eugene/internal/model"
+)
+
+// unknownFieldsType describes the JSON methods a struct gets under the
+// unknown-fields policy.
+type unknownFieldsType struct {
+	Known  []string // JSON names the struct decodes, including embedded ones
+	Embeds bool     // embeds a type whose JSON methods must not be promoted
+	Extra  string   // name of the capture field, empty unless capturing
+}
+
+// unknownFieldsTypes returns the structs that reject or capture unknown JSON
+// members under policy, keyed by Go type name. Types with their own JSON
+// handling (enums, x-oink-ordered, x-oink-raw, x-oink-marshal, x-oink-go-type
+// and envelopes) and free-form objects are left alone, and with the error
+// policy so are structs that declare or embed additionalProperties.
+func unknownFieldsTypes(policy string, spec *model.Spec, nested []golang.ResolvedType) map[string]*unknownFieldsType {
+	if policy != "error" && policy != "capture" {
+		return nil
+	}
+	types := make(map[string]*unknownFieldsType)
+	add := func(name string, s *model.Schema) {
+		if len(s.Enum) > 0 || golang.GoTypeWithExtension(s) != "" || golang.MarshalMode(s) != "" ||
+			golang.IsOrderedStruct(s) || golang.IsRaw(s) || golang.IsEnvelope(s) {
+			return
+		}
+		schemas := embeddedSchemas(spec, s, map[string]bool{})
+		var known []string
+		for _, es := range schemas {
+			if policy == "error" && es.AdditionalProperties != nil {
+				return
+			}
+			for _, prop := range es.Properties {
+				name := golang.JSONName(prop)
+				if !golang.IsJSONIgnored(prop.Schema) && !golang.IsEmbedded(prop.Schema) && !slices.Contains(known, name) {
+					known = append(known, name)
+				}
+			}
+		}
+		if len(known) == 0 {
+			return
+		}
+		t := unknownFieldsType{Known: known, Embeds: embeds(s)}
+		if policy == "capture" {
+			t.Extra = extraField(s)
+		}
+		types[name] = &t
+	}
+
+	for i := range spec.Schemas {
+		s := &spec.Schemas[i]
+		if s.Type != model.TypeObject || len(s.OneOf) > 0 || len(s.AnyOf) > 0 || len(s.AllOf) > 0 {
+			continue
+		}
+		add(golang.PascalCase(s.Name), s)
+	}
+	for _, n := range nested {
+		if n.IsUnion || n.IsEnum || n.Schema == nil {
+			continue
+		}
+		add(n.Name, n.Schema)
+	}
+
+	// a variant need not declare the discriminator the union dispatches on
+	for _, n := range nested {
+		if !n.IsUnion || n.Discriminator == nil {
+			continue
+		}
+		for _, v := range n.Variants {
+			if t := types[v.TypeName]; t != nil && !slices.Contains(t.Known, n.Discriminator.PropertyName) {
+				t.Known = append(t.Known, n.Discriminator.PropertyName)
+			}
+		}
+	}
+	return types
+}
+
+// embeddedSchemas returns s followed by the schemas it is composed of: its
+// inline allOf members and, recursively, the schemas it embeds through an
+// allOf $ref or x-oink-embed.
+func embeddedSchemas(spec *model.Spec, s *model.Schema, visiting map[string]bool) []*model.Schema {
+	schemas := []*model.Schema{s}
+	embedded := func(ref string) {
+		if visiting[ref] {
+			return
+		}
+		if target := spec.SchemaByRef(ref); target != nil {
+			visiting[ref] = true
+			schemas = append(schemas, embeddedSchemas(spec, target, visiting)...)
+			delete(visiting, ref)
+		}
+	}
+	for _, sub := range s.AllOf {
+		if sub.Ref != "" {
+			embedded(sub.Ref)
+		} else {
+			schemas = append(schemas, embeddedSchemas(spec, sub, visiting)...)
+		}
+	}
+	for _, prop := range s.Properties {
+		if golang.IsEmbedded(prop.Schema) {
+			embedded(prop.Schema.Ref)
+		}
+	}
+	return schemas
+}
+
+func embeds(s *model.Schema) bool {
+	for _, sub := range s.AllOf {
+		if sub.Ref != "" {
+			return true
+		}
+	}
+	return slices.ContainsFunc(s.Properties, func(prop model.Property) bool {
+		return golang.IsEmbedded(prop.Schema)
+	})
+}
+
+// extraField names the field capturing unknown members: Extra, with a
+// trailing underscore for each property already taking the name.
+func extraField(s *model.Schema) string {
+	taken := func(name string) bool {
+		return slices.ContainsFunc(s.Properties, func(prop model.Property) bool {
+			return golang.GoNameWithExtension(prop.Schema, prop.Name) == name
+		})
+	}
+	name := "Extra"
+	for taken(name) {
+		name += "_"
+	}
+	return name
+}
@@ -3,30 +3,53 @@ package templates
 import (
 	"bytes"
 	"embed"
+	"encoding/json"
 	"fmt"
 	"io/fs"
+	"log/slog"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
 	"text/template"
 )
 
+// EmbeddedPrefix names the embedded version of a template, or of a block
+// defined in one, when a custom template overrides it.
+const EmbeddedPrefix = "embedded/"
+
 type Engine interface {
 	Execute(name string, data any) (string, error)
 }
 
+// Options configures where custom templates come from and how templates are
+// parsed and executed.
+type Options struct {
+	// CustomDir holds templates that override or add to the embedded ones.
+	CustomDir string
+	// MissingKey is the missingkey option of text/template, applied to every
+	// template: default, zero or error.
+	MissingKey string
+	// LeftDelim and RightDelim replace {{ and }} in the custom templates, for
+	// templates whose output contains them. Embedded templates keep {{ }}.
+	LeftDelim  string
+	RightDelim string
+}
+
 type TextTemplateEngine struct {
 	templates *template.Template
 	funcs     template.FuncMap
 	embedded  embed.FS
-	customDir string
+	opts      Options
+	debugDir  string
 }
 
-func NewEngine(embedded embed.FS, customDir string, funcs template.FuncMap) (*TextTemplateEngine, error) {
+func NewEngine(embedded embed.FS, opts Options, funcs template.FuncMap) (*TextTemplateEngine, error) {
 	e := &TextTemplateEngine{
-		embedded:  embedded,
-		customDir: customDir,
-		funcs:     funcs,
+		embedded: embedded,
+		opts:     opts,
+		funcs:    funcs,
 	}
 	if err := e.load(); err != nil {
 		return nil, err
@@ -35,7 +58,10 @@ func NewEngine(embedded embed.FS, customDir string, funcs template.FuncMap) (*Te
 }
 
 func (e *TextTemplateEngine) load() error {
-	e.templates = template.New("").Funcs(e.funcs)
+	e.templates = template.New("").Funcs(Funcs()).Funcs(e.funcs)
+	if e.opts.MissingKey != "" {
+		e.templates.Option("missingkey=" + e.opts.MissingKey)
+	}
 
 	err := fs.WalkDir(e.embedded, ".", func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
@@ -59,8 +85,19 @@ func (e *TextTemplateEngine) load() error {
 		return fmt.Errorf("loading embedded templates: %w", err)
 	}
 
-	if e.customDir != "" {
-		err = filepath.WalkDir(e.customDir, func(path string, d fs.DirEntry, err error) error {
+	// Every embedded template and block stays reachable as embedded/<name>,
+	// so a custom template that overrides one can still include the original
+	for _, tmpl := range e.templates.Templates() {
+		if tmpl.Tree == nil || tmpl.Name() == "" {
+			continue
+		}
+		if _, err := e.templates.AddParseTree(EmbeddedPrefix+tmpl.Name(), tmpl.Tree); err != nil {
+			return fmt.Errorf("aliasing embedded template %s: %w", tmpl.Name(), err)
+		}
+	}
+
+	if e.opts.CustomDir != "" {
+		err = filepath.WalkDir(e.opts.CustomDir, func(path string, d fs.DirEntry, err error) error {
 			if err != nil {
 				return err
 			}
@@ -71,8 +108,8 @@ func (e *TextTemplateEngine) load() error {
 			if err != nil {
 				return fmt.Errorf("reading custom template %s: %w", path, err)
 			}
-			relPath, _ := filepath.Rel(e.customDir, path)
-			_, err = e.templates.New(relPath).Parse(string(content))
+			relPath, _ := filepath.Rel(e.opts.CustomDir, path)
+			_, err = e.templates.New(relPath).Delims(e.opts.LeftDelim, e.opts.RightDelim).Parse(string(content))
 			if err != nil {
 				return fmt.Errorf("parsing custom template %s: %w", path, err)
 			}
@@ -92,6 +129,10 @@ func (e *TextTemplateEngine) Execute(name string, data any) (string, error) {
 		return "", fmt.Errorf("template not found: %s", name)
 	}
 
+	if e.debugDir != "" {
+		e.dumpData(name, data)
+	}
+
 	var buf bytes.Buffer
 	if err := tmpl.Execute(&buf, data); err != nil {
 		return "", fmt.Errorf("executing template %s: %w", name, err)
@@ -99,3 +140,46 @@ func (e *TextTemplateEngine) Execute(name string, data any) (string, error) {
 
 	return buf.String(), nil
 }
+
+// SetDebugDir makes Execute write the data it passes to each template into
+// dir as JSON, named after the template: the first execution of
+// go/types.tmpl is dumped to go/types.tmpl.json, later ones in the same run,
+// such as for other packages, to go/types.tmpl.2.json and so on.
+func (e *TextTemplateEngine) SetDebugDir(dir string) {
+	e.debugDir = dir
+}
+
+// dumped counts the dumps of each debug file path in this run, across
+// engines, so that the engines of several packages do not overwrite each
+// other's dumps.
+var dumped = struct {
+	sync.Mutex
+	count map[string]int
+}{count: make(map[string]int)}
+
+// dumpData writes the data of one template execution into the debug
+// directory. Failing to is logged rather than failing generation.
+func (e *TextTemplateEngine) dumpData(name string, data any) {
+	base := filepath.Join(e.debugDir, filepath.FromSlash(name))
+	dumped.Lock()
+	dumped.count[base]++
+	n := dumped.count[base]
+	dumped.Unlock()
+	path := base + ".json"
+	if n > 1 {
+		path = base + "." + strconv.Itoa(n) + ".json"
+	}
+
+	content, err := json.MarshalIndent(data, "", "  ")
+	if err == nil {
+		err = os.MkdirAll(filepath.Dir(path), 0o755)
+	}
+	if err == nil {
+		err = os.WriteFile(path, append(content, '\n'), 0o644)
+	}
+	if err != nil {
+		slog.Warn("cannot dump template data", "template", name, "error", err)
+		return
+	}
+	slog.Debug("dumped template data", "template", name, "file", path)
+}
@@ -0,0 +1,75 @@
+package templates
+
+import (
+	"embed"
+	"os"
+	"path/filepath"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/require"
+)
+
+// customEngine returns an engine with the custom templates files, by path,
+// and no embedded ones.
+func customEngine(t *testing.T, opts Options, files map[string]string) *TextTemplateEngine {
+	t.Helper()
+	opts.CustomDir = t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(opts.CustomDir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+	engine, err := NewEngine(embed.FS{}, opts, nil)
+	require.NoError(t, err)
+	return engine
+}
+
+func TestEngineFuncsOverride(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "t.tmpl"), []byte(`{{ trim "x" }}`), 0o644))
+	engine, err := NewEngine(embed.FS{}, Options{CustomDir: dir}, template.FuncMap{"trim": func(s string) string { return "custom " + s }})
+	require.NoError(t, err)
+	out, err := engine.Execute("t.tmpl", nil)
+	require.NoError(t, err)
+	require.Equal(t, "custom x", out)
+}
+
+func TestEngineDelims(t *testing.T) {
+	engine := customEngine(t, Options{LeftDelim: "[[", RightDelim: "]]"}, map[string]string{
+		"t.tmpl": "const tmpl = `{{ .Name }}`; // [[ .Name ]]",
+	})
+	out, err := engine.Execute("t.tmpl", map[string]string{"Name": "pet"})
+	require.NoError(t, err)
+	require.Equal(t, "const tmpl = `{{ .Name }}`; // pet", out)
+}
+
+func TestEngineMissingKey(t *testing.T) {
+	files := map[string]string{"t.tmpl": "[{{ .Missing }}]"}
+	data := map[string]any{}
+
+	out, err := customEngine(t, Options{}, files).Execute("t.tmpl", data)
+	require.NoError(t, err)
+	require.Equal(t, "[<no value>]", out)
+
+	_, err = customEngine(t, Options{MissingKey: "error"}, files).Execute("t.tmpl", data)
+	require.ErrorContains(t, err, `map has no entry for key "Missing"`)
+}
+
+func TestEngineDebugDir(t *testing.T) {
+	engine := customEngine(t, Options{}, map[string]string{"go/t.tmpl": "{{ .Name }}"})
+	debugDir := t.TempDir()
+	engine.SetDebugDir(debugDir)
+
+	for _, name := range []string{"first", "second"} {
+		_, err := engine.Execute("go/t.tmpl", map[string]string{"Name": name})
+		require.NoError(t, err)
+	}
+
+	first, err := os.ReadFile(filepath.Join(debugDir, "go", "t.tmpl.json"))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"Name": "first"}`, string(first))
+	second, err := os.ReadFile(filepath.Join(debugDir, "go", "t.tmpl.2.json"))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"Name": "second"}`, string(second))
+}
@@ -0,0 +1,284 @@
+package templates
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// Funcs returns the general-purpose helpers every template can use, named and
+// ordered like their sprig counterparts so that the value piped in comes
+// last. They are pure: none reads the environment, files or the clock. The
+// functions passed to NewEngine take precedence over them.
+func Funcs() template.FuncMap {
+	return template.FuncMap{
+		// Strings
+		"contains":  func(substr, s string) bool { return strings.Contains(s, substr) },
+		"replace":   func(old, new, s string) string { return strings.ReplaceAll(s, old, new) },
+		"splitList": func(sep, s string) []string { return strings.Split(s, sep) },
+		"trim":      strings.TrimSpace,
+		"trimAll":   func(cutset, s string) string { return strings.Trim(s, cutset) },
+		"repeat":    func(count int, s string) string { return strings.Repeat(s, max(count, 0)) },
+		"trunc":     trunc,
+		"substr":    substr,
+		"indent":    indent,
+		"nindent":   func(n int, s string) string { return "\n" + indent(n, s) },
+		"quote":     func(s any) string { return strconv.Quote(fmt.Sprint(s)) },
+		"squote":    func(s any) string { return "'" + fmt.Sprint(s) + "'" },
+
+		// Defaults and conditions
+		"default":  func(def, v any) any { return ternary(def, v, empty(v)) },
+		"empty":    empty,
+		"coalesce": coalesce,
+		"ternary":  ternary,
+
+		// Lists
+		"list":      func(items ...any) []any { return items },
+		"first":     first,
+		"last":      last,
+		"rest":      rest,
+		"initial":   initial,
+		"append":    func(list, v any) []any { return append(toList(list), v) },
+		"prepend":   func(list, v any) []any { return append([]any{v}, toList(list)...) },
+		"concat":    concat,
+		"reverse":   reverse,
+		"uniq":      uniq,
+		"compact":   compact,
+		"has":       func(needle, list any) bool { return slices.ContainsFunc(toList(list), equalTo(needle)) },
+		"without":   without,
+		"sortAlpha": sortAlpha,
+		"until":     until,
+
+		// Math, on integers of any size
+		"add":  func(a, b any) int { return toInt(a) + toInt(b) },
+		"add1": func(a any) int { return toInt(a) + 1 },
+		"sub":  func(a, b any) int { return toInt(a) - toInt(b) },
+		"mul":  func(a, b any) int { return toInt(a) * toInt(b) },
+		"div":  div,
+		"mod":  mod,
+		"max":  func(a any, others ...any) int { return reduceInts(func(x, y int) int { return max(x, y) }, a, others) },
+		"min":  func(a any, others ...any) int { return reduceInts(func(x, y int) int { return min(x, y) }, a, others) },
+	}
+}
+
+func trunc(n int, s string) string {
+	r := []rune(s)
+	if n >= 0 && n < len(r) {
+		return string(r[:n])
+	}
+	if n < 0 && -n < len(r) {
+		return string(r[len(r)+n:])
+	}
+	return s
+}
+
+func substr(start, end int, s string) string {
+	r := []rune(s)
+	start = min(max(start, 0), len(r))
+	if end < 0 || end > len(r) {
+		end = len(r)
+	}
+	if start > end {
+		return ""
+	}
+	return string(r[start:end])
+}
+
+// indent prefixes every line of s with n spaces.
+func indent(n int, s string) string {
+	pad := strings.Repeat(" ", max(n, 0))
+	return pad + strings.ReplaceAll(s, "\n", "\n"+pad)
+}
+
+// empty reports whether v is nil or the zero value of its type; an empty
+// slice, map or string is empty too.
+func empty(v any) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice, reflect.String:
+		return rv.Len() == 0
+	case reflect.Pointer, reflect.Interface:
+		return rv.IsNil()
+	}
+	return rv.IsZero()
+}
+
+func coalesce(values ...any) any {
+	for _, v := range values {
+		if !empty(v) {
+			return v
+		}
+	}
+	return nil
+}
+
+func ternary(whenTrue, whenFalse any, cond bool) any {
+	if cond {
+		return whenTrue
+	}
+	return whenFalse
+}
+
+// toList returns the items of a slice or array, and nil for anything else.
+func toList(list any) []any {
+	if list == nil {
+		return nil
+	}
+	rv := reflect.ValueOf(list)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return nil
+	}
+	items := make([]any, rv.Len())
+	for i := range items {
+		items[i] = rv.Index(i).Interface()
+	}
+	return items
+}
+
+func first(list any) any {
+	items := toList(list)
+	if len(items) == 0 {
+		return nil
+	}
+	return items[0]
+}
+
+func last(list any) any {
+	items := toList(list)
+	if len(items) == 0 {
+		return nil
+	}
+	return items[len(items)-1]
+}
+
+func rest(list any) []any {
+	items := toList(list)
+	if len(items) == 0 {
+		return nil
+	}
+	return items[1:]
+}
+
+func initial(list any) []any {
+	items := toList(list)
+	if len(items) == 0 {
+		return nil
+	}
+	return items[:len(items)-1]
+}
+
+func concat(lists ...any) []any {
+	var items []any
+	for _, list := range lists {
+		items = append(items, toList(list)...)
+	}
+	return items
+}
+
+func reverse(list any) []any {
+	items := toList(list)
+	slices.Reverse(items)
+	return items
+}
+
+// uniq returns the items of list with later duplicates left out.
+func uniq(list any) []any {
+	var items []any
+	for _, v := range toList(list) {
+		if !slices.ContainsFunc(items, equalTo(v)) {
+			items = append(items, v)
+		}
+	}
+	return items
+}
+
+func compact(list any) []any {
+	var items []any
+	for _, v := range toList(list) {
+		if !empty(v) {
+			items = append(items, v)
+		}
+	}
+	return items
+}
+
+func without(list any, omit ...any) []any {
+	var items []any
+	for _, v := range toList(list) {
+		if !slices.ContainsFunc(omit, equalTo(v)) {
+			items = append(items, v)
+		}
+	}
+	return items
+}
+
+// sortAlpha returns the items of list as strings, sorted.
+func sortAlpha(list any) []string {
+	items := toList(list)
+	sorted := make([]string, len(items))
+	for i, v := range items {
+		sorted[i] = fmt.Sprint(v)
+	}
+	slices.Sort(sorted)
+	return sorted
+}
+
+// until returns the integers from 0 up to n, for ranging a number of times.
+func until(n any) []int {
+	count := max(toInt(n), 0)
+	seq := make([]int, count)
+	for i := range seq {
+		seq[i] = i
+	}
+	return seq
+}
+
+func equalTo(v any) func(any) bool {
+	return func(item any) bool { return reflect.DeepEqual(item, v) }
+}
+
+// toInt converts the integers, floats and numeric strings templates pass
+// around to an int, and anything else to 0.
+func toInt(v any) int {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return int(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		return int(rv.Float())
+	case reflect.String:
+		n, _ := strconv.Atoi(rv.String())
+		return n
+	}
+	return 0
+}
+
+func div(a, b any) (int, error) {
+	if toInt(b) == 0 {
+		return 0, fmt.Errorf("div: division by zero")
+	}
+	return toInt(a) / toInt(b), nil
+}
+
+func mod(a, b any) (int, error) {
+	if toInt(b) == 0 {
+		return 0, fmt.Errorf("mod: division by zero")
+	}
+	return toInt(a) % toInt(b), nil
+}
+
+func reduceInts(pick func(a, b int) int, a any, others []any) int {
+	n := toInt(a)
+	for _, v := range others {
+		n = pick(n, toInt(v))
+	}
+	return n
+}
@@ -0,0 +1,61 @@
+package templates
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFuncs(t *testing.T) {
+	tests := []struct {
+		template string
+		data     any
+		expected string
+	}{
+		{`{{ contains "ell" "hello" }}`, nil, "true"},
+		{`{{ "a-b-c" | replace "-" "_" }}`, nil, "a_b_c"},
+		{`{{ splitList "," "a,b" | last }}`, nil, "b"},
+		{`{{ "  x  " | trim }}|{{ "--x--" | trimAll "-" }}`, nil, "x|x"},
+		{`{{ "ab" | repeat 3 }}`, nil, "ababab"},
+		{`{{ "héllo" | trunc 2 }}|{{ "hello" | trunc -2 }}|{{ "hi" | trunc 5 }}`, nil, "hé|lo|hi"},
+		{`{{ "hello" | substr 1 3 }}`, nil, "el"},
+		{`{{ "a\nb" | indent 2 }}`, nil, "  a\n  b"},
+		{`{{ "a" | nindent 1 }}`, nil, "\n a"},
+		{`{{ quote "a\"b" }} {{ squote 1 }}`, nil, `"a\"b" '1'`},
+		{`{{ .Missing | default "none" }}`, map[string]any{"Missing": ""}, "none"},
+		{`{{ .Set | default "none" }}`, map[string]any{"Set": "x"}, "x"},
+		{`{{ empty .List }} {{ empty 0 }} {{ empty "a" }}`, map[string]any{"List": []string{}}, "true true false"},
+		{`{{ coalesce "" .Nil "b" "c" }}`, map[string]any{"Nil": nil}, "b"},
+		{`{{ ternary "yes" "no" true }}`, nil, "yes"},
+		{`{{ list 1 2 3 | first }} {{ list 1 2 3 | rest }} {{ list 1 2 3 | initial }}`, nil, "1 [2 3] [1 2]"},
+		{`{{ append .L "c" }} {{ prepend .L "z" }}`, map[string]any{"L": []string{"a", "b"}}, "[a b c] [z a b]"},
+		{`{{ concat (list 1) (list 2 3) | reverse }}`, nil, "[3 2 1]"},
+		{`{{ list "a" "b" "a" "" | uniq }} {{ list "a" "" "b" | compact }}`, nil, "[a b ] [a b]"},
+		{`{{ has "b" .L }} {{ has "z" .L }}`, map[string]any{"L": []string{"a", "b"}}, "true false"},
+		{`{{ without .L "a" }}`, map[string]any{"L": []string{"a", "b", "a"}}, "[b]"},
+		{`{{ list "b" "c" "a" | sortAlpha }}`, nil, "[a b c]"},
+		{`{{ range until 3 }}{{ . }}{{ end }}`, nil, "012"},
+		{`{{ add 1 2 }} {{ add1 .N }} {{ sub 5 2 }} {{ mul 2 3 }} {{ div 7 2 }} {{ mod 7 2 }}`, map[string]any{"N": int64(4)}, "3 5 3 6 3 1"},
+		{`{{ max 1 5 3 }} {{ min 4 2 }}`, nil, "5 2"},
+		{`{{ if eq (len .L) (add 1 1) }}two{{ end }}`, map[string]any{"L": []string{"a", "b"}}, "two"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.template, func(t *testing.T) {
+			tmpl, err := template.New("").Funcs(Funcs()).Parse(tt.template)
+			require.NoError(t, err)
+			var out strings.Builder
+			require.NoError(t, tmpl.Execute(&out, tt.data))
+			require.Equal(t, tt.expected, out.String())
+		})
+	}
+}
+
+func TestFuncsDivisionByZero(t *testing.T) {
+	tmpl, err := template.New("").Funcs(Funcs()).Parse(`{{ div 1 0 }}`)
+	require.NoError(t, err)
+	err = tmpl.Execute(&strings.Builder{}, nil)
+	require.ErrorContains(t, err, "division by zero")
+}
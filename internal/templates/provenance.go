@@ -0,0 +1,185 @@
+package templates
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// Provenance records the embedded template a custom template was copied from,
+// in a template comment on its first line that renders nothing, written with
+// the delimiters of the custom templates:
+//
+//	{{/* eugene:template go/server/chi.tmpl v1.4.0 sha256:3f2a9c0d1b7e4a55 */ -}}
+type Provenance struct {
+	Name    string // path of the embedded template
+	Version string // eugene release it was copied from
+	Hash    string // Hash of the embedded template at the time
+}
+
+// Delims are the action delimiters custom templates are parsed with.
+type Delims struct {
+	Left, Right string
+}
+
+// DefaultDelims are the delimiters of text/template, used unless
+// templates.delims sets others.
+var DefaultDelims = Delims{Left: "{{", Right: "}}"}
+
+func (d Delims) header() *regexp.Regexp {
+	return regexp.MustCompile(`^` + regexp.QuoteMeta(d.Left) + `/\* eugene:template (\S+) (\S+) (sha256:[0-9a-f]+) \*/ -` + regexp.QuoteMeta(d.Right) + `\n`)
+}
+
+// Hash identifies the content of a template.
+func Hash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(sum[:8])
+}
+
+// WithProvenance returns content with p recorded in its header.
+func WithProvenance(content []byte, p Provenance, d Delims) []byte {
+	header := fmt.Sprintf("%s/* eugene:template %s %s %s */ -%s\n", d.Left, p.Name, p.Version, p.Hash, d.Right)
+	return append([]byte(header), content...)
+}
+
+// ReadProvenance returns the provenance recorded in the header of content and
+// the content without it, or false when content has no header.
+func ReadProvenance(content []byte, d Delims) (Provenance, []byte, bool) {
+	m := d.header().FindSubmatch(content)
+	if m == nil {
+		return Provenance{}, content, false
+	}
+	return Provenance{Name: string(m[1]), Version: string(m[2]), Hash: string(m[3])}, content[len(m[0]):], true
+}
+
+// DriftStatus is how a custom template relates to the embedded templates.
+type DriftStatus string
+
+const (
+	DriftUpToDate  DriftStatus = "up to date"
+	DriftChanged   DriftStatus = "upstream changed"
+	DriftUntracked DriftStatus = "untracked"
+	DriftCustom    DriftStatus = "not embedded"
+)
+
+// Drift is the result of comparing one custom template with the embedded
+// template it overrides.
+type Drift struct {
+	Name   string // path relative to the custom templates directory
+	Status DriftStatus
+	Copied Provenance // from the header, for up to date and changed templates
+	// Diff is a unified diff from the custom template to the current
+	// embedded one, for changed templates. Its additions are mostly the
+	// upstream changes to port, its removals the local customizations.
+	Diff string
+}
+
+// CheckDrift compares the templates of customDir with the embedded templates
+// they override. A template copied with a provenance header is up to date
+// while the embedded template still has the hash the header records.
+func CheckDrift(embedded fs.FS, customDir string, delims Delims) ([]Drift, error) {
+	var drifts []Drift
+	err := filepath.WalkDir(customDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.HasSuffix(path, ".tmpl") {
+			return nil
+		}
+		rel, err := filepath.Rel(customDir, path)
+		if err != nil {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("reading custom template %s: %w", path, err)
+		}
+		drift, err := checkTemplate(embedded, filepath.ToSlash(rel), content, delims)
+		if err != nil {
+			return err
+		}
+		drifts = append(drifts, drift)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return drifts, nil
+}
+
+func checkTemplate(embedded fs.FS, name string, content []byte, d Delims) (Drift, error) {
+	drift := Drift{Name: name}
+	copied, body, tracked := ReadProvenance(content, d)
+	source := name
+	if tracked {
+		source = copied.Name
+		drift.Copied = copied
+	}
+
+	upstream, err := fs.ReadFile(embedded, source)
+	if errors.Is(err, fs.ErrNotExist) {
+		drift.Status = DriftCustom
+		return drift, nil
+	}
+	if err != nil {
+		return Drift{}, fmt.Errorf("reading embedded template %s: %w", source, err)
+	}
+
+	switch {
+	case !tracked:
+		drift.Status = DriftUntracked
+	case Hash(upstream) == copied.Hash:
+		drift.Status = DriftUpToDate
+	default:
+		drift.Status = DriftChanged
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(string(body)),
+			B:        difflib.SplitLines(string(upstream)),
+			FromFile: name,
+			ToFile:   "embedded/" + source,
+			Context:  3,
+		})
+		if err != nil {
+			return Drift{}, fmt.Errorf("diffing %s: %w", name, err)
+		}
+		drift.Diff = diff
+	}
+	return drift, nil
+}
+
+// Export returns the embedded template name with a provenance header naming
+// version, ready to be customized.
+func Export(embedded fs.FS, name, version string, d Delims) ([]byte, error) {
+	content, err := fs.ReadFile(embedded, name)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("no embedded template %s", name)
+		}
+		return nil, err
+	}
+	return WithProvenance(bytes.Clone(content), Provenance{Name: name, Version: version, Hash: Hash(content)}, d), nil
+}
+
+// Accept returns a tracked custom template with its header recording the
+// current embedded template and version, once its upstream changes are
+// ported, so that the next drift check starts from there.
+func Accept(embedded fs.FS, content []byte, version string, d Delims) ([]byte, error) {
+	copied, body, ok := ReadProvenance(content, d)
+	if !ok {
+		return nil, fmt.Errorf("no eugene:template header")
+	}
+	upstream, err := fs.ReadFile(embedded, copied.Name)
+	if err != nil {
+		return nil, fmt.Errorf("reading embedded template %s: %w", copied.Name, err)
+	}
+	return WithProvenance(bytes.Clone(body), Provenance{Name: copied.Name, Version: version, Hash: Hash(upstream)}, d), nil
+}
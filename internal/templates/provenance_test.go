@@ -0,0 +1,115 @@
+package templates
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"text/template"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportRendersLikeEmbedded(t *testing.T) {
+	embedded := fstest.MapFS{"go/t.tmpl": {Data: []byte("package {{ .Package }}\n")}}
+
+	content, err := Export(embedded, "go/t.tmpl", "v1.2.0", DefaultDelims)
+	require.NoError(t, err)
+	copied, body, ok := ReadProvenance(content, DefaultDelims)
+	require.True(t, ok)
+	require.Equal(t, Provenance{Name: "go/t.tmpl", Version: "v1.2.0", Hash: Hash(embedded["go/t.tmpl"].Data)}, copied)
+	require.Equal(t, "package {{ .Package }}\n", string(body))
+
+	// The header is a template comment that renders nothing
+	tmpl, err := template.New("").Parse(string(content))
+	require.NoError(t, err)
+	var out strings.Builder
+	require.NoError(t, tmpl.Execute(&out, map[string]string{"Package": "api"}))
+	require.Equal(t, "package api\n", out.String())
+
+	_, err = Export(embedded, "go/missing.tmpl", "v1.2.0", DefaultDelims)
+	require.ErrorContains(t, err, "no embedded template go/missing.tmpl")
+}
+
+func TestProvenanceDelims(t *testing.T) {
+	embedded := fstest.MapFS{"go/t.tmpl": {Data: []byte("package [[ .Package ]]\n")}}
+	delims := Delims{Left: "[[", Right: "]]"}
+
+	content, err := Export(embedded, "go/t.tmpl", "v1.2.0", delims)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(content), "[[/* eugene:template go/t.tmpl v1.2.0 sha256:"))
+	_, _, ok := ReadProvenance(content, DefaultDelims)
+	require.False(t, ok)
+	copied, body, ok := ReadProvenance(content, delims)
+	require.True(t, ok)
+	require.Equal(t, "go/t.tmpl", copied.Name)
+	require.Equal(t, "package [[ .Package ]]\n", string(body))
+
+	// The header is a comment under the custom delimiters too
+	tmpl, err := template.New("").Delims("[[", "]]").Parse(string(content))
+	require.NoError(t, err)
+	var out strings.Builder
+	require.NoError(t, tmpl.Execute(&out, map[string]string{"Package": "api"}))
+	require.Equal(t, "package api\n", out.String())
+}
+
+func TestCheckDrift(t *testing.T) {
+	old := fstest.MapFS{
+		"go/changed.tmpl": {Data: []byte("a\nb\nc\n")},
+		"go/same.tmpl":    {Data: []byte("same\n")},
+	}
+	customDir := t.TempDir()
+	write := func(name string, content []byte) {
+		path := filepath.Join(customDir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, content, 0o644))
+	}
+	for _, name := range []string{"go/changed.tmpl", "go/same.tmpl"} {
+		content, err := Export(old, name, "v1.0.0", DefaultDelims)
+		require.NoError(t, err)
+		write(name, content)
+	}
+	write("go/untracked.tmpl", []byte("copied by hand\n"))
+	write("go/extra.tmpl", []byte("added\n"))
+
+	// After the upgrade, changed.tmpl has a new line upstream
+	upgraded := fstest.MapFS{
+		"go/changed.tmpl":   {Data: []byte("a\nb\nnew\nc\n")},
+		"go/same.tmpl":      old["go/same.tmpl"],
+		"go/untracked.tmpl": {Data: []byte("upstream\n")},
+	}
+	drifts, err := CheckDrift(upgraded, customDir, DefaultDelims)
+	require.NoError(t, err)
+
+	statuses := make(map[string]DriftStatus)
+	for _, d := range drifts {
+		statuses[d.Name] = d.Status
+	}
+	require.Equal(t, map[string]DriftStatus{
+		"go/changed.tmpl":   DriftChanged,
+		"go/extra.tmpl":     DriftCustom,
+		"go/same.tmpl":      DriftUpToDate,
+		"go/untracked.tmpl": DriftUntracked,
+	}, statuses)
+
+	require.Equal(t, "go/changed.tmpl", drifts[0].Name)
+	require.Equal(t, "v1.0.0", drifts[0].Copied.Version)
+	require.Contains(t, drifts[0].Diff, "--- go/changed.tmpl\n+++ embedded/go/changed.tmpl\n")
+	require.Contains(t, drifts[0].Diff, "\n+new\n")
+
+	// Accepting the upgrade brings it up to date and keeps the body
+	path := filepath.Join(customDir, "go", "changed.tmpl")
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	accepted, err := Accept(upgraded, content, "v1.1.0", DefaultDelims)
+	require.NoError(t, err)
+	copied, body, ok := ReadProvenance(accepted, DefaultDelims)
+	require.True(t, ok)
+	require.Equal(t, "v1.1.0", copied.Version)
+	require.Equal(t, "a\nb\nc\n", string(body))
+	require.NoError(t, os.WriteFile(path, accepted, 0o644))
+	drifts, err = CheckDrift(upgraded, customDir, DefaultDelims)
+	require.NoError(t, err)
+	require.Equal(t, DriftUpToDate, drifts[0].Status)
+}
@@ -0,0 +1,19 @@
+package typescript
+
+import (
+	"text/template"
+
+	"github.com/kolah/eugene/internal/model"
+)
+
+// TemplateFuncs returns the helpers of the ts templates. They are prefixed
+// with ts so they sit alongside the Go helpers in the one template engine.
+func TemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"tsType":     func(s *model.Schema) string { return (&Types{}).Type(s) },
+		"tsTypeName": TypeName,
+		"tsProperty": PropertyName,
+		"tsString":   String,
+		"tsDoc":      Doc,
+	}
+}
@@ -0,0 +1,217 @@
+// Package typescript maps the spec model to TypeScript, for the ts targets.
+// Schemas become structural types: interfaces for objects, unions of
+// literals for enums, and union and intersection types for oneOf, anyOf and
+// allOf.
+package typescript
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kolah/eugene/internal/golang"
+	"github.com/kolah/eugene/internal/model"
+)
+
+// Types maps schemas to TypeScript types. Component schemas are referred to
+// by name, prefixed with Qualifier, so a file importing the generated types
+// as a namespace can use them as types.Pet.
+type Types struct {
+	Qualifier string
+	used      bool
+}
+
+// Used reports whether a type returned so far referred to a component schema.
+func (t *Types) Used() bool {
+	return t.used
+}
+
+// Type returns the TypeScript type of s.
+func (t *Types) Type(s *model.Schema) string {
+	return t.typeAt(s, 0)
+}
+
+// typeAt returns the type of s, the members of its object literals indented
+// by depth levels.
+func (t *Types) typeAt(s *model.Schema, depth int) string {
+	if s == nil {
+		return "unknown"
+	}
+	typ := t.baseType(s, depth)
+	if s.Nullable && typ != "unknown" {
+		typ += " | null"
+	}
+	return typ
+}
+
+func (t *Types) baseType(s *model.Schema, depth int) string {
+	if s.Ref != "" {
+		t.used = true
+		return t.Qualifier + TypeName(refName(s.Ref))
+	}
+	if len(s.Enum) > 0 {
+		return enumType(s.Enum)
+	}
+	if len(s.OneOf) > 0 {
+		return t.join(s.OneOf, " | ", depth)
+	}
+	if len(s.AnyOf) > 0 {
+		return t.join(s.AnyOf, " | ", depth)
+	}
+	if len(s.AllOf) > 0 {
+		typ := t.join(s.AllOf, " & ", depth)
+		if len(s.Properties) > 0 {
+			typ += " & " + t.ObjectType(s, depth)
+		}
+		return typ
+	}
+
+	switch s.Type {
+	case model.TypeString:
+		if s.Format == "binary" {
+			return "Blob"
+		}
+		return "string"
+	case model.TypeInteger, model.TypeNumber:
+		return "number"
+	case model.TypeBoolean:
+		return "boolean"
+	case model.TypeNull:
+		return "null"
+	case model.TypeArray:
+		item := t.typeAt(s.Items, depth)
+		if strings.ContainsAny(item, "|&") {
+			item = "(" + item + ")"
+		}
+		return item + "[]"
+	case model.TypeObject:
+		if len(s.Properties) > 0 {
+			return t.ObjectType(s, depth)
+		}
+		if s.AdditionalProperties != nil {
+			return "Record<string, " + t.typeAt(s.AdditionalProperties, depth) + ">"
+		}
+		return "Record<string, unknown>"
+	}
+	return "unknown"
+}
+
+// ObjectType returns the object literal type of the properties of s, its
+// members indented by depth levels of two spaces. Additional properties
+// widen it with an index signature.
+func (t *Types) ObjectType(s *model.Schema, depth int) string {
+	pad := strings.Repeat("  ", depth)
+	var b strings.Builder
+	b.WriteString("{\n")
+	for _, p := range s.Properties {
+		if p.Schema != nil {
+			if doc := Doc(p.Schema.Description, p.Schema.Deprecated); doc != "" {
+				b.WriteString(pad + "  " + strings.ReplaceAll(doc, "\n", "\n"+pad+"  ") + "\n")
+			}
+		}
+		fmt.Fprintf(&b, "%s  %s%s: %s;\n", pad, PropertyName(p.Name), optional(s, p.Name), t.typeAt(p.Schema, depth+1))
+	}
+	if s.AdditionalProperties != nil {
+		fmt.Fprintf(&b, "%s  [key: string]: unknown;\n", pad)
+	}
+	b.WriteString(pad + "}")
+	return b.String()
+}
+
+func (t *Types) join(schemas []*model.Schema, sep string, depth int) string {
+	parts := make([]string, len(schemas))
+	for i, s := range schemas {
+		parts[i] = t.typeAt(s, depth)
+		if strings.ContainsAny(parts[i], "|&") {
+			parts[i] = "(" + parts[i] + ")"
+		}
+	}
+	return strings.Join(parts, sep)
+}
+
+// enumType returns the union of the literals of values.
+func enumType(values []any) string {
+	parts := make([]string, 0, len(values))
+	for _, v := range values {
+		if v == nil {
+			parts = append(parts, "null")
+			continue
+		}
+		literal, err := json.Marshal(v)
+		if err != nil {
+			return "unknown"
+		}
+		parts = append(parts, string(literal))
+	}
+	return strings.Join(parts, " | ")
+}
+
+// optional returns the ? of a property its object does not require.
+func optional(s *model.Schema, name string) string {
+	for _, r := range s.Required {
+		if r == name {
+			return ""
+		}
+	}
+	return "?"
+}
+
+func refName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+// TypeName returns the name of the interface or alias declared for the
+// component schema name.
+func TypeName(name string) string {
+	return golang.PascalCase(name)
+}
+
+// MemberName returns the name of the client method or function declared for
+// an operation or parameter name.
+func MemberName(name string) string {
+	return golang.CamelCase(name)
+}
+
+var identifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// PropertyName returns name as an object key, quoted when it is not an
+// identifier, as in "x-rate-limit".
+func PropertyName(name string) string {
+	if identifier.MatchString(name) {
+		return name
+	}
+	return String(name)
+}
+
+// String returns s as a double-quoted string literal.
+func String(s string) string {
+	literal, _ := json.Marshal(s)
+	return string(literal)
+}
+
+// Doc returns text as a JSDoc comment, tagged @deprecated when deprecated.
+// It returns an empty string when there is nothing to say.
+func Doc(text string, deprecated bool) string {
+	text = strings.TrimSpace(strings.ReplaceAll(text, "*/", "*\\/"))
+	var lines []string
+	if text != "" {
+		lines = strings.Split(text, "\n")
+	}
+	if deprecated {
+		lines = append(lines, "@deprecated")
+	}
+	switch len(lines) {
+	case 0:
+		return ""
+	case 1:
+		return "/** " + lines[0] + " */"
+	}
+	var b strings.Builder
+	b.WriteString("/**\n")
+	for _, line := range lines {
+		b.WriteString(strings.TrimRight(" * "+line, " ") + "\n")
+	}
+	b.WriteString(" */")
+	return b.String()
+}
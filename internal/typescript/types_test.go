@@ -0,0 +1,82 @@
+package typescript
+
+import (
+	"testing"
+
+	"github.com/kolah/eugene/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestType(t *testing.T) {
+	str := &model.Schema{Type: model.TypeString}
+	pet := &model.Schema{Ref: "#/components/schemas/pet_owner"}
+	tests := []struct {
+		name     string
+		schema   *model.Schema
+		expected string
+	}{
+		{"nil schema", nil, "unknown"},
+		{"string", str, "string"},
+		{"date-time", &model.Schema{Type: model.TypeString, Format: "date-time"}, "string"},
+		{"binary", &model.Schema{Type: model.TypeString, Format: "binary"}, "Blob"},
+		{"integer", &model.Schema{Type: model.TypeInteger, Format: "int64"}, "number"},
+		{"boolean", &model.Schema{Type: model.TypeBoolean}, "boolean"},
+		{"nullable", &model.Schema{Type: model.TypeString, Nullable: true}, "string | null"},
+		{"ref", pet, "PetOwner"},
+		{"array", &model.Schema{Type: model.TypeArray, Items: pet}, "PetOwner[]"},
+		{"array of union", &model.Schema{Type: model.TypeArray, Items: &model.Schema{OneOf: []*model.Schema{str, pet}}}, "(string | PetOwner)[]"},
+		{"string enum", &model.Schema{Type: model.TypeString, Enum: []any{"a", "b"}}, `"a" | "b"`},
+		{"number enum", &model.Schema{Type: model.TypeInteger, Enum: []any{1, 2}}, "1 | 2"},
+		{"anyOf", &model.Schema{AnyOf: []*model.Schema{str, pet}}, "string | PetOwner"},
+		{"allOf", &model.Schema{AllOf: []*model.Schema{pet, {Type: model.TypeObject}}}, "PetOwner & Record<string, unknown>"},
+		{"map", &model.Schema{Type: model.TypeObject, AdditionalProperties: str}, "Record<string, string>"},
+		{"empty object", &model.Schema{Type: model.TypeObject}, "Record<string, unknown>"},
+		{"untyped", &model.Schema{}, "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, (&Types{}).Type(tt.schema))
+		})
+	}
+}
+
+func TestTypesQualifier(t *testing.T) {
+	types := &Types{Qualifier: "types."}
+	require.Equal(t, "string[]", types.Type(&model.Schema{Type: model.TypeArray, Items: &model.Schema{Type: model.TypeString}}))
+	require.False(t, types.Used())
+	require.Equal(t, "types.Pet | null", types.Type(&model.Schema{Ref: "#/components/schemas/Pet", Nullable: true}))
+	require.True(t, types.Used())
+}
+
+func TestObjectType(t *testing.T) {
+	s := &model.Schema{
+		Type:     model.TypeObject,
+		Required: []string{"id"},
+		Properties: []model.Property{
+			{Name: "id", Schema: &model.Schema{Type: model.TypeString, Description: "Unique ID"}},
+			{Name: "x-trace", Schema: &model.Schema{Type: model.TypeString, Deprecated: true}},
+			{Name: "owner", Schema: &model.Schema{Type: model.TypeObject, Properties: []model.Property{
+				{Name: "name", Schema: &model.Schema{Type: model.TypeString}},
+			}}},
+		},
+		AdditionalProperties: &model.Schema{Type: model.TypeString},
+	}
+
+	require.Equal(t, `{
+  /** Unique ID */
+  id: string;
+  /** @deprecated */
+  "x-trace"?: string;
+  owner?: {
+    name?: string;
+  };
+  [key: string]: unknown;
+}`, (&Types{}).ObjectType(s, 0))
+}
+
+func TestDoc(t *testing.T) {
+	require.Empty(t, Doc("", false))
+	require.Equal(t, "/** Lists pets */", Doc("Lists pets\n", false))
+	require.Equal(t, "/**\n * Lists pets\n *\n * Paged by cursor *\\/\n * @deprecated\n */", Doc("Lists pets\n\nPaged by cursor */", true))
+}
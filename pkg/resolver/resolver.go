@@ -0,0 +1,175 @@
+// Package resolver maps the schemas of an OpenAPI document to Go types the
+// way eugene's types target names them, so that generators outside this
+// module can refer to the generated types without reimplementing the
+// mapping.
+package resolver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/kolah/eugene/internal/config"
+	"github.com/kolah/eugene/internal/golang"
+	"github.com/kolah/eugene/internal/loader"
+	"github.com/kolah/eugene/internal/model"
+)
+
+// Options configures the mapping like the go.types settings of eugene.yaml.
+// The zero value maps types like eugene does without those settings.
+type Options struct {
+	EnumStrategy     string            // const (default), type or struct
+	UUIDPackage      string            // string (default), google or gofrs
+	NullableStrategy string            // pointer (default) or nullable
+	AllOfStrategy    string            // embed (default) or flatten
+	ImportMapping    map[string]string // $ref → Go import path, like go.import-mapping
+}
+
+// Kind classifies a nested type.
+type Kind string
+
+const (
+	KindStruct Kind = "struct" // inline object
+	KindEnum   Kind = "enum"   // inline enum
+	KindUnion  Kind = "union"  // inline or component oneOf/anyOf
+	KindAllOf  Kind = "allOf"  // inline or component allOf
+)
+
+// NestedType is a type the types target declares for a schema that is not
+// a component of its own, such as an inline object property.
+type NestedType struct {
+	Name string
+	Kind Kind
+}
+
+// Resolver resolves the schemas of one document. It collects the nested
+// types of everything it resolved until Reset, and is not safe for
+// concurrent use.
+type Resolver struct {
+	spec     *model.Spec
+	resolver *golang.TypeResolver
+}
+
+// Load loads the OpenAPI document at path.
+func Load(path string, opts Options) (*Resolver, error) {
+	result, err := loader.LoadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return newResolver(result, opts)
+}
+
+// LoadData loads an OpenAPI document in YAML or JSON that was not read
+// from a file. Relative external references resolve against the working
+// directory.
+func LoadData(data []byte, opts Options) (*Resolver, error) {
+	result, err := loader.LoadData(data, "spec", "")
+	if err != nil {
+		return nil, err
+	}
+	return newResolver(result, opts)
+}
+
+func newResolver(result *loader.Result, opts Options) (*Resolver, error) {
+	cfg := &config.TypesConfig{
+		EnumStrategy:     opts.EnumStrategy,
+		UUIDPackage:      opts.UUIDPackage,
+		NullableStrategy: opts.NullableStrategy,
+		AllOfStrategy:    opts.AllOfStrategy,
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	spec, err := loader.Transform(result)
+	if err != nil {
+		return nil, err
+	}
+	return &Resolver{
+		spec:     spec,
+		resolver: golang.NewTypeResolverWithSchemaLookup(cfg, opts.ImportMapping, golang.NewSpecEnumRegistry(spec), spec.SchemaByRef),
+	}, nil
+}
+
+var pointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+// Resolve returns the Go type of the schema at pointer, which names a
+// component schema and optionally a chain of its properties:
+//
+//	r.Resolve("#/components/schemas/Pet")                       // "Pet"
+//	r.Resolve("#/components/schemas/Pet/properties/owner")      // "PetOwner"
+//	r.Resolve("#/components/schemas/Pet/properties/tags")       // "[]string"
+//
+// The type is the one a required field is declared with; optional and
+// nullable fields wrap it as the nullable strategy decides. The nested types the resolved
+// type needs are collected for NestedTypes.
+func (r *Resolver) Resolve(pointer string) (string, error) {
+	rest, ok := strings.CutPrefix(pointer, "#/components/schemas/")
+	if !ok {
+		return "", fmt.Errorf("%s: not a component schema", pointer)
+	}
+	segments := strings.Split(rest, "/")
+	for i, seg := range segments {
+		segments[i] = pointerUnescaper.Replace(seg)
+	}
+
+	schema := r.spec.SchemaByRef("#/components/schemas/" + segments[0])
+	if schema == nil {
+		return "", fmt.Errorf("%s: no schema %s", pointer, segments[0])
+	}
+	typ := r.resolver.ResolveSchema(schema)
+	parent := schema.Name
+	for i := 1; i < len(segments); i += 2 {
+		if segments[i] != "properties" || i+1 == len(segments) {
+			return "", fmt.Errorf("%s: only properties can follow a component schema", pointer)
+		}
+		name := segments[i+1]
+		var prop *model.Property
+		for j := range schema.Properties {
+			if schema.Properties[j].Name == name {
+				prop = &schema.Properties[j]
+				break
+			}
+		}
+		if prop == nil {
+			return "", fmt.Errorf("%s: %s has no property %s", pointer, parent, name)
+		}
+		typ = r.resolver.ResolveType(prop.Schema, parent, name)
+
+		// a property of a referenced schema belongs to that component, and
+		// one of an inline object to the nested type declared for it
+		schema, parent = prop.Schema, typ
+		if schema == nil {
+			schema = &model.Schema{}
+		} else if schema.Ref != "" {
+			if schema = r.spec.SchemaByRef(schema.Ref); schema == nil {
+				return "", fmt.Errorf("%s: unresolved reference %s", pointer, prop.Schema.Ref)
+			}
+			parent = schema.Name
+		}
+	}
+	return typ, nil
+}
+
+// NestedTypes returns the nested types collected since the resolver was
+// created or last reset, in the order they were first resolved.
+func (r *Resolver) NestedTypes() []NestedType {
+	var types []NestedType
+	for _, t := range r.resolver.NestedTypes() {
+		kind := KindStruct
+		switch {
+		case t.IsEnum:
+			kind = KindEnum
+		case t.IsUnion:
+			kind = KindUnion
+		case t.IsAllOf:
+			kind = KindAllOf
+		}
+		types = append(types, NestedType{Name: t.Name, Kind: kind})
+	}
+	return types
+}
+
+// Reset forgets the nested types collected so far, so that the resolver
+// can be reused for the next generated file. Enum names stay the same.
+func (r *Resolver) Reset() {
+	r.resolver.Reset()
+}
@@ -0,0 +1,97 @@
+package resolver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const spec = `
+openapi: 3.0.3
+info:
+  title: Pets
+  version: 1.0.0
+paths: {}
+components:
+  schemas:
+    Pet:
+      type: object
+      properties:
+        name:
+          type: string
+        status:
+          type: string
+          enum: [available, sold]
+        tags:
+          type: array
+          items:
+            type: string
+        owner:
+          type: object
+          properties:
+            address:
+              type: object
+              properties:
+                city:
+                  type: string
+        friend:
+          $ref: "#/components/schemas/Friend"
+    Friend:
+      type: object
+      properties:
+        id:
+          type: string
+          format: uuid
+    Animal:
+      oneOf:
+        - $ref: "#/components/schemas/Pet"
+        - $ref: "#/components/schemas/Friend"
+`
+
+func TestResolve(t *testing.T) {
+	r, err := LoadData([]byte(spec), Options{UUIDPackage: "google"})
+	require.NoError(t, err)
+
+	for pointer, want := range map[string]string{
+		"#/components/schemas/Pet":                                     "Pet",
+		"#/components/schemas/Pet/properties/name":                     "string",
+		"#/components/schemas/Pet/properties/tags":                     "[]string",
+		"#/components/schemas/Pet/properties/status":                   "Status",
+		"#/components/schemas/Pet/properties/owner":                    "PetOwner",
+		"#/components/schemas/Pet/properties/owner/properties/address": "PetOwnerAddress",
+		"#/components/schemas/Pet/properties/friend":                   "Friend",
+		"#/components/schemas/Pet/properties/friend/properties/id":     "uuid.UUID",
+		"#/components/schemas/Animal":                                  "Animal",
+	} {
+		got, err := r.Resolve(pointer)
+		require.NoError(t, err, pointer)
+		require.Equal(t, want, got, pointer)
+	}
+
+	_, err = r.Resolve("#/components/schemas/Pet/properties/age")
+	require.EqualError(t, err, "#/components/schemas/Pet/properties/age: Pet has no property age")
+	_, err = r.Resolve("#/paths/~1pets")
+	require.EqualError(t, err, "#/paths/~1pets: not a component schema")
+
+	_, err = LoadData([]byte(spec), Options{EnumStrategy: "iota"})
+	require.EqualError(t, err, "invalid enum strategy: iota (valid: const, type, struct)")
+}
+
+func TestNestedTypesAndReset(t *testing.T) {
+	r, err := LoadData([]byte(spec), Options{})
+	require.NoError(t, err)
+
+	_, err = r.Resolve("#/components/schemas/Pet")
+	require.NoError(t, err)
+	require.Equal(t, []NestedType{
+		{Name: "Status", Kind: KindEnum},
+		{Name: "PetOwnerAddress", Kind: KindStruct},
+		{Name: "PetOwner", Kind: KindStruct},
+	}, r.NestedTypes())
+
+	r.Reset()
+	require.Empty(t, r.NestedTypes())
+	_, err = r.Resolve("#/components/schemas/Animal")
+	require.NoError(t, err)
+	require.Equal(t, []NestedType{{Name: "Animal", Kind: KindUnion}}, r.NestedTypes())
+}
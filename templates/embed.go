@@ -2,5 +2,5 @@ package templates
 
 import "embed"
 
-//go:embed go/*.tmpl go/server/*.tmpl
+//go:embed go/*.tmpl go/partials/*.tmpl go/server/*.tmpl ts/*.tmpl ts/partials/*.tmpl jvm/*.tmpl jvm/partials/*.tmpl
 var FS embed.FS
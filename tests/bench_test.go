@@ -0,0 +1,174 @@
+package tests
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kolah/eugene/internal/codegen"
+	"github.com/kolah/eugene/internal/config"
+	"github.com/kolah/eugene/internal/golang"
+	"github.com/kolah/eugene/internal/loader"
+	"github.com/stretchr/testify/require"
+)
+
+// largeSpec writes a synthetic spec with n resources, each a schema with a
+// nested object, an enum and a reference to the previous resource, plus
+// list, get and create operations.
+func largeSpec(b *testing.B, n int) string {
+	b.Helper()
+	var sb strings.Builder
+	sb.WriteString("openapi: \"3.1.0\"\ninfo:\n  title: Large\n  version: \"1.0.0\"\npaths:\n")
+	for i := range n {
+		fmt.Fprintf(&sb, `  /resources%[1]d:
+    get:
+      operationId: listResource%[1]d
+      tags: [group%[2]d]
+      parameters:
+        - name: limit
+          in: query
+          schema:
+            type: integer
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json:
+              schema:
+                type: array
+                items:
+                  $ref: "#/components/schemas/Resource%[1]d"
+    post:
+      operationId: createResource%[1]d
+      tags: [group%[2]d]
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              $ref: "#/components/schemas/Resource%[1]d"
+      responses:
+        "201":
+          description: Created
+          content:
+            application/json:
+              schema:
+                $ref: "#/components/schemas/Resource%[1]d"
+  /resources%[1]d/{id}:
+    get:
+      operationId: getResource%[1]d
+      tags: [group%[2]d]
+      parameters:
+        - name: id
+          in: path
+          required: true
+          schema:
+            type: string
+      responses:
+        "200":
+          description: OK
+          content:
+            application/json:
+              schema:
+                $ref: "#/components/schemas/Resource%[1]d"
+`, i, i%20)
+	}
+	sb.WriteString("components:\n  schemas:\n")
+	for i := range n {
+		fmt.Fprintf(&sb, `    Resource%[1]d:
+      type: object
+      required: [id, name]
+      properties:
+        id:
+          type: string
+          format: uuid
+        name:
+          type: string
+          maxLength: 100
+        state:
+          type: string
+          enum: [active, disabled]
+        meta:
+          type: object
+          properties:
+            created:
+              type: string
+              format: date-time
+            labels:
+              type: object
+              additionalProperties:
+                type: string
+`, i)
+		if i > 0 {
+			fmt.Fprintf(&sb, "        previous:\n          $ref: \"#/components/schemas/Resource%d\"\n", i-1)
+		}
+	}
+
+	path := filepath.Join(b.TempDir(), "large.yaml")
+	require.NoError(b, os.WriteFile(path, []byte(sb.String()), 0644))
+	return path
+}
+
+var benchSizes = []int{100, 1000}
+
+func BenchmarkLoadFile(b *testing.B) {
+	for _, n := range benchSizes {
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			path := largeSpec(b, n)
+			b.ReportAllocs()
+			for b.Loop() {
+				_, err := loader.LoadFile(path)
+				require.NoError(b, err)
+			}
+		})
+	}
+}
+
+func BenchmarkTransform(b *testing.B) {
+	for _, n := range benchSizes {
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			result, err := loader.LoadFile(largeSpec(b, n))
+			require.NoError(b, err)
+			b.ReportAllocs()
+			for b.Loop() {
+				_, err := loader.Transform(result)
+				require.NoError(b, err)
+			}
+		})
+	}
+}
+
+// BenchmarkGenerate renders and formats all server and client targets,
+// formatting every file (cold) or serving them from a warm format cache.
+func BenchmarkGenerate(b *testing.B) {
+	for _, n := range benchSizes {
+		for _, mode := range []string{"cold", "cached"} {
+			b.Run(fmt.Sprintf("%d/%s", n, mode), func(b *testing.B) {
+				if mode == "cold" {
+					b.Setenv(golang.FormatCacheEnv, "off")
+				} else {
+					b.Setenv(golang.FormatCacheEnv, b.TempDir())
+				}
+				result, err := loader.LoadFile(largeSpec(b, n))
+				require.NoError(b, err)
+				spec, err := loader.Transform(result)
+				require.NoError(b, err)
+				cfg := &config.Config{Go: config.GoConfig{
+					Package:         "gen",
+					OutputDir:       b.TempDir(),
+					ServerFramework: "chi",
+					Targets:         []string{"types", "server", "strict-server", "client"},
+				}}
+				b.ReportAllocs()
+				for b.Loop() {
+					gen, err := codegen.New(cfg)
+					require.NoError(b, err)
+					_, err = gen.Generate(spec, result.RawData)
+					require.NoError(b, err)
+				}
+			})
+		}
+	}
+}
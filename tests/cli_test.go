@@ -0,0 +1,84 @@
+package tests
+
+import (
+	"bytes"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/kolah/eugene/internal/cli"
+	"github.com/stretchr/testify/require"
+)
+
+// runCLI runs eugene with args and returns what it printed.
+func runCLI(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	cmd := cli.RootCmd()
+	var out bytes.Buffer
+	cmd.SetOut(&out)
+	cmd.SetErr(&out)
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+func TestCLIServerOnly(t *testing.T) {
+	for _, target := range []string{"server", "strict-server"} {
+		for _, framework := range []string{"stdlib", "chi", "echo"} {
+			t.Run(target+" "+framework, func(t *testing.T) {
+				dir := filepath.Join("generated", "cli_"+strings.ReplaceAll(target, "-", "_")+"_"+framework)
+				require.NoError(t, os.RemoveAll(dir))
+				out, err := runCLI(t, "generate", "go", target, "-s", "testdata/specs/operations/enum-param.yaml", "-o", dir, "-p", "gen", "-f", framework)
+				require.NoError(t, err, out)
+
+				// The parameter enums live in the types the target adds
+				require.FileExists(t, filepath.Join(dir, "types.eugene.go"))
+				build := exec.Command("go", "build", "./...")
+				build.Dir = dir
+				output, err := build.CombinedOutput()
+				require.NoError(t, err, "generated code failed to compile:\n%s", output)
+			})
+		}
+	}
+}
+
+func TestCLIVerify(t *testing.T) {
+	spec, err := filepath.Abs("testdata/specs/operations/enum-param.yaml")
+	require.NoError(t, err)
+
+	t.Run("targets from the command line", func(t *testing.T) {
+		dir := t.TempDir()
+		cfg := filepath.Join(dir, "eugene.yaml")
+		err := os.WriteFile(cfg, []byte("spec: "+spec+"\ngo:\n  output-dir: "+filepath.Join(dir, "gen")+"\n  package: api\n  server-framework: chi\n  output-options:\n    lock-file: true\n"), 0644)
+		require.NoError(t, err)
+
+		out, err := runCLI(t, "generate", "go", "all", "-q", "-c", cfg)
+		require.NoError(t, err, out)
+		out, err = runCLI(t, "verify", "-q", "-c", cfg)
+		require.NoError(t, err, out)
+
+		out, err = runCLI(t, "verify", "types", "-q", "-c", cfg)
+		require.Error(t, err)
+		require.Contains(t, out, "configuration changed since generation")
+	})
+
+	t.Run("flags only", func(t *testing.T) {
+		dir := t.TempDir()
+		cfg := filepath.Join(dir, "eugene.yaml")
+		require.NoError(t, os.WriteFile(cfg, nil, 0644))
+		gen := filepath.Join(dir, "gen")
+		args := []string{"-q", "-c", cfg, "-s", spec, "-o", gen, "-p", "api"}
+		out, err := runCLI(t, append([]string{"generate", "go", "client", "--lock-file"}, args...)...)
+		require.NoError(t, err, out)
+		out, err = runCLI(t, append([]string{"verify"}, args...)...)
+		require.NoError(t, err, out)
+
+		err = os.WriteFile(filepath.Join(gen, "client.eugene.go"), []byte("package api\n"), 0644)
+		require.NoError(t, err)
+		out, err = runCLI(t, append([]string{"verify"}, args...)...)
+		require.Error(t, err)
+		require.Contains(t, out, "client.eugene.go was modified after generation")
+	})
+}
@@ -1,29 +1,82 @@
 package tests
 
 import (
+	"go/ast"
+	"go/doc"
+	"go/parser"
+	"go/token"
+	"maps"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/kolah/eugene/internal/codegen"
 	"github.com/kolah/eugene/internal/config"
+	"github.com/kolah/eugene/internal/golang"
+	"github.com/kolah/eugene/internal/jvm"
 	"github.com/kolah/eugene/internal/loader"
+	"github.com/kolah/eugene/internal/model"
+	"github.com/kolah/eugene/internal/targets/client"
+	"github.com/kolah/eugene/internal/targets/server"
+	"github.com/kolah/eugene/internal/targets/strictserver"
+	"github.com/kolah/eugene/internal/templates"
+	"github.com/kolah/eugene/internal/typescript"
+	embeddedtmpl "github.com/kolah/eugene/templates"
 	"github.com/stretchr/testify/require"
 )
 
+// TestMain keeps the format cache of the tests out of the user cache
+// directory.
+func TestMain(m *testing.M) {
+	dir, err := os.MkdirTemp("", "eugene-cache-")
+	if err != nil {
+		panic(err)
+	}
+	os.Setenv(golang.FormatCacheEnv, dir)
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
+}
+
 func TestGeneratedCodeCompiles(t *testing.T) {
 	tests := []struct {
 		name             string
 		targets          []string // types, server, client
 		serverFramework  string
 		enumStrategy     string
+		enumUnknown      string
+		unknownFields    string
 		uuidPackage      string
 		nullableStrategy string
 		enableYAMLTags   bool
+		singleFile       bool
+		clientServices   bool
+		compactClient    bool
+		splitByTag       bool
+		examples         bool
+		strictValidation bool
+		exampleChecks    bool
+		testHelpers      bool
+		clientMock       bool
+		vcr              bool
+		healthEndpoints  bool
+		prefixVariables  bool
+		permissions      bool
+		constraints      bool
+		routeDrift       bool
+		cors             bool
+		maxBody          int64
+		disallowUnknown  bool
+		allOfStrategy    string
+		sortFields       string
 		outputDir        string
 		specFile         string // optional, defaults to routing.yaml
+		asyncAPIFile     string // optional AsyncAPI document merged into the spec
+		schemaFile       string // optional bare JSON Schema used instead of specFile
 	}{
 		// Enum strategy tests
 		{
@@ -50,6 +103,92 @@ func TestGeneratedCodeCompiles(t *testing.T) {
 			outputDir:    "generated/types_struct",
 			specFile:     "testdata/specs/types/enums.yaml",
 		},
+		// x-oink-map-key typed map keys
+		{
+			name:        "map_keys",
+			targets:     []string{"types"},
+			uuidPackage: "google",
+			outputDir:   "generated/map_keys",
+			specFile:    "testdata/specs/types/map-keys.yaml",
+		},
+		// x-oink-ordered key order preservation
+		{
+			name:      "ordered",
+			targets:   []string{"types"},
+			outputDir: "generated/ordered",
+			specFile:  "testdata/specs/types/ordered.yaml",
+		},
+		// x-oink-raw json.RawMessage passthrough
+		{
+			name:      "raw_json",
+			targets:   []string{"types"},
+			outputDir: "generated/raw_json",
+			specFile:  "testdata/specs/types/raw.yaml",
+		},
+		// x-oink-envelope generic envelopes
+		{
+			name:      "envelope",
+			targets:   []string{"types", "client"},
+			outputDir: "generated/envelope",
+			specFile:  "testdata/specs/types/envelope.yaml",
+		},
+		// x-oink-bitmask flag enums
+		{
+			name:         "bitmask_enums",
+			targets:      []string{"types"},
+			enumStrategy: "const",
+			outputDir:    "generated/bitmask_enums",
+			specFile:     "testdata/specs/types/bitmask.yaml",
+		},
+		{
+			name:         "bitmask_enums_struct",
+			targets:      []string{"types"},
+			enumStrategy: "struct",
+			enumUnknown:  "reject",
+			outputDir:    "generated/bitmask_enums_struct",
+			specFile:     "testdata/specs/types/bitmask.yaml",
+		},
+		// Unknown enum value handling
+		{
+			name:         "enum_unknown_reject",
+			targets:      []string{"types"},
+			enumStrategy: "const",
+			enumUnknown:  "reject",
+			outputDir:    "generated/enum_unknown_reject",
+			specFile:     "testdata/specs/types/enum-unknown.yaml",
+		},
+		{
+			name:         "enum_unknown_extend",
+			targets:      []string{"types"},
+			enumStrategy: "const",
+			enumUnknown:  "extend",
+			outputDir:    "generated/enum_unknown_extend",
+			specFile:     "testdata/specs/types/enum-unknown.yaml",
+		},
+		{
+			name:         "enum_unknown_struct",
+			targets:      []string{"types"},
+			enumStrategy: "struct",
+			enumUnknown:  "extend",
+			outputDir:    "generated/enum_unknown_struct",
+			specFile:     "testdata/specs/types/enum-unknown.yaml",
+		},
+		// Unknown JSON member handling
+		{
+			name:          "unknown_fields_error",
+			targets:       []string{"types"},
+			unknownFields: "error",
+			outputDir:     "generated/unknown_fields_error",
+			specFile:      "testdata/specs/types/unknown-fields.yaml",
+		},
+		{
+			name:           "unknown_fields_capture",
+			targets:        []string{"types", "strict-server"},
+			unknownFields:  "capture",
+			enableYAMLTags: true,
+			outputDir:      "generated/unknown_fields_capture",
+			specFile:       "testdata/specs/types/unknown-fields.yaml",
+		},
 		// Server framework tests
 		{
 			name:            "server_echo",
@@ -79,6 +218,13 @@ func TestGeneratedCodeCompiles(t *testing.T) {
 			outputDir: "generated/client",
 			specFile:  "testdata/specs/routing.yaml",
 		},
+		// A schema named like the client's helpers
+		{
+			name:      "operation_schema",
+			targets:   []string{"types", "client"},
+			outputDir: "generated/operation_schema",
+			specFile:  "testdata/specs/operations/operation-schema.yaml",
+		},
 		// Full generation test (types + server + client)
 		{
 			name:            "full_echo",
@@ -106,6 +252,7 @@ func TestGeneratedCodeCompiles(t *testing.T) {
 			name:            "openapi32_stdlib",
 			targets:         []string{"types", "server", "client"},
 			serverFramework: "stdlib",
+			clientMock:      true,
 			outputDir:       "generated/openapi32_stdlib",
 			specFile:        "testdata/specs/openapi32/features.yaml",
 		},
@@ -173,6 +320,21 @@ func TestGeneratedCodeCompiles(t *testing.T) {
 			outputDir: "generated/types_allof",
 			specFile:  "testdata/specs/types/allof.yaml",
 		},
+		{
+			name:          "types_sort_fields",
+			targets:       []string{"types"},
+			allOfStrategy: "flatten",
+			sortFields:    "alpha",
+			outputDir:     "generated/types_sort_fields",
+			specFile:      "testdata/specs/types/allof-flatten.yaml",
+		},
+		{
+			name:          "types_allof_conflict",
+			targets:       []string{"types"},
+			allOfStrategy: "flatten",
+			outputDir:     "generated/types_allof_conflict",
+			specFile:      "testdata/specs/types/allof-conflict.yaml",
+		},
 		{
 			name:      "types_anyof",
 			targets:   []string{"types"},
@@ -212,11 +374,34 @@ func TestGeneratedCodeCompiles(t *testing.T) {
 			outputDir:       "generated/post_query_params",
 			specFile:        "testdata/specs/parameters/post-query-params.yaml",
 		},
+		{
+			name:            "query_encoding",
+			targets:         []string{"types", "server", "client"},
+			serverFramework: "stdlib",
+			outputDir:       "generated/query_encoding",
+			specFile:        "testdata/specs/parameters/query-encoding.yaml",
+		},
+		{
+			name:            "query_encoding_compact",
+			targets:         []string{"types", "strict-server", "client"},
+			serverFramework: "chi",
+			compactClient:   true,
+			outputDir:       "generated/query_encoding_compact",
+			specFile:        "testdata/specs/parameters/query-encoding.yaml",
+		},
+		{
+			name:            "query_encoding_echo",
+			targets:         []string{"types", "server"},
+			serverFramework: "echo",
+			outputDir:       "generated/query_encoding_echo",
+			specFile:        "testdata/specs/parameters/query-encoding.yaml",
+		},
 		// Content type tests
 		{
 			name:            "multipart",
 			targets:         []string{"types", "server", "client"},
 			serverFramework: "echo",
+			clientMock:      true,
 			outputDir:       "generated/multipart",
 			specFile:        "testdata/specs/content/multipart.yaml",
 		},
@@ -234,6 +419,132 @@ func TestGeneratedCodeCompiles(t *testing.T) {
 			outputDir:       "generated/sse",
 			specFile:        "testdata/specs/content/sse.yaml",
 		},
+		// Server binary scaffold
+		{
+			name:            "main_stdlib",
+			targets:         []string{"types", "server", "main"},
+			serverFramework: "stdlib",
+			outputDir:       "generated/main_stdlib",
+			specFile:        "testdata/specs/e2e/roundtrip.yaml",
+		},
+		{
+			name:            "main_echo",
+			targets:         []string{"types", "server", "main"},
+			serverFramework: "echo",
+			outputDir:       "generated/main_echo",
+			specFile:        "testdata/specs/e2e/roundtrip.yaml",
+		},
+		{
+			name:            "main_strict_chi",
+			targets:         []string{"types", "strict-server", "main"},
+			serverFramework: "chi",
+			outputDir:       "generated/main_strict_chi",
+			specFile:        "testdata/specs/e2e/roundtrip.yaml",
+		},
+		// Health, readiness and version routes
+		{
+			name:            "health_stdlib",
+			targets:         []string{"types", "server", "strict-server"},
+			serverFramework: "stdlib",
+			healthEndpoints: true,
+			cors:            true,
+			outputDir:       "generated/health_stdlib",
+			specFile:        "testdata/specs/e2e/roundtrip.yaml",
+		},
+		{
+			name:            "health_chi",
+			targets:         []string{"types", "server", "strict-server"},
+			serverFramework: "chi",
+			healthEndpoints: true,
+			routeDrift:      true,
+			outputDir:       "generated/health_chi",
+			specFile:        "testdata/specs/e2e/roundtrip.yaml",
+		},
+		{
+			name:            "health_echo",
+			targets:         []string{"types", "server", "strict-server"},
+			serverFramework: "echo",
+			healthEndpoints: true,
+			routeDrift:      true,
+			cors:            true,
+			outputDir:       "generated/health_echo",
+			specFile:        "testdata/specs/e2e/roundtrip.yaml",
+		},
+		{
+			name:            "cors_paths",
+			targets:         []string{"types", "server"},
+			serverFramework: "chi",
+			cors:            true,
+			outputDir:       "generated/cors_paths",
+			specFile:        "testdata/specs/operations/cors-paths.yaml",
+		},
+		// Request body limits and unknown fields rejected
+		{
+			name:            "body_limits_chi",
+			targets:         []string{"types", "strict-server"},
+			serverFramework: "chi",
+			maxBody:         64,
+			unknownFields:   "error",
+			disallowUnknown: true,
+			outputDir:       "generated/body_limits_chi",
+			specFile:        "testdata/specs/operations/body-limits.yaml",
+		},
+		{
+			name:            "body_limits_echo",
+			targets:         []string{"types", "server"},
+			serverFramework: "echo",
+			outputDir:       "generated/body_limits_echo",
+			specFile:        "testdata/specs/operations/body-limits.yaml",
+		},
+		// Server URL variables prefixing every operation
+		{
+			name:            "tenant_prefix_stdlib",
+			targets:         []string{"types", "server", "strict-server", "client"},
+			serverFramework: "stdlib",
+			prefixVariables: true,
+			outputDir:       "generated/tenant_prefix_stdlib",
+			specFile:        "testdata/specs/tenants/api.yaml",
+		},
+		{
+			name:            "tenant_prefix_chi",
+			targets:         []string{"types", "server", "strict-server", "client"},
+			serverFramework: "chi",
+			prefixVariables: true,
+			outputDir:       "generated/tenant_prefix_chi",
+			specFile:        "testdata/specs/tenants/api.yaml",
+		},
+		{
+			name:            "tenant_prefix_echo",
+			targets:         []string{"types", "server", "strict-server", "client"},
+			serverFramework: "echo",
+			prefixVariables: true,
+			outputDir:       "generated/tenant_prefix_echo",
+			specFile:        "testdata/specs/tenants/api.yaml",
+		},
+		// Load-test scenario from spec examples
+		{
+			name:            "loadtest",
+			targets:         []string{"types", "server", "client", "loadtest"},
+			serverFramework: "echo",
+			outputDir:       "generated/loadtest",
+			specFile:        "testdata/specs/loadtest/api.yaml",
+		},
+		// Response links test
+		{
+			name:      "links",
+			targets:   []string{"types", "client"},
+			outputDir: "generated/links",
+			specFile:  "testdata/specs/links/links.yaml",
+		},
+		// Single-file bundle test
+		{
+			name:            "single_file",
+			targets:         []string{"types", "server", "strict-server", "client", "spec"},
+			serverFramework: "chi",
+			singleFile:      true,
+			outputDir:       "generated/single_file",
+			specFile:        "testdata/specs/content/multipart.yaml",
+		},
 		// Error responses test
 		{
 			name:            "errors",
@@ -254,6 +565,7 @@ func TestGeneratedCodeCompiles(t *testing.T) {
 			name:            "security",
 			targets:         []string{"types", "server"},
 			serverFramework: "echo",
+			permissions:     true,
 			outputDir:       "generated/security",
 			specFile:        "testdata/specs/security/auth.yaml",
 		},
@@ -280,14 +592,200 @@ func TestGeneratedCodeCompiles(t *testing.T) {
 			outputDir:       "generated/e2e_echo",
 			specFile:        "testdata/specs/e2e/roundtrip.yaml",
 		},
+		// Operations without operationId
+		{
+			name:            "synthesized_operation_ids",
+			targets:         []string{"types", "server", "strict-server", "client"},
+			serverFramework: "chi",
+			outputDir:       "generated/synthesized_operation_ids",
+			specFile:        "testdata/specs/operations/no-operation-ids.yaml",
+		},
 		// E2E tests - strict server
 		{
 			name:            "e2e_strict_echo",
 			targets:         []string{"types", "strict-server", "client"},
 			serverFramework: "echo",
+			testHelpers:     true,
 			outputDir:       "generated/e2e_strict_echo",
 			specFile:        "testdata/specs/e2e/roundtrip.yaml",
 		},
+		// Vendor JSON media types
+		{
+			name:            "vendor_json",
+			targets:         []string{"types", "strict-server", "client"},
+			serverFramework: "echo",
+			outputDir:       "generated/vendor_json",
+			specFile:        "testdata/specs/content/vendor-json.yaml",
+		},
+		// text/plain and text/html bodies
+		{
+			name:            "text_bodies",
+			targets:         []string{"types", "strict-server", "client"},
+			serverFramework: "chi",
+			outputDir:       "generated/text_bodies",
+			specFile:        "testdata/specs/content/text.yaml",
+		},
+		// application/octet-stream bodies
+		{
+			name:            "binary_bodies",
+			targets:         []string{"types", "strict-server"},
+			serverFramework: "stdlib",
+			outputDir:       "generated/binary_bodies",
+			specFile:        "testdata/specs/content/binary.yaml",
+		},
+		// Per-tag client services
+		{
+			name:           "client_services",
+			targets:        []string{"types", "client"},
+			clientServices: true,
+			outputDir:      "generated/client_services",
+			specFile:       "testdata/specs/operations/tagged.yaml",
+		},
+		// Table-driven client core
+		{
+			name:          "compact_client",
+			targets:       []string{"types", "client"},
+			compactClient: true,
+			outputDir:     "generated/compact_client",
+			specFile:      "testdata/specs/e2e/roundtrip.yaml",
+		},
+		// Components-only document of shared models
+		{
+			name:      "components_only",
+			targets:   []string{"types", "spec"},
+			outputDir: "generated/components_only",
+			specFile:  "testdata/specs/types/components-only.yaml",
+		},
+		// Bare JSON Schema input
+		{
+			name:       "json_schema",
+			targets:    []string{"types"},
+			outputDir:  "generated/json_schema",
+			schemaFile: "testdata/specs/jsonschema/order.schema.json",
+		},
+		// AsyncAPI payload types and event interfaces
+		{
+			name:         "events_v3",
+			targets:      []string{"types", "client", "events"},
+			outputDir:    "generated/events_v3",
+			specFile:     "testdata/specs/events/api.yaml",
+			asyncAPIFile: "testdata/specs/events/asyncapi-v3.yaml",
+		},
+		{
+			name:         "events_v2",
+			targets:      []string{"types", "events"},
+			outputDir:    "generated/events_v2",
+			specFile:     "testdata/specs/events/api.yaml",
+			asyncAPIFile: "testdata/specs/events/asyncapi-v2.yaml",
+		},
+		// LLM tool manifest and CallTool dispatcher
+		{
+			name:      "tools",
+			targets:   []string{"types", "client", "tools"},
+			outputDir: "generated/tools",
+			specFile:  "testdata/specs/e2e/roundtrip.yaml",
+		},
+		// x-oink-async polling helpers
+		{
+			name:      "async_operations",
+			targets:   []string{"types", "client"},
+			outputDir: "generated/async_operations",
+			specFile:  "testdata/specs/operations/async.yaml",
+		},
+		// OpenAPI 3.2 OAuth device authorization flow
+		{
+			name:      "device_flow",
+			targets:   []string{"types", "client"},
+			outputDir: "generated/device_flow",
+			specFile:  "testdata/specs/security/device.yaml",
+		},
+		// Self-referencing schemas through arrays and maps
+		{
+			name:            "recursive_schemas",
+			targets:         []string{"types", "server", "client"},
+			serverFramework: "chi",
+			outputDir:       "generated/recursive_schemas",
+			specFile:        "testdata/specs/types/recursive.yaml",
+		},
+		// One package per tag sharing the types package
+		{
+			name:            "split_by_tag",
+			targets:         []string{"types", "server", "strict-server", "client", "tools"},
+			serverFramework: "chi",
+			splitByTag:      true,
+			outputDir:       "generated/split_by_tag",
+			specFile:        "testdata/specs/operations/tagged.yaml",
+		},
+		// Per-tag chi handlers mountable on an existing router
+		{
+			name:            "chi_mount",
+			targets:         []string{"types", "server"},
+			serverFramework: "chi",
+			outputDir:       "generated/chi_mount",
+			specFile:        "testdata/specs/operations/tagged.yaml",
+		},
+		// Example functions for the client and server
+		{
+			name:            "examples_echo",
+			targets:         []string{"types", "server", "strict-server", "client"},
+			serverFramework: "echo",
+			examples:        true,
+			outputDir:       "generated/examples_echo",
+			specFile:        "testdata/specs/operations/examples.yaml",
+		},
+		{
+			name:            "examples_chi",
+			targets:         []string{"types", "strict-server", "client"},
+			serverFramework: "chi",
+			examples:        true,
+			outputDir:       "generated/examples_chi",
+			specFile:        "testdata/specs/operations/async.yaml",
+		},
+		{
+			name:            "examples_stdlib",
+			targets:         []string{"types", "server", "strict-server"},
+			serverFramework: "stdlib",
+			examples:        true,
+			outputDir:       "generated/examples_stdlib",
+			specFile:        "testdata/specs/operations/examples.yaml",
+		},
+		// Validating strict server
+		{
+			name:             "strict_validation",
+			targets:          []string{"types", "strict-server"},
+			serverFramework:  "stdlib",
+			strictValidation: true,
+			constraints:      true,
+			outputDir:        "generated/strict_validation",
+			specFile:         "testdata/specs/operations/validation.yaml",
+		},
+		{
+			name:             "strict_validation_echo",
+			targets:          []string{"types", "strict-server"},
+			serverFramework:  "echo",
+			enumStrategy:     "struct",
+			strictValidation: true,
+			outputDir:        "generated/strict_validation_echo",
+			specFile:         "testdata/specs/operations/validation.yaml",
+		},
+		// Response example checks
+		{
+			name:            "example_checks",
+			targets:         []string{"types", "server"},
+			serverFramework: "stdlib",
+			exampleChecks:   true,
+			outputDir:       "generated/example_checks",
+			specFile:        "testdata/specs/responses/examples.yaml",
+		},
+		// Echo binding of typed path and query parameters
+		{
+			name:        "echo_binding",
+			targets:     []string{"types", "server"},
+			uuidPackage: "google",
+			enumUnknown: "reject",
+			outputDir:   "generated/echo_binding",
+			specFile:    "testdata/specs/operations/echo-binding.yaml",
+		},
 		// E2E tests - Chi server
 		{
 			name:            "e2e_chi",
@@ -301,6 +799,9 @@ func TestGeneratedCodeCompiles(t *testing.T) {
 			name:            "e2e_stdlib",
 			targets:         []string{"types", "server", "client"},
 			serverFramework: "stdlib",
+			testHelpers:     true,
+			clientMock:      true,
+			vcr:             true,
 			outputDir:       "generated/e2e_stdlib",
 			specFile:        "testdata/specs/e2e/roundtrip.yaml",
 		},
@@ -325,12 +826,24 @@ func TestGeneratedCodeCompiles(t *testing.T) {
 			require.NoError(t, err)
 
 			// Load spec
-			result, err := loader.LoadFile(specPath)
+			var result *loader.Result
+			if tt.schemaFile != "" {
+				specPath = filepath.Join(testDir, tt.schemaFile)
+				result, err = loader.LoadSchemaFile(specPath)
+			} else {
+				result, err = loader.LoadFile(specPath)
+			}
 			require.NoError(t, err, "failed to load spec")
 
 			spec, err := loader.Transform(result)
 			require.NoError(t, err, "failed to transform spec")
 
+			if tt.asyncAPIFile != "" {
+				events, err := loader.LoadAsyncAPIFile(filepath.Join(testDir, tt.asyncAPIFile))
+				require.NoError(t, err, "failed to load AsyncAPI document")
+				require.NoError(t, loader.MergeEvents(spec, events))
+			}
+
 			// Create config with new structure
 			serverFramework := tt.serverFramework
 			if serverFramework == "" {
@@ -338,7 +851,8 @@ func TestGeneratedCodeCompiles(t *testing.T) {
 			}
 
 			cfg := &config.Config{
-				Spec: specPath,
+				Spec:     specPath,
+				AsyncAPI: tt.asyncAPIFile,
 				Go: config.GoConfig{
 					OutputDir:       outputPath,
 					Package:         "gen",
@@ -346,11 +860,32 @@ func TestGeneratedCodeCompiles(t *testing.T) {
 					Targets:         tt.targets,
 					Types: config.TypesConfig{
 						EnumStrategy:     tt.enumStrategy,
+						EnumUnknown:      tt.enumUnknown,
+						UnknownFields:    tt.unknownFields,
 						UUIDPackage:      tt.uuidPackage,
 						NullableStrategy: tt.nullableStrategy,
+						AllOfStrategy:    tt.allOfStrategy,
 					},
 					OutputOptions: config.OutputOptions{
-						EnableYAMLTags: tt.enableYAMLTags,
+						EnableYAMLTags:        tt.enableYAMLTags,
+						ClientServices:        tt.clientServices,
+						CompactClient:         tt.compactClient,
+						SplitByTag:            tt.splitByTag,
+						Examples:              tt.examples,
+						StrictValidation:      tt.strictValidation,
+						ExampleChecks:         tt.exampleChecks,
+						TestHelpers:           tt.testHelpers,
+						ClientMock:            tt.clientMock,
+						VCR:                   tt.vcr,
+						HealthEndpoints:       tt.healthEndpoints,
+						PrefixVariables:       tt.prefixVariables,
+						Permissions:           tt.permissions,
+						ConstraintConstants:   tt.constraints,
+						RouteDrift:            tt.routeDrift,
+						CORS:                  tt.cors,
+						MaxBody:               tt.maxBody,
+						DisallowUnknownFields: tt.disallowUnknown,
+						SortFields:            tt.sortFields,
 					},
 				},
 			}
@@ -362,10 +897,18 @@ func TestGeneratedCodeCompiles(t *testing.T) {
 			outputs, err := gen.Generate(spec, result.RawData)
 			require.NoError(t, err, "failed to generate")
 
+			if tt.singleFile {
+				outputs, err = codegen.Bundle(cfg.Go.Package, outputs)
+				require.NoError(t, err, "failed to bundle")
+				require.Len(t, outputs, 1)
+			}
+
 			// Write generated files
 			for _, o := range outputs {
 				filePath := filepath.Join(outputPath, o.Filename)
-				err := os.WriteFile(filePath, []byte(o.Content), 0644)
+				err := os.MkdirAll(filepath.Dir(filePath), 0755)
+				require.NoError(t, err)
+				err = os.WriteFile(filePath, []byte(o.Content), 0644)
 				require.NoError(t, err, "failed to write %s", o.Filename)
 			}
 
@@ -374,6 +917,13 @@ func TestGeneratedCodeCompiles(t *testing.T) {
 			cmd.Dir = outputPath
 			output, err := cmd.CombinedOutput()
 			require.NoError(t, err, "generated code failed to compile:\n%s", string(output))
+
+			if tt.examples {
+				cmd := exec.Command("go", "vet", "./...")
+				cmd.Dir = outputPath
+				output, err := cmd.CombinedOutput()
+				require.NoError(t, err, "generated examples failed to vet:\n%s", string(output))
+			}
 		})
 	}
 }
@@ -429,3 +979,1125 @@ func TestCustomTemplateOverride(t *testing.T) {
 
 	require.True(t, strings.Contains(typesContent, "CUSTOM TEMPLATE"), "custom template was not used")
 }
+
+func TestCustomTemplateBlocks(t *testing.T) {
+	result, err := loader.LoadFile("testdata/specs/routing.yaml")
+	require.NoError(t, err)
+	spec, err := loader.Transform(result)
+	require.NoError(t, err)
+
+	customDir := t.TempDir()
+	custom := map[string]string{
+		// A partial shared by every generated file
+		"go/partials/header.tmpl": `{{ define "go/partials/header" }}// Code generated by eugene for Acme. DO NOT EDIT.{{ end }}`,
+		// Only blocks: the rest of the embedded server template stays
+		"go/server/chi.tmpl": `{{ define "go/server/chi/interface" }}
+{{- template "embedded/go/server/chi/interface" . }}
+
+// ServerName names the server.
+const ServerName = "acme"
+{{- end }}`,
+	}
+	for name, content := range custom {
+		path := filepath.Join(customDir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+
+	cfg := &config.Config{
+		Templates: config.TemplateConfig{Dir: customDir},
+		Go: config.GoConfig{
+			OutputDir:       t.TempDir(),
+			Package:         "gen",
+			ServerFramework: "chi",
+			Targets:         []string{"types", "server"},
+		},
+	}
+	gen, err := codegen.New(cfg)
+	require.NoError(t, err)
+	outputs, err := gen.Generate(spec, result.RawData)
+	require.NoError(t, err)
+
+	var server string
+	for _, o := range outputs {
+		require.True(t, strings.HasPrefix(o.Content, "// Code generated by eugene for Acme. DO NOT EDIT.\n"), o.Filename)
+		if o.Filename == "server.eugene.go" {
+			server = o.Content
+		}
+	}
+	require.Contains(t, server, "const ServerName = \"acme\"")
+	require.Contains(t, server, "type ServerInterface interface {")
+	require.Contains(t, server, "func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {")
+}
+
+func TestFindConflicts(t *testing.T) {
+	outputs := []codegen.Output{{
+		Filename: "types.eugene.go",
+		Content:  "package gen\n\ntype Pet struct{}\n\nfunc (p *Pet) Validate() error { return nil }\n",
+	}}
+
+	dir := t.TempDir()
+	handwritten := filepath.Join(dir, "pet.go")
+	err := os.WriteFile(handwritten, []byte("package gen\n\nfunc (p Pet) Validate() error { return nil }\n\nfunc (p Pet) Name() string { return \"\" }\n"), 0644)
+	require.NoError(t, err)
+	otherPkg := filepath.Join(dir, "pet_test.go")
+	err = os.WriteFile(otherPkg, []byte("package gen_test\n\ntype Pet struct{}\n"), 0644)
+	require.NoError(t, err)
+
+	conflicts, err := codegen.FindConflicts("gen", outputs, []string{handwritten, otherPkg})
+	require.NoError(t, err)
+	require.Len(t, conflicts, 1)
+	require.Equal(t, "Pet.Validate", conflicts[0].Name)
+	require.Equal(t, "pet.go:3", conflicts[0].Position)
+	require.Equal(t, "types.eugene.go", conflicts[0].Generated)
+}
+
+func TestModuleFiles(t *testing.T) {
+	outputs := []codegen.Output{{
+		Filename: "server.eugene.go",
+		Content:  "package api\n\nimport (\n\t\"net/http\"\n\n\t\"github.com/go-chi/chi/v5\"\n\t\"github.com/google/uuid\"\n\t\"github.com/shopspring/decimal\"\n)\n",
+	}}
+
+	files, unresolved, err := codegen.ModuleFiles("github.com/acme/api", "api", "Pet Store", outputs)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+
+	require.Equal(t, "go.mod", files[0].Filename)
+	require.Contains(t, files[0].Content, "module github.com/acme/api\n")
+	require.Contains(t, files[0].Content, "\tgithub.com/go-chi/chi/v5 v5.2.3\n\tgithub.com/google/uuid v1.6.0\n")
+	require.NotContains(t, files[0].Content, "decimal")
+	require.Equal(t, []string{"github.com/shopspring/decimal"}, unresolved)
+
+	require.Equal(t, "doc.go", files[1].Filename)
+	require.Equal(t, "// Package api is generated by eugene from the Pet Store OpenAPI specification.\npackage api\n", files[1].Content)
+}
+
+func TestLockVerify(t *testing.T) {
+	dir := t.TempDir()
+	cfg := &config.Config{Spec: "api.yaml", Go: config.GoConfig{Package: "gen", OutputDir: dir}}
+	outputs := []codegen.Output{
+		{Filename: "types.eugene.go", Content: "package gen\n"},
+		{Filename: "types_marshal.go", Content: "package gen\n"},
+	}
+	err := os.WriteFile(filepath.Join(dir, "types.eugene.go"), []byte(outputs[0].Content), 0644)
+	require.NoError(t, err)
+
+	lock, err := codegen.NewLock("1.0.0", cfg, []byte("openapi: 3.1.0"), outputs, time.Now())
+	require.NoError(t, err)
+	require.Len(t, lock.Files, 1, "companion files are not locked")
+
+	data, err := lock.Marshal()
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(dir, codegen.LockFilename), data, 0644)
+	require.NoError(t, err)
+
+	recorded, err := codegen.ReadLock(dir)
+	require.NoError(t, err)
+	require.Empty(t, recorded.Verify(lock, dir))
+
+	changed, err := codegen.NewLock("1.0.0", cfg, []byte("openapi: 3.2.0"), outputs, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, []string{"spec api.yaml changed since generation"}, recorded.Verify(changed, dir))
+
+	err = os.WriteFile(filepath.Join(dir, "types.eugene.go"), []byte("package gen\n\n// edited\n"), 0644)
+	require.NoError(t, err)
+	require.Equal(t, []string{"types.eugene.go was modified after generation"}, recorded.Verify(lock, dir))
+}
+
+func TestLockVerifyAsyncAPI(t *testing.T) {
+	dir := t.TempDir()
+	asyncAPI := filepath.Join(dir, "asyncapi.yaml")
+	err := os.WriteFile(asyncAPI, []byte("asyncapi: 3.0.0\n"), 0644)
+	require.NoError(t, err)
+	cfg := &config.Config{Spec: "api.yaml", AsyncAPI: asyncAPI, Go: config.GoConfig{Package: "gen", OutputDir: dir}}
+
+	lock, err := codegen.NewLock("1.0.0", cfg, []byte("openapi: 3.1.0"), nil, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, asyncAPI, lock.AsyncAPI)
+
+	err = os.WriteFile(asyncAPI, []byte("asyncapi: 3.0.0\nchannels: {}\n"), 0644)
+	require.NoError(t, err)
+	changed, err := codegen.NewLock("1.0.0", cfg, []byte("openapi: 3.1.0"), nil, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, []string{"AsyncAPI document " + asyncAPI + " changed since generation"}, lock.Verify(changed, dir))
+}
+
+func TestTransformWarnings(t *testing.T) {
+	result, err := loader.LoadFile("testdata/specs/warnings/unsupported.yaml")
+	require.NoError(t, err)
+	spec, err := loader.Transform(result)
+	require.NoError(t, err)
+
+	require.Equal(t, []model.Warning{
+		{Kind: model.WarningIgnoredKeyword, Location: "#/components/schemas/Item", Message: "patternProperties is ignored"},
+		{Kind: model.WarningIgnoredKeyword, Location: "#/components/schemas/Item/properties/id", Message: "type [string integer] uses only string"},
+		{Kind: model.WarningIgnoredKeyword, Location: "#/components/schemas/Item/properties/code", Message: "not is ignored"},
+		{Kind: model.WarningExtension, Location: "#/components/schemas/Item/properties/color", Message: "x-oink-bitmask applies to integer enums only and is ignored"},
+		{Kind: model.WarningExtension, Location: "#/components/schemas/Item/properties/counts", Message: "x-oink-map-key \"date\" is ignored (valid: int, int32, int64, uuid)"},
+		{Kind: model.WarningExtension, Location: "#/components/schemas/Item/properties/tags", Message: "x-oink-ordered applies to objects only and is ignored"},
+		{Kind: model.WarningExtension, Location: "#/components/schemas/Item/properties/extra", Message: "x-oink-raw is ignored: x-oink-go-type is set"},
+		{Kind: model.WarningComposition, Location: "#/paths/~1items/post/requestBody/content/application~1json/schema", Message: "inline oneOf is mapped to any; move it to components/schemas"},
+		{Kind: model.WarningMediaType, Location: "#/paths/~1items/post/requestBody", Message: "application/xml is not generated; only application/json is used"},
+		{Kind: model.WarningExtension, Location: "#/paths/~1items/get", Message: "x-oink-async is ignored: unknown status-operation \"getExport\""},
+		{Kind: model.WarningExtension, Location: "#/components/schemas/CountPage", Message: "x-oink-envelope is ignored: properties differ from envelope Page"},
+		{Kind: model.WarningExtension, Location: "#/components/schemas/Listing", Message: "x-oink-envelope is ignored: no property \"items\""},
+	}, spec.Warnings)
+}
+
+func TestPositions(t *testing.T) {
+	data, err := os.ReadFile("testdata/specs/warnings/unsupported.yaml")
+	require.NoError(t, err)
+	positions := loader.NewPositions(data)
+
+	for pointer, want := range map[string][2]int{
+		"#/components/schemas/Item":                                         {36, 5},
+		"#/components/schemas/Item/properties/id":                           {39, 9},
+		"#/paths/~1items/post/requestBody/content/application~1json/schema": {20, 13},
+		// Unresolved pointers fall back to their closest ancestor
+		"#/components/schemas/Item/properties/missing": {38, 7},
+		"#": {1, 1},
+	} {
+		line, col := positions.Find(pointer)
+		require.Equal(t, want, [2]int{line, col}, pointer)
+	}
+
+	line, col := loader.NewPositions([]byte("{")).Find("#/paths")
+	require.Zero(t, line)
+	require.Zero(t, col)
+}
+
+func TestSpecErrors(t *testing.T) {
+	_, err := loader.LoadFile("testdata/specs/invalid/unresolved-ref.yaml")
+	require.Error(t, err)
+	var specErr *loader.SpecError
+	require.ErrorAs(t, err, &specErr)
+	require.Equal(t, 15, specErr.Line)
+	require.Equal(t, "#/paths/~1pets/get/responses/200/content/application~1json/schema", specErr.Pointer)
+	require.Contains(t, err.Error(), "testdata/specs/invalid/unresolved-ref.yaml:15:17: "+
+		"#/paths/~1pets/get/responses/200/content/application~1json/schema: "+
+		"component `#/components/schemas/Missing` does not exist in the specification")
+	require.Contains(t, err.Error(), "testdata/specs/invalid/unresolved-ref.yaml:22:11: "+
+		"#/components/schemas/Pet/properties/owner: "+
+		"component `#/components/schemas/Owner` does not exist in the specification")
+
+	_, err = loader.LoadFile("testdata/specs/invalid/external-ref.yaml")
+	require.ErrorContains(t, err, "testdata/specs/invalid/external/pet.yaml:7:7: "+
+		"#/Pet/properties/owner: cannot resolve reference `#/Owner`, it's missing")
+}
+
+func TestInvalidTypeWarnings(t *testing.T) {
+	result, err := loader.LoadFile("testdata/specs/invalid/invalid-type.yaml")
+	require.NoError(t, err)
+	spec, err := loader.Transform(result)
+	require.NoError(t, err)
+	require.Equal(t, []model.Warning{
+		{Kind: model.WarningInvalidType, Location: "#/components/schemas/Pet/properties/kind", Message: `invalid type "strin"; generated as any`},
+		{Kind: model.WarningInvalidType, Location: "#/components/schemas/Tags/items", Message: `invalid type "text"; generated as any`},
+		{Kind: model.WarningIgnoredKeyword, Location: "#/components/schemas/Tags/items", Message: "type [string text] uses only string"},
+	}, spec.Warnings)
+}
+
+func TestLoadData(t *testing.T) {
+	data, err := os.ReadFile("testdata/specs/invalid/unresolved-ref.yaml")
+	require.NoError(t, err)
+
+	_, err = loader.LoadData(data, "stdin", "yaml")
+	require.ErrorContains(t, err, "stdin:22:11: #/components/schemas/Pet/properties/owner: "+
+		"component `#/components/schemas/Owner` does not exist in the specification")
+
+	_, err = loader.LoadData(data, "stdin", "json")
+	require.ErrorContains(t, err, "stdin: invalid JSON at line 1")
+
+	_, err = loader.LoadData([]byte("openapi: 3.1.0\ninfo: [\n"), "stdin", "yaml")
+	require.ErrorContains(t, err, "stdin: invalid YAML")
+
+	result, err := loader.LoadData([]byte(`{"openapi": "3.1.0", "info": {"title": "Piped", "version": "1.0.0"}, "paths": {}}`), "stdin", "json")
+	require.NoError(t, err)
+	spec, err := loader.Transform(result)
+	require.NoError(t, err)
+	require.Equal(t, "Piped", spec.Info.Title)
+}
+
+func TestBundle(t *testing.T) {
+	result, err := loader.LoadFile("testdata/specs/bundle/api.yaml")
+	require.NoError(t, err)
+
+	data, err := loader.Bundle(result, loader.BundleOptions{})
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "common.yaml", "external refs are inlined")
+	require.Contains(t, string(data), "$ref: '#/components/schemas/Pet'", "local refs are kept")
+
+	bundled, err := loader.LoadData(data, "bundled.yaml", "yaml")
+	require.NoError(t, err)
+	spec, err := loader.Transform(bundled)
+	require.NoError(t, err)
+	breed := spec.SchemaByRef("#/components/schemas/Dog").AllOf[1].Properties[0].Schema
+	require.Equal(t, []any{"lab", "pug"}, breed.Enum)
+
+	data, err = loader.Bundle(result, loader.BundleOptions{FlattenAllOf: true, JSON: true})
+	require.NoError(t, err)
+	bundled, err = loader.LoadData(data, "bundled.json", "json")
+	require.NoError(t, err)
+	spec, err = loader.Transform(bundled)
+	require.NoError(t, err)
+
+	dog := spec.SchemaByRef("#/components/schemas/Dog")
+	require.Empty(t, dog.AllOf)
+	require.Equal(t, "A pet.", dog.Description)
+	require.Len(t, dog.Properties, 2)
+	require.Equal(t, "name", dog.Properties[0].Name)
+	require.Equal(t, model.TypeString, dog.Properties[0].Schema.Type, "the first property of a name wins")
+	require.Equal(t, "breed", dog.Properties[1].Name)
+	require.Equal(t, []string{"name", "breed"}, dog.Required)
+}
+
+func TestBundleAllOfConflicts(t *testing.T) {
+	data, err := os.ReadFile("testdata/specs/types/allof-conflict.yaml")
+	require.NoError(t, err)
+	load := func(resolution string) (*loader.Result, *model.Spec) {
+		spec := strings.Replace(string(data), "x-oink-allof-conflict: last", "x-oink-allof-conflict: "+resolution, 1)
+		if resolution == "" {
+			spec = strings.Replace(string(data), "      x-oink-allof-conflict: last\n", "", 1)
+		}
+		result, err := loader.LoadData([]byte(spec), "allof-conflict.yaml", "yaml")
+		require.NoError(t, err)
+		transformed, err := loader.Transform(result)
+		require.NoError(t, err)
+		return result, transformed
+	}
+
+	// bundling flattens by the rules generation checks
+	_, spec := load("")
+	require.ErrorContains(t, codegen.CheckAllOfConflicts(spec), `allOf members declare "revision" as integer`)
+
+	for resolution, want := range map[string]model.SchemaType{"first": model.TypeInteger, "last": model.TypeString} {
+		result, spec := load(resolution)
+		require.NoError(t, codegen.CheckAllOfConflicts(spec))
+
+		out, err := loader.Bundle(result, loader.BundleOptions{FlattenAllOf: true})
+		require.NoError(t, err)
+		require.NotContains(t, string(out), "x-oink-allof-conflict")
+		bundled, err := loader.LoadData(out, "bundled.yaml", "yaml")
+		require.NoError(t, err)
+		spec, err = loader.Transform(bundled)
+		require.NoError(t, err)
+		require.Empty(t, spec.Warnings)
+
+		document := spec.SchemaByRef("#/components/schemas/Document")
+		var names []string
+		for _, prop := range document.Properties {
+			names = append(names, prop.Name)
+		}
+		require.Equal(t, []string{"createdAt", "revision", "title"}, names)
+		require.Equal(t, want, document.Properties[1].Schema.Type, resolution)
+	}
+}
+
+func TestLoadOverlays(t *testing.T) {
+	result, err := loader.LoadFile("testdata/specs/overlay/vendor.yaml",
+		"testdata/specs/overlay/deployment.yaml", "testdata/specs/overlay/stale.yaml")
+	require.NoError(t, err)
+	spec, err := loader.Transform(result)
+	require.NoError(t, err)
+
+	require.Len(t, spec.Servers, 2)
+	require.Equal(t, "https://widgets.internal.example", spec.Servers[1].URL)
+
+	require.Len(t, spec.Operations, 1, "removed paths are not generated")
+	require.Equal(t, "getWidget", spec.Operations[0].ID)
+	require.True(t, spec.Operations[0].Batchable)
+
+	widget := spec.SchemaByRef("#/components/schemas/Widget")
+	require.NotNil(t, widget)
+	price := widget.Properties[1].Schema
+	require.Equal(t, "decimal.Decimal", price.Extensions.GoType)
+	require.Equal(t, "github.com/shopspring/decimal", price.Extensions.GoTypeImport.Path)
+
+	require.Len(t, result.Warnings, 2)
+	require.Contains(t, result.Warnings[0], "stale.yaml: ")
+	require.Contains(t, result.Warnings[0], "$.paths['/gadgets'].get")
+	require.Contains(t, result.Warnings[1], "OpenAPI 3.0.x detected")
+
+	_, err = loader.LoadFile("testdata/specs/overlay/vendor.yaml", "testdata/specs/overlay/missing.yaml")
+	require.ErrorContains(t, err, "reading overlay file")
+}
+
+func TestLoadSchemaFile(t *testing.T) {
+	names := func(path string) []string {
+		result, err := loader.LoadSchemaFile(path)
+		require.NoError(t, err)
+		spec, err := loader.Transform(result)
+		require.NoError(t, err)
+		var names []string
+		for _, s := range spec.Schemas {
+			names = append(names, s.Name)
+		}
+		return names
+	}
+
+	require.Equal(t, []string{"Order", "LineItem", "Status", "Address"}, names("testdata/specs/jsonschema/order.schema.json"))
+	require.Equal(t, []string{"Money"}, names("testdata/specs/jsonschema/definitions-only.schema.json"), "a root with only definitions is not generated")
+}
+
+func TestPruneSchemas(t *testing.T) {
+	load := func() *model.Spec {
+		result, err := loader.LoadFile("testdata/specs/operations/unused-schemas.yaml")
+		require.NoError(t, err)
+		spec, err := loader.Transform(result)
+		require.NoError(t, err)
+		return spec
+	}
+	names := func(spec *model.Spec) []string {
+		var names []string
+		for _, s := range spec.Schemas {
+			names = append(names, s.Name)
+		}
+		return names
+	}
+
+	spec := load()
+	require.Equal(t, []string{"LegacyOrder"}, loader.PruneSchemas(spec))
+	require.NotContains(t, names(spec), "LegacyOrder")
+
+	// Schemas used only by filtered-out operations go too; references through
+	// properties, items, oneOf and discriminator mappings are followed
+	spec = load()
+	loader.FilterTags(spec, []string{"orders"}, nil)
+	require.Len(t, spec.Operations, 1)
+	require.Equal(t, []string{"AuditEntry", "LegacyOrder"}, loader.PruneSchemas(spec))
+	require.Equal(t, []string{"Order", "OrderStatus", "LineItem", "Payment", "CardPayment", "BankPayment", "Error"}, names(spec))
+
+	spec = load()
+	loader.FilterTags(spec, nil, []string{"orders"})
+	require.Equal(t, "listAuditEntries", spec.Operations[0].ID)
+	loader.PruneSchemas(spec)
+	require.Equal(t, []string{"AuditEntry"}, names(spec))
+}
+
+func TestSecurityInheritance(t *testing.T) {
+	result, err := loader.LoadFile("testdata/specs/security/auth.yaml")
+	require.NoError(t, err)
+	spec, err := loader.Transform(result)
+	require.NoError(t, err)
+
+	security := make(map[string][]model.SecurityRequirement)
+	for _, op := range spec.Operations {
+		security[op.ID] = op.Security
+	}
+	// security: [] opts out of the document-level requirement
+	require.Empty(t, security["publicEndpoint"])
+	require.Equal(t, []model.SecurityRequirement{{Name: "bearerAuth"}}, security["inheritedEndpoint"])
+	require.Equal(t, []model.SecurityRequirement{{Name: "apiKey"}}, security["apiEndpoint"])
+	require.Equal(t, []model.SecurityRequirement{{Name: "oauth2", Scopes: []string{"admin:read", "admin:write"}}}, security["adminEndpoint"])
+}
+
+func TestSynthesizedOperationIDs(t *testing.T) {
+	result, err := loader.LoadFile("testdata/specs/operations/no-operation-ids.yaml")
+	require.NoError(t, err)
+	spec, err := loader.Transform(result)
+	require.NoError(t, err)
+
+	var ids []string
+	for _, op := range spec.Operations {
+		ids = append(ids, op.ID)
+	}
+	require.Equal(t, []string{"GetRoot", "GetPets", "addPet", "GetPetsPetId", "deletePet", "GetUsersUserIdAvatarPng"}, ids)
+
+	require.Len(t, spec.Warnings, 4, "x-oink-go-name names are not reported")
+	require.Equal(t, model.Warning{
+		Kind:     model.WarningOperationID,
+		Location: "#/paths/~1pets~1{petId}/get",
+		Message:  "missing operationId; using GetPetsPetId",
+	}, spec.Warnings[2])
+}
+
+func TestCheckOperations(t *testing.T) {
+	ops := []model.Operation{
+		{ID: "getPet", Method: model.MethodGet, Path: "/pets/{id}"},
+		{ID: "deletePet", Method: model.MethodDelete, Path: "/pets/{petId}"},
+		{ID: "GetPet", Method: model.MethodGet, Path: "/animals/{id}"},
+		{ID: "showPet", Method: model.MethodGet, Path: "/pets/{petId}"},
+	}
+	require.NoError(t, codegen.CheckOperations(ops[:2], nil))
+
+	echo, err := server.New("echo")
+	require.NoError(t, err)
+	err = codegen.CheckOperations(ops, echo.RoutePath)
+	require.EqualError(t, err, "conflicting operations:\n"+
+		"  operationId \"getPet\" (GET /pets/{id}) and \"GetPet\" (GET /animals/{id}) both generate GetPet\n"+
+		"  GET /pets/{id} and GET /pets/{petId} match the same requests (routes /pets/:id and /pets/:petId)")
+
+	// Without a server target only operation names are checked
+	require.NoError(t, codegen.CheckOperations([]model.Operation{ops[0], ops[3]}, nil))
+}
+
+func TestConstraintConstantClash(t *testing.T) {
+	result, err := loader.LoadFile("testdata/specs/invalid/constraint-clash.yaml")
+	require.NoError(t, err)
+	spec, err := loader.Transform(result)
+	require.NoError(t, err)
+
+	gen, err := codegen.New(&config.Config{Go: config.GoConfig{
+		Package:       "gen",
+		Targets:       []string{"types"},
+		OutputOptions: config.OutputOptions{ConstraintConstants: true},
+	}})
+	require.NoError(t, err)
+	_, err = gen.Generate(spec, nil)
+	require.ErrorContains(t, err, "constraint constant PetNameMaxLength of PetName clashes with the constant of Pet.name")
+}
+
+func TestStrictValidationClash(t *testing.T) {
+	tests := []struct {
+		specFile string
+		want     string
+	}{
+		{
+			specFile: "testdata/specs/invalid/validation-type-clash.yaml",
+			want:     "strict-validation: FieldError clashes with type FieldError of schema FieldError; rename the schema",
+		},
+		{
+			specFile: "testdata/specs/invalid/validation-clash.yaml",
+			want:     `strict-validation: pattern variable createPetBodyNamePattern would compile both "^[a-z]+$" and "^[A-Za-z ]+$"; rename the body field with x-oink-go-name`,
+		},
+	}
+	for _, tt := range tests {
+		result, err := loader.LoadFile(tt.specFile)
+		require.NoError(t, err)
+		spec, err := loader.Transform(result)
+		require.NoError(t, err)
+
+		gen, err := codegen.New(&config.Config{Go: config.GoConfig{
+			Package:         "gen",
+			ServerFramework: "stdlib",
+			Targets:         []string{"types", "strict-server"},
+			OutputOptions:   config.OutputOptions{StrictValidation: true},
+		}})
+		require.NoError(t, err)
+		_, err = gen.Generate(spec, nil)
+		require.ErrorContains(t, err, tt.want, tt.specFile)
+	}
+}
+
+func TestGeneratedDeclarationClash(t *testing.T) {
+	result, err := loader.LoadFile("testdata/specs/operations/operation-schema.yaml")
+	require.NoError(t, err)
+	spec, err := loader.Transform(result)
+	require.NoError(t, err)
+	spec.Schemas = append(spec.Schemas, model.Schema{Name: "Breaker", Type: model.TypeObject})
+
+	gen, err := codegen.New(&config.Config{Go: config.GoConfig{
+		Package: "gen",
+		Targets: []string{"types", "client"},
+	}})
+	require.NoError(t, err)
+	_, err = gen.Generate(spec, nil)
+	require.EqualError(t, err, "types.eugene.go and client.eugene.go both declare Breaker; rename the schema or operation it comes from")
+}
+
+func TestHealthEndpointClash(t *testing.T) {
+	result, err := loader.LoadFile("testdata/specs/invalid/health-clash.yaml")
+	require.NoError(t, err)
+	spec, err := loader.Transform(result)
+	require.NoError(t, err)
+
+	for _, framework := range []string{"stdlib", "chi", "echo"} {
+		gen, err := codegen.New(&config.Config{Go: config.GoConfig{
+			Package:         "gen",
+			ServerFramework: framework,
+			Targets:         []string{"types", "server"},
+			OutputOptions:   config.OutputOptions{HealthEndpoints: true},
+		}})
+		require.NoError(t, err)
+		_, err = gen.Generate(spec, nil)
+		require.EqualError(t, err, "health-endpoints: operation getVersion (GET /version) is served on the /version route of the health endpoints", framework)
+	}
+}
+
+func TestGenerateVersions(t *testing.T) {
+	testDir, err := os.Getwd()
+	require.NoError(t, err)
+	outputPath := filepath.Join(testDir, "generated/versions")
+	require.NoError(t, os.RemoveAll(outputPath))
+
+	cfg := &config.Config{
+		Versions: []config.Version{
+			{Name: "v1", Spec: "testdata/specs/versions/v1.yaml"},
+			{Name: "v2", Spec: "testdata/specs/versions/v2.yaml"},
+		},
+		Go: config.GoConfig{
+			OutputDir:       outputPath,
+			ServerFramework: "chi",
+			Targets:         []string{"types", "server", "client"},
+		},
+	}
+	var versions []codegen.VersionSpec
+	for _, v := range cfg.Versions {
+		result, err := loader.LoadFile(v.Spec)
+		require.NoError(t, err)
+		spec, err := loader.Transform(result)
+		require.NoError(t, err)
+		versions = append(versions, codegen.VersionSpec{Version: v, Spec: spec, Data: result.RawData})
+	}
+
+	gen, err := codegen.New(cfg)
+	require.NoError(t, err)
+	outputs, err := gen.GenerateVersions(versions)
+	require.NoError(t, err)
+
+	files := make(map[string]string)
+	for _, o := range outputs {
+		files[o.Filename] = o.Content
+		path := filepath.Join(outputPath, o.Filename)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(o.Content), 0644))
+	}
+
+	// Identical schemas move to the shared package with everything they refer to
+	common := files["common/types.eugene.go"]
+	require.Contains(t, common, "package common\n")
+	require.Contains(t, common, "type Money struct")
+	require.Contains(t, common, "type Currency string")
+	require.Contains(t, common, "type Error struct")
+	// Pet differs, and Circle is a variant of the Shape union that differs
+	require.NotContains(t, common, "type Pet struct")
+	require.NotContains(t, common, "type Circle struct")
+
+	for _, v := range []string{"v1", "v2"} {
+		types := files[v+"/types.eugene.go"]
+		require.Contains(t, types, "package "+v+"\n")
+		require.Contains(t, types, "\"github.com/kolah/eugene/tests/generated/versions/common\"")
+		require.Regexp(t, `Price\s+common\.Money`, types)
+		require.NotContains(t, types, "type Money struct")
+		require.Contains(t, types, "type Circle struct")
+		require.Contains(t, files[v+"/client.eugene.go"], "var body common.Error")
+	}
+	require.Contains(t, files["v2/types.eugene.go"], "Nickname")
+
+	cmd := exec.Command("go", "build", "./...")
+	cmd.Dir = outputPath
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, "generated code failed to compile:\n%s", string(output))
+}
+
+func TestDiffSpecs(t *testing.T) {
+	load := func(path string) *model.Spec {
+		result, err := loader.LoadFile(path)
+		require.NoError(t, err)
+		spec, err := loader.Transform(result)
+		require.NoError(t, err)
+		return spec
+	}
+	v1 := load("testdata/specs/versions/v1.yaml")
+	v2 := load("testdata/specs/versions/v2.yaml")
+
+	require.Empty(t, loader.DiffSpecs(v1, load("testdata/specs/versions/v1.yaml")))
+
+	changes := loader.DiffSpecs(v1, v2)
+	require.Equal(t, []loader.Change{
+		{Kind: loader.ChangeAdditive, Location: "#/components/schemas/Pet/properties/nickname", Message: "property nickname added"},
+		{Kind: loader.ChangeBreaking, Location: "#/components/schemas/Shape/oneOf", Message: "oneOf changed from 2 to 3 schemas"},
+		{Kind: loader.ChangeAdditive, Location: "#/components/schemas/Triangle", Message: "schema Triangle added"},
+	}, changes)
+	require.Equal(t, loader.ChangeBreaking, loader.HighestChange(changes))
+
+	next := load("testdata/specs/versions/v1.yaml")
+	next.Operations[0].Summary = "Fetch a pet"
+	require.Equal(t, loader.ChangeDocs, loader.HighestChange(loader.DiffSpecs(v1, next)))
+
+	currency := next.SchemaByRef("#/components/schemas/Currency")
+	currency.Enum = append(currency.Enum, "GBP")
+	require.Equal(t, loader.ChangeAdditive, loader.HighestChange(loader.DiffSpecs(v1, next)))
+
+	maxLength := int64(20)
+	pet := next.SchemaByRef("#/components/schemas/Pet")
+	pet.Properties[1].Schema.MaxLength = &maxLength
+	changes = loader.DiffSpecs(v1, next)
+	require.Contains(t, changes, loader.Change{
+		Kind:     loader.ChangeBreaking,
+		Location: "#/components/schemas/Pet/properties/name",
+		Message:  "maxLength tightened",
+	})
+	// Loosening the same constraint is additive
+	require.Contains(t, loader.DiffSpecs(next, v1), loader.Change{
+		Kind:     loader.ChangeAdditive,
+		Location: "#/components/schemas/Pet/properties/name",
+		Message:  "maxLength loosened",
+	})
+}
+
+func TestGenerateSharedTypes(t *testing.T) {
+	testDir, err := os.Getwd()
+	require.NoError(t, err)
+	outputPath := filepath.Join(testDir, "generated/shared_types")
+	require.NoError(t, os.RemoveAll(outputPath))
+
+	base := config.Config{
+		SharedTypes: config.SharedTypes{Package: "common", OutputDir: filepath.Join(outputPath, "common")},
+		Go: config.GoConfig{
+			ServerFramework: "stdlib",
+			Targets:         []string{"types", "client"},
+		},
+	}
+	profiles := map[string]string{
+		"pets": "testdata/specs/versions/v1.yaml",
+		"shop": "testdata/specs/versions/v2.yaml",
+	}
+	names := []string{"pets", "shop"}
+	cfgs := make([]*config.Config, len(names))
+	specs := make([]*model.Spec, len(names))
+	for i, name := range names {
+		cfg := base
+		cfg.Profile = name
+		cfg.Spec = profiles[name]
+		cfg.Go.Package = name
+		cfg.Go.OutputDir = filepath.Join(outputPath, name)
+		cfgs[i] = &cfg
+
+		result, err := loader.LoadFile(cfg.Spec)
+		require.NoError(t, err)
+		specs[i], err = loader.Transform(result)
+		require.NoError(t, err)
+	}
+
+	gen, err := codegen.New(base.ForSharedTypes())
+	require.NoError(t, err)
+	shared, err := gen.GenerateShared(specs)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Money", "Currency", "Error"}, shared.Schemas)
+	writeOutputs(t, filepath.Join(outputPath, "common"), shared.Outputs)
+
+	for i, cfg := range cfgs {
+		gen, err := codegen.New(cfg)
+		require.NoError(t, err)
+		outputs, err := gen.Generate(specs[i], nil)
+		require.NoError(t, err)
+		outputs, err = shared.Qualify(outputs)
+		require.NoError(t, err)
+		writeOutputs(t, cfg.Go.OutputDir, outputs)
+
+		types, err := os.ReadFile(filepath.Join(cfg.Go.OutputDir, "types.eugene.go"))
+		require.NoError(t, err)
+		require.Contains(t, string(types), "\"github.com/kolah/eugene/tests/generated/shared_types/common\"")
+		require.Regexp(t, `Price\s+common\.Money`, string(types))
+		require.NotContains(t, string(types), "type Money struct")
+	}
+
+	cmd := exec.Command("go", "build", "./...")
+	cmd.Dir = outputPath
+	output, err := cmd.CombinedOutput()
+	require.NoError(t, err, "generated code failed to compile:\n%s", string(output))
+}
+
+func TestSharedSchemasAtOtherLines(t *testing.T) {
+	var specs []*model.Spec
+	for _, path := range []string{"testdata/specs/versions/v1.yaml", "testdata/specs/versions/v2.yaml"} {
+		result, err := loader.LoadFile(path)
+		require.NoError(t, err)
+		spec, err := loader.Transform(result)
+		require.NoError(t, err)
+		specs = append(specs, spec)
+	}
+
+	testDir, err := os.Getwd()
+	require.NoError(t, err)
+	cfg := config.Config{
+		SharedTypes: config.SharedTypes{Package: "common", OutputDir: filepath.Join(testDir, "generated/shared_types/common")},
+	}
+	gen, err := codegen.New(cfg.ForSharedTypes())
+	require.NoError(t, err)
+
+	// v2 declares Money, and the example of its amount, two lines further
+	// down than v1
+	shared, err := gen.GenerateShared(specs)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Money", "Currency", "Error"}, shared.Schemas)
+}
+
+func TestFieldProvenance(t *testing.T) {
+	result, err := loader.LoadFile("testdata/specs/types/allof.yaml")
+	require.NoError(t, err)
+
+	tests := []struct {
+		strategy string
+		fields   []string
+	}{
+		{
+			strategy: "embed",
+			fields: []string{
+				`FirstName \*string\s+\S+\s+// from: #/components/schemas/BaseProperties\n`,
+				`Person\s+// from: #/components/schemas/Person via allOf\n`,
+				`ID\s+string\s+\S+\s+// from: #/components/schemas/PersonWithID/allOf/1 via allOf\n`,
+			},
+		},
+		{
+			strategy: "flatten",
+			fields: []string{
+				`FirstName string\s+\S+\s+// from: #/components/schemas/BaseProperties via allOf\n`,
+				`ID\s+string\s+\S+\s+// from: #/components/schemas/PersonWithID/allOf/1 via allOf\n`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.strategy, func(t *testing.T) {
+			spec, err := loader.Transform(result)
+			require.NoError(t, err)
+
+			cfg := &config.Config{Go: config.GoConfig{
+				Package:       "api",
+				Targets:       []string{"types"},
+				Types:         config.TypesConfig{AllOfStrategy: tt.strategy},
+				OutputOptions: config.OutputOptions{FieldProvenance: true},
+			}}
+			gen, err := codegen.New(cfg)
+			require.NoError(t, err)
+			outputs, err := gen.Generate(spec, result.RawData)
+			require.NoError(t, err)
+			require.Len(t, outputs, 1)
+
+			for _, field := range tt.fields {
+				require.Regexp(t, field, outputs[0].Content)
+			}
+		})
+	}
+}
+
+func TestAllOfConflicts(t *testing.T) {
+	result, err := loader.LoadFile("testdata/specs/types/allof-conflict.yaml")
+	require.NoError(t, err)
+	cfg := &config.Config{Go: config.GoConfig{
+		Package: "api",
+		Targets: []string{"types"},
+		Types:   config.TypesConfig{AllOfStrategy: "flatten"},
+	}}
+	document := func(spec *model.Spec) *model.Schema {
+		for i := range spec.Schemas {
+			if spec.Schemas[i].Name == "Document" {
+				return &spec.Schemas[i]
+			}
+		}
+		t.Fatal("no Document schema")
+		return nil
+	}
+
+	t.Run("unresolved", func(t *testing.T) {
+		spec, err := loader.Transform(result)
+		require.NoError(t, err)
+		document(spec).Extensions.AllOfConflict = ""
+
+		gen, err := codegen.New(cfg)
+		require.NoError(t, err)
+		_, err = gen.Generate(spec, result.RawData)
+		require.ErrorContains(t, err, `allOf members declare "revision" as integer in #/components/schemas/Auditable and as string in #/components/schemas/Document/allOf/1`)
+	})
+
+	for _, resolution := range []string{"first", "last"} {
+		t.Run(resolution, func(t *testing.T) {
+			spec, err := loader.Transform(result)
+			require.NoError(t, err)
+			document(spec).Extensions.AllOfConflict = resolution
+
+			gen, err := codegen.New(cfg)
+			require.NoError(t, err)
+			outputs, err := gen.Generate(spec, result.RawData)
+			require.NoError(t, err)
+			require.Contains(t, spec.Warnings, model.Warning{
+				Kind:     model.WarningComposition,
+				Location: "#/components/schemas/Document/allOf/1",
+				Message:  `allOf members declare "revision" as integer in #/components/schemas/Auditable and as string in #/components/schemas/Document/allOf/1; x-oink-allof-conflict keeps the ` + resolution,
+			})
+
+			want := map[string]string{"first": "*int", "last": "*string"}[resolution]
+			require.Regexp(t, `Revision\s+`+regexp.QuoteMeta(want)+`\s`, outputs[0].Content)
+		})
+	}
+}
+
+func writeOutputs(t *testing.T, dir string, outputs []codegen.Output) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	for _, o := range outputs {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, o.Filename), []byte(o.Content), 0644))
+	}
+}
+
+func TestDiffOperations(t *testing.T) {
+	result, err := loader.LoadFile("testdata/specs/operations/tagged.yaml")
+	require.NoError(t, err)
+	spec, err := loader.Transform(result)
+	require.NoError(t, err)
+
+	cfg := &config.Config{Go: config.GoConfig{
+		Package:         "api",
+		ServerFramework: "chi",
+		Targets:         []string{"types", "server", "strict-server"},
+	}}
+	generate := func(ops []model.Operation) []codegen.Output {
+		gen, err := codegen.New(cfg)
+		require.NoError(t, err)
+		s := *spec
+		s.Operations = ops
+		outputs, err := gen.Generate(&s, result.RawData)
+		require.NoError(t, err)
+		return outputs
+	}
+
+	dir := t.TempDir()
+	writeOutputs(t, dir, generate(spec.Operations[:4]))
+
+	changes, err := codegen.DiffOperations(dir, generate(spec.Operations))
+	require.NoError(t, err)
+	var lines []string
+	for _, c := range changes {
+		lines = append(lines, c.String())
+	}
+	require.Equal(t, []string{"+ ServerInterface.HealthCheck", "+ StrictServerInterface.HealthCheck"}, lines)
+
+	changes, err = codegen.DiffOperations(dir, generate(spec.Operations[1:4]))
+	require.NoError(t, err)
+	require.Equal(t, []codegen.OperationChange{
+		{Interface: "ServerInterface", Method: "ListPets"},
+		{Interface: "StrictServerInterface", Method: "ListPets"},
+	}, changes)
+
+	changes, err = codegen.DiffOperations(t.TempDir(), generate(spec.Operations[:1]))
+	require.NoError(t, err)
+	require.Equal(t, []codegen.OperationChange{
+		{Interface: "ServerInterface", Method: "ListPets", Added: true},
+		{Interface: "StrictServerInterface", Method: "ListPets", Added: true},
+	}, changes)
+}
+
+func TestParameterEnums(t *testing.T) {
+	result, err := loader.LoadFile("testdata/specs/operations/validation.yaml")
+	require.NoError(t, err)
+
+	generate := func(t *testing.T, opts config.OutputOptions, tag string) map[string]string {
+		spec, err := loader.Transform(result)
+		require.NoError(t, err)
+		if tag != "" {
+			spec.Operations[0].Tags = []string{tag}
+		}
+		cfg := &config.Config{Go: config.GoConfig{
+			OutputDir:       t.TempDir(),
+			Package:         "api",
+			ServerFramework: "chi",
+			Targets:         []string{"types", "server", "strict-server"},
+			Types:           config.TypesConfig{EnumStrategy: "struct"},
+			OutputOptions:   opts,
+		}}
+		gen, err := codegen.New(cfg)
+		require.NoError(t, err)
+		outputs, err := gen.Generate(spec, result.RawData)
+		require.NoError(t, err)
+
+		files := make(map[string]string)
+		for _, o := range outputs {
+			files[o.Filename] = o.Content
+		}
+		return files
+	}
+	declarations := func(files map[string]string) []string {
+		var declared []string
+		for name, content := range files {
+			if strings.Contains(content, "\ntype Status ") {
+				declared = append(declared, name)
+			}
+		}
+		return declared
+	}
+
+	t.Run("package", func(t *testing.T) {
+		files := generate(t, config.OutputOptions{}, "")
+		// One declaration, in types, as a string type whatever the enum
+		// strategy, since the servers convert query values to it
+		require.Equal(t, []string{"types.eugene.go"}, declarations(files))
+		require.Contains(t, files["types.eugene.go"], "type Status string\n")
+		require.Contains(t, files["server.eugene.go"], "typed := Status(v)")
+		require.Contains(t, files["strict_server.eugene.go"], "typed := Status(v)")
+	})
+
+	t.Run("split-by-tag", func(t *testing.T) {
+		files := generate(t, config.OutputOptions{SplitByTag: true, InitModule: "github.com/acme/api"}, "pets")
+		require.Equal(t, []string{"types.eugene.go"}, declarations(files))
+		require.Contains(t, files["pets/server.eugene.go"], "typed := api.Status(v)")
+	})
+}
+
+func TestPackageDoc(t *testing.T) {
+	result, err := loader.LoadFile("testdata/specs/operations/tagged.yaml")
+	require.NoError(t, err)
+
+	generate := func(t *testing.T, opts config.OutputOptions) map[string]string {
+		spec, err := loader.Transform(result)
+		require.NoError(t, err)
+		spec.Info.Description = "Keeps track of pets\nand their owners."
+		spec.Servers = []model.Server{{URL: "https://pets.example.com/v1", Description: "Production"}}
+		opts.PackageDoc = true
+		cfg := &config.Config{Go: config.GoConfig{
+			OutputDir:       t.TempDir(),
+			Package:         "api",
+			ServerFramework: "chi",
+			Targets:         []string{"types", "client"},
+			OutputOptions:   opts,
+		}}
+		gen, err := codegen.New(cfg)
+		require.NoError(t, err)
+		outputs, err := gen.Generate(spec, result.RawData)
+		require.NoError(t, err)
+		if opts.SingleFile {
+			outputs, err = codegen.Bundle(cfg.Go.Package, outputs)
+			require.NoError(t, err)
+		}
+
+		files := make(map[string]string)
+		for _, o := range outputs {
+			files[o.Filename] = o.Content
+		}
+		return files
+	}
+	// packageDoc returns the package documentation go doc shows for files
+	packageDoc := func(t *testing.T, files map[string]string, dir string) string {
+		fset := token.NewFileSet()
+		var parsed []*ast.File
+		for name, content := range files {
+			if filepath.Dir(name) != dir {
+				continue
+			}
+			f, err := parser.ParseFile(fset, name, content, parser.ParseComments)
+			require.NoError(t, err)
+			parsed = append(parsed, f)
+		}
+		pkg, err := doc.NewFromFiles(fset, parsed, "example.com/api")
+		require.NoError(t, err)
+		return pkg.Doc
+	}
+
+	want := `Package api is generated from Tagged Operations, version 1.0.0.
+
+Keeps track of pets
+and their owners.
+
+# Servers
+
+  - https://pets.example.com/v1: Production
+
+# Tags
+
+  - pets: Everything about your pets. Pets are keyed by ID.
+  - store
+`
+
+	t.Run("package", func(t *testing.T) {
+		files := generate(t, config.OutputOptions{})
+		require.Contains(t, files, "doc.eugene.go")
+		require.True(t, strings.HasPrefix(files["doc.eugene.go"], "// Code generated by eugene. DO NOT EDIT.\n\n"))
+		// The generated-code headers of the other files stay out of it
+		require.Equal(t, want, packageDoc(t, files, "."))
+	})
+
+	t.Run("single-file", func(t *testing.T) {
+		files := generate(t, config.OutputOptions{SingleFile: true})
+		require.Len(t, files, 1)
+		require.Contains(t, files, "api.eugene.go")
+		require.Equal(t, want, packageDoc(t, files, "."))
+	})
+
+	t.Run("split-by-tag", func(t *testing.T) {
+		files := generate(t, config.OutputOptions{SplitByTag: true, InitModule: "github.com/acme/api"})
+		require.Equal(t, want, packageDoc(t, files, "."))
+		require.Equal(t, `Package pets holds the operations of Tagged Operations tagged pets.
+The types they share with the rest of the API are declared in package api.
+
+Everything about your pets.
+Pets are keyed by ID.
+`, packageDoc(t, files, "pets"))
+	})
+}
+
+func TestSplitByTag(t *testing.T) {
+	result, err := loader.LoadFile("testdata/specs/operations/tagged.yaml")
+	require.NoError(t, err)
+	spec, err := loader.Transform(result)
+	require.NoError(t, err)
+
+	cfg := &config.Config{Go: config.GoConfig{
+		OutputDir:       t.TempDir(),
+		Package:         "api",
+		ServerFramework: "chi",
+		Targets:         []string{"types", "server", "client"},
+		OutputOptions:   config.OutputOptions{SplitByTag: true, InitModule: "github.com/acme/api"},
+	}}
+	gen, err := codegen.New(cfg)
+	require.NoError(t, err)
+	outputs, err := gen.Generate(spec, result.RawData)
+	require.NoError(t, err)
+
+	files := make(map[string]string)
+	var names []string
+	for _, o := range outputs {
+		files[o.Filename] = o.Content
+		names = append(names, o.Filename)
+	}
+	require.ElementsMatch(t, []string{
+		"types.eugene.go", "operation.eugene.go", "body.eugene.go", "server.eugene.go", "client.eugene.go",
+		"pets/operation.eugene.go", "pets/body.eugene.go", "pets/server.eugene.go", "pets/client.eugene.go",
+		"store/operation.eugene.go", "store/body.eugene.go", "store/server.eugene.go", "store/client.eugene.go",
+	}, names)
+
+	// Untagged operations stay in the shared package, tags get their own
+	require.Contains(t, files["client.eugene.go"], "func (c *Client) HealthCheck(")
+	require.NotContains(t, files["client.eugene.go"], "ListPets")
+	require.Contains(t, files["pets/client.eugene.go"], "package pets\n")
+	require.Contains(t, files["pets/client.eugene.go"], "\"github.com/acme/api\"")
+	require.Contains(t, files["pets/client.eugene.go"], "func (c *Client) CreatePet(ctx context.Context, body api.Pet)")
+	// getInventory is tagged [store, pets] and goes with its first tag
+	require.Contains(t, files["store/client.eugene.go"], "func (c *Client) GetInventory(")
+	require.NotContains(t, files["store/client.eugene.go"], "\"github.com/acme/api\"")
+}
+
+func TestTargetDataOptions(t *testing.T) {
+	result, err := loader.LoadFile("testdata/specs/operations/validation.yaml")
+	require.NoError(t, err)
+	spec, err := loader.Transform(result)
+	require.NoError(t, err)
+
+	funcs, _ := golang.TemplateFuncsWithResolver(&config.TypesConfig{})
+	maps.Copy(funcs, typescript.TemplateFuncs())
+	maps.Copy(funcs, jvm.TemplateFuncs())
+	engine, err := templates.NewEngine(embeddedtmpl.FS, templates.Options{}, funcs)
+	require.NoError(t, err)
+	registry := golang.NewEnumRegistry()
+
+	// A target reused with other options builds what a new target would
+	t.Run("client", func(t *testing.T) {
+		generate := func(target *client.Target, opts config.OutputOptions) string {
+			content, err := target.Generate(engine, spec, "api", &opts)
+			require.NoError(t, err)
+			return content
+		}
+		reused := client.New("")
+		plain := generate(reused, config.OutputOptions{})
+		compact := generate(reused, config.OutputOptions{CompactClient: true})
+		require.NotEqual(t, plain, compact)
+		require.Equal(t, generate(client.New(""), config.OutputOptions{CompactClient: true}), compact)
+	})
+
+	t.Run("strict-server", func(t *testing.T) {
+		generate := func(target *strictserver.Target, cfg config.TypesConfig) string {
+			content, err := target.GenerateTypes(engine, spec, "api", &cfg, registry)
+			require.NoError(t, err)
+			return content
+		}
+		newTarget := func() *strictserver.Target {
+			target, err := strictserver.New("chi")
+			require.NoError(t, err)
+			return target
+		}
+		reused := newTarget()
+		generate(reused, config.TypesConfig{})
+		nullable := generate(reused, config.TypesConfig{NullableStrategy: "nullable"})
+		require.Equal(t, generate(newTarget(), config.TypesConfig{NullableStrategy: "nullable"}), nullable)
+	})
+}
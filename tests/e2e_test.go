@@ -1,24 +1,80 @@
 package tests
 
 import (
+	"bufio"
 	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
 	"strconv"
+	"strings"
+	"syscall"
 	"testing"
+	"time"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	asyncops "github.com/kolah/eugene/tests/generated/async_operations"
+	binarybodies "github.com/kolah/eugene/tests/generated/binary_bodies"
+	bitmask "github.com/kolah/eugene/tests/generated/bitmask_enums"
+	bitmaskstruct "github.com/kolah/eugene/tests/generated/bitmask_enums_struct"
+	devicelogin "github.com/kolah/eugene/tests/generated/device_flow"
+	mapkeys "github.com/kolah/eugene/tests/generated/map_keys"
+	ordered "github.com/kolah/eugene/tests/generated/ordered"
+	envelope "github.com/kolah/eugene/tests/generated/envelope"
+	echobinding "github.com/kolah/eugene/tests/generated/echo_binding"
+	rawjson "github.com/kolah/eugene/tests/generated/raw_json"
+	enumextend "github.com/kolah/eugene/tests/generated/enum_unknown_extend"
+	enumreject "github.com/kolah/eugene/tests/generated/enum_unknown_reject"
+	enumstruct "github.com/kolah/eugene/tests/generated/enum_unknown_struct"
+	corspaths "github.com/kolah/eugene/tests/generated/cors_paths"
+	examplechecks "github.com/kolah/eugene/tests/generated/example_checks"
+	unknowncapture "github.com/kolah/eugene/tests/generated/unknown_fields_capture"
+	unknownerror "github.com/kolah/eugene/tests/generated/unknown_fields_error"
+	healthchi "github.com/kolah/eugene/tests/generated/health_chi"
+	healthecho "github.com/kolah/eugene/tests/generated/health_echo"
+	healthstdlib "github.com/kolah/eugene/tests/generated/health_stdlib"
+	loadtestGen "github.com/kolah/eugene/tests/generated/loadtest"
 	basic "github.com/kolah/eugene/tests/generated/e2e_echo"
+	bodylimitschi "github.com/kolah/eugene/tests/generated/body_limits_chi"
+	bodylimitsecho "github.com/kolah/eugene/tests/generated/body_limits_echo"
 	chiGen "github.com/kolah/eugene/tests/generated/e2e_chi"
+	chimount "github.com/kolah/eugene/tests/generated/chi_mount"
+	queryenc "github.com/kolah/eugene/tests/generated/query_encoding"
+	queryenccompact "github.com/kolah/eugene/tests/generated/query_encoding_compact"
+	queryencecho "github.com/kolah/eugene/tests/generated/query_encoding_echo"
+	compact "github.com/kolah/eugene/tests/generated/compact_client"
+	services "github.com/kolah/eugene/tests/generated/client_services"
+	securitygen "github.com/kolah/eugene/tests/generated/security"
+	discriminators "github.com/kolah/eugene/tests/generated/types_discriminators"
+	sortfields "github.com/kolah/eugene/tests/generated/types_sort_fields"
 	stdlibGen "github.com/kolah/eugene/tests/generated/e2e_stdlib"
 	strict "github.com/kolah/eugene/tests/generated/e2e_strict_echo"
+	tenantchi "github.com/kolah/eugene/tests/generated/tenant_prefix_chi"
+	tenantecho "github.com/kolah/eugene/tests/generated/tenant_prefix_echo"
+	tenantstdlib "github.com/kolah/eugene/tests/generated/tenant_prefix_stdlib"
+	validation "github.com/kolah/eugene/tests/generated/strict_validation"
+	textbodies "github.com/kolah/eugene/tests/generated/text_bodies"
+	toolsGen "github.com/kolah/eugene/tests/generated/tools"
+	vendorjson "github.com/kolah/eugene/tests/generated/vendor_json"
 )
 
 // === Basic Server Handler ===
@@ -172,6 +228,13 @@ func (h *StrictEchoHandler) GetItem(ctx context.Context, req strict.GetItemReque
 }
 
 func (h *StrictEchoHandler) CreateResource(ctx context.Context, req strict.CreateResourceRequestObject) (strict.CreateResourceResponseObject, error) {
+	if req.Body.Name == "" {
+		code, msg := "INVALID", "name is required"
+		return strict.CreateResource4XXJSONResponse{
+			StatusCode: http.StatusUnprocessableEntity,
+			Body:       strict.ErrorResponse{Code: &code, Message: &msg},
+		}, nil
+	}
 	id := "res-123"
 	return strict.CreateResource201JSONResponse{
 		ID:          &id,
@@ -182,6 +245,13 @@ func (h *StrictEchoHandler) CreateResource(ctx context.Context, req strict.Creat
 }
 
 func (h *StrictEchoHandler) DeleteResource(ctx context.Context, req strict.DeleteResourceRequestObject) (strict.DeleteResourceResponseObject, error) {
+	if req.ID == "locked" {
+		code, msg := "LOCKED", "Resource is locked"
+		return strict.DeleteResourceDefaultJSONResponse{
+			StatusCode: http.StatusConflict,
+			Body:       strict.ErrorResponse{Code: &code, Message: &msg},
+		}, nil
+	}
 	return strict.DeleteResource204Response{}, nil
 }
 
@@ -614,6 +684,28 @@ func TestE2EStrictServer(t *testing.T) {
 		assert.Equal(t, "NOT_FOUND", *resp.JSON404.Code)
 		assert.Equal(t, "Item not found", *resp.JSON404.Message)
 	})
+
+	t.Run("Range response", func(t *testing.T) {
+		resp, err := client.CreateResource(ctx, strict.NewResource{})
+		require.Error(t, err)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+		require.NotNil(t, resp.JSON4XX)
+		assert.Equal(t, "INVALID", *resp.JSON4XX.Code)
+		assert.Nil(t, resp.JSON201)
+	})
+
+	t.Run("Default response", func(t *testing.T) {
+		resp, err := client.DeleteResource(ctx, "locked")
+		require.Error(t, err)
+		assert.Equal(t, http.StatusConflict, resp.StatusCode)
+		require.NotNil(t, resp.JSONDefault)
+		assert.Equal(t, "LOCKED", *resp.JSONDefault.Code)
+
+		resp, err = client.DeleteResource(ctx, "res-123")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+		assert.Nil(t, resp.JSONDefault)
+	})
 }
 
 func TestE2EHeaderParams(t *testing.T) {
@@ -645,6 +737,16 @@ func TestE2EHeaderParams(t *testing.T) {
 		assert.Contains(t, string(body), `"filter":"test"`)
 		assert.Contains(t, string(body), `"requestId":"req-12345"`)
 	})
+
+	t.Run("Non-canonical header key", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/items/header-test", nil)
+		req.Header["x-request-id"] = []string{"req-67890"}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"requestId":"req-67890"`)
+	})
 }
 
 func TestE2EChiServer(t *testing.T) {
@@ -738,6 +840,123 @@ func TestE2EChiServer(t *testing.T) {
 	})
 }
 
+// petsOnly implements chimount.PetsHandler and nothing else.
+type petsOnly struct{}
+
+func (petsOnly) ListPets(w http.ResponseWriter, r *http.Request, params chimount.ListPetsQueryParams) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode([]chimount.Pet{{Name: "status:" + *params.Status}})
+}
+
+func (petsOnly) CreatePet(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (petsOnly) GetPetByID(w http.ResponseWriter, r *http.Request, petID string) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(chimount.Pet{Name: petID})
+}
+
+func TestE2EUnimplementedServer(t *testing.T) {
+	server := httptest.NewServer(chiGen.Handler(chiGen.UnimplementedServer{}))
+	defer server.Close()
+
+	client := chiGen.NewClient(server.URL)
+	_, err := client.EchoJSON(context.Background(), chiGen.EchoPayload{Message: "hello"})
+	require.ErrorContains(t, err, "status 501")
+
+	e := echo.New()
+	strict.RegisterStrictHandlers(e, strict.UnimplementedStrictServer{})
+	strictServer := httptest.NewServer(e)
+	defer strictServer.Close()
+
+	_, err = strict.NewClient(strictServer.URL).EchoJSON(context.Background(), strict.EchoPayload{Message: "hello"})
+	require.ErrorContains(t, err, "status 501")
+}
+
+func TestE2EChiMount(t *testing.T) {
+	r := chi.NewRouter()
+	r.Get("/version", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("1.0"))
+	})
+	r.Route("/api", func(r chi.Router) {
+		chimount.MountPets(r, petsOnly{})
+	})
+
+	server := httptest.NewServer(r)
+	defer server.Close()
+
+	get := func(path string) (int, string) {
+		resp, err := http.Get(server.URL + path)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, strings.TrimSpace(string(body))
+	}
+
+	status, body := get("/api/pets/rex")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, `{"name":"rex"}`, body)
+
+	status, body = get("/api/pets?status=sold")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, `[{"name":"status:sold"}]`, body)
+
+	// The existing routes keep working and other tags stay unmounted
+	status, body = get("/version")
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "1.0", body)
+
+	status, _ = get("/api/store/inventory")
+	assert.Equal(t, http.StatusNotFound, status)
+}
+
+func TestE2EOperationContext(t *testing.T) {
+	t.Run("chi middleware before routing", func(t *testing.T) {
+		var labels []string
+		label := func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				r = r.WithContext(chiGen.WithOperation(r.Context()))
+				next.ServeHTTP(w, r)
+				labels = append(labels, chiGen.OperationID(r.Context())+" "+chiGen.OperationPath(r.Context()))
+			})
+		}
+		router := chiGen.HandlerWithOptions(&ChiHandler{}, chiGen.ChiServerOptions{
+			Middlewares: []func(http.Handler) http.Handler{label},
+		})
+		server := httptest.NewServer(router)
+		defer server.Close()
+
+		_, err := chiGen.NewClient(server.URL).GetItem(context.Background(), "item-1", nil)
+		require.NoError(t, err)
+		resp, err := http.Get(server.URL + "/unknown")
+		require.NoError(t, err)
+		resp.Body.Close()
+
+		// Unrouted requests leave the operation empty
+		assert.Equal(t, []string{"getItem /items/{id}", " "}, labels)
+	})
+
+	t.Run("echo strict middleware", func(t *testing.T) {
+		var label string
+		e := echo.New()
+		e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+			return func(c echo.Context) error {
+				err := next(c)
+				label = strict.OperationID(c.Request().Context()) + " " + strict.OperationPath(c.Request().Context())
+				return err
+			}
+		})
+		strict.RegisterStrictHandlers(e, &StrictEchoHandler{})
+		server := httptest.NewServer(e)
+		defer server.Close()
+
+		_, err := strict.NewClient(server.URL).GetItem(context.Background(), "item-1", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "getItem /items/{id}", label)
+	})
+}
+
 func TestE2EStdlibServer(t *testing.T) {
 	handler := &StdlibHandler{}
 	router := stdlibGen.Handler(handler)
@@ -861,6 +1080,216 @@ func TestE2EMultipleStatusCodes(t *testing.T) {
 	})
 }
 
+func TestE2ECompactClient(t *testing.T) {
+	e := echo.New()
+	basic.RegisterHandlers(e, &BasicEchoHandler{})
+
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		query = req.URL.RawQuery
+		e.ServeHTTP(w, req)
+	}))
+	defer server.Close()
+
+	client := compact.NewClient(server.URL)
+	ctx := context.Background()
+
+	t.Run("JSON body", func(t *testing.T) {
+		resp, err := client.EchoJSON(ctx, compact.EchoPayload{Message: "hello"})
+		require.NoError(t, err)
+		require.NotNil(t, resp.JSON200)
+		assert.Equal(t, "hello", resp.JSON200.Message)
+	})
+
+	t.Run("Path and query params", func(t *testing.T) {
+		filter := "active"
+		resp, err := client.GetItem(ctx, "item-123", &compact.GetItemParams{Filter: &filter})
+		require.NoError(t, err)
+		assert.Equal(t, "filter=active", query)
+		require.NotNil(t, resp.JSON200)
+		assert.Equal(t, "item-123", *resp.JSON200.ID)
+		assert.Equal(t, "active", *resp.JSON200.Filter)
+
+		_, err = client.GetItem(ctx, "item-123", &compact.GetItemParams{})
+		require.NoError(t, err)
+		assert.Empty(t, query, "nil optional params are left out")
+	})
+
+	t.Run("Error status", func(t *testing.T) {
+		resp, err := client.GetItem(ctx, "not-found", nil)
+		require.Error(t, err)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		require.NotNil(t, resp.JSON404)
+		assert.Equal(t, "NOT_FOUND", *resp.JSON404.Code)
+		assert.Nil(t, resp.JSON200)
+	})
+
+	t.Run("Created and no content", func(t *testing.T) {
+		resp, err := client.CreateResource(ctx, compact.NewResource{Name: "new-resource"})
+		require.NoError(t, err)
+		require.NotNil(t, resp.JSON201)
+		assert.Equal(t, "res-123", *resp.JSON201.ID)
+
+		deleted, err := client.DeleteResource(ctx, "res-123")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, deleted.StatusCode)
+		assert.Nil(t, deleted.JSONDefault)
+	})
+
+	t.Run("Multipart keeps its dedicated code", func(t *testing.T) {
+		resp, err := client.EchoMultipart(ctx, compact.EchoMultipartRequest{
+			File:        &compact.FileUpload{Reader: strings.NewReader("content"), Filename: "a.txt"},
+			Description: "file",
+		})
+		require.NoError(t, err)
+		require.NotNil(t, resp.JSON200)
+		assert.Equal(t, "a.txt", *resp.JSON200.Filename)
+	})
+}
+
+// QueryEncodingHandler echoes the query parameters as the stdlib server read them
+type QueryEncodingHandler struct {
+	queryenc.UnimplementedServer
+}
+
+func (h *QueryEncodingHandler) Search(w http.ResponseWriter, r *http.Request, params queryenc.SearchQueryParams) {
+	echo := queryenc.SearchEcho{Q: params.Q, Path: params.Path, Filter: params.Filter, Limit: params.Limit, RawQuery: r.URL.RawQuery}
+	filterSet := params.Filter != nil
+	echo.FilterSet = &filterSet
+	if params.Tag != nil {
+		echo.Tag = *params.Tag
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(echo)
+}
+
+// QueryEncodingEchoHandler echoes the query parameters as the echo server read them
+type QueryEncodingEchoHandler struct {
+	queryencecho.UnimplementedServer
+}
+
+func (h *QueryEncodingEchoHandler) Search(ctx echo.Context, params queryencecho.SearchQueryParams) error {
+	filterSet := params.Filter != nil
+	resp := queryencecho.SearchEcho{Q: params.Q, Path: params.Path, Filter: params.Filter, FilterSet: &filterSet, Limit: params.Limit, RawQuery: ctx.QueryString()}
+	if params.Tag != nil {
+		resp.Tag = *params.Tag
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// QueryEncodingStrictHandler echoes the query parameters as the strict chi server read them
+type QueryEncodingStrictHandler struct {
+	queryenccompact.UnimplementedStrictServer
+}
+
+func (h *QueryEncodingStrictHandler) Search(ctx context.Context, req queryenccompact.SearchRequestObject) (queryenccompact.SearchResponseObject, error) {
+	filterSet := req.Filter != nil
+	echo := queryenccompact.Search200JSONResponse{Q: req.Q, Path: req.Path, Filter: req.Filter, FilterSet: &filterSet, Limit: req.Limit}
+	if req.Tag != nil {
+		echo.Tag = *req.Tag
+	}
+	return echo, nil
+}
+
+func TestE2EQueryEncoding(t *testing.T) {
+	const (
+		q    = "a b&c=d+e/f?g#h;i%j ü"
+		path = "/docs/a b:c@d!$'()*,;[]&=+#"
+	)
+	tags := []string{"x,y", "a&b"}
+	limit := 5
+
+	// Reserved characters are sent as they are for path only; & = + # ;
+	// stay escaped so that the pair reaches the server whole
+	wantQuery := "q=a+b%26c%3Dd%2Be%2Ff%3Fg%23h%3Bi%25j+%C3%BC" +
+		"&path=/docs/a+b:c@d!$'()*,%3B[]%26%3D%2B%23" +
+		"&filter=" +
+		"&tag=x%2Cy&tag=a%26b" +
+		"&limit=5"
+
+	t.Run("client and stdlib server", func(t *testing.T) {
+		server := httptest.NewServer(queryenc.Handler(&QueryEncodingHandler{}))
+		defer server.Close()
+		client := queryenc.NewClient(server.URL)
+
+		p, empty := path, ""
+		resp, err := client.Search(context.Background(), &queryenc.SearchParams{Q: q, Path: &p, Filter: &empty, Tag: &tags, Limit: &limit})
+		require.NoError(t, err)
+		require.NotNil(t, resp.JSON200)
+		assert.Equal(t, wantQuery, resp.JSON200.RawQuery)
+		assert.Equal(t, q, resp.JSON200.Q)
+		assert.Equal(t, path, *resp.JSON200.Path)
+		assert.Equal(t, "", *resp.JSON200.Filter, "allowEmptyValue reads an empty value as a value")
+		assert.Equal(t, tags, resp.JSON200.Tag)
+		assert.Equal(t, limit, *resp.JSON200.Limit)
+
+		// Without allowEmptyValue an empty value is left out, as the server
+		// would read it as missing anyway
+		resp, err = client.Search(context.Background(), &queryenc.SearchParams{Q: "", Path: &empty})
+		require.NoError(t, err)
+		assert.Equal(t, "", resp.JSON200.RawQuery)
+		assert.False(t, *resp.JSON200.FilterSet)
+	})
+
+	t.Run("compact client and strict chi server", func(t *testing.T) {
+		r := chi.NewRouter()
+		queryenccompact.RegisterStrictHandlers(r, &QueryEncodingStrictHandler{})
+		var rawQuery string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			rawQuery = req.URL.RawQuery
+			r.ServeHTTP(w, req)
+		}))
+		defer server.Close()
+		client := queryenccompact.NewClient(server.URL)
+
+		p, empty := path, ""
+		resp, err := client.Search(context.Background(), &queryenccompact.SearchParams{Q: q, Path: &p, Filter: &empty, Tag: &tags, Limit: &limit})
+		require.NoError(t, err)
+		require.NotNil(t, resp.JSON200)
+		assert.Equal(t, wantQuery, rawQuery)
+		assert.Equal(t, q, resp.JSON200.Q)
+		assert.Equal(t, path, *resp.JSON200.Path)
+		assert.True(t, *resp.JSON200.FilterSet)
+		assert.Equal(t, "", *resp.JSON200.Filter)
+		assert.Equal(t, tags, resp.JSON200.Tag)
+		assert.Equal(t, limit, *resp.JSON200.Limit)
+	})
+
+	t.Run("client and echo server", func(t *testing.T) {
+		e := echo.New()
+		queryencecho.RegisterHandlers(e, &QueryEncodingEchoHandler{})
+		server := httptest.NewServer(e)
+		defer server.Close()
+		client := queryenc.NewClient(server.URL)
+
+		p, empty := path, ""
+		resp, err := client.Search(context.Background(), &queryenc.SearchParams{Q: q, Path: &p, Filter: &empty, Tag: &tags, Limit: &limit})
+		require.NoError(t, err)
+		require.NotNil(t, resp.JSON200)
+		assert.Equal(t, wantQuery, resp.JSON200.RawQuery)
+		assert.Equal(t, q, resp.JSON200.Q)
+		assert.Equal(t, path, *resp.JSON200.Path)
+		require.NotNil(t, resp.JSON200.Filter, "allowEmptyValue reads an empty value as a value")
+		assert.Equal(t, "", *resp.JSON200.Filter)
+		assert.Equal(t, tags, resp.JSON200.Tag)
+		assert.Equal(t, limit, *resp.JSON200.Limit)
+
+		resp, err = client.Search(context.Background(), &queryenc.SearchParams{Q: "x"})
+		require.NoError(t, err)
+		assert.False(t, *resp.JSON200.FilterSet)
+
+		// Without allowEmptyValue a value sent empty is missing, as for the
+		// other servers
+		raw, err := http.Get(server.URL + "/search?q=x&path=&limit=")
+		require.NoError(t, err)
+		defer raw.Body.Close()
+		var read queryencecho.SearchEcho
+		require.NoError(t, json.NewDecoder(raw.Body).Decode(&read))
+		assert.Nil(t, read.Path)
+		assert.Nil(t, read.Limit)
+	})
+}
+
 func TestE2ECookieParams(t *testing.T) {
 	e := echo.New()
 	handler := &BasicEchoHandler{}
@@ -1139,3 +1568,1877 @@ func TestE2EDiscriminator(t *testing.T) {
 
 // Ensure Chi imports are used
 var _ chi.Router
+
+func TestE2EClientDecoder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/vnd.error; charset=utf-8")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte("NOT_FOUND: no such item"))
+	}))
+	defer server.Close()
+
+	decodeError := func(data []byte, v any) error {
+		code, msg, _ := strings.Cut(string(data), ": ")
+		e := v.(*basic.ErrorResponse)
+		e.Code, e.Message = &code, &msg
+		return nil
+	}
+	client := basic.NewClient(server.URL, basic.WithDecoder("text/vnd.error", decodeError))
+
+	resp, err := client.GetItem(context.Background(), "missing", nil)
+	require.Error(t, err)
+	require.NotNil(t, resp.JSON404)
+	assert.Equal(t, "NOT_FOUND", *resp.JSON404.Code)
+	assert.Equal(t, "no such item", *resp.JSON404.Message)
+
+	_, err = basic.NewClient(server.URL).GetItem(context.Background(), "missing", nil)
+	require.ErrorContains(t, err, "decoding response")
+}
+
+// === Vendor JSON Handler ===
+
+type VendorJSONHandler struct{}
+
+func (h *VendorJSONHandler) CreateOrder(ctx context.Context, req vendorjson.CreateOrderRequestObject) (vendorjson.CreateOrderResponseObject, error) {
+	if req.Body.Item == "" {
+		title := "item is required"
+		status := http.StatusBadRequest
+		return vendorjson.CreateOrder400JSONResponse{Title: &title, Status: &status}, nil
+	}
+	id := "order-1"
+	order := req.Body
+	order.ID = &id
+	return vendorjson.CreateOrder201JSONResponse(order), nil
+}
+
+func TestE2EVendorJSON(t *testing.T) {
+	e := echo.New()
+	vendorjson.RegisterStrictHandlers(e, &VendorJSONHandler{})
+
+	var contentType, accept string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType, accept = r.Header.Get("Content-Type"), r.Header.Get("Accept")
+		e.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	client := vendorjson.NewClient(server.URL)
+	ctx := context.Background()
+
+	t.Run("Vendor request and HAL response", func(t *testing.T) {
+		resp, err := client.CreateOrder(ctx, vendorjson.Order{Item: "widget"})
+		require.NoError(t, err)
+		assert.Equal(t, "application/vnd.eugene.order.v1+json", contentType)
+		assert.Equal(t, "application/hal+json, application/problem+json", accept)
+		assert.Equal(t, "application/hal+json", resp.Raw.Header.Get("Content-Type"))
+		require.NotNil(t, resp.JSON201)
+		assert.Equal(t, "order-1", *resp.JSON201.ID)
+		assert.Equal(t, "widget", resp.JSON201.Item)
+	})
+
+	t.Run("Problem response", func(t *testing.T) {
+		resp, err := client.CreateOrder(ctx, vendorjson.Order{})
+		require.Error(t, err)
+		assert.Equal(t, "application/problem+json", resp.Raw.Header.Get("Content-Type"))
+		require.NotNil(t, resp.JSON400)
+		assert.Equal(t, "item is required", *resp.JSON400.Title)
+	})
+}
+
+// === Text Body Handler ===
+
+type TextBodyHandler struct{}
+
+func (h *TextBodyHandler) CreateNote(ctx context.Context, req textbodies.CreateNoteRequestObject) (textbodies.CreateNoteResponseObject, error) {
+	if req.Body == "" {
+		return textbodies.CreateNoteDefaultTextResponse{StatusCode: http.StatusBadRequest, Body: "empty note"}, nil
+	}
+	return textbodies.CreateNote201TextResponse("stored: " + req.Body), nil
+}
+
+func (h *TextBodyHandler) GetPage(ctx context.Context, req textbodies.GetPageRequestObject) (textbodies.GetPageResponseObject, error) {
+	if req.Name != "home" {
+		msg := "no such page"
+		return textbodies.GetPage404JSONResponse{Message: &msg}, nil
+	}
+	return textbodies.GetPage200TextResponse("<h1>Home</h1>"), nil
+}
+
+func TestE2ETextBodies(t *testing.T) {
+	r := chi.NewRouter()
+	textbodies.RegisterStrictHandlers(r, &TextBodyHandler{})
+
+	var contentType string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		contentType = req.Header.Get("Content-Type")
+		r.ServeHTTP(w, req)
+	}))
+	defer server.Close()
+
+	client := textbodies.NewClient(server.URL)
+	ctx := context.Background()
+
+	t.Run("Plain text round-trip", func(t *testing.T) {
+		resp, err := client.CreateNote(ctx, "buy milk")
+		require.NoError(t, err)
+		assert.Equal(t, "text/plain", contentType)
+		assert.Equal(t, http.StatusCreated, resp.StatusCode)
+		require.NotNil(t, resp.Text201)
+		assert.Equal(t, "stored: buy milk", *resp.Text201)
+	})
+
+	t.Run("Plain text default response", func(t *testing.T) {
+		resp, err := client.CreateNote(ctx, "")
+		require.Error(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+		require.NotNil(t, resp.TextDefault)
+		assert.Equal(t, "empty note", *resp.TextDefault)
+	})
+
+	t.Run("HTML response", func(t *testing.T) {
+		resp, err := client.GetPage(ctx, "home")
+		require.NoError(t, err)
+		assert.Equal(t, "text/html", resp.Raw.Header.Get("Content-Type"))
+		require.NotNil(t, resp.Text200)
+		assert.Equal(t, "<h1>Home</h1>", *resp.Text200)
+
+		resp, err = client.GetPage(ctx, "missing")
+		require.Error(t, err)
+		require.NotNil(t, resp.JSON404)
+		assert.Equal(t, "no such page", *resp.JSON404.Message)
+	})
+}
+
+// === Binary Body Handler ===
+
+type BinaryBodyHandler struct{}
+
+func (h *BinaryBodyHandler) PutBlob(ctx context.Context, req binarybodies.PutBlobRequestObject) (binarybodies.PutBlobResponseObject, error) {
+	n, err := io.Copy(io.Discard, req.Body)
+	if err != nil {
+		return nil, err
+	}
+	return binarybodies.PutBlob200JSONResponse{Key: req.Key, Size: n, DeclaredSize: &req.ContentLength}, nil
+}
+
+func TestE2EBinaryBodies(t *testing.T) {
+	mux := http.NewServeMux()
+	binarybodies.RegisterStrictHandlers(mux, &BinaryBodyHandler{})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	put := func(t *testing.T, body io.Reader) binarybodies.BlobInfo {
+		req, err := http.NewRequest(http.MethodPut, server.URL+"/blobs/archive", body)
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/octet-stream")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var info binarybodies.BlobInfo
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&info))
+		return info
+	}
+
+	t.Run("Known length", func(t *testing.T) {
+		info := put(t, bytes.NewReader(bytes.Repeat([]byte{0xff}, 1<<20)))
+		assert.Equal(t, "archive", info.Key)
+		assert.Equal(t, int64(1<<20), info.Size)
+		assert.Equal(t, int64(1<<20), *info.DeclaredSize)
+	})
+
+	t.Run("Chunked", func(t *testing.T) {
+		pr, pw := io.Pipe()
+		go func() {
+			_, _ = pw.Write([]byte("streamed"))
+			_ = pw.Close()
+		}()
+		info := put(t, pr)
+		assert.Equal(t, int64(len("streamed")), info.Size)
+		assert.Equal(t, int64(-1), *info.DeclaredSize)
+	})
+}
+
+// === Echo Binding Handler ===
+
+type EchoBindingHandler struct {
+	storeID uuid.UUID
+	day     time.Time
+	seq     int64
+	params  echobinding.GetOrderQueryParams
+	status  echobinding.OrderStatus
+}
+
+func (h *EchoBindingHandler) GetOrder(ctx echo.Context, storeID uuid.UUID, day time.Time, seq int64, params echobinding.GetOrderQueryParams) error {
+	h.storeID, h.day, h.seq, h.params = storeID, day, seq, params
+	return ctx.JSON(http.StatusOK, echobinding.Order{ID: "o1"})
+}
+
+func (h *EchoBindingHandler) ListOrdersByStatus(ctx echo.Context, status echobinding.OrderStatus) error {
+	h.status = status
+	return ctx.JSON(http.StatusOK, []echobinding.Order{})
+}
+
+func TestE2EEchoBinding(t *testing.T) {
+	handler := &EchoBindingHandler{}
+	e := echo.New()
+	echobinding.RegisterHandlers(e, handler)
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	get := func(t *testing.T, path string) int {
+		resp, err := http.Get(server.URL + path)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		return resp.StatusCode
+	}
+
+	t.Run("Typed path and query parameters", func(t *testing.T) {
+		storeID := uuid.New()
+		code := get(t, "/stores/"+storeID.String()+"/days/2024-03-01/orders/42?since=2024-02-01&status=shipped")
+		require.Equal(t, http.StatusOK, code)
+		assert.Equal(t, storeID, handler.storeID)
+		assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), handler.day)
+		assert.Equal(t, int64(42), handler.seq)
+		require.NotNil(t, handler.params.Since)
+		assert.Equal(t, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC), *handler.params.Since)
+		require.NotNil(t, handler.params.Status)
+		assert.Equal(t, echobinding.OrderStatusShipped, *handler.params.Status)
+	})
+
+	t.Run("Enum path parameter", func(t *testing.T) {
+		require.Equal(t, http.StatusOK, get(t, "/orders/open"))
+		assert.Equal(t, echobinding.OrderStatusOpen, handler.status)
+	})
+
+	t.Run("Invalid values are rejected", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, get(t, "/stores/not-a-uuid/days/2024-03-01/orders/42"))
+		assert.Equal(t, http.StatusBadRequest, get(t, "/stores/"+uuid.NewString()+"/days/03-01-2024/orders/42"))
+		assert.Equal(t, http.StatusBadRequest, get(t, "/stores/"+uuid.NewString()+"/days/2024-03-01/orders/x"))
+		assert.Equal(t, http.StatusBadRequest, get(t, "/stores/"+uuid.NewString()+"/days/2024-03-01/orders/42?status=lost"))
+		assert.Equal(t, http.StatusBadRequest, get(t, "/orders/lost"))
+	})
+}
+
+// === Validating Strict Server ===
+
+type ValidationHandler struct {
+	calls int
+}
+
+func (h *ValidationHandler) ListPets(ctx context.Context, req validation.ListPetsRequestObject) (validation.ListPetsResponseObject, error) {
+	h.calls++
+	return validation.ListPets200JSONResponse{}, nil
+}
+
+func (h *ValidationHandler) CreatePet(ctx context.Context, req validation.CreatePetRequestObject) (validation.CreatePetResponseObject, error) {
+	h.calls++
+	return validation.CreatePet201JSONResponse{ID: "p1", Name: req.Body.Name}, nil
+}
+
+func (h *ValidationHandler) SetPetNote(ctx context.Context, req validation.SetPetNoteRequestObject) (validation.SetPetNoteResponseObject, error) {
+	h.calls++
+	return validation.SetPetNote204Response{}, nil
+}
+
+func (h *ValidationHandler) Health(ctx context.Context) (validation.HealthResponseObject, error) {
+	h.calls++
+	return validation.Health200Response{}, nil
+}
+
+func TestE2EStrictValidation(t *testing.T) {
+	handler := &ValidationHandler{}
+	mux := http.NewServeMux()
+	validation.RegisterStrictHandlers(mux, validation.NewValidatingStrictServer(handler))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	do := func(t *testing.T, method, path, contentType, body string, header http.Header) (int, validation.RequestValidationError) {
+		req, err := http.NewRequest(method, server.URL+path, strings.NewReader(body))
+		require.NoError(t, err)
+		if contentType != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		var verr validation.RequestValidationError
+		if resp.StatusCode == http.StatusBadRequest {
+			assert.Equal(t, "application/json", resp.Header.Get("Content-Type"))
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&verr))
+		}
+		return resp.StatusCode, verr
+	}
+
+	t.Run("Valid requests reach the handler", func(t *testing.T) {
+		handler.calls = 0
+		code, _ := do(t, http.MethodGet, "/pets?owner=ann&limit=10&status=sold", "", "", http.Header{"X-Request-Id": {"0badcafe"}})
+		assert.Equal(t, http.StatusOK, code)
+		code, _ = do(t, http.MethodPost, "/pets", "application/json", `{"name":"Rex","kind":"dog","age":3}`, nil)
+		assert.Equal(t, http.StatusCreated, code)
+		code, _ = do(t, http.MethodPut, "/pets/p7/note", "text/plain", "good boy", nil)
+		assert.Equal(t, http.StatusNoContent, code)
+		code, _ = do(t, http.MethodGet, "/health", "", "", nil)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, 4, handler.calls)
+	})
+
+	t.Run("Parameters", func(t *testing.T) {
+		handler.calls = 0
+		code, verr := do(t, http.MethodGet, "/pets?owner=a&limit=0&status=lost", "", "", http.Header{"X-Request-Id": {"nope"}})
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, []validation.FieldError{
+			{Field: "query.owner", Message: "must be at least 2 characters"},
+			{Field: "query.limit", Message: "must be >= 1"},
+			{Field: "query.status", Message: "must be one of available, sold"},
+			{Field: "header.X-Request-Id", Message: "must match ^[a-f0-9]{8}$"},
+		}, verr.Errors)
+		assert.Zero(t, handler.calls)
+	})
+
+	t.Run("Body fields", func(t *testing.T) {
+		code, verr := do(t, http.MethodPost, "/pets", "application/json", `{"name":"R2-D2","kind":"droid","age":30,"tags":["a","b","c","d"]}`, nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, []validation.FieldError{
+			{Field: "body.name", Message: "must match ^[A-Za-z ]+$"},
+			{Field: "body.kind", Message: "must be one of cat, dog"},
+			{Field: "body.age", Message: "must be < 30"},
+			{Field: "body.tags", Message: "must have at most 3 items"},
+		}, verr.Errors)
+	})
+
+	t.Run("Required body and path", func(t *testing.T) {
+		code, verr := do(t, http.MethodPut, "/pets/x1/note", "text/plain", "", nil)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, []validation.FieldError{
+			{Field: "path.petId", Message: "must match ^p[0-9]+$"},
+			{Field: "body", Message: "is required"},
+		}, verr.Errors)
+		assert.EqualError(t, verr, "invalid request: path.petId must match ^p[0-9]+$; body is required")
+	})
+}
+
+func TestE2EConstraintConstants(t *testing.T) {
+	require.Equal(t, 1, validation.NewPetNameMinLength)
+	require.Equal(t, 20, validation.NewPetNameMaxLength)
+	require.Equal(t, 0, validation.NewPetAgeMinimum)
+	require.Equal(t, 30, validation.NewPetAgeExclusiveMaximum)
+	require.Equal(t, 3, validation.NewPetTagsMaxItems)
+
+	require.True(t, validation.NewPetNamePattern.MatchString("Rex"))
+	require.False(t, validation.NewPetNamePattern.MatchString("R2"))
+
+	// Constants are untyped, so they size arrays and compare without conversions
+	var name [validation.NewPetNameMaxLength]byte
+	require.Len(t, name, 20)
+}
+
+// ExampleChecksHandler answers with the body set for the next request.
+type ExampleChecksHandler struct {
+	status int
+	body   string
+}
+
+func (h *ExampleChecksHandler) write(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(h.status)
+	io.WriteString(w, h.body)
+}
+
+func (h *ExampleChecksHandler) ListPets(w http.ResponseWriter, r *http.Request) { h.write(w) }
+
+func (h *ExampleChecksHandler) GetPet(w http.ResponseWriter, r *http.Request, petID string) {
+	h.write(w)
+}
+
+func (h *ExampleChecksHandler) Health(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain")
+	io.WriteString(w, "ok")
+}
+
+func TestE2EExampleChecks(t *testing.T) {
+	handler := &ExampleChecksHandler{}
+	var mismatches []examplechecks.ExampleMismatch
+	server := httptest.NewServer(examplechecks.HandlerWithOptions(handler, examplechecks.StdlibServerOptions{
+		Middlewares: []func(http.Handler) http.Handler{
+			examplechecks.CheckExamples(func(r *http.Request, m []examplechecks.ExampleMismatch) {
+				mismatches = append(mismatches, m...)
+			}),
+		},
+	}))
+	defer server.Close()
+
+	get := func(t *testing.T, path string, status int, body string) []examplechecks.ExampleMismatch {
+		handler.status, handler.body, mismatches = status, body, nil
+		resp, err := http.Get(server.URL + path)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		got, err := io.ReadAll(resp.Body)
+		require.NoError(t, err)
+		if body != "" {
+			assert.Equal(t, status, resp.StatusCode)
+			assert.Equal(t, body, string(got), "the response passes through unchanged")
+		}
+		return mismatches
+	}
+
+	t.Run("Matching shapes", func(t *testing.T) {
+		assert.Empty(t, get(t, "/pets", http.StatusOK, `[{"id":2,"name":"Max","tags":[],"owner":"ann"},{"id":3,"name":"Bo","tags":["x"],"owner":null}]`))
+		assert.Empty(t, get(t, "/pets/p1", http.StatusOK, `{"id":2,"name":"Max","tags":["dog"]}`), "schema example")
+		assert.Empty(t, get(t, "/pets/p1", http.StatusNotFound, `{"code":"gone","message":"no"}`), "4XX example")
+		assert.Empty(t, get(t, "/health", http.StatusOK, ""), "text responses are not checked")
+		assert.Empty(t, get(t, "/pets/p1", http.StatusInternalServerError, `{"oops":true}`), "no example for 500")
+	})
+
+	t.Run("Drift", func(t *testing.T) {
+		assert.Equal(t, []examplechecks.ExampleMismatch{
+			{OperationID: "listPets", StatusCode: 200, Path: "$[0].id", Message: "is a string, the example has a number"},
+			{OperationID: "listPets", StatusCode: 200, Path: "$[0].tags", Message: "missing from the response"},
+			{OperationID: "listPets", StatusCode: 200, Path: "$[0].nickname", Message: "not in the example"},
+		}, get(t, "/pets", http.StatusOK, `[{"id":"2","name":"Max","owner":null,"nickname":"M"}]`))
+
+		got := get(t, "/pets/p1", http.StatusBadRequest, `["not_found"]`)
+		require.Len(t, got, 1)
+		assert.Equal(t, "getPet 400 $: is an array, the example has an object", got[0].String())
+	})
+}
+
+func TestE2ETestHelpers(t *testing.T) {
+	server, client := stdlibGen.NewTestServer(t, &StdlibHandler{}, stdlibGen.WithHeader("X-Request-ID", "req-1"))
+	ctx := context.Background()
+
+	t.Run("Client of the test server", func(t *testing.T) {
+		filter := "f"
+		resp, err := client.GetItem(ctx, "item-1", &stdlibGen.GetItemParams{Filter: &filter})
+		item := stdlibGen.RequireGetItem200(t, resp, err)
+		assert.Equal(t, "item-1", *item.ID)
+		assert.Equal(t, "req-1", *item.RequestID)
+
+		resp, err = client.GetItem(ctx, "not-found", nil)
+		assert.Equal(t, "NOT_FOUND", *stdlibGen.RequireGetItem404(t, resp, err).Code)
+
+		created, err := client.CreateResource(ctx, stdlibGen.NewResource{Name: "box"})
+		assert.Equal(t, "box", *stdlibGen.RequireCreateResource201(t, created, err).Name)
+	})
+
+	t.Run("Failed assertions", func(t *testing.T) {
+		resp, err := client.GetItem(ctx, "not-found", nil)
+		msg := fatalMessage(t, func(tb testing.TB) { stdlibGen.RequireGetItem200(tb, resp, err) })
+		assert.Equal(t, "GetItem: want a 200 response, got 404: request failed with status 404: "+
+			`{"code":"NOT_FOUND","message":"Item not found"}`+"\n", msg)
+
+		msg = fatalMessage(t, func(tb testing.TB) {
+			stdlibGen.RequireGetItem200(tb, nil, errors.New("connection refused"))
+		})
+		assert.Equal(t, "GetItem: want a 200 response: connection refused", msg)
+	})
+
+	t.Run("Request builder", func(t *testing.T) {
+		build := stdlibGen.NewRequestBuilder(t, server.URL, stdlibGen.WithHeader("X-Request-ID", "req-2"))
+		filter := "a b"
+		req := build.GetItem(ctx, "item-2", &stdlibGen.GetItemParams{Filter: &filter})
+		assert.Equal(t, http.MethodGet, req.Method)
+		assert.Equal(t, server.URL+"/items/item-2?filter=a+b", req.URL.String())
+		assert.Equal(t, "req-2", req.Header.Get("X-Request-ID"))
+
+		req = build.CreateResource(ctx, stdlibGen.NewResource{Name: "box"})
+		assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+		req.Body, req.ContentLength, req.GetBody = io.NopCloser(strings.NewReader("{")), 1, nil
+		resp, err := server.Client().Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode, "the altered request reaches the server")
+	})
+}
+
+func TestE2EVCRTransport(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+	newClient := func(serverURL string, mode stdlibGen.VCRMode) *stdlibGen.Client {
+		vcr := stdlibGen.NewVCRTransport(dir, mode)
+		return stdlibGen.NewClient(serverURL, stdlibGen.WithHTTPClient(&http.Client{Transport: vcr}),
+			stdlibGen.WithHeader("X-API-Key", "valid-api-key"))
+	}
+
+	server := httptest.NewServer(stdlibGen.Handler(&StdlibHandler{}))
+	recorder := newClient(server.URL, stdlibGen.VCRRecord)
+	filter := "f"
+	_, err := recorder.GetItem(ctx, "item-1", &stdlibGen.GetItemParams{Filter: &filter})
+	require.NoError(t, err)
+	_, err = recorder.GetSecureData(ctx)
+	require.NoError(t, err)
+	_, err = recorder.CreateResource(ctx, stdlibGen.NewResource{Name: "box"})
+	require.NoError(t, err)
+	server.Close()
+
+	data, err := os.ReadFile(filepath.Join(dir, "getSecureData.json"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "valid-api-key")
+	assert.Contains(t, string(data), `"X-Api-Key": [
+            "REDACTED"
+          ]`)
+
+	// the server is gone, so responses come from the recordings
+	replayer := newClient(server.URL, stdlibGen.VCRReplay)
+	resp, err := replayer.GetItem(ctx, "item-1", &stdlibGen.GetItemParams{Filter: &filter})
+	require.NoError(t, err)
+	assert.Equal(t, "item-1", *resp.JSON200.ID)
+	assert.Equal(t, "f", *resp.JSON200.Filter)
+
+	secure, err := replayer.GetSecureData(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, secure.StatusCode)
+
+	created, err := replayer.CreateResource(ctx, stdlibGen.NewResource{Name: "box"})
+	require.NoError(t, err)
+	assert.Equal(t, "box", *created.JSON201.Name)
+
+	_, err = replayer.GetItem(ctx, "item-2", nil)
+	require.ErrorContains(t, err, `vcr: no recording of getItem with params "id=item-2"`)
+	_, err = replayer.CreateResource(ctx, stdlibGen.NewResource{Name: "bag"})
+	require.ErrorContains(t, err, "vcr: no recording of createResource")
+}
+
+func TestE2ELoadTestScenario(t *testing.T) {
+	var ids []string
+	for _, target := range loadtestGen.LoadScenario() {
+		ids = append(ids, target.OperationID)
+	}
+	// deletePet is weighted 0, getPhoto lacks a path parameter example and
+	// streamEvents streams
+	assert.Equal(t, []string{"createPet", "getPet", "searchPets"}, ids)
+
+	header := http.Header{"Authorization": {"Bearer t"}}
+	var buf bytes.Buffer
+	require.NoError(t, loadtestGen.WriteVegetaTargets(&buf, "http://localhost:8080/", header))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2+5+1)
+
+	type vegetaTarget struct {
+		Method string
+		URL    string
+		Body   []byte
+		Header http.Header
+	}
+	var create, get vegetaTarget
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &create))
+	assert.Equal(t, "POST", create.Method)
+	assert.Equal(t, "http://localhost:8080/pets", create.URL)
+	assert.JSONEq(t, `{"name":"Rex","tag":"dog"}`, string(create.Body))
+	assert.Equal(t, "application/json", create.Header.Get("Content-Type"))
+	assert.Equal(t, "Bearer t", create.Header.Get("Authorization"))
+
+	require.NoError(t, json.Unmarshal([]byte(lines[2]), &get))
+	assert.Equal(t, "http://localhost:8080/pets/p%201?fields=name&fields=tag", get.URL)
+	assert.Equal(t, "acme", get.Header.Get("X-Tenant"))
+	assert.Equal(t, lines[2], lines[6])
+
+	buf.Reset()
+	require.NoError(t, loadtestGen.WriteK6Script(&buf, "http://localhost:8080", header))
+	script := buf.String()
+	assert.Contains(t, script, `const baseURL = __ENV.BASE_URL || "http://localhost:8080";`)
+	assert.Contains(t, script, `"operationId": "searchPets"`)
+	assert.Contains(t, script, `"path": "/search?q=cat"`)
+	assert.Contains(t, script, `"weight": 5`)
+	assert.Contains(t, script, `"body": "{\"name\":\"Rex\",\"tag\":\"dog\"}"`)
+}
+
+func TestE2EMainScaffold(t *testing.T) {
+	binary := filepath.Join(t.TempDir(), "server")
+	build := exec.Command("go", "build", "-o", binary, "./generated/main_stdlib/cmd/server")
+	output, err := build.CombinedOutput()
+	require.NoError(t, err, "building the scaffold:\n%s", output)
+
+	cmd := exec.Command(binary, "-addr", "127.0.0.1:0", "-shutdown-timeout", "5s")
+	stderr, err := cmd.StderrPipe()
+	require.NoError(t, err)
+	require.NoError(t, cmd.Start())
+	t.Cleanup(func() { _ = cmd.Process.Kill() })
+
+	// the server logs the address it listens on
+	logs := bufio.NewScanner(stderr)
+	var baseURL string
+	for logs.Scan() {
+		if _, addr, ok := strings.Cut(logs.Text(), "addr="); ok {
+			baseURL = "http://" + addr
+			break
+		}
+	}
+	require.NotEmpty(t, baseURL, "no listening address logged")
+	go io.Copy(io.Discard, stderr)
+
+	status := func(path string) int {
+		resp, err := http.Get(baseURL + path)
+		require.NoError(t, err)
+		resp.Body.Close()
+		return resp.StatusCode
+	}
+	assert.Equal(t, http.StatusOK, status("/healthz"))
+	assert.Equal(t, http.StatusOK, status("/readyz"))
+	assert.Equal(t, http.StatusNotImplemented, status("/items/item-1"))
+
+	require.NoError(t, cmd.Process.Signal(syscall.SIGTERM))
+	require.NoError(t, cmd.Wait(), "the server should exit cleanly on SIGTERM")
+}
+
+func TestE2EHealthEndpoints(t *testing.T) {
+	get := func(handler http.Handler, path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	// the routes are not operations, so middleware keyed by operation sees none
+	var operations []string
+	recordOperation := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			r = r.WithContext(healthstdlib.WithOperation(r.Context()))
+			next.ServeHTTP(w, r)
+			operations = append(operations, healthstdlib.OperationID(r.Context()))
+		})
+	}
+	handler := healthstdlib.HandlerWithOptions(healthstdlib.UnimplementedServer{}, healthstdlib.StdlibServerOptions{
+		BaseURL:     "/api",
+		Middlewares: []func(http.Handler) http.Handler{recordOperation},
+	})
+
+	rec := get(handler, "/healthz")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, http.StatusOK, get(handler, "/readyz").Code)
+	assert.Equal(t, http.StatusNotImplemented, get(handler, "/api/items/item-1").Code)
+	assert.Equal(t, []string{"", "", "getItem"}, operations)
+
+	t.Cleanup(func() { healthstdlib.ReadinessCheck = nil })
+	healthstdlib.ReadinessCheck = func(ctx context.Context) error { return errors.New("database unreachable") }
+	rec = get(handler, "/readyz")
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	assert.Contains(t, rec.Body.String(), "database unreachable")
+
+	t.Cleanup(func() { healthstdlib.BuildVersion, healthstdlib.BuildCommit = "", "" })
+	healthstdlib.BuildVersion, healthstdlib.BuildCommit = "v1.2.0", "abc123"
+	rec = get(handler, "/version")
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	var version map[string]string
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &version))
+	assert.Equal(t, "E2E Round-trip Test", version["title"])
+	assert.Equal(t, "1.0.0", version["specVersion"])
+	assert.Equal(t, "v1.2.0", version["version"])
+	assert.Equal(t, "abc123", version["commit"])
+	assert.Equal(t, runtime.Version(), version["goVersion"])
+
+	mux := http.NewServeMux()
+	healthstdlib.RegisterStrictHandlers(mux, healthstdlib.UnimplementedStrictServer{})
+	assert.Equal(t, http.StatusOK, get(mux, "/healthz").Code)
+
+	r := chi.NewRouter()
+	healthchi.RegisterStrictHandlers(r, healthchi.UnimplementedStrictServer{})
+	assert.Equal(t, http.StatusOK, get(r, "/readyz").Code)
+	assert.Equal(t, http.StatusOK, get(healthchi.Handler(healthchi.UnimplementedServer{}), "/version").Code)
+
+	e := echo.New()
+	healthecho.RegisterHandlersWithBaseURL(e.Group("/v1"), healthecho.UnimplementedServer{}, "")
+	assert.Equal(t, http.StatusOK, get(e, "/v1/healthz").Code)
+	e = echo.New()
+	healthecho.RegisterStrictHandlers(e, healthecho.UnimplementedStrictServer{})
+	assert.Equal(t, http.StatusOK, get(e, "/version").Code)
+}
+
+func TestE2ERouteDrift(t *testing.T) {
+	r := chi.NewRouter()
+	r.Route("/api", func(r chi.Router) {
+		healthchi.RegisterStrictHandlers(r, healthchi.UnimplementedStrictServer{})
+	})
+	assert.NoError(t, healthchi.CheckRoutes(r, healthchi.RouteCheckOptions{BaseURL: "/api"}).Err())
+
+	// a hand-added route bypasses the spec
+	r.Get("/api/items/{id}/export", func(http.ResponseWriter, *http.Request) {})
+	r.Handle("/metrics", http.NotFoundHandler())
+	drift := healthchi.CheckRoutes(r, healthchi.RouteCheckOptions{BaseURL: "/api", Ignore: []string{"/metrics"}})
+	assert.Equal(t, []healthchi.RouteInfo{{Method: "GET", Path: "/api/items/{id}/export"}}, drift.Extra)
+	assert.Empty(t, drift.Missing)
+
+	e := echo.New()
+	healthecho.RegisterHandlersWithBaseURL(e.Group("/v1"), healthecho.UnimplementedServer{}, "")
+	assert.True(t, healthecho.CheckRoutes(e, healthecho.RouteCheckOptions{BaseURL: "/v1"}).Empty())
+
+	// operations registered by hand, one of them left out
+	e = echo.New()
+	wrapper := &healthecho.ServerInterfaceWrapper{Handler: healthecho.UnimplementedServer{}}
+	e.GET("/items/:id", wrapper.GetItem)
+	e.POST("/resources", wrapper.CreateResource)
+	e.GET("/debug/*", echo.NotFoundHandler)
+	echoDrift := healthecho.CheckRoutes(e, healthecho.RouteCheckOptions{})
+	assert.Equal(t, []healthecho.RouteInfo{{Method: "GET", Path: "/debug/*"}}, echoDrift.Extra)
+	require.Len(t, echoDrift.Missing, 7)
+	assert.Equal(t, healthecho.RouteInfo{Method: "POST", Path: "/echo/json", OperationID: "echoJSON"}, echoDrift.Missing[0])
+	assert.ErrorContains(t, echoDrift.Err(), "routes drift from the spec:\n  extra GET /debug/*\n  missing POST /echo/json (echoJSON)")
+}
+
+func TestE2ECORS(t *testing.T) {
+	cors := healthstdlib.CORS(healthstdlib.CORSOptions{
+		AllowedOrigins: []string{"https://app.example.com"},
+		ExposedHeaders: []string{"ETag"},
+		MaxAge:         10 * time.Minute,
+		BaseURL:        "/api",
+	})
+	handler := healthstdlib.HandlerWithOptions(healthstdlib.UnimplementedServer{}, healthstdlib.StdlibServerOptions{
+		BaseURL:     "/api",
+		Middlewares: []func(http.Handler) http.Handler{cors},
+	})
+	preflight := func(h http.Handler, path, origin, method, headers string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodOptions, path, nil)
+		req.Header.Set("Origin", origin)
+		req.Header.Set("Access-Control-Request-Method", method)
+		if headers != "" {
+			req.Header.Set("Access-Control-Request-Headers", headers)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	// the ServeMux would answer 405, since the spec has no OPTIONS operation
+	rec := preflight(handler, "/api/items/42", "https://app.example.com", "GET", "x-request-id")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, "https://app.example.com", rec.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "GET", rec.Header().Get("Access-Control-Allow-Methods"))
+	assert.Equal(t, "x-request-id", rec.Header().Get("Access-Control-Allow-Headers"))
+	assert.Equal(t, "600", rec.Header().Get("Access-Control-Max-Age"))
+
+	// the security scheme's header and the body's Content-Type are allowed
+	rec = preflight(handler, "/api/secure/data", "https://app.example.com", "GET", "X-API-Key")
+	assert.Equal(t, "X-API-Key", rec.Header().Get("Access-Control-Allow-Headers"))
+	rec = preflight(handler, "/api/shapes", "https://app.example.com", "POST", "content-type")
+	assert.Equal(t, "POST", rec.Header().Get("Access-Control-Allow-Methods"))
+
+	// refused preflights are answered without the allow headers
+	for _, rec := range []*httptest.ResponseRecorder{
+		preflight(handler, "/api/items/42", "https://evil.example.com", "GET", ""),
+		preflight(handler, "/api/items/42", "https://app.example.com", "DELETE", ""),
+		preflight(handler, "/api/items/42", "https://app.example.com", "GET", "X-Debug"),
+	} {
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+	}
+
+	// paths outside the spec reach the router
+	rec = preflight(handler, "/api/unknown", "https://app.example.com", "GET", "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/session", nil)
+	req.Header.Set("Origin", "https://app.example.com")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNotImplemented, rec.Code)
+	assert.Equal(t, "https://app.example.com", rec.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "ETag", rec.Header().Get("Access-Control-Expose-Headers"))
+	assert.Equal(t, "Origin", rec.Header().Get("Vary"))
+
+	// echo answers preflights before routing with Pre
+	e := echo.New()
+	e.Pre(echo.WrapMiddleware(healthecho.CORS(healthecho.CORSOptions{AllowedOrigins: []string{"*"}, AllowedHeaders: []string{"*"}})))
+	healthecho.RegisterHandlers(e, healthecho.UnimplementedServer{})
+	rec = preflight(e, "/resources/7", "https://any.example.com", "DELETE", "X-Custom")
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, "*", rec.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "X-Custom", rec.Header().Get("Access-Control-Allow-Headers"))
+
+	// a literal path wins over a parameter declared before it
+	paths := corspaths.CORS(corspaths.CORSOptions{AllowedOrigins: []string{"*"}})(http.NotFoundHandler())
+	rec = preflight(paths, "/pets/mine", "https://any.example.com", "POST", "")
+	assert.Equal(t, "POST", rec.Header().Get("Access-Control-Allow-Methods"))
+	rec = preflight(paths, "/pets/7", "https://any.example.com", "GET", "")
+	assert.Equal(t, "GET", rec.Header().Get("Access-Control-Allow-Methods"))
+}
+
+type bodyLimitsServer struct{}
+
+func (bodyLimitsServer) CreateNote(ctx context.Context, request bodylimitschi.CreateNoteRequestObject) (bodylimitschi.CreateNoteResponseObject, error) {
+	return bodylimitschi.CreateNote201JSONResponse(request.Body), nil
+}
+
+func (bodyLimitsServer) UpdateNote(ctx context.Context, request bodylimitschi.UpdateNoteRequestObject) (bodylimitschi.UpdateNoteResponseObject, error) {
+	if request.Body == nil {
+		return bodylimitschi.UpdateNote200JSONResponse{Text: "unchanged"}, nil
+	}
+	return bodylimitschi.UpdateNote200JSONResponse(*request.Body), nil
+}
+
+func (bodyLimitsServer) CreateShape(ctx context.Context, request bodylimitschi.CreateShapeRequestObject) (bodylimitschi.CreateShapeResponseObject, error) {
+	// a union holds its JSON until a variant is asked for, and each variant
+	// rejects the members it does not know
+	if _, err := request.Body.AsCircle(); err == nil {
+		return bodylimitschi.CreateShape201JSONResponse(request.Body), nil
+	}
+	if _, err := request.Body.AsSquare(); err == nil {
+		return bodylimitschi.CreateShape201JSONResponse(request.Body), nil
+	}
+	return bodylimitschi.CreateShape400Response{}, nil
+}
+
+func (bodyLimitsServer) Upload(ctx context.Context, request bodylimitschi.UploadRequestObject) (bodylimitschi.UploadResponseObject, error) {
+	if _, err := io.Copy(io.Discard, request.Body); err != nil {
+		return nil, err
+	}
+	return bodylimitschi.Upload204Response{}, nil
+}
+
+type bodyLimitsEchoServer struct {
+	bodylimitsecho.UnimplementedServer
+}
+
+func (bodyLimitsEchoServer) CreateNote(ctx echo.Context) error {
+	var note bodylimitsecho.Note
+	if err := bodylimitsecho.DecodeJSONBody(ctx.Request(), &note); err != nil {
+		return echo.NewHTTPError(bodylimitsecho.BodyErrorStatus(err), err.Error())
+	}
+	return ctx.JSON(http.StatusCreated, note)
+}
+
+func TestE2EBodyLimits(t *testing.T) {
+	r := chi.NewRouter()
+	bodylimitschi.RegisterStrictHandlers(r, bodyLimitsServer{})
+	send := func(h http.Handler, method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		return rec
+	}
+
+	rec := send(r, http.MethodPost, "/notes", `{"text":"hello"}`)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.JSONEq(t, `{"text":"hello"}`, rec.Body.String())
+
+	// max-body is 64 bytes
+	rec = send(r, http.MethodPost, "/notes", `{"text":"`+strings.Repeat("a", 64)+`"}`)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+
+	// disallow-unknown-fields
+	rec = send(r, http.MethodPost, "/notes", `{"text":"hello","color":"red"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), `unknown field "color"`)
+
+	// oneOf variants decode themselves, and reject unknown fields under
+	// unknown-fields: error
+	rec = send(r, http.MethodPost, "/shapes", `{"radius":2}`)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	rec = send(r, http.MethodPost, "/shapes", `{"radius":2,"color":"red"}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// x-oink-max-body: 32 lowers the limit of one operation
+	rec = send(r, http.MethodPatch, "/notes/1", `{"text":"`+strings.Repeat("a", 32)+`"}`)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	rec = send(r, http.MethodPatch, "/notes/1", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"text":"unchanged"}`, rec.Body.String())
+	// an optional body that does not decode is refused rather than dropped
+	rec = send(r, http.MethodPatch, "/notes/1", `{"text":`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// x-oink-max-body: 0 lifts it
+	rec = send(r, http.MethodPut, "/uploads", strings.Repeat("a", 1024))
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	// ServerInterface handlers decode with DecodeJSONBody under the default
+	// limit of 1 MiB, and unknown fields are accepted
+	e := echo.New()
+	bodylimitsecho.RegisterHandlers(e, bodyLimitsEchoServer{})
+	assert.EqualValues(t, 1<<20, bodylimitsecho.MaxBodySize)
+	rec = send(e, http.MethodPost, "/notes", `{"text":"hello","color":"red"}`)
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	rec = send(e, http.MethodPost, "/notes", `{"text":"`+strings.Repeat("a", 1<<20)+`"}`)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+}
+
+// tenantChiServer answers projects of the tenant its requests are served
+// under.
+type tenantChiServer struct{}
+
+func (tenantChiServer) ListProjects(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode([]tenantchi.Project{{ID: "p1", Tenant: tenantchi.TenantIDFromContext(r.Context())}})
+}
+
+func (tenantChiServer) GetProject(w http.ResponseWriter, r *http.Request, projectID string) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(tenantchi.Project{ID: projectID, Tenant: tenantchi.TenantIDFromContext(r.Context())})
+}
+
+type tenantStrictEchoServer struct{}
+
+func (tenantStrictEchoServer) ListProjects(ctx context.Context) (tenantecho.ListProjectsResponseObject, error) {
+	return tenantecho.ListProjects200JSONResponse{{ID: "p1", Tenant: tenantecho.TenantIDFromContext(ctx)}}, nil
+}
+
+func (tenantStrictEchoServer) GetProject(ctx context.Context, request tenantecho.GetProjectRequestObject) (tenantecho.GetProjectResponseObject, error) {
+	return tenantecho.GetProject200JSONResponse{ID: request.ProjectID, Tenant: tenantecho.TenantIDFromContext(ctx)}, nil
+}
+
+type tenantStrictStdlibServer struct{}
+
+func (tenantStrictStdlibServer) ListProjects(ctx context.Context) (tenantstdlib.ListProjectsResponseObject, error) {
+	return tenantstdlib.ListProjects200JSONResponse{{ID: "p1", Tenant: tenantstdlib.TenantIDFromContext(ctx)}}, nil
+}
+
+func (tenantStrictStdlibServer) GetProject(ctx context.Context, request tenantstdlib.GetProjectRequestObject) (tenantstdlib.GetProjectResponseObject, error) {
+	return tenantstdlib.GetProject200JSONResponse{ID: request.ProjectID, Tenant: tenantstdlib.TenantIDFromContext(ctx)}, nil
+}
+
+func TestE2ETenantPrefix(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, "/tenants/{tenantId}", tenantchi.PathPrefix)
+
+	t.Run("Chi", func(t *testing.T) {
+		server := httptest.NewServer(tenantchi.Handler(tenantChiServer{}))
+		defer server.Close()
+
+		resp, err := tenantchi.NewClient(server.URL, tenantchi.WithTenantID("globex")).GetProject(ctx, "p7")
+		require.NoError(t, err)
+		require.NotNil(t, resp.JSON200)
+		assert.Equal(t, tenantchi.Project{ID: "p7", Tenant: "globex"}, *resp.JSON200)
+
+		// the server variable's default applies without the option
+		list, err := tenantchi.NewClient(server.URL).ListProjects(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, list.JSON200)
+		assert.Equal(t, "acme", (*list.JSON200)[0].Tenant)
+
+		// operations are only served under the prefix
+		plain, err := http.Get(server.URL + "/projects")
+		require.NoError(t, err)
+		plain.Body.Close()
+		assert.Equal(t, http.StatusNotFound, plain.StatusCode)
+	})
+
+	t.Run("Strict echo", func(t *testing.T) {
+		e := echo.New()
+		tenantecho.RegisterStrictHandlers(e, tenantStrictEchoServer{})
+		server := httptest.NewServer(e)
+		defer server.Close()
+
+		resp, err := tenantecho.NewClient(server.URL, tenantecho.WithTenantID("initech")).ListProjects(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, resp.JSON200)
+		assert.Equal(t, []tenantecho.Project{{ID: "p1", Tenant: "initech"}}, *resp.JSON200)
+	})
+
+	t.Run("Strict stdlib escapes the tenant", func(t *testing.T) {
+		mux := http.NewServeMux()
+		tenantstdlib.RegisterStrictHandlers(mux, tenantStrictStdlibServer{})
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		resp, err := tenantstdlib.NewClient(server.URL, tenantstdlib.WithTenantID("umbrella corp/eu")).GetProject(ctx, "p2")
+		require.NoError(t, err)
+		require.NotNil(t, resp.JSON200)
+		assert.Equal(t, tenantstdlib.Project{ID: "p2", Tenant: "umbrella corp/eu"}, *resp.JSON200)
+	})
+}
+
+// scopedServer grants an endpoint to requests whose X-Scopes header holds
+// every scope of any one of the operation's alternative requirements.
+type scopedServer struct {
+	securitygen.UnimplementedServer
+}
+
+func (s scopedServer) AdminEndpoint(ctx echo.Context) error { return s.authorize(ctx) }
+
+func (s scopedServer) ReportsEndpoint(ctx echo.Context) error { return s.authorize(ctx) }
+
+func (scopedServer) authorize(ctx echo.Context) error {
+	granted := strings.Fields(ctx.Request().Header.Get("X-Scopes"))
+	for _, req := range securitygen.Permissions[securitygen.OperationID(ctx.Request().Context())] {
+		if req.Granted(granted) {
+			return ctx.NoContent(http.StatusOK)
+		}
+	}
+	return ctx.NoContent(http.StatusForbidden)
+}
+
+func TestE2EPermissions(t *testing.T) {
+	assert.Equal(t, "admin:read", securitygen.ScopeAdminRead)
+	assert.Equal(t, "reports.export", securitygen.ScopeReportsExport)
+	assert.Equal(t, []securitygen.ScopeRequirement{
+		{Scheme: "oauth2", Scopes: []string{securitygen.ScopeAdminRead, securitygen.ScopeAdminWrite}},
+	}, securitygen.Permissions["adminEndpoint"])
+	// alternative requirements are listed apart
+	assert.Equal(t, []securitygen.ScopeRequirement{
+		{Scheme: "oauth2", Scopes: []string{securitygen.ScopeAdminRead}},
+		{Scheme: "oidc", Scopes: []string{securitygen.ScopeReportsExport}},
+	}, securitygen.Permissions["reportsEndpoint"])
+	assert.Empty(t, securitygen.Permissions["publicEndpoint"])
+	assert.Contains(t, securitygen.Permissions, "inheritedEndpoint")
+
+	e := echo.New()
+	securitygen.RegisterHandlers(e, scopedServer{})
+	status := func(path, scopes string) int {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("X-Scopes", scopes)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec.Code
+	}
+	assert.Equal(t, http.StatusOK, status("/admin", "admin:read admin:write"))
+	assert.Equal(t, http.StatusForbidden, status("/admin", "admin:read"))
+	// either alternative is enough
+	assert.Equal(t, http.StatusOK, status("/reports", "admin:read"))
+	assert.Equal(t, http.StatusOK, status("/reports", "reports.export"))
+	assert.Equal(t, http.StatusForbidden, status("/reports", "admin:write"))
+}
+
+func TestE2ESortFields(t *testing.T) {
+	// sort-fields: alpha orders the flattened allOf fields by name, which
+	// encoding/json follows when marshaling.
+	promoted := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	owner := sortfields.WorkspaceOwner{
+		ID:         "6f1c1f4e-0c55-4a8e-9d9a-5a4a1f1b2c3d",
+		Email:      "owner@example.com",
+		CreatedAt:  promoted,
+		UpdatedAt:  promoted,
+		PromotedAt: promoted,
+	}
+	data, err := json.Marshal(owner)
+	require.NoError(t, err)
+
+	var keys []string
+	dec := json.NewDecoder(bytes.NewReader(data))
+	_, err = dec.Token()
+	require.NoError(t, err)
+	for dec.More() {
+		key, err := dec.Token()
+		require.NoError(t, err)
+		keys = append(keys, key.(string))
+		var value json.RawMessage
+		require.NoError(t, dec.Decode(&value))
+	}
+	assert.Equal(t, []string{"createdAt", "email", "id", "promotedAt", "updatedAt"}, keys)
+}
+
+func TestE2EClientMock(t *testing.T) {
+	itemName := func(ctx context.Context, client stdlibGen.ClientInterface, id string) (string, error) {
+		resp, err := client.GetItem(ctx, id, nil)
+		if err != nil {
+			return "", err
+		}
+		return *resp.JSON200.ID, nil
+	}
+
+	var gotID string
+	mock := &stdlibGen.ClientMock{
+		GetItemFunc: func(ctx context.Context, id string, params *stdlibGen.GetItemParams) (*stdlibGen.GetItemResponse, error) {
+			gotID = id
+			return &stdlibGen.GetItemResponse{StatusCode: http.StatusOK, JSON200: &stdlibGen.ItemWithParams{ID: &id}}, nil
+		},
+	}
+	name, err := itemName(context.Background(), mock, "item-1")
+	require.NoError(t, err)
+	assert.Equal(t, "item-1", name)
+	assert.Equal(t, "item-1", gotID)
+
+	_, err = mock.CreateResource(context.Background(), stdlibGen.NewResource{Name: "box"})
+	require.EqualError(t, err, "ClientMock: CreateResourceFunc is not set")
+
+	// the generated client satisfies the same interface
+	_, client := stdlibGen.NewTestServer(t, &StdlibHandler{})
+	name, err = itemName(context.Background(), client, "item-2")
+	require.NoError(t, err)
+	assert.Equal(t, "item-2", name)
+}
+
+// fatalMessage runs check on its own goroutine and returns the message it
+// calls Fatalf with, without failing t.
+func fatalMessage(t *testing.T, check func(tb testing.TB)) string {
+	r := &fatalRecorder{TB: t}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		check(r)
+	}()
+	<-done
+	return r.msg
+}
+
+// fatalRecorder records the message of a Fatalf call instead of failing.
+type fatalRecorder struct {
+	testing.TB
+	msg string
+}
+
+func (r *fatalRecorder) Helper() {}
+
+func (r *fatalRecorder) Fatalf(format string, args ...any) {
+	r.msg = fmt.Sprintf(format, args...)
+	runtime.Goexit()
+}
+
+func TestE2EClientServices(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/pets/p1":
+			_, _ = w.Write([]byte(`{"id":"p1","name":"Rex"}`))
+		case "/store/inventory":
+			_, _ = w.Write([]byte(`{"available":3}`))
+		case "/pets":
+			assert.Equal(t, "sold", r.URL.Query().Get("status"))
+			_, _ = w.Write([]byte(`[{"id":"p2","name":"Fido"}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := services.NewClient(server.URL)
+	ctx := context.Background()
+
+	pet, err := client.Pets.GetByID(ctx, "p1")
+	require.NoError(t, err)
+	require.NotNil(t, pet.JSON200)
+	assert.Equal(t, "Rex", pet.JSON200.Name)
+
+	status := "sold"
+	pets, err := client.Pets.List(ctx, &services.ListPetsParams{Status: &status})
+	require.NoError(t, err)
+	require.NotNil(t, pets.JSON200)
+	require.Len(t, *pets.JSON200, 1)
+	assert.Equal(t, "Fido", (*pets.JSON200)[0].Name)
+
+	inventory, err := client.Store.GetInventory(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, inventory.StatusCode)
+}
+
+func TestE2EClientOptions(t *testing.T) {
+	var got http.Header
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"item-1"}`))
+	}))
+	defer server.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(server.Certificate())
+	tlsConfig := &tls.Config{RootCAs: roots}
+	ctx := context.Background()
+
+	t.Run("Defaults", func(t *testing.T) {
+		client := basic.NewClient(server.URL, basic.WithTLSConfig(tlsConfig))
+		_, err := client.GetItem(ctx, "item-1", nil)
+		require.NoError(t, err)
+		assert.Equal(t, basic.DefaultUserAgent, got.Get("User-Agent"))
+		assert.Equal(t, "e2e-round-trip-test/1.0.0 eugene", basic.DefaultUserAgent)
+	})
+
+	t.Run("Headers, user agent and editors", func(t *testing.T) {
+		client := basic.NewClient(server.URL,
+			basic.WithTLSConfig(tlsConfig),
+			basic.WithHeader("X-Tenant", "acme"),
+			basic.WithUserAgent("my-app/2.0"),
+			basic.WithRequestEditor(func(ctx context.Context, req *http.Request) error {
+				req.Header.Set("Authorization", "Bearer token")
+				return nil
+			}),
+		)
+		_, err := client.GetItem(ctx, "item-1", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "acme", got.Get("X-Tenant"))
+		assert.Equal(t, "my-app/2.0", got.Get("User-Agent"))
+		assert.Equal(t, "Bearer token", got.Get("Authorization"))
+	})
+
+	t.Run("Editor error aborts request", func(t *testing.T) {
+		errDenied := errors.New("denied")
+		client := basic.NewClient(server.URL,
+			basic.WithTLSConfig(tlsConfig),
+			basic.WithRequestEditor(func(ctx context.Context, req *http.Request) error {
+				return errDenied
+			}),
+		)
+		_, err := client.GetItem(ctx, "item-1", nil)
+		require.ErrorIs(t, err, errDenied)
+	})
+
+	t.Run("Untrusted certificate", func(t *testing.T) {
+		_, err := basic.NewClient(server.URL).GetItem(ctx, "item-1", nil)
+		require.Error(t, err)
+	})
+
+	t.Run("TLS config needs an http.Transport", func(t *testing.T) {
+		var sent bool
+		transport := &recordingTransport{next: http.DefaultTransport, sent: &sent}
+		client := basic.NewClient(server.URL,
+			basic.WithHTTPClient(&http.Client{Transport: transport}),
+			basic.WithTLSConfig(tlsConfig),
+		)
+		_, err := client.GetItem(ctx, "item-1", nil)
+		require.EqualError(t, err, "WithTLSConfig: transport *tests.recordingTransport is not an *http.Transport; set its TLS configuration directly")
+		assert.False(t, sent)
+	})
+}
+
+// recordingTransport notes that a request was sent before passing it on.
+type recordingTransport struct {
+	next http.RoundTripper
+	sent *bool
+}
+
+func (t *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	*t.sent = true
+	return t.next.RoundTrip(req)
+}
+
+func TestE2EClientSigner(t *testing.T) {
+	key := []byte("secret")
+	mac := func(method, path string, body []byte) string {
+		h := hmac.New(sha256.New, key)
+		h.Write([]byte(method + "\n" + path + "\n"))
+		h.Write(body)
+		return hex.EncodeToString(h.Sum(nil))
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get("X-Signature") != mac(r.Method, r.URL.Path, body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	signer := basic.SignerFunc(func(ctx context.Context, req *http.Request, body []byte) error {
+		req.Header.Set("X-Signature", mac(req.Method, req.URL.Path, body))
+		return nil
+	})
+	client := basic.NewClient(server.URL, basic.WithSigner(signer))
+
+	resp, err := client.EchoJSON(context.Background(), basic.EchoPayload{Message: "signed"})
+	require.NoError(t, err)
+	require.NotNil(t, resp.JSON200)
+	assert.Equal(t, "signed", resp.JSON200.Message)
+
+	_, err = basic.NewClient(server.URL).EchoJSON(context.Background(), basic.EchoPayload{Message: "unsigned"})
+	require.Error(t, err)
+}
+
+// countingBreaker opens the circuit for an operation after two server errors.
+type countingBreaker struct {
+	failures map[basic.OperationKey]int
+}
+
+var errCircuitOpen = errors.New("circuit open")
+
+func (b *countingBreaker) Execute(ctx context.Context, op basic.OperationKey, send func() (*http.Response, error)) (*http.Response, error) {
+	if b.failures[op] >= 2 {
+		return nil, errCircuitOpen
+	}
+	resp, err := send()
+	if err != nil || resp.StatusCode >= 500 {
+		b.failures[op]++
+	}
+	return resp, err
+}
+
+func TestE2EClientBreaker(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Method == http.MethodDelete {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"item-1"}`))
+	}))
+	defer server.Close()
+
+	breaker := &countingBreaker{failures: make(map[basic.OperationKey]int)}
+	client := basic.NewClient(server.URL, basic.WithBreaker(breaker))
+	ctx := context.Background()
+
+	for range 3 {
+		_, err := client.DeleteResource(ctx, "res-1")
+		require.Error(t, err)
+	}
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, 2, breaker.failures[basic.OperationKeyDeleteResource])
+
+	_, err := client.DeleteResource(ctx, "res-1")
+	require.ErrorIs(t, err, errCircuitOpen)
+
+	_, err = client.GetItem(ctx, "item-1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, basic.OperationKey("getItem"), basic.OperationKeyGetItem)
+}
+
+func TestE2EClientBatch(t *testing.T) {
+	e := echo.New()
+	basic.RegisterHandlers(e, &BasicEchoHandler{})
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	client := basic.NewClient(server.URL)
+	ctx := context.Background()
+
+	t.Run("Results in input order", func(t *testing.T) {
+		filter := "active"
+		args := []basic.GetItemBatchArgs{
+			{ID: "item-1"},
+			{ID: "not-found"},
+			{ID: "item-3", Params: &basic.GetItemParams{Filter: &filter}},
+		}
+		results, err := client.BatchGetItem(ctx, args, &basic.BatchOptions{Concurrency: 2})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "batch item 1:")
+		require.Len(t, results, 3)
+
+		require.NoError(t, results[0].Err)
+		assert.Equal(t, "item-1", *results[0].Response.JSON200.ID)
+		require.Error(t, results[1].Err)
+		require.NotNil(t, results[1].Response.JSON404)
+		require.NoError(t, results[2].Err)
+		assert.Equal(t, "active", *results[2].Response.JSON200.Filter)
+	})
+
+	t.Run("Stop on error", func(t *testing.T) {
+		args := []basic.GetItemBatchArgs{{ID: "not-found"}, {ID: "item-2"}, {ID: "item-3"}}
+		results, err := client.BatchGetItem(ctx, args, &basic.BatchOptions{Concurrency: 1, StopOnError: true})
+		require.Error(t, err)
+		require.Error(t, results[0].Err)
+		assert.ErrorIs(t, results[1].Err, context.Canceled)
+		assert.ErrorIs(t, results[2].Err, context.Canceled)
+	})
+}
+
+// === x-oink-async polling ===
+
+func newAsyncJobServer(t *testing.T, final string) *httptest.Server {
+	t.Helper()
+	var polls int
+	return newAsyncStatusServer(t, func(id string) any {
+		polls++
+		state := "running"
+		if polls >= 3 {
+			state = final
+		}
+		return map[string]string{"id": id, "state": state}
+	})
+}
+
+// newAsyncStatusServer accepts jobs and answers each status poll with the
+// document status returns.
+func newAsyncStatusServer(t *testing.T, status func(id string) any) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /jobs", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Location", "/jobs/j1")
+		w.WriteHeader(http.StatusAccepted)
+	})
+	mux.HandleFunc("GET /jobs/{jobId}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(status(r.PathValue("jobId")))
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestE2EAsyncOperation(t *testing.T) {
+	ctx := context.Background()
+	opts := asyncops.PollOptions{Interval: time.Millisecond}
+
+	t.Run("Polls until success", func(t *testing.T) {
+		server := newAsyncJobServer(t, "succeeded")
+		defer server.Close()
+		client := asyncops.NewClient(server.URL)
+
+		resp, err := client.CreateJob(ctx, asyncops.JobRequest{Input: "x"})
+		require.NoError(t, err)
+		require.Equal(t, http.StatusAccepted, resp.StatusCode)
+
+		job, err := client.WaitForCreateJobCompletion(ctx, resp, opts)
+		require.NoError(t, err)
+		assert.Equal(t, "j1", job.ID)
+		assert.Equal(t, asyncops.State("succeeded"), job.State)
+	})
+
+	t.Run("Failure state", func(t *testing.T) {
+		server := newAsyncJobServer(t, "failed")
+		defer server.Close()
+		client := asyncops.NewClient(server.URL)
+
+		resp, err := client.CreateJob(ctx, asyncops.JobRequest{Input: "x"})
+		require.NoError(t, err)
+
+		job, err := client.WaitForCreateJobCompletion(ctx, resp, opts)
+		require.ErrorIs(t, err, asyncops.ErrOperationFailed)
+		require.NotNil(t, job)
+		assert.Equal(t, asyncops.State("failed"), job.State)
+	})
+
+	t.Run("Context cancellation", func(t *testing.T) {
+		server := newAsyncJobServer(t, "succeeded")
+		defer server.Close()
+		client := asyncops.NewClient(server.URL)
+
+		resp, err := client.CreateJob(ctx, asyncops.JobRequest{Input: "x"})
+		require.NoError(t, err)
+
+		cctx, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err = client.WaitForCreateJobCompletion(cctx, resp, asyncops.PollOptions{Interval: time.Hour})
+		require.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("Malformed status", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			doc  map[string]any
+			want string
+		}{
+			"missing":    {map[string]any{"id": "j1"}, "status document has no state field"},
+			"not string": {map[string]any{"id": "j1", "state": 3}, "status field state is 3, not a string"},
+		} {
+			t.Run(name, func(t *testing.T) {
+				server := newAsyncStatusServer(t, func(string) any { return tc.doc })
+				defer server.Close()
+				client := asyncops.NewClient(server.URL)
+
+				resp, err := client.CreateJob(ctx, asyncops.JobRequest{Input: "x"})
+				require.NoError(t, err)
+
+				// without the check, polling would only end at the deadline
+				cctx, cancel := context.WithTimeout(ctx, time.Second)
+				defer cancel()
+				_, err = client.WaitForCreateJobCompletion(cctx, resp, asyncops.PollOptions{Interval: time.Millisecond})
+				require.EqualError(t, err, tc.want)
+			})
+		}
+	})
+}
+
+// === LLM tools ===
+
+func TestE2ETools(t *testing.T) {
+	e := echo.New()
+	basic.RegisterHandlers(e, &BasicEchoHandler{})
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	client := toolsGen.NewClient(server.URL)
+	ctx := context.Background()
+
+	t.Run("Manifest", func(t *testing.T) {
+		data, err := toolsGen.ToolManifest()
+		require.NoError(t, err)
+
+		var manifest []struct {
+			Name        string         `json:"name"`
+			InputSchema map[string]any `json:"inputSchema"`
+		}
+		require.NoError(t, json.Unmarshal(data, &manifest))
+		names := make(map[string]map[string]any)
+		for _, tool := range manifest {
+			names[tool.Name] = tool.InputSchema
+		}
+		require.Contains(t, names, "getItem")
+		assert.NotContains(t, names, "echoForm")
+		assert.NotContains(t, names, "echoMultipart")
+		assert.Equal(t, []any{"id"}, names["getItem"]["required"])
+		assert.Contains(t, names["echoJSON"]["$defs"], "EchoPayload")
+	})
+
+	t.Run("Call with path and query arguments", func(t *testing.T) {
+		result, err := client.CallTool(ctx, "getItem", json.RawMessage(`{"id":"item-1","filter":"active"}`))
+		require.NoError(t, err)
+		resp, ok := result.(*toolsGen.GetItemResponse)
+		require.True(t, ok)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "item-1", *resp.JSON200.ID)
+		assert.Equal(t, "active", *resp.JSON200.Filter)
+	})
+
+	t.Run("Call with body", func(t *testing.T) {
+		result, err := client.CallTool(ctx, "echoJSON", json.RawMessage(`{"body":{"message":"hi"}}`))
+		require.NoError(t, err)
+		resp := result.(*toolsGen.EchoJSONResponse)
+		assert.Equal(t, "hi", resp.JSON200.Message)
+	})
+
+	t.Run("Unknown tool and arguments", func(t *testing.T) {
+		_, err := client.CallTool(ctx, "nope", nil)
+		require.ErrorIs(t, err, toolsGen.ErrUnknownTool)
+
+		_, err = client.CallTool(ctx, "getItem", json.RawMessage(`{"id":"item-1","extra":true}`))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "extra")
+	})
+}
+
+// newDeviceAuthServer serves RFC 8628 device authorization and token
+// endpoints. The token endpoint reports authorization_pending once, then
+// issues a token or, with deny set, access_denied.
+func newDeviceAuthServer(t *testing.T, deny bool) *httptest.Server {
+	t.Helper()
+	var polls int
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /device", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "cli", r.FormValue("client_id"))
+		assert.Equal(t, "profile:read", r.FormValue("scope"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"device_code":"dc1","user_code":"ABCD-EFGH","verification_uri":"https://auth.example.com/activate","expires_in":600,"interval":1}`))
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "urn:ietf:params:oauth:grant-type:device_code", r.FormValue("grant_type"))
+		assert.Equal(t, "dc1", r.FormValue("device_code"))
+		w.Header().Set("Content-Type", "application/json")
+		polls++
+		switch {
+		case polls == 1:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"authorization_pending"}`))
+		case deny:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"access_denied","error_description":"user declined"}`))
+		default:
+			_, _ = w.Write([]byte(`{"access_token":"tok","token_type":"bearer","expires_in":3600}`))
+		}
+	})
+	mux.HandleFunc("GET /profile", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer tok", r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name":"gopher"}`))
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestE2EDeviceFlow(t *testing.T) {
+	ctx := context.Background()
+	flow := func(server *httptest.Server) devicelogin.DeviceFlow {
+		f := devicelogin.CliLoginDeviceFlow
+		f.DeviceAuthorizationURL = server.URL + "/device"
+		f.TokenURL = server.URL + "/token"
+		return f
+	}
+
+	t.Run("Login and call", func(t *testing.T) {
+		server := newDeviceAuthServer(t, false)
+		defer server.Close()
+		f := flow(server)
+
+		auth, err := f.Authorize(ctx, server.Client(), "cli", "profile:read")
+		require.NoError(t, err)
+		assert.Equal(t, "ABCD-EFGH", auth.UserCode)
+
+		token, err := f.Token(ctx, server.Client(), "cli", auth)
+		require.NoError(t, err)
+		assert.Equal(t, "tok", token.AccessToken)
+
+		client := devicelogin.NewClient(server.URL, devicelogin.WithRequestEditor(token.RequestEditor()))
+		resp, err := client.GetProfile(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, resp.JSON200)
+		assert.Equal(t, "gopher", resp.JSON200.Name)
+	})
+
+	t.Run("Access denied", func(t *testing.T) {
+		server := newDeviceAuthServer(t, true)
+		defer server.Close()
+		f := flow(server)
+
+		auth, err := f.Authorize(ctx, server.Client(), "cli", "profile:read")
+		require.NoError(t, err)
+		_, err = f.Token(ctx, server.Client(), "cli", auth)
+		var oauthErr *devicelogin.DeviceFlowError
+		require.ErrorAs(t, err, &oauthErr)
+		assert.Equal(t, "access_denied", oauthErr.Code)
+	})
+}
+
+func TestEnumUnknown(t *testing.T) {
+	const doc = `{"status":"archived","level":7,"source":"batch","kind":"digital"}`
+
+	t.Run("Reject", func(t *testing.T) {
+		var item enumreject.Item
+		err := json.Unmarshal([]byte(doc), &item)
+		require.ErrorContains(t, err, "invalid Status value: archived")
+
+		require.NoError(t, json.Unmarshal([]byte(`{"status":"active","level":0}`), &item))
+		assert.Equal(t, enumreject.StatusActive, *item.Status)
+		assert.Equal(t, enumreject.Level0, *item.Level)
+	})
+
+	t.Run("Extend", func(t *testing.T) {
+		var item enumextend.Item
+		require.NoError(t, json.Unmarshal([]byte(doc), &item))
+		assert.Equal(t, enumextend.StatusUnknown, *item.Status)
+		assert.Equal(t, enumextend.LevelUnknown, *item.Level)
+		assert.Equal(t, enumextend.SourceUnknown, *item.Source)
+		assert.Equal(t, enumextend.KindDigital, *item.Kind)
+	})
+
+	t.Run("Extend struct enums", func(t *testing.T) {
+		var item enumstruct.Item
+		require.NoError(t, json.Unmarshal([]byte(doc), &item))
+		assert.Equal(t, enumstruct.StatusUnknown, *item.Status)
+		assert.False(t, item.Status.IsValid())
+		assert.Equal(t, enumstruct.LevelUnknown, *item.Level)
+		assert.Equal(t, enumstruct.KindDigital, *item.Kind)
+	})
+
+	t.Run("Null", func(t *testing.T) {
+		const doc = `{"m":null,"ms":["x",null]}`
+
+		var rejected enumreject.Item
+		require.NoError(t, json.Unmarshal([]byte(doc), &rejected))
+		assert.Equal(t, enumreject.Maybe(""), rejected.M)
+		assert.Equal(t, []enumreject.Maybe{enumreject.MaybeX, ""}, rejected.Ms)
+
+		extended := enumextend.Item{M: enumextend.MaybeY}
+		require.NoError(t, json.Unmarshal([]byte(doc), &extended))
+		assert.Equal(t, enumextend.MaybeY, extended.M, "null leaves the value untouched")
+		assert.Equal(t, []enumextend.Maybe{enumextend.MaybeX, ""}, extended.Ms)
+
+		var structs enumstruct.Item
+		require.NoError(t, json.Unmarshal([]byte(doc), &structs))
+		assert.Equal(t, enumstruct.Maybe{}, structs.M)
+		assert.Equal(t, []enumstruct.Maybe{enumstruct.MaybeX, {}}, structs.Ms)
+	})
+}
+
+func TestUnknownFields(t *testing.T) {
+	t.Run("Error", func(t *testing.T) {
+		var pet unknownerror.Pet
+		require.NoError(t, json.Unmarshal([]byte(`{"name":"Rex","Tag":"good","owner":{"email":"ana@example.com"}}`), &pet))
+		assert.Equal(t, "good", *pet.Tag)
+		require.EqualError(t, json.Unmarshal([]byte(`{"name":"Rex","color":"red","age":3}`), &pet), `unknown field "age" in Pet`)
+		require.EqualError(t, json.Unmarshal([]byte(`{"name":"Rex","owner":{"phone":"1"}}`), &pet), `unknown field "phone" in PetOwner`)
+
+		var dog unknownerror.Dog
+		require.NoError(t, json.Unmarshal([]byte(`{"name":"Rex","breed":"collie"}`), &dog))
+		assert.Equal(t, "Rex", dog.Name)
+		assert.Equal(t, "collie", *dog.Breed)
+		require.EqualError(t, json.Unmarshal([]byte(`{"name":"Rex","color":"red"}`), &dog), `unknown field "color" in Dog`)
+
+		var note unknownerror.Note
+		require.NoError(t, json.Unmarshal([]byte(`{"by":"ana","body":"hi"}`), &note))
+		assert.Equal(t, "ana", *note.By)
+		assert.Equal(t, "hi", *note.Text)
+		require.EqualError(t, json.Unmarshal([]byte(`{"text":"hi"}`), &note), `unknown field "text" in Note`)
+
+		// declaring additionalProperties opts out
+		var labels unknownerror.Labels
+		require.NoError(t, json.Unmarshal([]byte(`{"team":"core","env":"prod"}`), &labels))
+
+		var animal unknownerror.Animal
+		require.NoError(t, json.Unmarshal([]byte(`{"kind":"cat","lives":9}`), &animal))
+		cat, err := animal.AsCat()
+		require.NoError(t, err)
+		assert.Equal(t, 9, *cat.Lives)
+		require.NoError(t, json.Unmarshal([]byte(`{"kind":"bird","feathers":"blue"}`), &animal))
+		_, err = animal.Variant()
+		require.EqualError(t, err, `unknown field "feathers" in Bird`)
+	})
+
+	t.Run("Capture", func(t *testing.T) {
+		var pet unknowncapture.Pet
+		require.NoError(t, json.Unmarshal([]byte(`{"name":"Rex","color":"red","owner":{"email":"ana@example.com","phone":"1"}}`), &pet))
+		assert.Equal(t, map[string]json.RawMessage{"color": json.RawMessage(`"red"`)}, pet.Extra)
+		assert.Equal(t, map[string]json.RawMessage{"phone": json.RawMessage(`"1"`)}, pet.Owner.Extra)
+		data, err := json.Marshal(pet)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"name":"Rex","color":"red","owner":{"email":"ana@example.com","phone":"1"}}`, string(data))
+
+		require.NoError(t, json.Unmarshal([]byte(`{"name":"Rex"}`), &pet))
+		assert.Nil(t, pet.Extra)
+
+		var dog unknowncapture.Dog
+		require.NoError(t, json.Unmarshal([]byte(`{"name":"Rex","breed":"collie","color":"red"}`), &dog))
+		assert.Equal(t, "Rex", dog.Name)
+		assert.Equal(t, map[string]json.RawMessage{"color": json.RawMessage(`"red"`)}, dog.Extra)
+		assert.Nil(t, dog.Pet.Extra)
+		data, err = json.Marshal(dog)
+		require.NoError(t, err)
+		assert.Equal(t, `{"name":"Rex","owner":{},"breed":"collie","color":"red"}`, string(data))
+
+		var note unknowncapture.Note
+		require.NoError(t, json.Unmarshal([]byte(`{"by":"ana","extra":"x","pinned":true}`), &note))
+		assert.Equal(t, "x", *note.Extra)
+		assert.Equal(t, map[string]json.RawMessage{"pinned": json.RawMessage(`true`)}, note.Extra_)
+
+		var animal unknowncapture.Animal
+		require.NoError(t, json.Unmarshal([]byte(`{"kind":"bird","wingspan":0.5,"feathers":"blue"}`), &animal))
+		bird, err := animal.AsBird()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]json.RawMessage{"feathers": json.RawMessage(`"blue"`)}, bird.Extra)
+	})
+}
+
+func TestStructEnumOrdering(t *testing.T) {
+	statuses := []enumstruct.Status{enumstruct.StatusActive, enumstruct.StatusUnknown, enumstruct.StatusPending}
+	slices.SortFunc(statuses, enumstruct.Status.Compare)
+	assert.Equal(t, []enumstruct.Status{enumstruct.StatusUnknown, enumstruct.StatusPending, enumstruct.StatusActive}, statuses)
+	assert.Equal(t, []enumstruct.Level{enumstruct.Level0, enumstruct.Level1, enumstruct.Level2}, enumstruct.LevelValues())
+	assert.Equal(t, 2, enumstruct.Level2.Index())
+	assert.Equal(t, 2, enumstruct.Level2.Value())
+
+	counts := map[enumstruct.Level]int{enumstruct.Level1: 3, enumstruct.Level2: 1}
+	data, err := json.Marshal(counts)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"1":3,"2":1}`, string(data))
+
+	var decoded map[enumstruct.Status]int
+	require.NoError(t, json.Unmarshal([]byte(`{"active":2,"archived":1}`), &decoded))
+	assert.Equal(t, map[enumstruct.Status]int{enumstruct.StatusActive: 2, enumstruct.StatusUnknown: 1}, decoded)
+}
+
+func TestBitmaskEnum(t *testing.T) {
+	var perms bitmask.Permissions
+	perms.Set(bitmask.PermissionsRead | bitmask.PermissionsAdmin)
+	perms.Set(bitmask.PermissionsWrite)
+	perms.Clear(bitmask.PermissionsAdmin)
+	assert.True(t, perms.Has(bitmask.PermissionsRead|bitmask.PermissionsWrite))
+	assert.False(t, perms.Has(bitmask.PermissionsAdmin))
+
+	data, err := json.Marshal(bitmask.Grant{User: "ana", Permissions: perms})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"user":"ana","permissions":["read","write"]}`, string(data))
+
+	var grant bitmask.Grant
+	require.NoError(t, json.Unmarshal([]byte(`{"user":"ana","permissions":["delete","read","later"],"channels":5}`), &grant))
+	assert.Equal(t, bitmask.PermissionsRead|bitmask.PermissionsDelete, grant.Permissions)
+	assert.Equal(t, []string{"1", "4"}, grant.Channels.Names())
+
+	// enum-unknown: reject fails on names the enum does not list
+	var strict bitmaskstruct.Grant
+	err = json.Unmarshal([]byte(`{"user":"ana","permissions":["read","later"]}`), &strict)
+	require.ErrorContains(t, err, `invalid Permissions flag: "later"`)
+}
+
+func TestMapKeyTypes(t *testing.T) {
+	id := uuid.MustParse("6f1c1a52-8d7e-4a39-9a57-0c7b1d2e3f40")
+	board := mapkeys.Leaderboard{
+		ScoresByRank: map[int64]int{1: 980, 2: 875},
+		Players:      mapkeys.PlayersByID{id: {Name: "ana"}},
+	}
+	data, err := json.Marshal(board)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"scoresByRank":{"1":980,"2":875},"players":{"6f1c1a52-8d7e-4a39-9a57-0c7b1d2e3f40":{"name":"ana"}}}`, string(data))
+
+	var decoded mapkeys.Leaderboard
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, board, decoded)
+
+	require.Error(t, json.Unmarshal([]byte(`{"scoresByRank":{"first":1}}`), &decoded))
+}
+
+func TestOrderedObjects(t *testing.T) {
+	payload := `{"nonce":"n1","issuer":"acme","headers":{"z-trace":"1","a-key":"2"},"claims":{"sub":"ana","zeta":1,"alpha":2},"zone":"eu","app":"shop"}`
+
+	var decoded ordered.SignedPayload
+	require.NoError(t, json.Unmarshal([]byte(payload), &decoded))
+	assert.Equal(t, []string{"z-trace", "a-key"}, decoded.Headers.Keys())
+	assert.Equal(t, []string{"zeta", "alpha"}, decoded.Claims.AdditionalProperties.Keys())
+	assert.Equal(t, []string{"zone", "app"}, decoded.AdditionalProperties.Keys())
+	zeta, ok := decoded.Claims.AdditionalProperties.Get("zeta")
+	assert.True(t, ok)
+	assert.Equal(t, 1, zeta)
+
+	data, err := json.Marshal(decoded)
+	require.NoError(t, err)
+	assert.Equal(t, payload, string(data))
+
+	var built ordered.SignedPayload
+	built.Nonce, built.Issuer = "n2", "acme"
+	built.AdditionalProperties.Set("b", "1")
+	built.AdditionalProperties.Set("a", "2")
+	built.AdditionalProperties.Set("b", "3")
+	data, err = json.Marshal(built)
+	require.NoError(t, err)
+	assert.Equal(t, `{"nonce":"n2","issuer":"acme","claims":{"sub":""},"b":"3","a":"2"}`, string(data))
+
+	built.AdditionalProperties.Delete("b")
+	assert.Equal(t, []string{"a"}, built.AdditionalProperties.Keys())
+}
+
+func TestRawJSONPassthrough(t *testing.T) {
+	payload := `{"vendor":"acme","payload":{"z":[1,{"deep":true}],"a":null},"metadata":{"source":"api","extra":1},"attachments":[{"k":"v"},"text"]}`
+
+	var event rawjson.WebhookEvent
+	require.NoError(t, json.Unmarshal([]byte(payload), &event))
+	assert.Equal(t, `{"z":[1,{"deep":true}],"a":null}`, string(event.Payload))
+	assert.Equal(t, `{"source":"api","extra":1}`, string(event.Metadata))
+	require.Len(t, event.Attachments, 2)
+	assert.Equal(t, `"text"`, string(event.Attachments[1]))
+
+	data, err := json.Marshal(event)
+	require.NoError(t, err)
+	assert.Equal(t, payload, string(data))
+
+	data, err = json.Marshal(rawjson.WebhookEvent{Vendor: "acme", Payload: rawjson.VendorPayload(`{"id":1}`)})
+	require.NoError(t, err)
+	assert.Equal(t, `{"vendor":"acme","payload":{"id":1}}`, string(data))
+}
+
+func TestEnvelopeGenerics(t *testing.T) {
+	total := 2
+	pets := envelope.PetList{
+		Data: []envelope.Pet{{Name: "rex"}, {Name: "tom"}},
+		Meta: envelope.Meta{Total: &total},
+	}
+	data, err := json.Marshal(pets)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"data":[{"name":"rex"},{"name":"tom"}],"meta":{"total":2},"links":{}}`, string(data))
+
+	var owner envelope.OwnerResponse
+	require.NoError(t, json.Unmarshal([]byte(`{"data":{"id":"o1","pets":[{"name":"rex"}]},"meta":{"next":"c2"}}`), &owner))
+	assert.Equal(t, "o1", owner.Data.ID)
+	assert.Equal(t, []envelope.Pet{{Name: "rex"}}, owner.Data.Pets)
+	require.NotNil(t, owner.Meta.Next)
+	assert.Equal(t, "c2", *owner.Meta.Next)
+
+	// the aliases share one generic type
+	var generic envelope.Envelope[[]envelope.Pet] = pets
+	assert.Len(t, generic.Data, 2)
+}
+
+func TestDiscriminatorVariantInterface(t *testing.T) {
+	area := func(s basic.ShapeVariant) float64 {
+		switch v := s.(type) {
+		case basic.Circle:
+			return v.Radius * v.Radius * 3
+		case *basic.Rectangle:
+			return v.Width * v.Height
+		}
+		return 0
+	}
+	assert.Equal(t, 12.0, area(basic.Circle{Radius: 2}))
+
+	var shape basic.Shape
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"rectangle","width":2,"height":3}`), &shape))
+	variant, err := shape.Variant()
+	require.NoError(t, err)
+	assert.Equal(t, &basic.Rectangle{Type: "rectangle", Width: 2, Height: 3}, variant)
+	assert.Equal(t, 6.0, area(variant))
+
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"hexagon"}`), &shape))
+	_, err = shape.Variant()
+	require.ErrorContains(t, err, `unknown Shape type "hexagon"`)
+
+	// every mapping key naming a variant selects it
+	for _, kind := range []string{"cat", "kitty"} {
+		var pet discriminators.Pet
+		require.NoError(t, json.Unmarshal([]byte(`{"kind":"`+kind+`","indoor":true}`), &pet))
+		variant, err := pet.Variant()
+		require.NoError(t, err)
+		assert.IsType(t, &discriminators.Cat{}, variant)
+		_, err = pet.AsCat()
+		require.NoError(t, err)
+	}
+}
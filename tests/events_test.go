@@ -0,0 +1,125 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kolah/eugene/internal/loader"
+	"github.com/kolah/eugene/internal/model"
+	events "github.com/kolah/eugene/tests/generated/events_v3"
+)
+
+func TestLoadAsyncAPI(t *testing.T) {
+	t.Run("AsyncAPI 3", func(t *testing.T) {
+		spec, err := loader.LoadAsyncAPIFile("testdata/specs/events/asyncapi-v3.yaml")
+		require.NoError(t, err)
+
+		require.Len(t, spec.Events, 3)
+		send := spec.Events[0]
+		assert.Equal(t, "sendUserSignedUp", send.ID)
+		assert.Equal(t, model.EventSend, send.Action)
+		assert.Equal(t, "userSignedUp", send.Channel)
+		assert.Equal(t, "user/signedup", send.Address)
+		require.Len(t, send.Messages, 1)
+		assert.Equal(t, "UserSignedUp", send.Messages[0].Name)
+		assert.Equal(t, "#/components/schemas/UserSignedUp", send.Messages[0].Payload.Ref)
+		assert.True(t, send.Messages[0].Inline)
+
+		assert.Equal(t, "#/components/schemas/Order", spec.Events[1].Messages[0].Payload.Ref)
+		assert.False(t, spec.Events[1].Messages[0].Inline)
+		require.Len(t, spec.Events[2].Messages, 2, "operations without messages use the whole channel")
+
+		var names []string
+		for _, s := range spec.Schemas {
+			names = append(names, s.Name)
+		}
+		assert.Equal(t, []string{"User", "Order", "UserSignedUp"}, names)
+	})
+
+	t.Run("AsyncAPI 2", func(t *testing.T) {
+		spec, err := loader.LoadAsyncAPIFile("testdata/specs/events/asyncapi-v2.yaml")
+		require.NoError(t, err)
+
+		require.Len(t, spec.Events, 2)
+		assert.Equal(t, model.EventSend, spec.Events[0].Action, "subscribe operations are sent by the application")
+		assert.Equal(t, "EmailRequested", spec.Events[0].Messages[0].Name)
+		assert.Equal(t, model.EventReceive, spec.Events[1].Action)
+		require.Len(t, spec.Events[1].Messages, 2)
+		assert.Equal(t, "Bounced", spec.Events[1].Messages[1].Name)
+
+		require.Equal(t, []model.Warning{{
+			Kind:     model.WarningMediaType,
+			Location: "#/channels/notifications~1legacy/publish/message",
+			Message:  "message LegacyEvent is not generated: schemaFormat application/vnd.apache.avro;version=1.9.0 is not JSON Schema",
+		}}, spec.Warnings)
+	})
+
+	t.Run("Merge shares schemas by name", func(t *testing.T) {
+		result, err := loader.LoadFile("testdata/specs/events/api.yaml")
+		require.NoError(t, err)
+		spec, err := loader.Transform(result)
+		require.NoError(t, err)
+		asyncSpec, err := loader.LoadAsyncAPIFile("testdata/specs/events/asyncapi-v3.yaml")
+		require.NoError(t, err)
+
+		require.NoError(t, loader.MergeEvents(spec, asyncSpec))
+		var names []string
+		for _, s := range spec.Schemas {
+			names = append(names, s.Name)
+		}
+		assert.Equal(t, []string{"User", "Order", "UserSignedUp"}, names)
+		assert.Len(t, spec.Events, 3)
+	})
+
+	t.Run("Merge rejects inline payloads named like OpenAPI schemas", func(t *testing.T) {
+		result, err := loader.LoadFile("testdata/specs/events/api.yaml")
+		require.NoError(t, err)
+		spec, err := loader.Transform(result)
+		require.NoError(t, err)
+		spec.Schemas = append(spec.Schemas, model.Schema{Name: "UserSignedUp", Type: model.TypeObject})
+		asyncSpec, err := loader.LoadAsyncAPIFile("testdata/specs/events/asyncapi-v3.yaml")
+		require.NoError(t, err)
+
+		err = loader.MergeEvents(spec, asyncSpec)
+		require.EqualError(t, err, "AsyncAPI message UserSignedUp: its payload schema UserSignedUp clashes with the OpenAPI schema UserSignedUp; rename the message or move its payload to components.schemas")
+	})
+}
+
+type recordingSubscriber struct {
+	orders []events.Order
+}
+
+func (s *recordingSubscriber) HandleOrderPlacedFromOrderPlaced(ctx context.Context, msg events.Order) error {
+	s.orders = append(s.orders, msg)
+	return nil
+}
+
+func (s *recordingSubscriber) HandleUserSignedUp(ctx context.Context, msg events.UserSignedUp) error {
+	return nil
+}
+
+func (s *recordingSubscriber) HandleOrderPlacedFromAudit(ctx context.Context, msg events.Order) error {
+	return nil
+}
+
+func TestEventsDispatch(t *testing.T) {
+	ctx := context.Background()
+	sub := &recordingSubscriber{}
+	var _ events.EventSubscriber = sub
+
+	err := events.DispatchEvent(ctx, sub, events.ChannelOrderPlaced, []byte(`{"id":"o-1","total":9.5}`))
+	require.NoError(t, err)
+	require.Len(t, sub.orders, 1)
+	assert.Equal(t, "o-1", sub.orders[0].ID)
+	assert.Equal(t, 9.5, sub.orders[0].Total)
+
+	err = events.DispatchEvent(ctx, sub, events.ChannelAudit, []byte(`{}`))
+	require.ErrorIs(t, err, events.ErrUnknownChannel, "audit carries two received messages")
+
+	err = events.DispatchEvent(ctx, sub, events.ChannelOrderPlaced, []byte(`{`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "decoding OrderPlaced")
+}
@@ -0,0 +1,22 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+type JobRequest struct {
+	Input string `json:"input"`
+}
+
+type Job struct {
+	ID     string  `json:"id"`
+	State  State   `json:"state"`
+	Output *string `json:"output,omitempty"`
+}
+
+type State string
+
+const (
+	StateRunning   State = "running"
+	StateSucceeded State = "succeeded"
+	StateFailed    State = "failed"
+	StateCancelled State = "cancelled"
+)
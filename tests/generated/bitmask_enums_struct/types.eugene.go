@@ -0,0 +1,154 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type Permissions int
+
+type Grant struct {
+	User        string      `json:"user"`
+	Permissions Permissions `json:"permissions"`
+	Channels    *Channels   `json:"channels,omitempty"`
+}
+
+type Channels int
+
+const (
+	Channels1 Channels = 1
+	Channels2 Channels = 2
+	Channels4 Channels = 4
+)
+
+// Has reports whether all bits of flag are set in e.
+func (e Channels) Has(flag Channels) bool { return e&flag == flag }
+
+// Set sets the bits of flag.
+func (e *Channels) Set(flag Channels) { *e |= flag }
+
+// Clear clears the bits of flag.
+func (e *Channels) Clear(flag Channels) { *e &^= flag }
+
+var channelsFlags = []struct {
+	flag Channels
+	name string
+}{
+	{Channels1, "1"},
+	{Channels2, "2"},
+	{Channels4, "4"},
+}
+
+// Names returns the names of the flags set in e, in enum order. Bits that
+// no flag covers are left out.
+func (e Channels) Names() []string {
+	names := []string{}
+	for _, f := range channelsFlags {
+		if f.flag != 0 && e.Has(f.flag) {
+			names = append(names, f.name)
+		}
+	}
+	return names
+}
+
+// MarshalJSON encodes e as an array of flag names.
+func (e Channels) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.Names())
+}
+
+// UnmarshalJSON decodes an array of flag names, or a plain integer.
+func (e *Channels) UnmarshalJSON(data []byte) error {
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		var v int
+		if json.Unmarshal(data, &v) != nil {
+			return err
+		}
+		*e = Channels(v)
+		return nil
+	}
+	var v Channels
+names:
+	for _, name := range names {
+		for _, f := range channelsFlags {
+			if f.name == name {
+				v |= f.flag
+				continue names
+			}
+		}
+		return fmt.Errorf("invalid Channels flag: %q", name)
+	}
+	*e = v
+	return nil
+}
+
+const (
+	PermissionsRead   Permissions = 1
+	PermissionsWrite  Permissions = 2
+	PermissionsDelete Permissions = 4
+	PermissionsAdmin  Permissions = 8
+)
+
+// Has reports whether all bits of flag are set in e.
+func (e Permissions) Has(flag Permissions) bool { return e&flag == flag }
+
+// Set sets the bits of flag.
+func (e *Permissions) Set(flag Permissions) { *e |= flag }
+
+// Clear clears the bits of flag.
+func (e *Permissions) Clear(flag Permissions) { *e &^= flag }
+
+var permissionsFlags = []struct {
+	flag Permissions
+	name string
+}{
+	{PermissionsRead, "read"},
+	{PermissionsWrite, "write"},
+	{PermissionsDelete, "delete"},
+	{PermissionsAdmin, "admin"},
+}
+
+// Names returns the names of the flags set in e, in enum order. Bits that
+// no flag covers are left out.
+func (e Permissions) Names() []string {
+	names := []string{}
+	for _, f := range permissionsFlags {
+		if f.flag != 0 && e.Has(f.flag) {
+			names = append(names, f.name)
+		}
+	}
+	return names
+}
+
+// MarshalJSON encodes e as an array of flag names.
+func (e Permissions) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.Names())
+}
+
+// UnmarshalJSON decodes an array of flag names, or a plain integer.
+func (e *Permissions) UnmarshalJSON(data []byte) error {
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		var v int
+		if json.Unmarshal(data, &v) != nil {
+			return err
+		}
+		*e = Permissions(v)
+		return nil
+	}
+	var v Permissions
+names:
+	for _, name := range names {
+		for _, f := range permissionsFlags {
+			if f.name == name {
+				v |= f.flag
+				continue names
+			}
+		}
+		return fmt.Errorf("invalid Permissions flag: %q", name)
+	}
+	*e = v
+	return nil
+}
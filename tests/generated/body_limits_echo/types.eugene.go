@@ -0,0 +1,50 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"encoding/json"
+)
+
+type Note struct {
+	Text string `json:"text"`
+}
+
+type Circle struct {
+	Radius float64 `json:"radius"`
+}
+
+type Square struct {
+	Side float64 `json:"side"`
+}
+
+type Shape struct {
+	Type string          `json:"-"`
+	Raw  json.RawMessage `json:"-"`
+}
+
+func (u *Shape) UnmarshalJSON(data []byte) error {
+	u.Type = ""
+	u.Raw = data
+	return nil
+}
+
+func (u Shape) MarshalJSON() ([]byte, error) {
+	return u.Raw, nil
+}
+
+func (u *Shape) AsCircle() (*Circle, error) {
+	var v Circle
+	if err := json.Unmarshal(u.Raw, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func (u *Shape) AsSquare() (*Square, error) {
+	var v Square
+	if err := json.Unmarshal(u.Raw, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
@@ -1,4 +1,5 @@
 // Code generated by eugene. DO NOT EDIT.
+
 package gen
 
 import (
@@ -16,11 +17,24 @@ type ServerInterface interface {
 	CreateOrder(ctx echo.Context) error
 }
 
+// UnimplementedServer answers every operation with 501 Not Implemented.
+// Embed it to serve only some operations. Implementations that do not embed
+// it fail to compile, naming the method, when the spec gains an operation.
+type UnimplementedServer struct{}
+
+func (UnimplementedServer) CreateOrder(ctx echo.Context) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+var _ ServerInterface = (*UnimplementedServer)(nil)
+
 type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
 func (w *ServerInterfaceWrapper) CreateOrder(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "createOrder", "/orders")))
+	limitBody(ctx.Response(), ctx.Request(), MaxBodySize)
 	return w.Handler.CreateOrder(ctx)
 }
 
@@ -1,47 +1,296 @@
 // Code generated by eugene. DO NOT EDIT.
+
 package gen
 
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime"
 	"net/http"
 	"net/url"
 	"strings"
 )
 
+// DefaultUserAgent is sent with every request unless WithUserAgent
+// overrides it.
+const DefaultUserAgent = "routing-test/1.0.0 eugene"
+
 type Client struct {
-	baseURL    string
-	httpClient *http.Client
+	baseURL        string
+	httpClient     *http.Client
+	decoders       map[string]Decoder
+	headers        http.Header
+	userAgent      string
+	requestEditors []RequestEditorFn
+	signer         Signer
+	breaker        Breaker
+	tlsConfig      *tls.Config
+	err            error // an option NewClient could not apply, returned by every request
 }
 
 type ClientOption func(*Client)
 
+// RequestEditorFn modifies a request before it is sent. Returning an error
+// aborts the request.
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
 func WithHTTPClient(client *http.Client) ClientOption {
 	return func(c *Client) {
 		c.httpClient = client
 	}
 }
 
+// WithHeader adds a header sent with every request.
+func WithHeader(key, value string) ClientOption {
+	return func(c *Client) {
+		c.headers.Add(key, value)
+	}
+}
+
+// WithUserAgent replaces DefaultUserAgent.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithRequestEditor registers fn to run on every request after headers are
+// set, in registration order. Use it for auth tokens, tracing and similar.
+func WithRequestEditor(fn RequestEditorFn) ClientOption {
+	return func(c *Client) {
+		c.requestEditors = append(c.requestEditors, fn)
+	}
+}
+
+// Signer signs a fully built request, for schemes such as AWS SigV4 or HMAC
+// that cover the headers and a digest of the body. body holds the exact
+// bytes that will be sent, or nil when the request has none.
+type Signer interface {
+	Sign(ctx context.Context, req *http.Request, body []byte) error
+}
+
+// SignerFunc adapts a function to the Signer interface.
+type SignerFunc func(ctx context.Context, req *http.Request, body []byte) error
+
+func (f SignerFunc) Sign(ctx context.Context, req *http.Request, body []byte) error {
+	return f(ctx, req, body)
+}
+
+// WithSigner signs every request after its body, headers and request
+// editors have been applied.
+func WithSigner(signer Signer) ClientOption {
+	return func(c *Client) {
+		c.signer = signer
+	}
+}
+
+// OperationKey identifies a client operation by its operationId. It is the
+// key passed to a Breaker.
+type OperationKey string
+
+const (
+	OperationKeyListItems  OperationKey = "listItems"
+	OperationKeyCreateItem OperationKey = "createItem"
+	OperationKeyGetItem    OperationKey = "getItem"
+	OperationKeyUpdateItem OperationKey = "updateItem"
+	OperationKeyDeleteItem OperationKey = "deleteItem"
+)
+
+// Breaker guards the HTTP round trip of each operation, typically with a
+// circuit breaker per OperationKey. Execute should call send unless the circuit
+// is open; a response with a 5xx status is returned with a nil error, so
+// implementations that count server errors as failures must check it.
+type Breaker interface {
+	Execute(ctx context.Context, op OperationKey, send func() (*http.Response, error)) (*http.Response, error)
+}
+
+// WithBreaker routes every operation's round trip through breaker.
+func WithBreaker(breaker Breaker) ClientOption {
+	return func(c *Client) {
+		c.breaker = breaker
+	}
+}
+
+// WithTLSConfig sets the TLS configuration of the HTTP client's transport.
+// The transport is cloned, so the client passed to WithHTTPClient and
+// http.DefaultTransport are left unchanged. It needs an *http.Transport;
+// with another transport every request fails, since its TLS settings are
+// out of reach.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(c *Client) {
+		c.tlsConfig = config
+	}
+}
+
+// Decoder decodes a response body into v.
+type Decoder func(data []byte, v any) error
+
+// WithDecoder registers decode for response bodies of the given media type,
+// such as "application/vnd.api+json" or "application/xml". Media type
+// parameters like charset are ignored when matching. Bodies without a
+// registered decoder are decoded as JSON.
+func WithDecoder(mediaType string, decode Decoder) ClientOption {
+	return func(c *Client) {
+		if c.decoders == nil {
+			c.decoders = make(map[string]Decoder)
+		}
+		c.decoders[strings.ToLower(mediaType)] = decode
+	}
+}
+
+// editRequest applies the default headers, the user agent and the request
+// editors to req, then signs it.
+func (c *Client) editRequest(ctx context.Context, req *http.Request) error {
+	if c.err != nil {
+		return c.err
+	}
+	for key, values := range c.headers {
+		for _, v := range values {
+			req.Header.Add(key, v)
+		}
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	for _, edit := range c.requestEditors {
+		if err := edit(ctx, req); err != nil {
+			return fmt.Errorf("editing request: %w", err)
+		}
+	}
+	if c.signer == nil {
+		return nil
+	}
+	body, err := requestBody(req)
+	if err != nil {
+		return fmt.Errorf("reading request body for signing: %w", err)
+	}
+	if err := c.signer.Sign(ctx, req, body); err != nil {
+		return fmt.Errorf("signing request: %w", err)
+	}
+	return nil
+}
+
+// do sends req on behalf of op, through the breaker when one is set.
+func (c *Client) do(ctx context.Context, op OperationKey, req *http.Request) (*http.Response, error) {
+	if c.breaker == nil {
+		return c.httpClient.Do(req)
+	}
+	return c.breaker.Execute(ctx, op, func() (*http.Response, error) {
+		return c.httpClient.Do(req)
+	})
+}
+
+// requestBody returns the bytes req will send, leaving req.Body unread.
+func requestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		rc, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return io.ReadAll(rc)
+	}
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
+
+// decode unmarshals a response body with the decoder registered for its
+// Content-Type, falling back to JSON.
+func (c *Client) decode(resp *http.Response, data []byte, v any) error {
+	if mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type")); err == nil {
+		if decode, ok := c.decoders[mediaType]; ok {
+			return decode(data, v)
+		}
+	}
+	return json.Unmarshal(data, v)
+}
+
 func NewClient(baseURL string, opts ...ClientOption) *Client {
 	c := &Client{
 		baseURL:    strings.TrimSuffix(baseURL, "/"),
 		httpClient: http.DefaultClient,
+		headers:    make(http.Header),
+		userAgent:  DefaultUserAgent,
 	}
 	for _, opt := range opts {
 		opt(c)
 	}
+	if c.tlsConfig != nil {
+		c.httpClient, c.err = withTLSConfig(c.httpClient, c.tlsConfig)
+	}
 	return c
 }
 
+// withTLSConfig returns a copy of client whose transport uses config. It
+// returns client and an error when the transport is not an *http.Transport.
+func withTLSConfig(client *http.Client, config *tls.Config) (*http.Client, error) {
+	rt := client.Transport
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	transport, ok := rt.(*http.Transport)
+	if !ok || transport == nil {
+		return client, fmt.Errorf("WithTLSConfig: transport %T is not an *http.Transport; set its TLS configuration directly", rt)
+	}
+	transport = transport.Clone()
+	transport.TLSClientConfig = config
+	clone := *client
+	clone.Transport = transport
+	return &clone, nil
+}
+
 type Response[T any] struct {
 	StatusCode int
 	Body       T
 	Raw        *http.Response
 }
 
+// queryBuilder encodes query parameters the way the generated servers parse
+// them: pairs in the order the spec lists the parameters, an array as its
+// name repeated for each item, names and values escaped like
+// url.QueryEscape.
+type queryBuilder []string
+
+// add appends name=value. An empty value is left out unless the parameter
+// sets allowEmptyValue, since servers read it as a missing one. With
+// allowReserved, reserved characters that do not delimit pairs are sent as
+// they are.
+func (q *queryBuilder) add(name, value string, allowReserved, allowEmptyValue bool) {
+	if value == "" && !allowEmptyValue {
+		return
+	}
+	escaped := url.QueryEscape(value)
+	if allowReserved {
+		escaped = reservedQuery.Replace(escaped)
+	}
+	*q = append(*q, url.QueryEscape(name)+"="+escaped)
+}
+
+func (q queryBuilder) encode() string {
+	return strings.Join(q, "&")
+}
+
+// reservedQuery restores the RFC 3986 reserved characters allowReserved
+// parameters send unescaped. & = + # and ; stay escaped: they would split
+// the pair, decode as a space or end the query, and Go servers drop pairs
+// holding a ;.
+var reservedQuery = strings.NewReplacer(
+	"%21", "!", "%24", "$", "%27", "'", "%28", "(", "%29", ")", "%2A", "*", "%2C", ",",
+	"%2F", "/", "%3A", ":", "%3F", "?", "%40", "@", "%5B", "[", "%5D", "]",
+)
+
 func doRequest[T any](ctx context.Context, c *Client, method, path string, body any) (*Response[T], error) {
 	var bodyReader io.Reader
 	if body != nil {
@@ -62,6 +311,10 @@ func doRequest[T any](ctx context.Context, c *Client, method, path string, body
 	}
 	req.Header.Set("Accept", "application/json")
 
+	if err := c.editRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("executing request: %w", err)
@@ -78,8 +331,12 @@ func doRequest[T any](ctx context.Context, c *Client, method, path string, body
 		return result, fmt.Errorf("request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
 	}
 
-	if resp.ContentLength != 0 {
-		if err := json.NewDecoder(resp.Body).Decode(&result.Body); err != nil && err != io.EOF {
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return result, fmt.Errorf("reading response: %w", err)
+	}
+	if len(bodyBytes) > 0 {
+		if err := c.decode(resp, bodyBytes, &result.Body); err != nil {
 			return result, fmt.Errorf("decoding response: %w", err)
 		}
 	}
@@ -125,12 +382,12 @@ type DeleteItemResponse struct {
 func (c *Client) ListItems(ctx context.Context, params *ListItemsParams) (*ListItemsResponse, error) {
 	path := "/items"
 	if params != nil {
-		q := url.Values{}
+		var q queryBuilder
 		if params.Limit != nil {
-			q.Set("limit", fmt.Sprint(*params.Limit))
+			q.add("limit", fmt.Sprint(*params.Limit), false, false)
 		}
 		if len(q) > 0 {
-			path += "?" + q.Encode()
+			path += "?" + q.encode()
 		}
 	}
 
@@ -142,7 +399,11 @@ func (c *Client) ListItems(ctx context.Context, params *ListItemsParams) (*ListI
 	}
 	httpReq.Header.Set("Accept", "application/json")
 
-	resp, err := c.httpClient.Do(httpReq)
+	if err := c.editRequest(ctx, httpReq); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(ctx, OperationKeyListItems, httpReq)
 	if err != nil {
 		return nil, fmt.Errorf("executing request: %w", err)
 	}
@@ -162,7 +423,7 @@ func (c *Client) ListItems(ctx context.Context, params *ListItemsParams) (*ListI
 	case 200:
 		var body []Item
 		if len(bodyBytes) > 0 {
-			if err := json.Unmarshal(bodyBytes, &body); err != nil {
+			if err := c.decode(resp, bodyBytes, &body); err != nil {
 				return result, fmt.Errorf("decoding response: %w", err)
 			}
 		}
@@ -197,7 +458,11 @@ func (c *Client) CreateItem(ctx context.Context, body NewItem) (*CreateItemRespo
 	}
 	httpReq.Header.Set("Accept", "application/json")
 
-	resp, err := c.httpClient.Do(httpReq)
+	if err := c.editRequest(ctx, httpReq); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(ctx, OperationKeyCreateItem, httpReq)
 	if err != nil {
 		return nil, fmt.Errorf("executing request: %w", err)
 	}
@@ -217,7 +482,7 @@ func (c *Client) CreateItem(ctx context.Context, body NewItem) (*CreateItemRespo
 	case 201:
 		var body Item
 		if len(bodyBytes) > 0 {
-			if err := json.Unmarshal(bodyBytes, &body); err != nil {
+			if err := c.decode(resp, bodyBytes, &body); err != nil {
 				return result, fmt.Errorf("decoding response: %w", err)
 			}
 		}
@@ -242,7 +507,11 @@ func (c *Client) GetItem(ctx context.Context) (*GetItemResponse, error) {
 	}
 	httpReq.Header.Set("Accept", "application/json")
 
-	resp, err := c.httpClient.Do(httpReq)
+	if err := c.editRequest(ctx, httpReq); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(ctx, OperationKeyGetItem, httpReq)
 	if err != nil {
 		return nil, fmt.Errorf("executing request: %w", err)
 	}
@@ -262,7 +531,7 @@ func (c *Client) GetItem(ctx context.Context) (*GetItemResponse, error) {
 	case 200:
 		var body Item
 		if len(bodyBytes) > 0 {
-			if err := json.Unmarshal(bodyBytes, &body); err != nil {
+			if err := c.decode(resp, bodyBytes, &body); err != nil {
 				return result, fmt.Errorf("decoding response: %w", err)
 			}
 		}
@@ -297,7 +566,11 @@ func (c *Client) UpdateItem(ctx context.Context, body NewItem) (*UpdateItemRespo
 	}
 	httpReq.Header.Set("Accept", "application/json")
 
-	resp, err := c.httpClient.Do(httpReq)
+	if err := c.editRequest(ctx, httpReq); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(ctx, OperationKeyUpdateItem, httpReq)
 	if err != nil {
 		return nil, fmt.Errorf("executing request: %w", err)
 	}
@@ -335,7 +608,11 @@ func (c *Client) DeleteItem(ctx context.Context) (*DeleteItemResponse, error) {
 	}
 	httpReq.Header.Set("Accept", "application/json")
 
-	resp, err := c.httpClient.Do(httpReq)
+	if err := c.editRequest(ctx, httpReq); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(ctx, OperationKeyDeleteItem, httpReq)
 	if err != nil {
 		return nil, fmt.Errorf("executing request: %w", err)
 	}
@@ -0,0 +1,968 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultUserAgent is sent with every request unless WithUserAgent
+// overrides it.
+const DefaultUserAgent = "e2e-round-trip-test/1.0.0 eugene"
+
+type Client struct {
+	baseURL        string
+	httpClient     *http.Client
+	decoders       map[string]Decoder
+	headers        http.Header
+	userAgent      string
+	requestEditors []RequestEditorFn
+	signer         Signer
+	breaker        Breaker
+	tlsConfig      *tls.Config
+	err            error // an option NewClient could not apply, returned by every request
+}
+
+type ClientOption func(*Client)
+
+// RequestEditorFn modifies a request before it is sent. Returning an error
+// aborts the request.
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+func WithHTTPClient(client *http.Client) ClientOption {
+	return func(c *Client) {
+		c.httpClient = client
+	}
+}
+
+// WithHeader adds a header sent with every request.
+func WithHeader(key, value string) ClientOption {
+	return func(c *Client) {
+		c.headers.Add(key, value)
+	}
+}
+
+// WithUserAgent replaces DefaultUserAgent.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithRequestEditor registers fn to run on every request after headers are
+// set, in registration order. Use it for auth tokens, tracing and similar.
+func WithRequestEditor(fn RequestEditorFn) ClientOption {
+	return func(c *Client) {
+		c.requestEditors = append(c.requestEditors, fn)
+	}
+}
+
+// Signer signs a fully built request, for schemes such as AWS SigV4 or HMAC
+// that cover the headers and a digest of the body. body holds the exact
+// bytes that will be sent, or nil when the request has none.
+type Signer interface {
+	Sign(ctx context.Context, req *http.Request, body []byte) error
+}
+
+// SignerFunc adapts a function to the Signer interface.
+type SignerFunc func(ctx context.Context, req *http.Request, body []byte) error
+
+func (f SignerFunc) Sign(ctx context.Context, req *http.Request, body []byte) error {
+	return f(ctx, req, body)
+}
+
+// WithSigner signs every request after its body, headers and request
+// editors have been applied.
+func WithSigner(signer Signer) ClientOption {
+	return func(c *Client) {
+		c.signer = signer
+	}
+}
+
+// OperationKey identifies a client operation by its operationId. It is the
+// key passed to a Breaker.
+type OperationKey string
+
+const (
+	OperationKeyEchoJSON       OperationKey = "echoJSON"
+	OperationKeyEchoForm       OperationKey = "echoForm"
+	OperationKeyEchoMultipart  OperationKey = "echoMultipart"
+	OperationKeyGetItem        OperationKey = "getItem"
+	OperationKeyCreateResource OperationKey = "createResource"
+	OperationKeyDeleteResource OperationKey = "deleteResource"
+	OperationKeyGetSession     OperationKey = "getSession"
+	OperationKeyGetSecureData  OperationKey = "getSecureData"
+	OperationKeyCreateShape    OperationKey = "createShape"
+)
+
+// Breaker guards the HTTP round trip of each operation, typically with a
+// circuit breaker per OperationKey. Execute should call send unless the circuit
+// is open; a response with a 5xx status is returned with a nil error, so
+// implementations that count server errors as failures must check it.
+type Breaker interface {
+	Execute(ctx context.Context, op OperationKey, send func() (*http.Response, error)) (*http.Response, error)
+}
+
+// WithBreaker routes every operation's round trip through breaker.
+func WithBreaker(breaker Breaker) ClientOption {
+	return func(c *Client) {
+		c.breaker = breaker
+	}
+}
+
+// WithTLSConfig sets the TLS configuration of the HTTP client's transport.
+// The transport is cloned, so the client passed to WithHTTPClient and
+// http.DefaultTransport are left unchanged. It needs an *http.Transport;
+// with another transport every request fails, since its TLS settings are
+// out of reach.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(c *Client) {
+		c.tlsConfig = config
+	}
+}
+
+// Decoder decodes a response body into v.
+type Decoder func(data []byte, v any) error
+
+// WithDecoder registers decode for response bodies of the given media type,
+// such as "application/vnd.api+json" or "application/xml". Media type
+// parameters like charset are ignored when matching. Bodies without a
+// registered decoder are decoded as JSON.
+func WithDecoder(mediaType string, decode Decoder) ClientOption {
+	return func(c *Client) {
+		if c.decoders == nil {
+			c.decoders = make(map[string]Decoder)
+		}
+		c.decoders[strings.ToLower(mediaType)] = decode
+	}
+}
+
+// editRequest applies the default headers, the user agent and the request
+// editors to req, then signs it.
+func (c *Client) editRequest(ctx context.Context, req *http.Request) error {
+	if c.err != nil {
+		return c.err
+	}
+	for key, values := range c.headers {
+		for _, v := range values {
+			req.Header.Add(key, v)
+		}
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	for _, edit := range c.requestEditors {
+		if err := edit(ctx, req); err != nil {
+			return fmt.Errorf("editing request: %w", err)
+		}
+	}
+	if c.signer == nil {
+		return nil
+	}
+	body, err := requestBody(req)
+	if err != nil {
+		return fmt.Errorf("reading request body for signing: %w", err)
+	}
+	if err := c.signer.Sign(ctx, req, body); err != nil {
+		return fmt.Errorf("signing request: %w", err)
+	}
+	return nil
+}
+
+// do sends req on behalf of op, through the breaker when one is set.
+func (c *Client) do(ctx context.Context, op OperationKey, req *http.Request) (*http.Response, error) {
+	if c.breaker == nil {
+		return c.httpClient.Do(req)
+	}
+	return c.breaker.Execute(ctx, op, func() (*http.Response, error) {
+		return c.httpClient.Do(req)
+	})
+}
+
+// requestBody returns the bytes req will send, leaving req.Body unread.
+func requestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		rc, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return io.ReadAll(rc)
+	}
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
+
+// decode unmarshals a response body with the decoder registered for its
+// Content-Type, falling back to JSON.
+func (c *Client) decode(resp *http.Response, data []byte, v any) error {
+	if mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type")); err == nil {
+		if decode, ok := c.decoders[mediaType]; ok {
+			return decode(data, v)
+		}
+	}
+	return json.Unmarshal(data, v)
+}
+
+func NewClient(baseURL string, opts ...ClientOption) *Client {
+	c := &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: http.DefaultClient,
+		headers:    make(http.Header),
+		userAgent:  DefaultUserAgent,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.tlsConfig != nil {
+		c.httpClient, c.err = withTLSConfig(c.httpClient, c.tlsConfig)
+	}
+	return c
+}
+
+// withTLSConfig returns a copy of client whose transport uses config. It
+// returns client and an error when the transport is not an *http.Transport.
+func withTLSConfig(client *http.Client, config *tls.Config) (*http.Client, error) {
+	rt := client.Transport
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	transport, ok := rt.(*http.Transport)
+	if !ok || transport == nil {
+		return client, fmt.Errorf("WithTLSConfig: transport %T is not an *http.Transport; set its TLS configuration directly", rt)
+	}
+	transport = transport.Clone()
+	transport.TLSClientConfig = config
+	clone := *client
+	clone.Transport = transport
+	return &clone, nil
+}
+
+type Response[T any] struct {
+	StatusCode int
+	Body       T
+	Raw        *http.Response
+}
+
+type FileUpload struct {
+	Reader   io.Reader
+	Filename string
+}
+
+// queryBuilder encodes query parameters the way the generated servers parse
+// them: pairs in the order the spec lists the parameters, an array as its
+// name repeated for each item, names and values escaped like
+// url.QueryEscape.
+type queryBuilder []string
+
+// add appends name=value. An empty value is left out unless the parameter
+// sets allowEmptyValue, since servers read it as a missing one. With
+// allowReserved, reserved characters that do not delimit pairs are sent as
+// they are.
+func (q *queryBuilder) add(name, value string, allowReserved, allowEmptyValue bool) {
+	if value == "" && !allowEmptyValue {
+		return
+	}
+	escaped := url.QueryEscape(value)
+	if allowReserved {
+		escaped = reservedQuery.Replace(escaped)
+	}
+	*q = append(*q, url.QueryEscape(name)+"="+escaped)
+}
+
+func (q queryBuilder) encode() string {
+	return strings.Join(q, "&")
+}
+
+// reservedQuery restores the RFC 3986 reserved characters allowReserved
+// parameters send unescaped. & = + # and ; stay escaped: they would split
+// the pair, decode as a space or end the query, and Go servers drop pairs
+// holding a ;.
+var reservedQuery = strings.NewReplacer(
+	"%21", "!", "%24", "$", "%27", "'", "%28", "(", "%29", ")", "%2A", "*", "%2C", ",",
+	"%2F", "/", "%3A", ":", "%3F", "?", "%40", "@", "%5B", "[", "%5D", "]",
+)
+
+func doRequest[T any](ctx context.Context, c *Client, method, path string, body any) (*Response[T], error) {
+	var bodyReader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("marshaling request body: %w", err)
+		}
+		bodyReader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+
+	if err := c.editRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("executing request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	result := &Response[T]{
+		StatusCode: resp.StatusCode,
+		Raw:        resp,
+	}
+
+	if resp.StatusCode >= 400 {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return result, fmt.Errorf("request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return result, fmt.Errorf("reading response: %w", err)
+	}
+	if len(bodyBytes) > 0 {
+		if err := c.decode(resp, bodyBytes, &result.Body); err != nil {
+			return result, fmt.Errorf("decoding response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// operationSpec describes how the table-driven core sends an operation and
+// matches its responses.
+type operationSpec struct {
+	method      string
+	path        string       // path template with {name} placeholders
+	pathParams  []string     // placeholder names, in argument order
+	queryParams []queryParam // in argument order
+	accept      string
+	contentType string   // Content-Type of the request body, if any
+	textBody    bool     // the body is sent as a string rather than JSON
+	responses   []string // declared status codes: "200", "2XX" or "default"
+}
+
+type queryParam struct {
+	name            string
+	allowReserved   bool
+	allowEmptyValue bool
+}
+
+var operations = map[OperationKey]operationSpec{
+	OperationKeyEchoJSON: {
+		method:      "POST",
+		path:        "/echo/json",
+		accept:      "application/json",
+		contentType: "application/json",
+		responses:   []string{"200"},
+	},
+	OperationKeyGetItem: {
+		method:      "GET",
+		path:        "/items/{id}",
+		pathParams:  []string{"id"},
+		queryParams: []queryParam{{name: "filter"}},
+		accept:      "application/json",
+		responses:   []string{"200", "404"},
+	},
+	OperationKeyCreateResource: {
+		method:      "POST",
+		path:        "/resources",
+		accept:      "application/json",
+		contentType: "application/json",
+		responses:   []string{"201", "4XX"},
+	},
+	OperationKeyDeleteResource: {
+		method:     "DELETE",
+		path:       "/resources/{id}",
+		pathParams: []string{"id"},
+		accept:     "application/json",
+		responses:  []string{"204", "default"},
+	},
+	OperationKeyGetSession: {
+		method:    "GET",
+		path:      "/session",
+		accept:    "application/json",
+		responses: []string{"200"},
+	},
+	OperationKeyGetSecureData: {
+		method:    "GET",
+		path:      "/secure/data",
+		accept:    "application/json",
+		responses: []string{"200", "401"},
+	},
+	OperationKeyCreateShape: {
+		method:      "POST",
+		path:        "/shapes",
+		accept:      "application/json",
+		contentType: "application/json",
+		responses:   []string{"200"},
+	},
+}
+
+// call sends op with its path and query arguments in the order its spec
+// lists them, and returns the response with its body read. Query arguments
+// that are nil pointers are left out, slices are sent as repeated
+// parameters. body is nil when op has none.
+func (c *Client) call(ctx context.Context, op OperationKey, pathArgs, queryArgs []any, body any) (*http.Response, []byte, error) {
+	spec := operations[op]
+	path := spec.path
+	for i, name := range spec.pathParams {
+		path = strings.Replace(path, "{"+name+"}", fmt.Sprint(pathArgs[i]), 1)
+	}
+	if len(queryArgs) > 0 {
+		var q queryBuilder
+		for i, p := range spec.queryParams {
+			for _, v := range queryValues(queryArgs[i]) {
+				q.add(p.name, v, p.allowReserved, p.allowEmptyValue)
+			}
+		}
+		if len(q) > 0 {
+			path += "?" + q.encode()
+		}
+	}
+
+	var bodyReader io.Reader
+	if body != nil {
+		if spec.textBody {
+			bodyReader = strings.NewReader(body.(string))
+		} else {
+			data, err := json.Marshal(body)
+			if err != nil {
+				return nil, nil, fmt.Errorf("marshaling request body: %w", err)
+			}
+			bodyReader = bytes.NewReader(data)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, spec.method, c.baseURL+path, bodyReader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating request: %w", err)
+	}
+	if body != nil && spec.contentType != "" {
+		req.Header.Set("Content-Type", spec.contentType)
+	}
+	req.Header.Set("Accept", spec.accept)
+
+	if err := c.editRequest(ctx, req); err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.do(ctx, op, req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("executing request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return resp, nil, fmt.Errorf("reading response: %w", err)
+	}
+	return resp, data, nil
+}
+
+// match returns the index of the declared response that status selects: the
+// exact code, then its class like 2XX, then default. It returns -1 when none
+// is declared.
+func (s operationSpec) match(status int) int {
+	for _, code := range []string{strconv.Itoa(status), strconv.Itoa(status/100) + "XX", "default"} {
+		for i, declared := range s.responses {
+			if declared == code {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// queryValues formats a query argument, dereferencing a pointer and listing
+// the items of a slice. A nil pointer has no values.
+func queryValues(v any) []string {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Slice {
+		return []string{fmt.Sprint(rv.Interface())}
+	}
+	values := make([]string, rv.Len())
+	for i := range values {
+		values[i] = fmt.Sprint(rv.Index(i).Interface())
+	}
+	return values
+}
+
+// decodeInto decodes a response body into a new value stored in dst. An
+// empty body leaves the value zero.
+func decodeInto[T any](c *Client, resp *http.Response, data []byte, dst **T) error {
+	v := new(T)
+	if len(data) > 0 {
+		if err := c.decode(resp, data, v); err != nil {
+			return fmt.Errorf("decoding response: %w", err)
+		}
+	}
+	*dst = v
+	return nil
+}
+
+// finish returns err, or the failure of a response with an error status.
+func finish(resp *http.Response, data []byte, err error) error {
+	if err == nil && resp.StatusCode >= 400 {
+		err = fmt.Errorf("request failed with status %d: %s", resp.StatusCode, string(data))
+	}
+	return err
+}
+
+// EchoJSONResponse contains typed response data for EchoJSON.
+type EchoJSONResponse struct {
+	StatusCode int
+	JSON200    *EchoPayload
+	Raw        *http.Response
+}
+
+// EchoFormResponse contains typed response data for EchoForm.
+type EchoFormResponse struct {
+	StatusCode int
+	JSON200    *FormEchoResponse
+	Raw        *http.Response
+}
+
+// EchoFormRequest is the form-urlencoded request for EchoForm.
+type EchoFormRequest struct {
+	Field1 string
+	Field2 string
+	Tags   []string
+}
+
+// EchoMultipartResponse contains typed response data for EchoMultipart.
+type EchoMultipartResponse struct {
+	StatusCode int
+	JSON200    *FileEchoResponse
+	Raw        *http.Response
+}
+
+// EchoMultipartRequest is the multipart request for EchoMultipart.
+type EchoMultipartRequest struct {
+	File        *FileUpload
+	Description string
+}
+
+// GetItemResponse contains typed response data for GetItem.
+type GetItemResponse struct {
+	StatusCode int
+	JSON200    *ItemWithParams
+	JSON404    *ErrorResponse
+	Raw        *http.Response
+}
+
+// CreateResourceResponse contains typed response data for CreateResource.
+type CreateResourceResponse struct {
+	StatusCode int
+	JSON201    *Resource
+	JSON4XX    *ErrorResponse
+	Raw        *http.Response
+}
+
+// DeleteResourceResponse contains typed response data for DeleteResource.
+type DeleteResourceResponse struct {
+	StatusCode  int
+	JSON204     *struct{}
+	JSONDefault *ErrorResponse
+	Raw         *http.Response
+}
+
+// GetSessionResponse contains typed response data for GetSession.
+type GetSessionResponse struct {
+	StatusCode int
+	JSON200    *SessionInfo
+	Raw        *http.Response
+}
+
+// GetSecureDataResponse contains typed response data for GetSecureData.
+type GetSecureDataResponse struct {
+	StatusCode int
+	JSON200    *SecureData
+	JSON401    *ErrorResponse
+	Raw        *http.Response
+}
+
+// CreateShapeResponse contains typed response data for CreateShape.
+type CreateShapeResponse struct {
+	StatusCode int
+	JSON200    *Shape
+	Raw        *http.Response
+}
+
+func (c *Client) EchoJSON(ctx context.Context, body EchoPayload) (*EchoJSONResponse, error) {
+	resp, data, err := c.call(ctx, OperationKeyEchoJSON, nil, nil, body)
+	if resp == nil {
+		return nil, err
+	}
+	result := &EchoJSONResponse{StatusCode: resp.StatusCode, Raw: resp}
+	if err != nil {
+		return result, err
+	}
+	switch operations[OperationKeyEchoJSON].match(resp.StatusCode) {
+	case 0:
+		err = decodeInto(c, resp, data, &result.JSON200)
+	}
+	return result, finish(resp, data, err)
+}
+
+func (c *Client) EchoForm(ctx context.Context, req EchoFormRequest) (*EchoFormResponse, error) {
+	path := "/echo/form"
+
+	var bodyReader io.Reader
+	var contentType string
+	formData := url.Values{}
+	if req.Field1 != "" {
+		formData.Set("field1", req.Field1)
+	}
+	if req.Field2 != "" {
+		formData.Set("field2", req.Field2)
+	}
+	for _, v := range req.Tags {
+		formData.Add("tags", v)
+	}
+	bodyReader = strings.NewReader(formData.Encode())
+	contentType = "application/x-www-form-urlencoded"
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+path, bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	if contentType != "" {
+		httpReq.Header.Set("Content-Type", contentType)
+	}
+	httpReq.Header.Set("Accept", "application/json")
+
+	if err := c.editRequest(ctx, httpReq); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(ctx, OperationKeyEchoForm, httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("executing request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	result := &EchoFormResponse{
+		StatusCode: resp.StatusCode,
+		Raw:        resp,
+	}
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return result, fmt.Errorf("reading response: %w", err)
+	}
+
+	switch resp.StatusCode {
+	case 200:
+		var body FormEchoResponse
+		if len(bodyBytes) > 0 {
+			if err := c.decode(resp, bodyBytes, &body); err != nil {
+				return result, fmt.Errorf("decoding response: %w", err)
+			}
+		}
+		result.JSON200 = &body
+	}
+
+	if resp.StatusCode >= 400 {
+		return result, fmt.Errorf("request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	return result, nil
+}
+
+func (c *Client) EchoMultipart(ctx context.Context, req EchoMultipartRequest) (*EchoMultipartResponse, error) {
+	path := "/echo/multipart"
+
+	var bodyReader io.Reader
+	var contentType string
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	if req.File != nil {
+		part, err := writer.CreateFormFile("file", req.File.Filename)
+		if err != nil {
+			return nil, fmt.Errorf("creating form file file: %w", err)
+		}
+		if _, err := io.Copy(part, req.File.Reader); err != nil {
+			return nil, fmt.Errorf("writing file file: %w", err)
+		}
+	}
+	if req.Description != "" {
+		if err := writer.WriteField("description", req.Description); err != nil {
+			return nil, fmt.Errorf("writing field description: %w", err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("closing multipart writer: %w", err)
+	}
+	bodyReader = body
+	contentType = writer.FormDataContentType()
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+path, bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	if contentType != "" {
+		httpReq.Header.Set("Content-Type", contentType)
+	}
+	httpReq.Header.Set("Accept", "application/json")
+
+	if err := c.editRequest(ctx, httpReq); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(ctx, OperationKeyEchoMultipart, httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("executing request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	result := &EchoMultipartResponse{
+		StatusCode: resp.StatusCode,
+		Raw:        resp,
+	}
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return result, fmt.Errorf("reading response: %w", err)
+	}
+
+	switch resp.StatusCode {
+	case 200:
+		var body FileEchoResponse
+		if len(bodyBytes) > 0 {
+			if err := c.decode(resp, bodyBytes, &body); err != nil {
+				return result, fmt.Errorf("decoding response: %w", err)
+			}
+		}
+		result.JSON200 = &body
+	}
+
+	if resp.StatusCode >= 400 {
+		return result, fmt.Errorf("request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	return result, nil
+}
+
+func (c *Client) GetItem(ctx context.Context, id string, params *GetItemParams) (*GetItemResponse, error) {
+	var queryArgs []any
+	if params != nil {
+		queryArgs = []any{params.Filter}
+	}
+	resp, data, err := c.call(ctx, OperationKeyGetItem, []any{id}, queryArgs, nil)
+	if resp == nil {
+		return nil, err
+	}
+	result := &GetItemResponse{StatusCode: resp.StatusCode, Raw: resp}
+	if err != nil {
+		return result, err
+	}
+	switch operations[OperationKeyGetItem].match(resp.StatusCode) {
+	case 0:
+		err = decodeInto(c, resp, data, &result.JSON200)
+	case 1:
+		err = decodeInto(c, resp, data, &result.JSON404)
+	}
+	return result, finish(resp, data, err)
+}
+
+func (c *Client) CreateResource(ctx context.Context, body NewResource) (*CreateResourceResponse, error) {
+	resp, data, err := c.call(ctx, OperationKeyCreateResource, nil, nil, body)
+	if resp == nil {
+		return nil, err
+	}
+	result := &CreateResourceResponse{StatusCode: resp.StatusCode, Raw: resp}
+	if err != nil {
+		return result, err
+	}
+	switch operations[OperationKeyCreateResource].match(resp.StatusCode) {
+	case 0:
+		err = decodeInto(c, resp, data, &result.JSON201)
+	case 1:
+		err = decodeInto(c, resp, data, &result.JSON4XX)
+	}
+	return result, finish(resp, data, err)
+}
+
+func (c *Client) DeleteResource(ctx context.Context, id string) (*DeleteResourceResponse, error) {
+	resp, data, err := c.call(ctx, OperationKeyDeleteResource, []any{id}, nil, nil)
+	if resp == nil {
+		return nil, err
+	}
+	result := &DeleteResourceResponse{StatusCode: resp.StatusCode, Raw: resp}
+	if err != nil {
+		return result, err
+	}
+	switch operations[OperationKeyDeleteResource].match(resp.StatusCode) {
+	case 1:
+		err = decodeInto(c, resp, data, &result.JSONDefault)
+	}
+	return result, finish(resp, data, err)
+}
+
+func (c *Client) GetSession(ctx context.Context) (*GetSessionResponse, error) {
+	resp, data, err := c.call(ctx, OperationKeyGetSession, nil, nil, nil)
+	if resp == nil {
+		return nil, err
+	}
+	result := &GetSessionResponse{StatusCode: resp.StatusCode, Raw: resp}
+	if err != nil {
+		return result, err
+	}
+	switch operations[OperationKeyGetSession].match(resp.StatusCode) {
+	case 0:
+		err = decodeInto(c, resp, data, &result.JSON200)
+	}
+	return result, finish(resp, data, err)
+}
+
+func (c *Client) GetSecureData(ctx context.Context) (*GetSecureDataResponse, error) {
+	resp, data, err := c.call(ctx, OperationKeyGetSecureData, nil, nil, nil)
+	if resp == nil {
+		return nil, err
+	}
+	result := &GetSecureDataResponse{StatusCode: resp.StatusCode, Raw: resp}
+	if err != nil {
+		return result, err
+	}
+	switch operations[OperationKeyGetSecureData].match(resp.StatusCode) {
+	case 0:
+		err = decodeInto(c, resp, data, &result.JSON200)
+	case 1:
+		err = decodeInto(c, resp, data, &result.JSON401)
+	}
+	return result, finish(resp, data, err)
+}
+
+func (c *Client) CreateShape(ctx context.Context, body Shape) (*CreateShapeResponse, error) {
+	resp, data, err := c.call(ctx, OperationKeyCreateShape, nil, nil, body)
+	if resp == nil {
+		return nil, err
+	}
+	result := &CreateShapeResponse{StatusCode: resp.StatusCode, Raw: resp}
+	if err != nil {
+		return result, err
+	}
+	switch operations[OperationKeyCreateShape].match(resp.StatusCode) {
+	case 0:
+		err = decodeInto(c, resp, data, &result.JSON200)
+	}
+	return result, finish(resp, data, err)
+}
+
+type GetItemParams struct {
+	Filter *string
+}
+
+// BatchOptions controls a Batch<Operation> call.
+type BatchOptions struct {
+	// Concurrency is the maximum number of calls in flight; 4 when zero.
+	Concurrency int
+	// StopOnError cancels the calls not yet finished after the first failure.
+	StopOnError bool
+}
+
+// BatchResult is the outcome of one call in a batch.
+type BatchResult[T any] struct {
+	Response T
+	Err      error
+}
+
+// runBatch calls call for each index in [0, n) with bounded concurrency. Results
+// are in input order; the returned error joins the failures, each prefixed with
+// its index.
+func runBatch[T any](ctx context.Context, n int, opts *BatchOptions, call func(ctx context.Context, i int) (T, error)) ([]BatchResult[T], error) {
+	concurrency, stopOnError := 4, false
+	if opts != nil {
+		if opts.Concurrency > 0 {
+			concurrency = opts.Concurrency
+		}
+		stopOnError = opts.StopOnError
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]BatchResult[T], n)
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		sem <- struct{}{}
+		if err := ctx.Err(); err != nil {
+			<-sem
+			results[i].Err = err
+			continue
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			resp, err := call(ctx, i)
+			results[i] = BatchResult[T]{Response: resp, Err: err}
+			if err != nil && stopOnError {
+				cancel()
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	var errs []error
+	for i, r := range results {
+		if r.Err != nil {
+			errs = append(errs, fmt.Errorf("batch item %d: %w", i, r.Err))
+		}
+	}
+	return results, errors.Join(errs...)
+}
+
+// GetItemBatchArgs holds the arguments of one GetItem call in a batch.
+type GetItemBatchArgs struct {
+	ID     string
+	Params *GetItemParams
+}
+
+// BatchGetItem calls GetItem once per element of args, with at most
+// opts.Concurrency calls in flight. Results are in the order of args.
+func (c *Client) BatchGetItem(ctx context.Context, args []GetItemBatchArgs, opts *BatchOptions) ([]BatchResult[*GetItemResponse], error) {
+	return runBatch(ctx, len(args), opts, func(ctx context.Context, i int) (*GetItemResponse, error) {
+		a := args[i]
+		return c.GetItem(ctx, a.ID, a.Params)
+	})
+}
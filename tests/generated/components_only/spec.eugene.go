@@ -0,0 +1,19 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import "encoding/base64"
+
+var openAPISpecBase64 = "b3BlbmFwaTogIjMuMS4wIgppbmZvOgogIHRpdGxlOiBTaGFyZWQgTW9kZWxzCiAgdmVyc2lvbjogIjEuMC4wIgogIGRlc2NyaXB0aW9uOiBDb21wb25lbnRzIHNoYXJlZCBieSBzZXZlcmFsIHNlcnZpY2VzOyB0aGUgZG9jdW1lbnQgaGFzIG5vIHBhdGhzLgpjb21wb25lbnRzOgogIHNjaGVtYXM6CiAgICBNb25leToKICAgICAgdHlwZTogb2JqZWN0CiAgICAgIHJlcXVpcmVkOiBbYW1vdW50LCBjdXJyZW5jeV0KICAgICAgcHJvcGVydGllczoKICAgICAgICBhbW91bnQ6CiAgICAgICAgICB0eXBlOiBpbnRlZ2VyCiAgICAgICAgICBmb3JtYXQ6IGludDY0CiAgICAgICAgY3VycmVuY3k6CiAgICAgICAgICAkcmVmOiAiIy9jb21wb25lbnRzL3NjaGVtYXMvQ3VycmVuY3kiCiAgICBDdXJyZW5jeToKICAgICAgdHlwZTogc3RyaW5nCiAgICAgIGVudW06IFtFVVIsIFVTRF0KICAgIEFkZHJlc3M6CiAgICAgIHR5cGU6IG9iamVjdAogICAgICBwcm9wZXJ0aWVzOgogICAgICAgIHN0cmVldDoKICAgICAgICAgIHR5cGU6IHN0cmluZwogICAgICAgIGNpdHk6CiAgICAgICAgICB0eXBlOiBzdHJpbmcK"
+
+// GetOpenAPISpec returns the embedded OpenAPI specification.
+func GetOpenAPISpec() string {
+	decoded, _ := base64.StdEncoding.DecodeString(openAPISpecBase64)
+	return string(decoded)
+}
+
+// GetOpenAPISpecBytes returns the embedded OpenAPI specification as bytes.
+func GetOpenAPISpecBytes() []byte {
+	decoded, _ := base64.StdEncoding.DecodeString(openAPISpecBase64)
+	return decoded
+}
@@ -0,0 +1,20 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+type Money struct {
+	Amount   int64    `json:"amount"`
+	Currency Currency `json:"currency"`
+}
+
+type Currency string
+
+type Address struct {
+	Street *string `json:"street,omitempty"`
+	City   *string `json:"city,omitempty"`
+}
+
+const (
+	CurrencyEur Currency = "EUR"
+	CurrencyUsd Currency = "USD"
+)
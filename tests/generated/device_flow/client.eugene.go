@@ -0,0 +1,521 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultUserAgent is sent with every request unless WithUserAgent
+// overrides it.
+const DefaultUserAgent = "device-login-test/1.0.0 eugene"
+
+type Client struct {
+	baseURL        string
+	httpClient     *http.Client
+	decoders       map[string]Decoder
+	headers        http.Header
+	userAgent      string
+	requestEditors []RequestEditorFn
+	signer         Signer
+	breaker        Breaker
+	tlsConfig      *tls.Config
+	err            error // an option NewClient could not apply, returned by every request
+}
+
+type ClientOption func(*Client)
+
+// RequestEditorFn modifies a request before it is sent. Returning an error
+// aborts the request.
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+func WithHTTPClient(client *http.Client) ClientOption {
+	return func(c *Client) {
+		c.httpClient = client
+	}
+}
+
+// WithHeader adds a header sent with every request.
+func WithHeader(key, value string) ClientOption {
+	return func(c *Client) {
+		c.headers.Add(key, value)
+	}
+}
+
+// WithUserAgent replaces DefaultUserAgent.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithRequestEditor registers fn to run on every request after headers are
+// set, in registration order. Use it for auth tokens, tracing and similar.
+func WithRequestEditor(fn RequestEditorFn) ClientOption {
+	return func(c *Client) {
+		c.requestEditors = append(c.requestEditors, fn)
+	}
+}
+
+// Signer signs a fully built request, for schemes such as AWS SigV4 or HMAC
+// that cover the headers and a digest of the body. body holds the exact
+// bytes that will be sent, or nil when the request has none.
+type Signer interface {
+	Sign(ctx context.Context, req *http.Request, body []byte) error
+}
+
+// SignerFunc adapts a function to the Signer interface.
+type SignerFunc func(ctx context.Context, req *http.Request, body []byte) error
+
+func (f SignerFunc) Sign(ctx context.Context, req *http.Request, body []byte) error {
+	return f(ctx, req, body)
+}
+
+// WithSigner signs every request after its body, headers and request
+// editors have been applied.
+func WithSigner(signer Signer) ClientOption {
+	return func(c *Client) {
+		c.signer = signer
+	}
+}
+
+// OperationKey identifies a client operation by its operationId. It is the
+// key passed to a Breaker.
+type OperationKey string
+
+const (
+	OperationKeyGetProfile OperationKey = "getProfile"
+)
+
+// Breaker guards the HTTP round trip of each operation, typically with a
+// circuit breaker per OperationKey. Execute should call send unless the circuit
+// is open; a response with a 5xx status is returned with a nil error, so
+// implementations that count server errors as failures must check it.
+type Breaker interface {
+	Execute(ctx context.Context, op OperationKey, send func() (*http.Response, error)) (*http.Response, error)
+}
+
+// WithBreaker routes every operation's round trip through breaker.
+func WithBreaker(breaker Breaker) ClientOption {
+	return func(c *Client) {
+		c.breaker = breaker
+	}
+}
+
+// WithTLSConfig sets the TLS configuration of the HTTP client's transport.
+// The transport is cloned, so the client passed to WithHTTPClient and
+// http.DefaultTransport are left unchanged. It needs an *http.Transport;
+// with another transport every request fails, since its TLS settings are
+// out of reach.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(c *Client) {
+		c.tlsConfig = config
+	}
+}
+
+// Decoder decodes a response body into v.
+type Decoder func(data []byte, v any) error
+
+// WithDecoder registers decode for response bodies of the given media type,
+// such as "application/vnd.api+json" or "application/xml". Media type
+// parameters like charset are ignored when matching. Bodies without a
+// registered decoder are decoded as JSON.
+func WithDecoder(mediaType string, decode Decoder) ClientOption {
+	return func(c *Client) {
+		if c.decoders == nil {
+			c.decoders = make(map[string]Decoder)
+		}
+		c.decoders[strings.ToLower(mediaType)] = decode
+	}
+}
+
+// editRequest applies the default headers, the user agent and the request
+// editors to req, then signs it.
+func (c *Client) editRequest(ctx context.Context, req *http.Request) error {
+	if c.err != nil {
+		return c.err
+	}
+	for key, values := range c.headers {
+		for _, v := range values {
+			req.Header.Add(key, v)
+		}
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	for _, edit := range c.requestEditors {
+		if err := edit(ctx, req); err != nil {
+			return fmt.Errorf("editing request: %w", err)
+		}
+	}
+	if c.signer == nil {
+		return nil
+	}
+	body, err := requestBody(req)
+	if err != nil {
+		return fmt.Errorf("reading request body for signing: %w", err)
+	}
+	if err := c.signer.Sign(ctx, req, body); err != nil {
+		return fmt.Errorf("signing request: %w", err)
+	}
+	return nil
+}
+
+// do sends req on behalf of op, through the breaker when one is set.
+func (c *Client) do(ctx context.Context, op OperationKey, req *http.Request) (*http.Response, error) {
+	if c.breaker == nil {
+		return c.httpClient.Do(req)
+	}
+	return c.breaker.Execute(ctx, op, func() (*http.Response, error) {
+		return c.httpClient.Do(req)
+	})
+}
+
+// requestBody returns the bytes req will send, leaving req.Body unread.
+func requestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		rc, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return io.ReadAll(rc)
+	}
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
+
+// decode unmarshals a response body with the decoder registered for its
+// Content-Type, falling back to JSON.
+func (c *Client) decode(resp *http.Response, data []byte, v any) error {
+	if mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type")); err == nil {
+		if decode, ok := c.decoders[mediaType]; ok {
+			return decode(data, v)
+		}
+	}
+	return json.Unmarshal(data, v)
+}
+
+func NewClient(baseURL string, opts ...ClientOption) *Client {
+	c := &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: http.DefaultClient,
+		headers:    make(http.Header),
+		userAgent:  DefaultUserAgent,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.tlsConfig != nil {
+		c.httpClient, c.err = withTLSConfig(c.httpClient, c.tlsConfig)
+	}
+	return c
+}
+
+// withTLSConfig returns a copy of client whose transport uses config. It
+// returns client and an error when the transport is not an *http.Transport.
+func withTLSConfig(client *http.Client, config *tls.Config) (*http.Client, error) {
+	rt := client.Transport
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	transport, ok := rt.(*http.Transport)
+	if !ok || transport == nil {
+		return client, fmt.Errorf("WithTLSConfig: transport %T is not an *http.Transport; set its TLS configuration directly", rt)
+	}
+	transport = transport.Clone()
+	transport.TLSClientConfig = config
+	clone := *client
+	clone.Transport = transport
+	return &clone, nil
+}
+
+type Response[T any] struct {
+	StatusCode int
+	Body       T
+	Raw        *http.Response
+}
+
+func doRequest[T any](ctx context.Context, c *Client, method, path string, body any) (*Response[T], error) {
+	var bodyReader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("marshaling request body: %w", err)
+		}
+		bodyReader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+
+	if err := c.editRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("executing request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	result := &Response[T]{
+		StatusCode: resp.StatusCode,
+		Raw:        resp,
+	}
+
+	if resp.StatusCode >= 400 {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return result, fmt.Errorf("request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return result, fmt.Errorf("reading response: %w", err)
+	}
+	if len(bodyBytes) > 0 {
+		if err := c.decode(resp, bodyBytes, &result.Body); err != nil {
+			return result, fmt.Errorf("decoding response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// GetProfileResponse contains typed response data for GetProfile.
+type GetProfileResponse struct {
+	StatusCode int
+	JSON200    *Profile
+	Raw        *http.Response
+}
+
+func (c *Client) GetProfile(ctx context.Context) (*GetProfileResponse, error) {
+	path := "/profile"
+
+	var bodyReader io.Reader
+
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", c.baseURL+path, bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	httpReq.Header.Set("Accept", "application/json")
+
+	if err := c.editRequest(ctx, httpReq); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(ctx, OperationKeyGetProfile, httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("executing request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	result := &GetProfileResponse{
+		StatusCode: resp.StatusCode,
+		Raw:        resp,
+	}
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return result, fmt.Errorf("reading response: %w", err)
+	}
+
+	switch resp.StatusCode {
+	case 200:
+		var body Profile
+		if len(bodyBytes) > 0 {
+			if err := c.decode(resp, bodyBytes, &body); err != nil {
+				return result, fmt.Errorf("decoding response: %w", err)
+			}
+		}
+		result.JSON200 = &body
+	}
+
+	if resp.StatusCode >= 400 {
+		return result, fmt.Errorf("request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	return result, nil
+}
+
+// DeviceFlow holds the endpoints of an OAuth 2.0 device authorization grant
+// (RFC 8628), which lets command-line tools and other input-constrained
+// clients log in through a browser on another device.
+type DeviceFlow struct {
+	DeviceAuthorizationURL string
+	TokenURL               string
+	Scopes                 []string // scopes the security scheme declares
+}
+
+// CliLoginDeviceFlow is the device authorization flow of the "cliLogin" security scheme.
+var CliLoginDeviceFlow = DeviceFlow{
+	DeviceAuthorizationURL: "https://auth.example.com/device",
+	TokenURL:               "https://auth.example.com/token",
+	Scopes:                 []string{"offline_access", "profile:read"},
+}
+
+// DeviceAuthorization is a device authorization response. Show the user
+// VerificationURI and UserCode, or VerificationURIComplete, while Token
+// waits for approval.
+type DeviceAuthorization struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete,omitempty"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval,omitempty"`
+}
+
+// DeviceToken is the access token issued once the user approves the device.
+type DeviceToken struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	ExpiresIn    int    `json:"expires_in,omitempty"`
+	Scope        string `json:"scope,omitempty"`
+}
+
+// RequestEditor returns a RequestEditorFn that sends the token in the
+// Authorization header, for use with WithRequestEditor.
+func (t *DeviceToken) RequestEditor() RequestEditorFn {
+	tokenType := t.TokenType
+	if tokenType == "" || strings.EqualFold(tokenType, "bearer") {
+		tokenType = "Bearer"
+	}
+	header := tokenType + " " + t.AccessToken
+	return func(ctx context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", header)
+		return nil
+	}
+}
+
+// DeviceFlowError is an OAuth error response of the device authorization or
+// token endpoint, such as access_denied or expired_token.
+type DeviceFlowError struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+func (e *DeviceFlowError) Error() string {
+	if e.Description != "" {
+		return fmt.Sprintf("oauth: %s: %s", e.Code, e.Description)
+	}
+	return "oauth: " + e.Code
+}
+
+// Authorize requests a device code and user code for clientID. httpClient
+// may be nil to use http.DefaultClient.
+func (f DeviceFlow) Authorize(ctx context.Context, httpClient *http.Client, clientID string, scopes ...string) (*DeviceAuthorization, error) {
+	form := url.Values{"client_id": {clientID}}
+	if len(scopes) > 0 {
+		form.Set("scope", strings.Join(scopes, " "))
+	}
+	var auth DeviceAuthorization
+	if err := postDeviceForm(ctx, httpClient, f.DeviceAuthorizationURL, form, &auth); err != nil {
+		return nil, err
+	}
+	return &auth, nil
+}
+
+// Token polls the token endpoint at the interval the server asks for until
+// the user approves the device. A denial or an expired code is returned as a
+// *DeviceFlowError.
+func (f DeviceFlow) Token(ctx context.Context, httpClient *http.Client, clientID string, auth *DeviceAuthorization) (*DeviceToken, error) {
+	interval := time.Duration(auth.Interval) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	form := url.Values{
+		"grant_type":  {"urn:ietf:params:oauth:grant-type:device_code"},
+		"device_code": {auth.DeviceCode},
+		"client_id":   {clientID},
+	}
+	for {
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+
+		var token DeviceToken
+		err := postDeviceForm(ctx, httpClient, f.TokenURL, form, &token)
+		if err == nil {
+			return &token, nil
+		}
+		var oauthErr *DeviceFlowError
+		if !errors.As(err, &oauthErr) {
+			return nil, err
+		}
+		switch oauthErr.Code {
+		case "authorization_pending":
+		case "slow_down":
+			interval += 5 * time.Second
+		default:
+			return nil, err
+		}
+	}
+}
+
+// postDeviceForm posts form to endpoint and decodes a successful JSON
+// response into v. OAuth error responses are returned as *DeviceFlowError.
+func postDeviceForm(ctx context.Context, httpClient *http.Client, endpoint string, form url.Values, v any) error {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("executing request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading response: %w", err)
+	}
+	if resp.StatusCode >= 400 {
+		var oauthErr DeviceFlowError
+		if json.Unmarshal(data, &oauthErr) == nil && oauthErr.Code != "" {
+			return &oauthErr
+		}
+		return fmt.Errorf("request failed with status %d: %s", resp.StatusCode, string(data))
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+	return nil
+}
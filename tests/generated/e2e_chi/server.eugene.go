@@ -1,4 +1,5 @@
 // Code generated by eugene. DO NOT EDIT.
+
 package gen
 
 import (
@@ -44,18 +45,65 @@ type ServerInterface interface {
 	CreateShape(w http.ResponseWriter, r *http.Request)
 }
 
+// UnimplementedServer answers every operation with 501 Not Implemented.
+// Embed it to serve only some operations. Implementations that do not embed
+// it fail to compile, naming the method, when the spec gains an operation.
+type UnimplementedServer struct{}
+
+func (UnimplementedServer) EchoJSON(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) EchoForm(w http.ResponseWriter, r *http.Request, req EchoFormFormRequest) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) EchoMultipart(w http.ResponseWriter, r *http.Request, req EchoMultipartMultipartRequest) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) GetItem(w http.ResponseWriter, r *http.Request, id string, params GetItemQueryParams) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) CreateResource(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) DeleteResource(w http.ResponseWriter, r *http.Request, id string) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) GetSession(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) GetSecureData(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) CreateShape(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+var _ ServerInterface = (*UnimplementedServer)(nil)
+
 type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
 func (w *ServerInterfaceWrapper) EchoJSON(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "echoJSON", "/echo/json"))
+	limitBody(rw, r, MaxBodySize)
 	w.Handler.EchoJSON(rw, r)
 }
 
 func (w *ServerInterfaceWrapper) EchoForm(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "echoForm", "/echo/form"))
+	limitBody(rw, r, MaxBodySize)
 	var req EchoFormFormRequest
 	if err := r.ParseForm(); err != nil {
-		http.Error(rw, "failed to parse form", http.StatusBadRequest)
+		http.Error(rw, "failed to parse form", BodyErrorStatus(err))
 		return
 	}
 	req.Field1 = r.FormValue("field1")
@@ -65,9 +113,11 @@ func (w *ServerInterfaceWrapper) EchoForm(rw http.ResponseWriter, r *http.Reques
 }
 
 func (w *ServerInterfaceWrapper) EchoMultipart(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "echoMultipart", "/echo/multipart"))
+	limitBody(rw, r, MaxBodySize)
 	var req EchoMultipartMultipartRequest
 	if err := r.ParseMultipartForm(32 << 20); err != nil {
-		http.Error(rw, "failed to parse multipart form", http.StatusBadRequest)
+		http.Error(rw, "failed to parse multipart form", BodyErrorStatus(err))
 		return
 	}
 	if r.MultipartForm != nil && r.MultipartForm.File != nil {
@@ -80,6 +130,7 @@ func (w *ServerInterfaceWrapper) EchoMultipart(rw http.ResponseWriter, r *http.R
 }
 
 func (w *ServerInterfaceWrapper) GetItem(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "getItem", "/items/{id}"))
 	id := chi.URLParam(r, "id")
 	var params GetItemQueryParams
 	if v := r.URL.Query().Get("filter"); v != "" {
@@ -89,23 +140,30 @@ func (w *ServerInterfaceWrapper) GetItem(rw http.ResponseWriter, r *http.Request
 }
 
 func (w *ServerInterfaceWrapper) CreateResource(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "createResource", "/resources"))
+	limitBody(rw, r, MaxBodySize)
 	w.Handler.CreateResource(rw, r)
 }
 
 func (w *ServerInterfaceWrapper) DeleteResource(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "deleteResource", "/resources/{id}"))
 	id := chi.URLParam(r, "id")
 	w.Handler.DeleteResource(rw, r, id)
 }
 
 func (w *ServerInterfaceWrapper) GetSession(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "getSession", "/session"))
 	w.Handler.GetSession(rw, r)
 }
 
 func (w *ServerInterfaceWrapper) GetSecureData(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "getSecureData", "/secure/data"))
 	w.Handler.GetSecureData(rw, r)
 }
 
 func (w *ServerInterfaceWrapper) CreateShape(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "createShape", "/shapes"))
+	limitBody(rw, r, MaxBodySize)
 	w.Handler.CreateShape(rw, r)
 }
 
@@ -1,4 +1,5 @@
 // Code generated by eugene. DO NOT EDIT.
+
 package gen
 
 import (
@@ -96,6 +97,32 @@ func (u Shape) MarshalJSON() ([]byte, error) {
 	return u.Raw, nil
 }
 
+// ShapeVariant is the closed set of Shape variants. Each variant
+// implements it, so code can accept any of them without the wrapper.
+type ShapeVariant interface {
+	isShape()
+}
+
+func (Circle) isShape()    {}
+func (Rectangle) isShape() {}
+
+// Variant decodes u into the variant its type names, as a pointer.
+func (u *Shape) Variant() (ShapeVariant, error) {
+	var v ShapeVariant
+	switch u.Type {
+	case "circle":
+		v = &Circle{}
+	case "rectangle":
+		v = &Rectangle{}
+	default:
+		return nil, fmt.Errorf("unknown Shape type %q", u.Type)
+	}
+	if err := json.Unmarshal(u.Raw, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
 func (u *Shape) AsCircle() (*Circle, error) {
 	if u.Type != "circle" {
 		return nil, fmt.Errorf("not a Circle, type is %s", u.Type)
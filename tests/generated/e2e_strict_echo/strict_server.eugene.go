@@ -1,12 +1,29 @@
 // Code generated by eugene. DO NOT EDIT.
+
 package gen
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 )
 
+// headerValue returns the first value of the named header. Get matches
+// canonical keys; keys set directly on the map in another case are found
+// by a case-insensitive scan.
+func headerValue(h http.Header, name string) string {
+	if v := h.Get(name); v != "" {
+		return v
+	}
+	for key, values := range h {
+		if len(values) > 0 && strings.EqualFold(key, name) {
+			return values[0]
+		}
+	}
+	return ""
+}
+
 // StrictEchoHandler wraps a StrictServerInterface to handle Echo requests.
 type StrictEchoHandler struct {
 	ssi StrictServerInterface
@@ -19,10 +36,12 @@ func NewStrictHandler(ssi StrictServerInterface) *StrictEchoHandler {
 
 // EchoJSON handles POST /echo/json
 func (h *StrictEchoHandler) EchoJSON(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "echoJSON", "/echo/json")))
+	limitBody(ctx.Response(), ctx.Request(), MaxBodySize)
 	var request EchoJSONRequestObject
 	var body EchoPayload
-	if err := ctx.Bind(&body); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	if err := DecodeJSONBody(ctx.Request(), &body); err != nil {
+		return echo.NewHTTPError(BodyErrorStatus(err), err.Error())
 	}
 	request.Body = body
 
@@ -36,10 +55,12 @@ func (h *StrictEchoHandler) EchoJSON(ctx echo.Context) error {
 
 // EchoForm handles POST /echo/form
 func (h *StrictEchoHandler) EchoForm(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "echoForm", "/echo/form")))
+	limitBody(ctx.Response(), ctx.Request(), MaxBodySize)
 	var request EchoFormRequestObject
 	var body any
-	if err := ctx.Bind(&body); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	if err := DecodeJSONBody(ctx.Request(), &body); err != nil {
+		return echo.NewHTTPError(BodyErrorStatus(err), err.Error())
 	}
 	request.Body = body
 
@@ -53,10 +74,12 @@ func (h *StrictEchoHandler) EchoForm(ctx echo.Context) error {
 
 // EchoMultipart handles POST /echo/multipart
 func (h *StrictEchoHandler) EchoMultipart(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "echoMultipart", "/echo/multipart")))
+	limitBody(ctx.Response(), ctx.Request(), MaxBodySize)
 	var request EchoMultipartRequestObject
 	var body any
-	if err := ctx.Bind(&body); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	if err := DecodeJSONBody(ctx.Request(), &body); err != nil {
+		return echo.NewHTTPError(BodyErrorStatus(err), err.Error())
 	}
 	request.Body = body
 
@@ -70,12 +93,13 @@ func (h *StrictEchoHandler) EchoMultipart(ctx echo.Context) error {
 
 // GetItem handles GET /items/{id}
 func (h *StrictEchoHandler) GetItem(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "getItem", "/items/{id}")))
 	var request GetItemRequestObject
 	request.ID = ctx.Param("id")
 	if v := ctx.QueryParam("filter"); v != "" {
 		request.Filter = &v
 	}
-	if v := ctx.Request().Header.Get("X-Request-ID"); v != "" {
+	if v := headerValue(ctx.Request().Header, "X-Request-Id"); v != "" {
 		request.XRequestID = &v
 	}
 
@@ -89,10 +113,12 @@ func (h *StrictEchoHandler) GetItem(ctx echo.Context) error {
 
 // CreateResource handles POST /resources
 func (h *StrictEchoHandler) CreateResource(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "createResource", "/resources")))
+	limitBody(ctx.Response(), ctx.Request(), MaxBodySize)
 	var request CreateResourceRequestObject
 	var body NewResource
-	if err := ctx.Bind(&body); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	if err := DecodeJSONBody(ctx.Request(), &body); err != nil {
+		return echo.NewHTTPError(BodyErrorStatus(err), err.Error())
 	}
 	request.Body = body
 
@@ -106,6 +132,7 @@ func (h *StrictEchoHandler) CreateResource(ctx echo.Context) error {
 
 // DeleteResource handles DELETE /resources/{id}
 func (h *StrictEchoHandler) DeleteResource(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "deleteResource", "/resources/{id}")))
 	var request DeleteResourceRequestObject
 	request.ID = ctx.Param("id")
 
@@ -119,6 +146,7 @@ func (h *StrictEchoHandler) DeleteResource(ctx echo.Context) error {
 
 // GetSession handles GET /session
 func (h *StrictEchoHandler) GetSession(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "getSession", "/session")))
 
 	response, err := h.ssi.GetSession(ctx.Request().Context())
 	if err != nil {
@@ -130,6 +158,7 @@ func (h *StrictEchoHandler) GetSession(ctx echo.Context) error {
 
 // GetSecureData handles GET /secure/data
 func (h *StrictEchoHandler) GetSecureData(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "getSecureData", "/secure/data")))
 
 	response, err := h.ssi.GetSecureData(ctx.Request().Context())
 	if err != nil {
@@ -141,10 +170,12 @@ func (h *StrictEchoHandler) GetSecureData(ctx echo.Context) error {
 
 // CreateShape handles POST /shapes
 func (h *StrictEchoHandler) CreateShape(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "createShape", "/shapes")))
+	limitBody(ctx.Response(), ctx.Request(), MaxBodySize)
 	var request CreateShapeRequestObject
 	var body Shape
-	if err := ctx.Bind(&body); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	if err := DecodeJSONBody(ctx.Request(), &body); err != nil {
+		return echo.NewHTTPError(BodyErrorStatus(err), err.Error())
 	}
 	request.Body = body
 
@@ -1,4 +1,5 @@
 // Code generated by eugene. DO NOT EDIT.
+
 package gen
 
 import (
@@ -7,6 +8,15 @@ import (
 	"net/http"
 )
 
+// responseStatus returns the status code chosen by the handler for a
+// default or range response, or fallback when it was left zero.
+func responseStatus(code, fallback int) int {
+	if code == 0 {
+		return fallback
+	}
+	return code
+}
+
 // EchoJSONRequestObject represents the request for EchoJSON.
 type EchoJSONRequestObject struct {
 	Body EchoPayload
@@ -123,6 +133,19 @@ func (r CreateResource201JSONResponse) VisitCreateResourceResponseObject(w http.
 	return json.NewEncoder(w).Encode(r)
 }
 
+// CreateResource4XXJSONResponse is the 4XX response for CreateResource.
+// It is sent with StatusCode, or 400 when StatusCode is zero.
+type CreateResource4XXJSONResponse struct {
+	StatusCode int
+	Body       ErrorResponse
+}
+
+func (r CreateResource4XXJSONResponse) VisitCreateResourceResponseObject(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(responseStatus(r.StatusCode, 400))
+	return json.NewEncoder(w).Encode(r.Body)
+}
+
 // DeleteResourceResponseObject is the interface for DeleteResource responses.
 type DeleteResourceResponseObject interface {
 	VisitDeleteResourceResponseObject(w http.ResponseWriter) error
@@ -136,6 +159,19 @@ func (r DeleteResource204Response) VisitDeleteResourceResponseObject(w http.Resp
 	return nil
 }
 
+// DeleteResourceDefaultJSONResponse is the default response for DeleteResource.
+// It is sent with StatusCode, or 500 when StatusCode is zero.
+type DeleteResourceDefaultJSONResponse struct {
+	StatusCode int
+	Body       ErrorResponse
+}
+
+func (r DeleteResourceDefaultJSONResponse) VisitDeleteResourceResponseObject(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(responseStatus(r.StatusCode, 500))
+	return json.NewEncoder(w).Encode(r.Body)
+}
+
 // GetSessionResponseObject is the interface for GetSession responses.
 type GetSessionResponseObject interface {
 	VisitGetSessionResponseObject(w http.ResponseWriter) error
@@ -208,3 +244,95 @@ type StrictServerInterface interface {
 	// CreateShape
 	CreateShape(ctx context.Context, request CreateShapeRequestObject) (CreateShapeResponseObject, error)
 }
+
+// UnimplementedStrictServer answers every operation with 501 Not
+// Implemented. Embed it to serve only some operations. Implementations that
+// do not embed it fail to compile, naming the method, when the spec gains an
+// operation.
+type UnimplementedStrictServer struct{}
+
+func (UnimplementedStrictServer) EchoJSON(ctx context.Context, request EchoJSONRequestObject) (EchoJSONResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+func (UnimplementedStrictServer) EchoForm(ctx context.Context, request EchoFormRequestObject) (EchoFormResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+func (UnimplementedStrictServer) EchoMultipart(ctx context.Context, request EchoMultipartRequestObject) (EchoMultipartResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+func (UnimplementedStrictServer) GetItem(ctx context.Context, request GetItemRequestObject) (GetItemResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+func (UnimplementedStrictServer) CreateResource(ctx context.Context, request CreateResourceRequestObject) (CreateResourceResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+func (UnimplementedStrictServer) DeleteResource(ctx context.Context, request DeleteResourceRequestObject) (DeleteResourceResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+func (UnimplementedStrictServer) GetSession(ctx context.Context) (GetSessionResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+func (UnimplementedStrictServer) GetSecureData(ctx context.Context) (GetSecureDataResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+func (UnimplementedStrictServer) CreateShape(ctx context.Context, request CreateShapeRequestObject) (CreateShapeResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+var _ StrictServerInterface = (*UnimplementedStrictServer)(nil)
+
+// notImplementedResponse is the 501 response of UnimplementedStrictServer.
+type notImplementedResponse struct{}
+
+func (notImplementedResponse) VisitEchoJSONResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
+
+func (notImplementedResponse) VisitEchoFormResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
+
+func (notImplementedResponse) VisitEchoMultipartResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
+
+func (notImplementedResponse) VisitGetItemResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
+
+func (notImplementedResponse) VisitCreateResourceResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
+
+func (notImplementedResponse) VisitDeleteResourceResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
+
+func (notImplementedResponse) VisitGetSessionResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
+
+func (notImplementedResponse) VisitGetSecureDataResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
+
+func (notImplementedResponse) VisitCreateShapeResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
@@ -0,0 +1,185 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type Status string
+
+type Level int
+
+type Source string
+
+type Maybe string
+
+type Item struct {
+	Status *Status `json:"status,omitempty"`
+	Level  *Level  `json:"level,omitempty"`
+	Source *Source `json:"source,omitempty"`
+	Kind   *Kind   `json:"kind,omitempty"`
+	M      Maybe   `json:"m"`
+	Ms     []Maybe `json:"ms,omitempty"`
+}
+
+type Kind string
+
+const (
+	KindPhysical Kind = "physical"
+	KindDigital  Kind = "digital"
+)
+
+func (e *Kind) UnmarshalJSON(data []byte) error {
+	// JSON null leaves the value untouched, as it does for other types
+	if string(data) == "null" {
+		return nil
+	}
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch Kind(v) {
+	case KindPhysical, KindDigital:
+		*e = Kind(v)
+		return nil
+	}
+	return fmt.Errorf("invalid Kind value: %v", v)
+}
+
+// UnmarshalText applies the same check to path and query parameters, which
+// echo's binder and other decoders pass as text.
+func (e *Kind) UnmarshalText(text []byte) error {
+	data, err := json.Marshal(string(text))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalJSON(data)
+}
+
+const (
+	StatusPending Status = "pending"
+	StatusActive  Status = "active"
+)
+
+func (e *Status) UnmarshalJSON(data []byte) error {
+	// JSON null leaves the value untouched, as it does for other types
+	if string(data) == "null" {
+		return nil
+	}
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch Status(v) {
+	case StatusPending, StatusActive:
+		*e = Status(v)
+		return nil
+	}
+	return fmt.Errorf("invalid Status value: %v", v)
+}
+
+// UnmarshalText applies the same check to path and query parameters, which
+// echo's binder and other decoders pass as text.
+func (e *Status) UnmarshalText(text []byte) error {
+	data, err := json.Marshal(string(text))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalJSON(data)
+}
+
+const (
+	Level0 Level = 0
+	Level1 Level = 1
+	Level2 Level = 2
+)
+
+func (e *Level) UnmarshalJSON(data []byte) error {
+	// JSON null leaves the value untouched, as it does for other types
+	if string(data) == "null" {
+		return nil
+	}
+	var v int
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch Level(v) {
+	case Level0, Level1, Level2:
+		*e = Level(v)
+		return nil
+	}
+	return fmt.Errorf("invalid Level value: %v", v)
+}
+
+// UnmarshalText applies the same check to path and query parameters, which
+// echo's binder and other decoders pass as text.
+func (e *Level) UnmarshalText(text []byte) error {
+	return e.UnmarshalJSON(text)
+}
+
+const (
+	SourceAPI     Source = "api"
+	SourceUnknown Source = "unknown"
+)
+
+func (e *Source) UnmarshalJSON(data []byte) error {
+	// JSON null leaves the value untouched, as it does for other types
+	if string(data) == "null" {
+		return nil
+	}
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch Source(v) {
+	case SourceAPI, SourceUnknown:
+		*e = Source(v)
+		return nil
+	}
+	return fmt.Errorf("invalid Source value: %v", v)
+}
+
+// UnmarshalText applies the same check to path and query parameters, which
+// echo's binder and other decoders pass as text.
+func (e *Source) UnmarshalText(text []byte) error {
+	data, err := json.Marshal(string(text))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalJSON(data)
+}
+
+const (
+	MaybeX    Maybe = "x"
+	MaybeY    Maybe = "y"
+	MaybeNull Maybe = "null"
+)
+
+func (e *Maybe) UnmarshalJSON(data []byte) error {
+	// JSON null leaves the value untouched, as it does for other types
+	if string(data) == "null" {
+		return nil
+	}
+	var v string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	switch Maybe(v) {
+	case MaybeX, MaybeY, MaybeNull:
+		*e = Maybe(v)
+		return nil
+	}
+	return fmt.Errorf("invalid Maybe value: %v", v)
+}
+
+// UnmarshalText applies the same check to path and query parameters, which
+// echo's binder and other decoders pass as text.
+func (e *Maybe) UnmarshalText(text []byte) error {
+	data, err := json.Marshal(string(text))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalJSON(data)
+}
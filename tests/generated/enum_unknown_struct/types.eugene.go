@@ -0,0 +1,396 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+)
+
+type Status struct {
+	value string
+}
+
+type Level struct {
+	value int
+}
+
+type Source struct {
+	value string
+}
+
+type Maybe struct {
+	value string
+}
+
+type Item struct {
+	Status *Status `json:"status,omitempty"`
+	Level  *Level  `json:"level,omitempty"`
+	Source *Source `json:"source,omitempty"`
+	Kind   *Kind   `json:"kind,omitempty"`
+	M      Maybe   `json:"m"`
+	Ms     []Maybe `json:"ms,omitempty"`
+}
+
+type Kind struct {
+	value string
+}
+
+func (e Kind) String() string { return fmt.Sprintf("%v", e.value) }
+func (e Kind) Value() string  { return e.value }
+func (e Kind) IsValid() bool  { return e.Index() >= 0 }
+
+// Index returns the position of e in the enum, or -1 for a value the enum
+// does not list.
+func (e Kind) Index() int {
+	switch e.value {
+	case "physical":
+		return 0
+	case "digital":
+		return 1
+	}
+	return -1
+}
+
+// Compare orders values as the enum lists them, after values it does not
+// list, which are ordered by value. It suits slices.SortFunc.
+func (e Kind) Compare(other Kind) int {
+	if c := cmp.Compare(e.Index(), other.Index()); c != 0 {
+		return c
+	}
+	return cmp.Compare(e.value, other.value)
+}
+
+// KindValues returns the values of the enum in order.
+func KindValues() []Kind {
+	return []Kind{KindPhysical, KindDigital}
+}
+
+func (e Kind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.value)
+}
+
+func (e *Kind) UnmarshalJSON(data []byte) error {
+	// JSON null leaves the value untouched, as it does for other types
+	if string(data) == "null" {
+		return nil
+	}
+	var v Kind
+	if err := json.Unmarshal(data, &v.value); err != nil {
+		return err
+	}
+	return e.set(v)
+}
+
+// MarshalText lets Kind be used as a JSON object key.
+func (e Kind) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+func (e *Kind) UnmarshalText(text []byte) error {
+	return e.set(Kind{value: string(text)})
+}
+
+func (e *Kind) set(v Kind) error {
+	if !v.IsValid() {
+		v = KindUnknown
+	}
+	*e = v
+	return nil
+}
+
+var (
+	KindPhysical = Kind{value: "physical"}
+	KindDigital  = Kind{value: "digital"}
+	// KindUnknown is what values missing from the enum decode to.
+	KindUnknown = Kind{value: ""}
+)
+
+func (e Status) String() string { return fmt.Sprintf("%v", e.value) }
+func (e Status) Value() string  { return e.value }
+func (e Status) IsValid() bool  { return e.Index() >= 0 }
+
+// Index returns the position of e in the enum, or -1 for a value the enum
+// does not list.
+func (e Status) Index() int {
+	switch e.value {
+	case "pending":
+		return 0
+	case "active":
+		return 1
+	}
+	return -1
+}
+
+// Compare orders values as the enum lists them, after values it does not
+// list, which are ordered by value. It suits slices.SortFunc.
+func (e Status) Compare(other Status) int {
+	if c := cmp.Compare(e.Index(), other.Index()); c != 0 {
+		return c
+	}
+	return cmp.Compare(e.value, other.value)
+}
+
+// StatusValues returns the values of the enum in order.
+func StatusValues() []Status {
+	return []Status{StatusPending, StatusActive}
+}
+
+func (e Status) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.value)
+}
+
+func (e *Status) UnmarshalJSON(data []byte) error {
+	// JSON null leaves the value untouched, as it does for other types
+	if string(data) == "null" {
+		return nil
+	}
+	var v Status
+	if err := json.Unmarshal(data, &v.value); err != nil {
+		return err
+	}
+	return e.set(v)
+}
+
+// MarshalText lets Status be used as a JSON object key.
+func (e Status) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+func (e *Status) UnmarshalText(text []byte) error {
+	return e.set(Status{value: string(text)})
+}
+
+func (e *Status) set(v Status) error {
+	if !v.IsValid() {
+		v = StatusUnknown
+	}
+	*e = v
+	return nil
+}
+
+var (
+	StatusPending = Status{value: "pending"}
+	StatusActive  = Status{value: "active"}
+	// StatusUnknown is what values missing from the enum decode to.
+	StatusUnknown = Status{value: ""}
+)
+
+func (e Level) String() string { return fmt.Sprintf("%v", e.value) }
+func (e Level) Value() int     { return e.value }
+func (e Level) IsValid() bool  { return e.Index() >= 0 }
+
+// Index returns the position of e in the enum, or -1 for a value the enum
+// does not list.
+func (e Level) Index() int {
+	switch e.value {
+	case 0:
+		return 0
+	case 1:
+		return 1
+	case 2:
+		return 2
+	}
+	return -1
+}
+
+// Compare orders values as the enum lists them, after values it does not
+// list, which are ordered by value. It suits slices.SortFunc.
+func (e Level) Compare(other Level) int {
+	if c := cmp.Compare(e.Index(), other.Index()); c != 0 {
+		return c
+	}
+	return cmp.Compare(e.value, other.value)
+}
+
+// LevelValues returns the values of the enum in order.
+func LevelValues() []Level {
+	return []Level{Level0, Level1, Level2}
+}
+
+func (e Level) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.value)
+}
+
+func (e *Level) UnmarshalJSON(data []byte) error {
+	// JSON null leaves the value untouched, as it does for other types
+	if string(data) == "null" {
+		return nil
+	}
+	var v Level
+	if err := json.Unmarshal(data, &v.value); err != nil {
+		return err
+	}
+	return e.set(v)
+}
+
+// MarshalText lets Level be used as a JSON object key.
+func (e Level) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+func (e *Level) UnmarshalText(text []byte) error {
+	var v Level
+	if err := json.Unmarshal(text, &v.value); err != nil {
+		return err
+	}
+	return e.set(v)
+}
+
+func (e *Level) set(v Level) error {
+	if !v.IsValid() {
+		v = LevelUnknown
+	}
+	*e = v
+	return nil
+}
+
+var (
+	Level0 = Level{value: 0}
+	Level1 = Level{value: 1}
+	Level2 = Level{value: 2}
+	// LevelUnknown is what values missing from the enum decode to.
+	LevelUnknown = Level{value: -1}
+)
+
+func (e Source) String() string { return fmt.Sprintf("%v", e.value) }
+func (e Source) Value() string  { return e.value }
+func (e Source) IsValid() bool  { return e.Index() >= 0 }
+
+// Index returns the position of e in the enum, or -1 for a value the enum
+// does not list.
+func (e Source) Index() int {
+	switch e.value {
+	case "api":
+		return 0
+	case "unknown":
+		return 1
+	}
+	return -1
+}
+
+// Compare orders values as the enum lists them, after values it does not
+// list, which are ordered by value. It suits slices.SortFunc.
+func (e Source) Compare(other Source) int {
+	if c := cmp.Compare(e.Index(), other.Index()); c != 0 {
+		return c
+	}
+	return cmp.Compare(e.value, other.value)
+}
+
+// SourceValues returns the values of the enum in order.
+func SourceValues() []Source {
+	return []Source{SourceAPI, SourceUnknown}
+}
+
+func (e Source) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.value)
+}
+
+func (e *Source) UnmarshalJSON(data []byte) error {
+	// JSON null leaves the value untouched, as it does for other types
+	if string(data) == "null" {
+		return nil
+	}
+	var v Source
+	if err := json.Unmarshal(data, &v.value); err != nil {
+		return err
+	}
+	return e.set(v)
+}
+
+// MarshalText lets Source be used as a JSON object key.
+func (e Source) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+func (e *Source) UnmarshalText(text []byte) error {
+	return e.set(Source{value: string(text)})
+}
+
+func (e *Source) set(v Source) error {
+	if !v.IsValid() {
+		v = SourceUnknown
+	}
+	*e = v
+	return nil
+}
+
+var (
+	SourceAPI     = Source{value: "api"}
+	SourceUnknown = Source{value: "unknown"}
+)
+
+func (e Maybe) String() string { return fmt.Sprintf("%v", e.value) }
+func (e Maybe) Value() string  { return e.value }
+func (e Maybe) IsValid() bool  { return e.Index() >= 0 }
+
+// Index returns the position of e in the enum, or -1 for a value the enum
+// does not list.
+func (e Maybe) Index() int {
+	switch e.value {
+	case "x":
+		return 0
+	case "y":
+		return 1
+	case "null":
+		return 2
+	}
+	return -1
+}
+
+// Compare orders values as the enum lists them, after values it does not
+// list, which are ordered by value. It suits slices.SortFunc.
+func (e Maybe) Compare(other Maybe) int {
+	if c := cmp.Compare(e.Index(), other.Index()); c != 0 {
+		return c
+	}
+	return cmp.Compare(e.value, other.value)
+}
+
+// MaybeValues returns the values of the enum in order.
+func MaybeValues() []Maybe {
+	return []Maybe{MaybeX, MaybeY, MaybeNull}
+}
+
+func (e Maybe) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.value)
+}
+
+func (e *Maybe) UnmarshalJSON(data []byte) error {
+	// JSON null leaves the value untouched, as it does for other types
+	if string(data) == "null" {
+		return nil
+	}
+	var v Maybe
+	if err := json.Unmarshal(data, &v.value); err != nil {
+		return err
+	}
+	return e.set(v)
+}
+
+// MarshalText lets Maybe be used as a JSON object key.
+func (e Maybe) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+func (e *Maybe) UnmarshalText(text []byte) error {
+	return e.set(Maybe{value: string(text)})
+}
+
+func (e *Maybe) set(v Maybe) error {
+	if !v.IsValid() {
+		v = MaybeUnknown
+	}
+	*e = v
+	return nil
+}
+
+var (
+	MaybeX    = Maybe{value: "x"}
+	MaybeY    = Maybe{value: "y"}
+	MaybeNull = Maybe{value: "null"}
+	// MaybeUnknown is what values missing from the enum decode to.
+	MaybeUnknown = Maybe{value: ""}
+)
@@ -0,0 +1,35 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+type Meta struct {
+	Total *int    `json:"total,omitempty"`
+	Next  *string `json:"next,omitempty"`
+}
+
+type Pet struct {
+	Name string `json:"name"`
+}
+
+type PetList = Envelope[[]Pet]
+
+type OwnerResponse = Envelope[OwnerResponseData]
+
+type Tag struct {
+	Label *string `json:"label,omitempty"`
+}
+
+// Envelope is the shape shared by the envelope schemas, which differ only in
+// the type of Data.
+type Envelope[T any] struct {
+	Data  T             `json:"data"`
+	Meta  Meta          `json:"meta,omitempty"`
+	Links EnvelopeLinks `json:"links,omitempty"`
+}
+type EnvelopeLinks struct {
+	Self *string `json:"self,omitempty"`
+}
+type OwnerResponseData struct {
+	ID   string `json:"id"`
+	Pets []Pet  `json:"pets,omitempty"`
+}
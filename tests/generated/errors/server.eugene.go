@@ -1,7 +1,10 @@
 // Code generated by eugene. DO NOT EDIT.
+
 package gen
 
 import (
+	"net/http"
+
 	"github.com/labstack/echo/v4"
 )
 
@@ -10,11 +13,23 @@ type ServerInterface interface {
 	GetItem(ctx echo.Context, id string) error
 }
 
+// UnimplementedServer answers every operation with 501 Not Implemented.
+// Embed it to serve only some operations. Implementations that do not embed
+// it fail to compile, naming the method, when the spec gains an operation.
+type UnimplementedServer struct{}
+
+func (UnimplementedServer) GetItem(ctx echo.Context, id string) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+var _ ServerInterface = (*UnimplementedServer)(nil)
+
 type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
 func (w *ServerInterfaceWrapper) GetItem(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "getItem", "/items/{id}")))
 	id := ctx.Param("id")
 	return w.Handler.GetItem(ctx, id)
 }
@@ -0,0 +1,44 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Channel addresses from the AsyncAPI document.
+const (
+	ChannelNotificationsEmail  = "notifications/email"
+	ChannelNotificationsStatus = "notifications/status"
+)
+
+// EventPublisher sends the messages the application produces. Implement it
+// over the message broker client.
+type EventPublisher interface {
+	// PublishEmailRequested sends EmailRequested to notifications/email.
+	PublishEmailRequested(ctx context.Context, msg EmailRequested) error
+}
+
+// EventSubscriber handles the messages the application consumes.
+type EventSubscriber interface {
+	// HandleDelivered handles Delivered received on notifications/status.
+	HandleDelivered(ctx context.Context, msg Delivered) error
+	// HandleBounced handles Bounced received on notifications/status.
+	HandleBounced(ctx context.Context, msg Bounced) error
+}
+
+// ErrUnknownChannel is returned by DispatchEvent for a channel without a
+// single received message.
+var ErrUnknownChannel = errors.New("unknown channel")
+
+// DispatchEvent decodes a JSON payload received on channel and passes it to
+// the matching EventSubscriber method. Channels carrying several received
+// messages must be dispatched by the caller.
+func DispatchEvent(ctx context.Context, s EventSubscriber, channel string, payload []byte) error {
+	switch channel {
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownChannel, channel)
+	}
+}
@@ -0,0 +1,22 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+type User struct {
+	ID    string `json:"id"`
+	Email string `json:"email"`
+}
+
+type EmailRequested struct {
+	To      string `json:"to"`
+	Subject string `json:"subject"`
+}
+
+type Delivered struct {
+	MessageID string `json:"messageId"`
+}
+
+type Bounced struct {
+	MessageID string `json:"messageId"`
+	Reason    string `json:"reason"`
+}
@@ -0,0 +1,56 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Channel addresses from the AsyncAPI document.
+const (
+	ChannelUserSignedUp = "user/signedup"
+	ChannelOrderPlaced  = "orders/placed"
+	ChannelAudit        = "audit"
+)
+
+// EventPublisher sends the messages the application produces. Implement it
+// over the message broker client.
+type EventPublisher interface {
+	// PublishUserSignedUp sends UserSignedUp to user/signedup.
+	// A user finished sign-up.
+	PublishUserSignedUp(ctx context.Context, msg UserSignedUp) error
+}
+
+// EventSubscriber handles the messages the application consumes.
+type EventSubscriber interface {
+	// HandleOrderPlacedFromOrderPlaced handles OrderPlaced received on orders/placed.
+	HandleOrderPlacedFromOrderPlaced(ctx context.Context, msg Order) error
+	// HandleUserSignedUp handles UserSignedUp received on audit.
+	// A user finished sign-up.
+	HandleUserSignedUp(ctx context.Context, msg UserSignedUp) error
+	// HandleOrderPlacedFromAudit handles OrderPlaced received on audit.
+	HandleOrderPlacedFromAudit(ctx context.Context, msg Order) error
+}
+
+// ErrUnknownChannel is returned by DispatchEvent for a channel without a
+// single received message.
+var ErrUnknownChannel = errors.New("unknown channel")
+
+// DispatchEvent decodes a JSON payload received on channel and passes it to
+// the matching EventSubscriber method. Channels carrying several received
+// messages must be dispatched by the caller.
+func DispatchEvent(ctx context.Context, s EventSubscriber, channel string, payload []byte) error {
+	switch channel {
+	case ChannelOrderPlaced:
+		var msg Order
+		if err := json.Unmarshal(payload, &msg); err != nil {
+			return fmt.Errorf("decoding OrderPlaced: %w", err)
+		}
+		return s.HandleOrderPlacedFromOrderPlaced(ctx, msg)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownChannel, channel)
+	}
+}
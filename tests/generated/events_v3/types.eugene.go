@@ -0,0 +1,30 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"time"
+)
+
+type User struct {
+	ID    string `json:"id"`
+	Email string `json:"email"`
+}
+
+type Order struct {
+	ID     string  `json:"id"`
+	Total  float64 `json:"total"`
+	Status *Status `json:"status,omitempty"`
+}
+
+type UserSignedUp struct {
+	User       User      `json:"user"`
+	SignedUpAt time.Time `json:"signedUpAt"`
+}
+
+type Status string
+
+const (
+	StatusNew  Status = "new"
+	StatusPaid Status = "paid"
+)
@@ -0,0 +1,74 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CreateOrderQueryParams struct {
+	DryRun *bool `query:"dryRun"`
+}
+
+type ServerInterface interface {
+	// CreateOrder
+	CreateOrder(ctx echo.Context, storeID string, params CreateOrderQueryParams) error
+	// SetOrderStatus
+	SetOrderStatus(ctx echo.Context, orderID string) error
+}
+
+// UnimplementedServer answers every operation with 501 Not Implemented.
+// Embed it to serve only some operations. Implementations that do not embed
+// it fail to compile, naming the method, when the spec gains an operation.
+type UnimplementedServer struct{}
+
+func (UnimplementedServer) CreateOrder(ctx echo.Context, storeID string, params CreateOrderQueryParams) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) SetOrderStatus(ctx echo.Context, orderID string) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+var _ ServerInterface = (*UnimplementedServer)(nil)
+
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+func (w *ServerInterfaceWrapper) CreateOrder(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "createOrder", "/stores/{storeId}/orders")))
+	limitBody(ctx.Response(), ctx.Request(), MaxBodySize)
+	storeID := ctx.Param("storeId")
+	var params CreateOrderQueryParams
+	if err := (&echo.DefaultBinder{}).BindQueryParams(ctx, &params); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid query parameters")
+	}
+	if ctx.QueryParam("dryRun") == "" {
+		params.DryRun = nil
+	}
+	return w.Handler.CreateOrder(ctx, storeID, params)
+}
+
+func (w *ServerInterfaceWrapper) SetOrderStatus(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "setOrderStatus", "/orders/{orderId}/status")))
+	limitBody(ctx.Response(), ctx.Request(), MaxBodySize)
+	orderID := ctx.Param("orderId")
+	return w.Handler.SetOrderStatus(ctx, orderID)
+}
+
+func RegisterHandlers(router Router, si ServerInterface) {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+
+	router.POST("/stores/:storeId/orders", wrapper.CreateOrder)
+	router.PUT("/orders/:orderId/status", wrapper.SetOrderStatus)
+}
+
+func RegisterHandlersWithBaseURL(router Router, si ServerInterface, baseURL string) {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+
+	router.POST(baseURL+"/stores/:storeId/orders", wrapper.CreateOrder)
+	router.PUT(baseURL+"/orders/:orderId/status", wrapper.SetOrderStatus)
+}
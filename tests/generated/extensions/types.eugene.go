@@ -1,7 +1,9 @@
 // Code generated by eugene. DO NOT EDIT.
+
 package gen
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,6 +13,8 @@ type User struct {
 	ID            uuid.UUID `json:"id"`
 	Email         string    `json:"email" validate:"required,email" db:"email_address"`
 	DisplayName   *string   `json:"nickname,omitempty"`
+	LegacyCode    *string   `json:"legacy_code,omitempty"`
+	PostalCode    *string   `json:"postcode,omitempty"`
 	InternalField *string   `json:"-"`
 	CreatedAt     *string   `json:"created_at"`
 	UpdatedAt     *string   `json:"updated_at,omitempty,omitzero"`
@@ -21,3 +25,40 @@ type Duration time.Duration
 
 // A custom ID type that stays as string
 type CustomID string
+
+type Audit struct {
+	CreatedBy *string `json:"created_by,omitempty"`
+	UpdatedBy *string `json:"updated_by,omitempty"`
+}
+
+type Document struct {
+	Title string `json:"title"`
+	Audit
+}
+
+// Serialized as a "12.50 EUR" string by a hand-written MarshalText
+type Money struct {
+	Amount   *string `json:"amount,omitempty"`
+	Currency *string `json:"currency,omitempty"`
+}
+
+type Attachment struct {
+	Type string          `json:"-"`
+	Raw  json.RawMessage `json:"-"`
+}
+
+func (u *Attachment) AsAudit() (*Audit, error) {
+	var v Audit
+	if err := json.Unmarshal(u.Raw, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func (u *Attachment) AsMoney() (*Money, error) {
+	var v Money
+	if err := json.Unmarshal(u.Raw, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
@@ -0,0 +1,27 @@
+// Code generated by eugene as a starting point for x-oink-marshal types.
+// The marker below keeps eugene from overwriting this file; remove it to regenerate.
+//eugene:keep
+
+package gen
+
+import "errors"
+
+// MarshalText implements encoding.TextMarshaler.
+func (v Money) MarshalText() ([]byte, error) {
+	return nil, errors.New("Money.MarshalText not implemented")
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (v *Money) UnmarshalText(data []byte) error {
+	return errors.New("Money.UnmarshalText not implemented")
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v Attachment) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("Attachment.MarshalJSON not implemented")
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *Attachment) UnmarshalJSON(data []byte) error {
+	return errors.New("Attachment.UnmarshalJSON not implemented")
+}
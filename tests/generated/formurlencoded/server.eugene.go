@@ -1,8 +1,10 @@
 // Code generated by eugene. DO NOT EDIT.
+
 package gen
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 )
@@ -19,19 +21,34 @@ type ServerInterface interface {
 	Login(ctx echo.Context, req LoginFormRequest) error
 }
 
+// UnimplementedServer answers every operation with 501 Not Implemented.
+// Embed it to serve only some operations. Implementations that do not embed
+// it fail to compile, naming the method, when the spec gains an operation.
+type UnimplementedServer struct{}
+
+func (UnimplementedServer) Login(ctx echo.Context, req LoginFormRequest) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+var _ ServerInterface = (*UnimplementedServer)(nil)
+
 type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
 func (w *ServerInterfaceWrapper) Login(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "login", "/login")))
+	limitBody(ctx.Response(), ctx.Request(), MaxBodySize)
 	var req LoginFormRequest
 	if err := ctx.Request().ParseForm(); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, "failed to parse form")
+		return echo.NewHTTPError(BodyErrorStatus(err), "failed to parse form")
 	}
 	req.Username = ctx.FormValue("username")
 	req.Password = ctx.FormValue("password")
 	req.RememberMe = ctx.FormValue("remember_me")
-	req.Scopes = ctx.Request().Form["scopes"]
+	if v := ctx.FormValue("scopes"); v != "" {
+		req.Scopes = strings.Split(v, " ")
+	}
 	return w.Handler.Login(ctx, req)
 }
 
@@ -0,0 +1,201 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"mime/multipart"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type EchoFormFormRequest struct {
+	Field1 string   `form:"field1"`
+	Field2 string   `form:"field2"`
+	Tags   []string `form:"tags"`
+}
+
+type EchoMultipartMultipartRequest struct {
+	File        *multipart.FileHeader `form:"file"`
+	Description string                `form:"description"`
+}
+
+type GetItemQueryParams struct {
+	Filter *string
+}
+
+type ServerInterface interface {
+	// EchoJSON
+	EchoJSON(w http.ResponseWriter, r *http.Request)
+	// EchoForm
+	EchoForm(w http.ResponseWriter, r *http.Request, req EchoFormFormRequest)
+	// EchoMultipart
+	EchoMultipart(w http.ResponseWriter, r *http.Request, req EchoMultipartMultipartRequest)
+	// GetItem
+	GetItem(w http.ResponseWriter, r *http.Request, id string, params GetItemQueryParams)
+	// CreateResource
+	CreateResource(w http.ResponseWriter, r *http.Request)
+	// DeleteResource
+	DeleteResource(w http.ResponseWriter, r *http.Request, id string)
+	// GetSession
+	GetSession(w http.ResponseWriter, r *http.Request)
+	// GetSecureData
+	GetSecureData(w http.ResponseWriter, r *http.Request)
+	// CreateShape
+	CreateShape(w http.ResponseWriter, r *http.Request)
+}
+
+// UnimplementedServer answers every operation with 501 Not Implemented.
+// Embed it to serve only some operations. Implementations that do not embed
+// it fail to compile, naming the method, when the spec gains an operation.
+type UnimplementedServer struct{}
+
+func (UnimplementedServer) EchoJSON(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) EchoForm(w http.ResponseWriter, r *http.Request, req EchoFormFormRequest) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) EchoMultipart(w http.ResponseWriter, r *http.Request, req EchoMultipartMultipartRequest) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) GetItem(w http.ResponseWriter, r *http.Request, id string, params GetItemQueryParams) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) CreateResource(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) DeleteResource(w http.ResponseWriter, r *http.Request, id string) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) GetSession(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) GetSecureData(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) CreateShape(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+var _ ServerInterface = (*UnimplementedServer)(nil)
+
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+func (w *ServerInterfaceWrapper) EchoJSON(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "echoJSON", "/echo/json"))
+	limitBody(rw, r, MaxBodySize)
+	w.Handler.EchoJSON(rw, r)
+}
+
+func (w *ServerInterfaceWrapper) EchoForm(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "echoForm", "/echo/form"))
+	limitBody(rw, r, MaxBodySize)
+	var req EchoFormFormRequest
+	if err := r.ParseForm(); err != nil {
+		http.Error(rw, "failed to parse form", BodyErrorStatus(err))
+		return
+	}
+	req.Field1 = r.FormValue("field1")
+	req.Field2 = r.FormValue("field2")
+	req.Tags = r.Form["tags"]
+	w.Handler.EchoForm(rw, r, req)
+}
+
+func (w *ServerInterfaceWrapper) EchoMultipart(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "echoMultipart", "/echo/multipart"))
+	limitBody(rw, r, MaxBodySize)
+	var req EchoMultipartMultipartRequest
+	if err := r.ParseMultipartForm(32 << 20); err != nil {
+		http.Error(rw, "failed to parse multipart form", BodyErrorStatus(err))
+		return
+	}
+	if r.MultipartForm != nil && r.MultipartForm.File != nil {
+		if files := r.MultipartForm.File["file"]; len(files) > 0 {
+			req.File = files[0]
+		}
+	}
+	req.Description = r.FormValue("description")
+	w.Handler.EchoMultipart(rw, r, req)
+}
+
+func (w *ServerInterfaceWrapper) GetItem(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "getItem", "/items/{id}"))
+	id := chi.URLParam(r, "id")
+	var params GetItemQueryParams
+	if v := r.URL.Query().Get("filter"); v != "" {
+		params.Filter = &v
+	}
+	w.Handler.GetItem(rw, r, id, params)
+}
+
+func (w *ServerInterfaceWrapper) CreateResource(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "createResource", "/resources"))
+	limitBody(rw, r, MaxBodySize)
+	w.Handler.CreateResource(rw, r)
+}
+
+func (w *ServerInterfaceWrapper) DeleteResource(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "deleteResource", "/resources/{id}"))
+	id := chi.URLParam(r, "id")
+	w.Handler.DeleteResource(rw, r, id)
+}
+
+func (w *ServerInterfaceWrapper) GetSession(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "getSession", "/session"))
+	w.Handler.GetSession(rw, r)
+}
+
+func (w *ServerInterfaceWrapper) GetSecureData(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "getSecureData", "/secure/data"))
+	w.Handler.GetSecureData(rw, r)
+}
+
+func (w *ServerInterfaceWrapper) CreateShape(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "createShape", "/shapes"))
+	limitBody(rw, r, MaxBodySize)
+	w.Handler.CreateShape(rw, r)
+}
+
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL     string
+	Middlewares []func(http.Handler) http.Handler
+}
+
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := chi.NewRouter()
+
+	for _, m := range options.Middlewares {
+		r.Use(m)
+	}
+
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+
+	r.Method("POST", options.BaseURL+"/echo/json", http.HandlerFunc(wrapper.EchoJSON))
+	r.Method("POST", options.BaseURL+"/echo/form", http.HandlerFunc(wrapper.EchoForm))
+	r.Method("POST", options.BaseURL+"/echo/multipart", http.HandlerFunc(wrapper.EchoMultipart))
+	r.Method("GET", options.BaseURL+"/items/{id}", http.HandlerFunc(wrapper.GetItem))
+	r.Method("POST", options.BaseURL+"/resources", http.HandlerFunc(wrapper.CreateResource))
+	r.Method("DELETE", options.BaseURL+"/resources/{id}", http.HandlerFunc(wrapper.DeleteResource))
+	r.Method("GET", options.BaseURL+"/session", http.HandlerFunc(wrapper.GetSession))
+	r.Method("GET", options.BaseURL+"/secure/data", http.HandlerFunc(wrapper.GetSecureData))
+	r.Method("POST", options.BaseURL+"/shapes", http.HandlerFunc(wrapper.CreateShape))
+
+	registerHealthEndpoints(r)
+
+	return r
+}
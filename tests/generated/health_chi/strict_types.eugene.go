@@ -0,0 +1,338 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// responseStatus returns the status code chosen by the handler for a
+// default or range response, or fallback when it was left zero.
+func responseStatus(code, fallback int) int {
+	if code == 0 {
+		return fallback
+	}
+	return code
+}
+
+// EchoJSONRequestObject represents the request for EchoJSON.
+type EchoJSONRequestObject struct {
+	Body EchoPayload
+}
+
+// EchoFormRequestObject represents the request for EchoForm.
+type EchoFormRequestObject struct {
+	Body any
+}
+
+// EchoMultipartRequestObject represents the request for EchoMultipart.
+type EchoMultipartRequestObject struct {
+	Body any
+}
+
+// GetItemRequestObject represents the request for GetItem.
+type GetItemRequestObject struct {
+	ID         string  // path parameter
+	Filter     *string // query parameter
+	XRequestID *string // header parameter
+}
+
+// CreateResourceRequestObject represents the request for CreateResource.
+type CreateResourceRequestObject struct {
+	Body NewResource
+}
+
+// DeleteResourceRequestObject represents the request for DeleteResource.
+type DeleteResourceRequestObject struct {
+	ID string // path parameter
+}
+
+// CreateShapeRequestObject represents the request for CreateShape.
+type CreateShapeRequestObject struct {
+	Body Shape
+}
+
+// EchoJSONResponseObject is the interface for EchoJSON responses.
+type EchoJSONResponseObject interface {
+	VisitEchoJSONResponseObject(w http.ResponseWriter) error
+}
+
+// EchoJSON200JSONResponse is the response for EchoJSON with status 200.
+type EchoJSON200JSONResponse EchoPayload
+
+func (r EchoJSON200JSONResponse) VisitEchoJSONResponseObject(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(r)
+}
+
+// EchoFormResponseObject is the interface for EchoForm responses.
+type EchoFormResponseObject interface {
+	VisitEchoFormResponseObject(w http.ResponseWriter) error
+}
+
+// EchoForm200JSONResponse is the response for EchoForm with status 200.
+type EchoForm200JSONResponse FormEchoResponse
+
+func (r EchoForm200JSONResponse) VisitEchoFormResponseObject(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(r)
+}
+
+// EchoMultipartResponseObject is the interface for EchoMultipart responses.
+type EchoMultipartResponseObject interface {
+	VisitEchoMultipartResponseObject(w http.ResponseWriter) error
+}
+
+// EchoMultipart200JSONResponse is the response for EchoMultipart with status 200.
+type EchoMultipart200JSONResponse FileEchoResponse
+
+func (r EchoMultipart200JSONResponse) VisitEchoMultipartResponseObject(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(r)
+}
+
+// GetItemResponseObject is the interface for GetItem responses.
+type GetItemResponseObject interface {
+	VisitGetItemResponseObject(w http.ResponseWriter) error
+}
+
+// GetItem200JSONResponse is the response for GetItem with status 200.
+type GetItem200JSONResponse ItemWithParams
+
+func (r GetItem200JSONResponse) VisitGetItemResponseObject(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(r)
+}
+
+// GetItem404JSONResponse is the response for GetItem with status 404.
+type GetItem404JSONResponse ErrorResponse
+
+func (r GetItem404JSONResponse) VisitGetItemResponseObject(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+	return json.NewEncoder(w).Encode(r)
+}
+
+// CreateResourceResponseObject is the interface for CreateResource responses.
+type CreateResourceResponseObject interface {
+	VisitCreateResourceResponseObject(w http.ResponseWriter) error
+}
+
+// CreateResource201JSONResponse is the response for CreateResource with status 201.
+type CreateResource201JSONResponse Resource
+
+func (r CreateResource201JSONResponse) VisitCreateResourceResponseObject(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+	return json.NewEncoder(w).Encode(r)
+}
+
+// CreateResource4XXJSONResponse is the 4XX response for CreateResource.
+// It is sent with StatusCode, or 400 when StatusCode is zero.
+type CreateResource4XXJSONResponse struct {
+	StatusCode int
+	Body       ErrorResponse
+}
+
+func (r CreateResource4XXJSONResponse) VisitCreateResourceResponseObject(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(responseStatus(r.StatusCode, 400))
+	return json.NewEncoder(w).Encode(r.Body)
+}
+
+// DeleteResourceResponseObject is the interface for DeleteResource responses.
+type DeleteResourceResponseObject interface {
+	VisitDeleteResourceResponseObject(w http.ResponseWriter) error
+}
+
+// DeleteResource204Response is the response for DeleteResource with status 204.
+type DeleteResource204Response struct{}
+
+func (r DeleteResource204Response) VisitDeleteResourceResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+// DeleteResourceDefaultJSONResponse is the default response for DeleteResource.
+// It is sent with StatusCode, or 500 when StatusCode is zero.
+type DeleteResourceDefaultJSONResponse struct {
+	StatusCode int
+	Body       ErrorResponse
+}
+
+func (r DeleteResourceDefaultJSONResponse) VisitDeleteResourceResponseObject(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(responseStatus(r.StatusCode, 500))
+	return json.NewEncoder(w).Encode(r.Body)
+}
+
+// GetSessionResponseObject is the interface for GetSession responses.
+type GetSessionResponseObject interface {
+	VisitGetSessionResponseObject(w http.ResponseWriter) error
+}
+
+// GetSession200JSONResponse is the response for GetSession with status 200.
+type GetSession200JSONResponse SessionInfo
+
+func (r GetSession200JSONResponse) VisitGetSessionResponseObject(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(r)
+}
+
+// GetSecureDataResponseObject is the interface for GetSecureData responses.
+type GetSecureDataResponseObject interface {
+	VisitGetSecureDataResponseObject(w http.ResponseWriter) error
+}
+
+// GetSecureData200JSONResponse is the response for GetSecureData with status 200.
+type GetSecureData200JSONResponse SecureData
+
+func (r GetSecureData200JSONResponse) VisitGetSecureDataResponseObject(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(r)
+}
+
+// GetSecureData401JSONResponse is the response for GetSecureData with status 401.
+type GetSecureData401JSONResponse ErrorResponse
+
+func (r GetSecureData401JSONResponse) VisitGetSecureDataResponseObject(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+	return json.NewEncoder(w).Encode(r)
+}
+
+// CreateShapeResponseObject is the interface for CreateShape responses.
+type CreateShapeResponseObject interface {
+	VisitCreateShapeResponseObject(w http.ResponseWriter) error
+}
+
+// CreateShape200JSONResponse is the response for CreateShape with status 200.
+type CreateShape200JSONResponse Shape
+
+func (r CreateShape200JSONResponse) VisitCreateShapeResponseObject(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(r)
+}
+
+// StrictServerInterface is the strict server interface with typed request/response.
+type StrictServerInterface interface {
+	// EchoJSON
+	EchoJSON(ctx context.Context, request EchoJSONRequestObject) (EchoJSONResponseObject, error)
+	// EchoForm
+	EchoForm(ctx context.Context, request EchoFormRequestObject) (EchoFormResponseObject, error)
+	// EchoMultipart
+	EchoMultipart(ctx context.Context, request EchoMultipartRequestObject) (EchoMultipartResponseObject, error)
+	// GetItem
+	GetItem(ctx context.Context, request GetItemRequestObject) (GetItemResponseObject, error)
+	// CreateResource
+	CreateResource(ctx context.Context, request CreateResourceRequestObject) (CreateResourceResponseObject, error)
+	// DeleteResource
+	DeleteResource(ctx context.Context, request DeleteResourceRequestObject) (DeleteResourceResponseObject, error)
+	// GetSession
+	GetSession(ctx context.Context) (GetSessionResponseObject, error)
+	// GetSecureData
+	GetSecureData(ctx context.Context) (GetSecureDataResponseObject, error)
+	// CreateShape
+	CreateShape(ctx context.Context, request CreateShapeRequestObject) (CreateShapeResponseObject, error)
+}
+
+// UnimplementedStrictServer answers every operation with 501 Not
+// Implemented. Embed it to serve only some operations. Implementations that
+// do not embed it fail to compile, naming the method, when the spec gains an
+// operation.
+type UnimplementedStrictServer struct{}
+
+func (UnimplementedStrictServer) EchoJSON(ctx context.Context, request EchoJSONRequestObject) (EchoJSONResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+func (UnimplementedStrictServer) EchoForm(ctx context.Context, request EchoFormRequestObject) (EchoFormResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+func (UnimplementedStrictServer) EchoMultipart(ctx context.Context, request EchoMultipartRequestObject) (EchoMultipartResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+func (UnimplementedStrictServer) GetItem(ctx context.Context, request GetItemRequestObject) (GetItemResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+func (UnimplementedStrictServer) CreateResource(ctx context.Context, request CreateResourceRequestObject) (CreateResourceResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+func (UnimplementedStrictServer) DeleteResource(ctx context.Context, request DeleteResourceRequestObject) (DeleteResourceResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+func (UnimplementedStrictServer) GetSession(ctx context.Context) (GetSessionResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+func (UnimplementedStrictServer) GetSecureData(ctx context.Context) (GetSecureDataResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+func (UnimplementedStrictServer) CreateShape(ctx context.Context, request CreateShapeRequestObject) (CreateShapeResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+var _ StrictServerInterface = (*UnimplementedStrictServer)(nil)
+
+// notImplementedResponse is the 501 response of UnimplementedStrictServer.
+type notImplementedResponse struct{}
+
+func (notImplementedResponse) VisitEchoJSONResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
+
+func (notImplementedResponse) VisitEchoFormResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
+
+func (notImplementedResponse) VisitEchoMultipartResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
+
+func (notImplementedResponse) VisitGetItemResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
+
+func (notImplementedResponse) VisitCreateResourceResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
+
+func (notImplementedResponse) VisitDeleteResourceResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
+
+func (notImplementedResponse) VisitGetSessionResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
+
+func (notImplementedResponse) VisitGetSecureDataResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
+
+func (notImplementedResponse) VisitCreateShapeResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
@@ -0,0 +1,26 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+type Order struct {
+	ID       string     `json:"id"`
+	Items    []LineItem `json:"items"`
+	Status   *Status    `json:"status,omitempty"`
+	Shipping Address    `json:"shipping,omitempty"`
+}
+
+type LineItem struct {
+	Sku      string `json:"sku"`
+	Quantity int    `json:"quantity"`
+}
+
+type Status string
+
+type Address struct {
+	City *string `json:"city,omitempty"`
+}
+
+const (
+	StatusPending Status = "pending"
+	StatusShipped Status = "shipped"
+)
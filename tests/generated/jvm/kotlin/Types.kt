@@ -0,0 +1,99 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package com.example.roundtrip
+
+import kotlinx.serialization.SerialName
+import kotlinx.serialization.Serializable
+import kotlinx.serialization.json.JsonElement
+
+@Serializable
+data class EchoPayload(
+    val message: String,
+    val number: Long? = null,
+    val nested: EchoPayloadNested? = null,
+)
+
+@Serializable
+data class EchoPayloadNested(
+    val value: String? = null,
+)
+
+@Serializable
+data class FormEchoResponse(
+    val receivedField1: String? = null,
+    val receivedField2: Long? = null,
+    val receivedTags: List<String>? = null,
+)
+
+@Serializable
+data class FileEchoResponse(
+    val filename: String? = null,
+    val size: Long? = null,
+    val description: String? = null,
+)
+
+@Serializable
+data class ItemWithParams(
+    val id: String? = null,
+    val filter: String? = null,
+    val requestId: String? = null,
+)
+
+@Serializable
+data class ErrorResponse(
+    val code: String? = null,
+    val message: String? = null,
+)
+
+@Serializable
+enum class Status {
+    @SerialName("pending")
+    PENDING,
+    @SerialName("active")
+    ACTIVE,
+    @SerialName("completed")
+    COMPLETED,
+}
+
+@Serializable
+data class NewResource(
+    val name: String,
+    val status: Status? = null,
+    val description: String? = null,
+)
+
+@Serializable
+data class Resource(
+    val id: String? = null,
+    val name: String? = null,
+    val status: Status? = null,
+    val description: String? = null,
+)
+
+@Serializable
+data class SessionInfo(
+    val sessionId: String? = null,
+    val userId: String? = null,
+    val expiresAt: String? = null,
+)
+
+@Serializable
+data class SecureData(
+    val secret: String? = null,
+    val accessLevel: String? = null,
+)
+
+typealias Shape = JsonElement
+
+@Serializable
+data class Circle(
+    val type: String,
+    val radius: Double,
+)
+
+@Serializable
+data class Rectangle(
+    val type: String,
+    val width: Double,
+    val height: Double,
+)
@@ -0,0 +1,713 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// DefaultUserAgent is sent with every request unless WithUserAgent
+// overrides it.
+const DefaultUserAgent = "links-test/1.0.0 eugene"
+
+type Client struct {
+	baseURL        string
+	httpClient     *http.Client
+	decoders       map[string]Decoder
+	headers        http.Header
+	userAgent      string
+	requestEditors []RequestEditorFn
+	signer         Signer
+	breaker        Breaker
+	tlsConfig      *tls.Config
+	err            error // an option NewClient could not apply, returned by every request
+}
+
+type ClientOption func(*Client)
+
+// RequestEditorFn modifies a request before it is sent. Returning an error
+// aborts the request.
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+func WithHTTPClient(client *http.Client) ClientOption {
+	return func(c *Client) {
+		c.httpClient = client
+	}
+}
+
+// WithHeader adds a header sent with every request.
+func WithHeader(key, value string) ClientOption {
+	return func(c *Client) {
+		c.headers.Add(key, value)
+	}
+}
+
+// WithUserAgent replaces DefaultUserAgent.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithRequestEditor registers fn to run on every request after headers are
+// set, in registration order. Use it for auth tokens, tracing and similar.
+func WithRequestEditor(fn RequestEditorFn) ClientOption {
+	return func(c *Client) {
+		c.requestEditors = append(c.requestEditors, fn)
+	}
+}
+
+// Signer signs a fully built request, for schemes such as AWS SigV4 or HMAC
+// that cover the headers and a digest of the body. body holds the exact
+// bytes that will be sent, or nil when the request has none.
+type Signer interface {
+	Sign(ctx context.Context, req *http.Request, body []byte) error
+}
+
+// SignerFunc adapts a function to the Signer interface.
+type SignerFunc func(ctx context.Context, req *http.Request, body []byte) error
+
+func (f SignerFunc) Sign(ctx context.Context, req *http.Request, body []byte) error {
+	return f(ctx, req, body)
+}
+
+// WithSigner signs every request after its body, headers and request
+// editors have been applied.
+func WithSigner(signer Signer) ClientOption {
+	return func(c *Client) {
+		c.signer = signer
+	}
+}
+
+// OperationKey identifies a client operation by its operationId. It is the
+// key passed to a Breaker.
+type OperationKey string
+
+const (
+	OperationKeyCreateUser     OperationKey = "createUser"
+	OperationKeyGetUser        OperationKey = "getUser"
+	OperationKeyListUserOrders OperationKey = "listUserOrders"
+)
+
+// Breaker guards the HTTP round trip of each operation, typically with a
+// circuit breaker per OperationKey. Execute should call send unless the circuit
+// is open; a response with a 5xx status is returned with a nil error, so
+// implementations that count server errors as failures must check it.
+type Breaker interface {
+	Execute(ctx context.Context, op OperationKey, send func() (*http.Response, error)) (*http.Response, error)
+}
+
+// WithBreaker routes every operation's round trip through breaker.
+func WithBreaker(breaker Breaker) ClientOption {
+	return func(c *Client) {
+		c.breaker = breaker
+	}
+}
+
+// WithTLSConfig sets the TLS configuration of the HTTP client's transport.
+// The transport is cloned, so the client passed to WithHTTPClient and
+// http.DefaultTransport are left unchanged. It needs an *http.Transport;
+// with another transport every request fails, since its TLS settings are
+// out of reach.
+func WithTLSConfig(config *tls.Config) ClientOption {
+	return func(c *Client) {
+		c.tlsConfig = config
+	}
+}
+
+// Decoder decodes a response body into v.
+type Decoder func(data []byte, v any) error
+
+// WithDecoder registers decode for response bodies of the given media type,
+// such as "application/vnd.api+json" or "application/xml". Media type
+// parameters like charset are ignored when matching. Bodies without a
+// registered decoder are decoded as JSON.
+func WithDecoder(mediaType string, decode Decoder) ClientOption {
+	return func(c *Client) {
+		if c.decoders == nil {
+			c.decoders = make(map[string]Decoder)
+		}
+		c.decoders[strings.ToLower(mediaType)] = decode
+	}
+}
+
+// editRequest applies the default headers, the user agent and the request
+// editors to req, then signs it.
+func (c *Client) editRequest(ctx context.Context, req *http.Request) error {
+	if c.err != nil {
+		return c.err
+	}
+	for key, values := range c.headers {
+		for _, v := range values {
+			req.Header.Add(key, v)
+		}
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	for _, edit := range c.requestEditors {
+		if err := edit(ctx, req); err != nil {
+			return fmt.Errorf("editing request: %w", err)
+		}
+	}
+	if c.signer == nil {
+		return nil
+	}
+	body, err := requestBody(req)
+	if err != nil {
+		return fmt.Errorf("reading request body for signing: %w", err)
+	}
+	if err := c.signer.Sign(ctx, req, body); err != nil {
+		return fmt.Errorf("signing request: %w", err)
+	}
+	return nil
+}
+
+// do sends req on behalf of op, through the breaker when one is set.
+func (c *Client) do(ctx context.Context, op OperationKey, req *http.Request) (*http.Response, error) {
+	if c.breaker == nil {
+		return c.httpClient.Do(req)
+	}
+	return c.breaker.Execute(ctx, op, func() (*http.Response, error) {
+		return c.httpClient.Do(req)
+	})
+}
+
+// requestBody returns the bytes req will send, leaving req.Body unread.
+func requestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	if req.GetBody != nil {
+		rc, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return io.ReadAll(rc)
+	}
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body.Close()
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	return data, nil
+}
+
+// decode unmarshals a response body with the decoder registered for its
+// Content-Type, falling back to JSON.
+func (c *Client) decode(resp *http.Response, data []byte, v any) error {
+	if mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type")); err == nil {
+		if decode, ok := c.decoders[mediaType]; ok {
+			return decode(data, v)
+		}
+	}
+	return json.Unmarshal(data, v)
+}
+
+func NewClient(baseURL string, opts ...ClientOption) *Client {
+	c := &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: http.DefaultClient,
+		headers:    make(http.Header),
+		userAgent:  DefaultUserAgent,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.tlsConfig != nil {
+		c.httpClient, c.err = withTLSConfig(c.httpClient, c.tlsConfig)
+	}
+	return c
+}
+
+// withTLSConfig returns a copy of client whose transport uses config. It
+// returns client and an error when the transport is not an *http.Transport.
+func withTLSConfig(client *http.Client, config *tls.Config) (*http.Client, error) {
+	rt := client.Transport
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	transport, ok := rt.(*http.Transport)
+	if !ok || transport == nil {
+		return client, fmt.Errorf("WithTLSConfig: transport %T is not an *http.Transport; set its TLS configuration directly", rt)
+	}
+	transport = transport.Clone()
+	transport.TLSClientConfig = config
+	clone := *client
+	clone.Transport = transport
+	return &clone, nil
+}
+
+type Response[T any] struct {
+	StatusCode int
+	Body       T
+	Raw        *http.Response
+}
+
+// queryBuilder encodes query parameters the way the generated servers parse
+// them: pairs in the order the spec lists the parameters, an array as its
+// name repeated for each item, names and values escaped like
+// url.QueryEscape.
+type queryBuilder []string
+
+// add appends name=value. An empty value is left out unless the parameter
+// sets allowEmptyValue, since servers read it as a missing one. With
+// allowReserved, reserved characters that do not delimit pairs are sent as
+// they are.
+func (q *queryBuilder) add(name, value string, allowReserved, allowEmptyValue bool) {
+	if value == "" && !allowEmptyValue {
+		return
+	}
+	escaped := url.QueryEscape(value)
+	if allowReserved {
+		escaped = reservedQuery.Replace(escaped)
+	}
+	*q = append(*q, url.QueryEscape(name)+"="+escaped)
+}
+
+func (q queryBuilder) encode() string {
+	return strings.Join(q, "&")
+}
+
+// reservedQuery restores the RFC 3986 reserved characters allowReserved
+// parameters send unescaped. & = + # and ; stay escaped: they would split
+// the pair, decode as a space or end the query, and Go servers drop pairs
+// holding a ;.
+var reservedQuery = strings.NewReplacer(
+	"%21", "!", "%24", "$", "%27", "'", "%28", "(", "%29", ")", "%2A", "*", "%2C", ",",
+	"%2F", "/", "%3A", ":", "%3F", "?", "%40", "@", "%5B", "[", "%5D", "]",
+)
+
+// resolveLinkExpression evaluates an OpenAPI runtime expression against a completed
+// exchange. Values that are not expressions are returned unchanged as constants.
+func resolveLinkExpression(expr string, resp *http.Response, body []byte, pathTemplate string) (string, error) {
+	if strings.HasPrefix(expr, "{$") && strings.HasSuffix(expr, "}") {
+		expr = expr[1 : len(expr)-1]
+	}
+	if !strings.HasPrefix(expr, "$") {
+		return expr, nil
+	}
+	if resp == nil {
+		return "", fmt.Errorf("no response available for %s", expr)
+	}
+	req := resp.Request
+
+	switch {
+	case expr == "$statusCode":
+		return strconv.Itoa(resp.StatusCode), nil
+	case expr == "$url" && req != nil:
+		return req.URL.String(), nil
+	case expr == "$method" && req != nil:
+		return req.Method, nil
+	case strings.HasPrefix(expr, "$response.header."):
+		return resp.Header.Get(strings.TrimPrefix(expr, "$response.header.")), nil
+	case strings.HasPrefix(expr, "$response.body"):
+		return resolveJSONPointer(body, strings.TrimPrefix(strings.TrimPrefix(expr, "$response.body"), "#"))
+	case strings.HasPrefix(expr, "$request.header.") && req != nil:
+		return req.Header.Get(strings.TrimPrefix(expr, "$request.header.")), nil
+	case strings.HasPrefix(expr, "$request.query.") && req != nil:
+		return req.URL.Query().Get(strings.TrimPrefix(expr, "$request.query.")), nil
+	case strings.HasPrefix(expr, "$request.path.") && req != nil:
+		return pathParamValue(pathTemplate, req.URL.Path, strings.TrimPrefix(expr, "$request.path."))
+	}
+
+	return "", fmt.Errorf("unsupported link expression %s", expr)
+}
+
+// pathParamValue extracts a named parameter from a request path by matching the
+// trailing segments against the operation's path template.
+func pathParamValue(pathTemplate, path, name string) (string, error) {
+	tmplParts := strings.Split(strings.Trim(pathTemplate, "/"), "/")
+	pathParts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(pathParts) < len(tmplParts) {
+		return "", fmt.Errorf("path %s does not match %s", path, pathTemplate)
+	}
+	pathParts = pathParts[len(pathParts)-len(tmplParts):]
+	for i, part := range tmplParts {
+		if part == "{"+name+"}" {
+			return url.PathUnescape(pathParts[i])
+		}
+	}
+	return "", fmt.Errorf("path parameter %s not found in %s", name, pathTemplate)
+}
+
+// resolveJSONPointer returns the string form of the value at pointer within a JSON document.
+func resolveJSONPointer(body []byte, pointer string) (string, error) {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return "", fmt.Errorf("decoding response body: %w", err)
+	}
+
+	if pointer != "" {
+		for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+			token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+			switch v := doc.(type) {
+			case map[string]any:
+				next, ok := v[token]
+				if !ok {
+					return "", fmt.Errorf("pointer %s not found in response body", pointer)
+				}
+				doc = next
+			case []any:
+				i, err := strconv.Atoi(token)
+				if err != nil || i < 0 || i >= len(v) {
+					return "", fmt.Errorf("pointer %s not found in response body", pointer)
+				}
+				doc = v[i]
+			default:
+				return "", fmt.Errorf("pointer %s not found in response body", pointer)
+			}
+		}
+	}
+
+	switch v := doc.(type) {
+	case string:
+		return v, nil
+	case json.Number:
+		return v.String(), nil
+	case nil:
+		return "", nil
+	case map[string]any, []any:
+		data, err := json.Marshal(v)
+		return string(data), err
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
+
+func doRequest[T any](ctx context.Context, c *Client, method, path string, body any) (*Response[T], error) {
+	var bodyReader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("marshaling request body: %w", err)
+		}
+		bodyReader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+
+	if err := c.editRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("executing request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	result := &Response[T]{
+		StatusCode: resp.StatusCode,
+		Raw:        resp,
+	}
+
+	if resp.StatusCode >= 400 {
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		return result, fmt.Errorf("request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return result, fmt.Errorf("reading response: %w", err)
+	}
+	if len(bodyBytes) > 0 {
+		if err := c.decode(resp, bodyBytes, &result.Body); err != nil {
+			return result, fmt.Errorf("decoding response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// CreateUserResponse contains typed response data for CreateUser.
+type CreateUserResponse struct {
+	StatusCode int
+	JSON201    *User
+	Raw        *http.Response
+
+	bodyBytes []byte
+}
+
+// GetUserResponse contains typed response data for GetUser.
+type GetUserResponse struct {
+	StatusCode int
+	JSON200    *User
+	Raw        *http.Response
+
+	bodyBytes []byte
+}
+
+// ListUserOrdersResponse contains typed response data for ListUserOrders.
+type ListUserOrdersResponse struct {
+	StatusCode int
+	JSON200    *[]Order
+	Raw        *http.Response
+}
+
+func (c *Client) CreateUser(ctx context.Context, body NewUser) (*CreateUserResponse, error) {
+	path := "/users"
+
+	var bodyReader io.Reader
+	var contentType string
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("marshaling request body: %w", err)
+	}
+	bodyReader = bytes.NewReader(data)
+	contentType = "application/json"
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+path, bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	if contentType != "" {
+		httpReq.Header.Set("Content-Type", contentType)
+	}
+	httpReq.Header.Set("Accept", "application/json")
+
+	if err := c.editRequest(ctx, httpReq); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(ctx, OperationKeyCreateUser, httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("executing request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	result := &CreateUserResponse{
+		StatusCode: resp.StatusCode,
+		Raw:        resp,
+	}
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return result, fmt.Errorf("reading response: %w", err)
+	}
+	result.bodyBytes = bodyBytes
+
+	switch resp.StatusCode {
+	case 201:
+		var body User
+		if len(bodyBytes) > 0 {
+			if err := c.decode(resp, bodyBytes, &body); err != nil {
+				return result, fmt.Errorf("decoding response: %w", err)
+			}
+		}
+		result.JSON201 = &body
+	}
+
+	if resp.StatusCode >= 400 {
+		return result, fmt.Errorf("request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	return result, nil
+}
+
+func (c *Client) GetUser(ctx context.Context, userid int64) (*GetUserResponse, error) {
+	path := "/users/{userId}"
+	path = strings.Replace(path, "{userId}", fmt.Sprint(userid), 1)
+
+	var bodyReader io.Reader
+
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", c.baseURL+path, bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	httpReq.Header.Set("Accept", "application/json")
+
+	if err := c.editRequest(ctx, httpReq); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(ctx, OperationKeyGetUser, httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("executing request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	result := &GetUserResponse{
+		StatusCode: resp.StatusCode,
+		Raw:        resp,
+	}
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return result, fmt.Errorf("reading response: %w", err)
+	}
+	result.bodyBytes = bodyBytes
+
+	switch resp.StatusCode {
+	case 200:
+		var body User
+		if len(bodyBytes) > 0 {
+			if err := c.decode(resp, bodyBytes, &body); err != nil {
+				return result, fmt.Errorf("decoding response: %w", err)
+			}
+		}
+		result.JSON200 = &body
+	}
+
+	if resp.StatusCode >= 400 {
+		return result, fmt.Errorf("request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	return result, nil
+}
+
+func (c *Client) ListUserOrders(ctx context.Context, userid int64, params *ListUserOrdersParams) (*ListUserOrdersResponse, error) {
+	path := "/users/{userId}/orders"
+	path = strings.Replace(path, "{userId}", fmt.Sprint(userid), 1)
+	if params != nil {
+		var q queryBuilder
+		if params.Limit != nil {
+			q.add("limit", fmt.Sprint(*params.Limit), false, false)
+		}
+		if params.Status != nil {
+			q.add("status", fmt.Sprint(*params.Status), false, false)
+		}
+		if len(q) > 0 {
+			path += "?" + q.encode()
+		}
+	}
+
+	var bodyReader io.Reader
+
+	httpReq, err := http.NewRequestWithContext(ctx, "GET", c.baseURL+path, bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("creating request: %w", err)
+	}
+	httpReq.Header.Set("Accept", "application/json")
+
+	if err := c.editRequest(ctx, httpReq); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(ctx, OperationKeyListUserOrders, httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("executing request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	result := &ListUserOrdersResponse{
+		StatusCode: resp.StatusCode,
+		Raw:        resp,
+	}
+
+	bodyBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return result, fmt.Errorf("reading response: %w", err)
+	}
+
+	switch resp.StatusCode {
+	case 200:
+		var body []Order
+		if len(bodyBytes) > 0 {
+			if err := c.decode(resp, bodyBytes, &body); err != nil {
+				return result, fmt.Errorf("decoding response: %w", err)
+			}
+		}
+		result.JSON200 = &body
+	}
+
+	if resp.StatusCode >= 400 {
+		return result, fmt.Errorf("request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+	}
+
+	return result, nil
+}
+
+// FollowUser calls GetUser using the values declared by the "user" link.
+// Fetch the user that was just created.
+func (r *CreateUserResponse) FollowUser(ctx context.Context, c *Client) (*GetUserResponse, error) {
+	userIDArgRaw, err := resolveLinkExpression("$response.body#/id", r.Raw, r.bodyBytes, "/users")
+	if err != nil {
+		return nil, fmt.Errorf("resolving link parameter userId: %w", err)
+	}
+	userIDArgParsed, err := strconv.ParseInt(userIDArgRaw, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parsing link parameter userId: %w", err)
+	}
+	userIDArg := int64(userIDArgParsed)
+	return c.GetUser(ctx, userIDArg)
+}
+
+// FollowOrders calls ListUserOrders using the values declared by the "orders" link.
+func (r *CreateUserResponse) FollowOrders(ctx context.Context, c *Client) (*ListUserOrdersResponse, error) {
+	userIDArgRaw, err := resolveLinkExpression("$response.body#/id", r.Raw, r.bodyBytes, "/users")
+	if err != nil {
+		return nil, fmt.Errorf("resolving link parameter userId: %w", err)
+	}
+	userIDArgParsed, err := strconv.ParseInt(userIDArgRaw, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parsing link parameter userId: %w", err)
+	}
+	userIDArg := int64(userIDArgParsed)
+	params := &ListUserOrdersParams{}
+	limitArgRaw, err := resolveLinkExpression("10", r.Raw, r.bodyBytes, "/users")
+	if err != nil {
+		return nil, fmt.Errorf("resolving link parameter limit: %w", err)
+	}
+	limitArg, err := strconv.Atoi(limitArgRaw)
+	if err != nil {
+		return nil, fmt.Errorf("parsing link parameter limit: %w", err)
+	}
+	params.Limit = &limitArg
+	return c.ListUserOrders(ctx, userIDArg, params)
+}
+
+// FollowOrders calls ListUserOrders using the values declared by the "orders" link.
+func (r *GetUserResponse) FollowOrders(ctx context.Context, c *Client) (*ListUserOrdersResponse, error) {
+	userIDArgRaw, err := resolveLinkExpression("$request.path.userId", r.Raw, r.bodyBytes, "/users/{userId}")
+	if err != nil {
+		return nil, fmt.Errorf("resolving link parameter userId: %w", err)
+	}
+	userIDArgParsed, err := strconv.ParseInt(userIDArgRaw, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parsing link parameter userId: %w", err)
+	}
+	userIDArg := int64(userIDArgParsed)
+	return c.ListUserOrders(ctx, userIDArg, nil)
+}
+
+// FollowManager calls GetUser using the values declared by the "manager" link.
+func (r *GetUserResponse) FollowManager(ctx context.Context, c *Client) (*GetUserResponse, error) {
+	userIDArgRaw, err := resolveLinkExpression("$response.body#/managerId", r.Raw, r.bodyBytes, "/users/{userId}")
+	if err != nil {
+		return nil, fmt.Errorf("resolving link parameter userId: %w", err)
+	}
+	userIDArgParsed, err := strconv.ParseInt(userIDArgRaw, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parsing link parameter userId: %w", err)
+	}
+	userIDArg := int64(userIDArgParsed)
+	return c.GetUser(ctx, userIDArg)
+}
+
+type ListUserOrdersParams struct {
+	Limit  *int
+	Status *string
+}
@@ -0,0 +1,18 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+type NewUser struct {
+	Name string `json:"name"`
+}
+
+type User struct {
+	ID        int64  `json:"id"`
+	Name      string `json:"name"`
+	ManagerID *int64 `json:"managerId,omitempty"`
+}
+
+type Order struct {
+	ID    *string  `json:"id,omitempty"`
+	Total *float64 `json:"total,omitempty"`
+}
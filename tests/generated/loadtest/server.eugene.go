@@ -0,0 +1,182 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Writer writes Server-Sent Events to an HTTP response.
+type Writer struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+}
+
+// NewWriter creates a Writer from an echo.Context.
+func NewWriter(ctx echo.Context) (*Writer, error) {
+	w := ctx.Response().Writer
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		return nil, fmt.Errorf("streaming not supported")
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	return &Writer{w: w, flusher: flusher}, nil
+}
+
+// Send writes an event with optional type. Data is JSON-encoded.
+func (w *Writer) Send(eventType string, data any) error {
+	jsonData, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return w.SendRaw(eventType, jsonData)
+}
+
+// SendRaw writes raw data without JSON encoding.
+func (w *Writer) SendRaw(eventType string, data []byte) error {
+	if eventType != "" {
+		if _, err := fmt.Fprintf(w.w, "event: %s\n", eventType); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(w.w, "data: %s\n\n", data); err != nil {
+		return err
+	}
+	w.flusher.Flush()
+	return nil
+}
+
+type GetPetQueryParams struct {
+	Fields  *[]string `query:"fields"`
+	Verbose *bool     `query:"verbose"`
+}
+
+type SearchPetsQueryParams struct {
+	Q string `query:"q"`
+}
+
+type ServerInterface interface {
+	// CreatePet
+	CreatePet(ctx echo.Context) error
+	// GetPet
+	GetPet(ctx echo.Context, petID string, params GetPetQueryParams) error
+	// DeletePet
+	DeletePet(ctx echo.Context, petID string) error
+	// GetPhoto
+	GetPhoto(ctx echo.Context, petID string, photoID string) error
+	// SearchPets
+	SearchPets(ctx echo.Context, params SearchPetsQueryParams) error
+	// StreamEvents (streaming)
+	StreamEvents(ctx echo.Context) error
+}
+
+// UnimplementedServer answers every operation with 501 Not Implemented.
+// Embed it to serve only some operations. Implementations that do not embed
+// it fail to compile, naming the method, when the spec gains an operation.
+type UnimplementedServer struct{}
+
+func (UnimplementedServer) CreatePet(ctx echo.Context) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) GetPet(ctx echo.Context, petID string, params GetPetQueryParams) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) DeletePet(ctx echo.Context, petID string) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) GetPhoto(ctx echo.Context, petID string, photoID string) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) SearchPets(ctx echo.Context, params SearchPetsQueryParams) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) StreamEvents(ctx echo.Context) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+var _ ServerInterface = (*UnimplementedServer)(nil)
+
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+func (w *ServerInterfaceWrapper) CreatePet(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "createPet", "/pets")))
+	limitBody(ctx.Response(), ctx.Request(), MaxBodySize)
+	return w.Handler.CreatePet(ctx)
+}
+
+func (w *ServerInterfaceWrapper) GetPet(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "getPet", "/pets/{petId}")))
+	petID := ctx.Param("petId")
+	var params GetPetQueryParams
+	if err := (&echo.DefaultBinder{}).BindQueryParams(ctx, &params); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid query parameters")
+	}
+	if ctx.QueryParam("verbose") == "" {
+		params.Verbose = nil
+	}
+	return w.Handler.GetPet(ctx, petID, params)
+}
+
+func (w *ServerInterfaceWrapper) DeletePet(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "deletePet", "/pets/{petId}")))
+	petID := ctx.Param("petId")
+	return w.Handler.DeletePet(ctx, petID)
+}
+
+func (w *ServerInterfaceWrapper) GetPhoto(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "getPhoto", "/pets/{petId}/photos/{photoId}")))
+	petID := ctx.Param("petId")
+	photoID := ctx.Param("photoId")
+	return w.Handler.GetPhoto(ctx, petID, photoID)
+}
+
+func (w *ServerInterfaceWrapper) SearchPets(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "searchPets", "/search")))
+	var params SearchPetsQueryParams
+	if err := (&echo.DefaultBinder{}).BindQueryParams(ctx, &params); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid query parameters")
+	}
+	return w.Handler.SearchPets(ctx, params)
+}
+
+func (w *ServerInterfaceWrapper) StreamEvents(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "streamEvents", "/events")))
+	return w.Handler.StreamEvents(ctx)
+}
+
+func RegisterHandlers(router Router, si ServerInterface) {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+
+	router.POST("/pets", wrapper.CreatePet)
+	router.GET("/pets/:petId", wrapper.GetPet)
+	router.DELETE("/pets/:petId", wrapper.DeletePet)
+	router.GET("/pets/:petId/photos/:photoId", wrapper.GetPhoto)
+	router.GET("/search", wrapper.SearchPets)
+	router.GET("/events", wrapper.StreamEvents)
+}
+
+func RegisterHandlersWithBaseURL(router Router, si ServerInterface, baseURL string) {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+
+	router.POST(baseURL+"/pets", wrapper.CreatePet)
+	router.GET(baseURL+"/pets/:petId", wrapper.GetPet)
+	router.DELETE(baseURL+"/pets/:petId", wrapper.DeletePet)
+	router.GET(baseURL+"/pets/:petId/photos/:photoId", wrapper.GetPhoto)
+	router.GET(baseURL+"/search", wrapper.SearchPets)
+	router.GET(baseURL+"/events", wrapper.StreamEvents)
+}
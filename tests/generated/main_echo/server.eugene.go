@@ -0,0 +1,195 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"mime/multipart"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type EchoFormFormRequest struct {
+	Field1 string   `form:"field1"`
+	Field2 string   `form:"field2"`
+	Tags   []string `form:"tags"`
+}
+
+type EchoMultipartMultipartRequest struct {
+	File        *multipart.FileHeader `form:"file"`
+	Description string                `form:"description"`
+}
+
+type GetItemQueryParams struct {
+	Filter *string `query:"filter"`
+}
+
+type ServerInterface interface {
+	// EchoJSON
+	EchoJSON(ctx echo.Context) error
+	// EchoForm
+	EchoForm(ctx echo.Context, req EchoFormFormRequest) error
+	// EchoMultipart
+	EchoMultipart(ctx echo.Context, req EchoMultipartMultipartRequest) error
+	// GetItem
+	GetItem(ctx echo.Context, id string, params GetItemQueryParams) error
+	// CreateResource
+	CreateResource(ctx echo.Context) error
+	// DeleteResource
+	DeleteResource(ctx echo.Context, id string) error
+	// GetSession
+	GetSession(ctx echo.Context) error
+	// GetSecureData
+	GetSecureData(ctx echo.Context) error
+	// CreateShape
+	CreateShape(ctx echo.Context) error
+}
+
+// UnimplementedServer answers every operation with 501 Not Implemented.
+// Embed it to serve only some operations. Implementations that do not embed
+// it fail to compile, naming the method, when the spec gains an operation.
+type UnimplementedServer struct{}
+
+func (UnimplementedServer) EchoJSON(ctx echo.Context) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) EchoForm(ctx echo.Context, req EchoFormFormRequest) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) EchoMultipart(ctx echo.Context, req EchoMultipartMultipartRequest) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) GetItem(ctx echo.Context, id string, params GetItemQueryParams) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) CreateResource(ctx echo.Context) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) DeleteResource(ctx echo.Context, id string) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) GetSession(ctx echo.Context) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) GetSecureData(ctx echo.Context) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) CreateShape(ctx echo.Context) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+var _ ServerInterface = (*UnimplementedServer)(nil)
+
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+func (w *ServerInterfaceWrapper) EchoJSON(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "echoJSON", "/echo/json")))
+	limitBody(ctx.Response(), ctx.Request(), MaxBodySize)
+	return w.Handler.EchoJSON(ctx)
+}
+
+func (w *ServerInterfaceWrapper) EchoForm(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "echoForm", "/echo/form")))
+	limitBody(ctx.Response(), ctx.Request(), MaxBodySize)
+	var req EchoFormFormRequest
+	if err := ctx.Request().ParseForm(); err != nil {
+		return echo.NewHTTPError(BodyErrorStatus(err), "failed to parse form")
+	}
+	req.Field1 = ctx.FormValue("field1")
+	req.Field2 = ctx.FormValue("field2")
+	req.Tags = ctx.Request().Form["tags"]
+	return w.Handler.EchoForm(ctx, req)
+}
+
+func (w *ServerInterfaceWrapper) EchoMultipart(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "echoMultipart", "/echo/multipart")))
+	limitBody(ctx.Response(), ctx.Request(), MaxBodySize)
+	var req EchoMultipartMultipartRequest
+	if err := ctx.Request().ParseMultipartForm(32 << 20); err != nil {
+		return echo.NewHTTPError(BodyErrorStatus(err), "failed to parse multipart form")
+	}
+	if file, err := ctx.FormFile("file"); err == nil {
+		req.File = file
+	}
+	req.Description = ctx.FormValue("description")
+	return w.Handler.EchoMultipart(ctx, req)
+}
+
+func (w *ServerInterfaceWrapper) GetItem(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "getItem", "/items/{id}")))
+	id := ctx.Param("id")
+	var params GetItemQueryParams
+	if err := (&echo.DefaultBinder{}).BindQueryParams(ctx, &params); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid query parameters")
+	}
+	if ctx.QueryParam("filter") == "" {
+		params.Filter = nil
+	}
+	return w.Handler.GetItem(ctx, id, params)
+}
+
+func (w *ServerInterfaceWrapper) CreateResource(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "createResource", "/resources")))
+	limitBody(ctx.Response(), ctx.Request(), MaxBodySize)
+	return w.Handler.CreateResource(ctx)
+}
+
+func (w *ServerInterfaceWrapper) DeleteResource(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "deleteResource", "/resources/{id}")))
+	id := ctx.Param("id")
+	return w.Handler.DeleteResource(ctx, id)
+}
+
+func (w *ServerInterfaceWrapper) GetSession(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "getSession", "/session")))
+	return w.Handler.GetSession(ctx)
+}
+
+func (w *ServerInterfaceWrapper) GetSecureData(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "getSecureData", "/secure/data")))
+	return w.Handler.GetSecureData(ctx)
+}
+
+func (w *ServerInterfaceWrapper) CreateShape(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "createShape", "/shapes")))
+	limitBody(ctx.Response(), ctx.Request(), MaxBodySize)
+	return w.Handler.CreateShape(ctx)
+}
+
+func RegisterHandlers(router Router, si ServerInterface) {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+
+	router.POST("/echo/json", wrapper.EchoJSON)
+	router.POST("/echo/form", wrapper.EchoForm)
+	router.POST("/echo/multipart", wrapper.EchoMultipart)
+	router.GET("/items/:id", wrapper.GetItem)
+	router.POST("/resources", wrapper.CreateResource)
+	router.DELETE("/resources/:id", wrapper.DeleteResource)
+	router.GET("/session", wrapper.GetSession)
+	router.GET("/secure/data", wrapper.GetSecureData)
+	router.POST("/shapes", wrapper.CreateShape)
+}
+
+func RegisterHandlersWithBaseURL(router Router, si ServerInterface, baseURL string) {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+
+	router.POST(baseURL+"/echo/json", wrapper.EchoJSON)
+	router.POST(baseURL+"/echo/form", wrapper.EchoForm)
+	router.POST(baseURL+"/echo/multipart", wrapper.EchoMultipart)
+	router.GET(baseURL+"/items/:id", wrapper.GetItem)
+	router.POST(baseURL+"/resources", wrapper.CreateResource)
+	router.DELETE(baseURL+"/resources/:id", wrapper.DeleteResource)
+	router.GET(baseURL+"/session", wrapper.GetSession)
+	router.GET(baseURL+"/secure/data", wrapper.GetSecureData)
+	router.POST(baseURL+"/shapes", wrapper.CreateShape)
+}
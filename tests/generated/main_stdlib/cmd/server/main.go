@@ -0,0 +1,94 @@
+// Code generated by eugene as a starting point for the E2E Round-trip Test server.
+// The marker below keeps eugene from overwriting this file; remove it to regenerate.
+//eugene:keep
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	gen "github.com/kolah/eugene/tests/generated/main_stdlib"
+)
+
+func main() {
+	port := os.Getenv("PORT")
+	if port == "" {
+		port = "8080"
+	}
+	addr := flag.String("addr", ":"+port, "address to listen on, :$PORT by default")
+	shutdownTimeout := flag.Duration("shutdown-timeout", 15*time.Second, "time given to requests in flight to finish on shutdown")
+	flag.Parse()
+
+	logger := slog.New(slog.NewTextHandler(os.Stderr, nil))
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if err := run(ctx, *addr, *shutdownTimeout, logger); err != nil {
+		logger.Error("server failed", "error", err)
+		os.Exit(1)
+	}
+}
+
+// run serves the API on addr until ctx is done, then shuts down gracefully:
+// /readyz starts failing, and requests in flight get shutdownTimeout to
+// finish.
+func run(ctx context.Context, addr string, shutdownTimeout time.Duration, logger *slog.Logger) error {
+	// Replace the Unimplemented server, which answers 501, with yours.
+	var service gen.ServerInterface = gen.UnimplementedServer{}
+
+	var ready atomic.Bool
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("GET /readyz", func(w http.ResponseWriter, r *http.Request) {
+		if !ready.Load() {
+			http.Error(w, "not ready", http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.Handle("/", apiHandler(service))
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	served := make(chan error, 1)
+	go func() {
+		served <- server.Serve(listener)
+	}()
+	ready.Store(true)
+	logger.Info("listening", "addr", listener.Addr().String())
+
+	select {
+	case err := <-served:
+		return err
+	case <-ctx.Done():
+	}
+	ready.Store(false)
+	logger.Info("shutting down")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+	if err := <-served; !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+func apiHandler(service gen.ServerInterface) http.Handler {
+	return gen.Handler(service)
+}
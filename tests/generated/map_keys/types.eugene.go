@@ -0,0 +1,18 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"github.com/google/uuid"
+)
+
+type Player struct {
+	Name string `json:"name"`
+}
+
+type PlayersByID map[uuid.UUID]Player
+
+type Leaderboard struct {
+	ScoresByRank map[int64]int `json:"scoresByRank,omitempty"`
+	Players      PlayersByID   `json:"players,omitempty"`
+}
@@ -1,13 +1,35 @@
 // Code generated by eugene. DO NOT EDIT.
+
 package gen
 
 import (
+	"mime"
 	"mime/multipart"
 	"net/http"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 )
 
+// matchesMediaType reports whether contentType is allowed by an encoding
+// contentType list such as "image/png, image/*".
+func matchesMediaType(contentType, allowed string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	for _, a := range strings.Split(allowed, ",") {
+		a = strings.TrimSpace(a)
+		if a == "*/*" || strings.EqualFold(a, mediaType) {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(a, "/*"); ok && strings.HasPrefix(mediaType, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
 type UploadFileMultipartRequest struct {
 	File        *multipart.FileHeader `form:"file"`
 	Description string                `form:"description"`
@@ -19,18 +41,34 @@ type ServerInterface interface {
 	UploadFile(ctx echo.Context, req UploadFileMultipartRequest) error
 }
 
+// UnimplementedServer answers every operation with 501 Not Implemented.
+// Embed it to serve only some operations. Implementations that do not embed
+// it fail to compile, naming the method, when the spec gains an operation.
+type UnimplementedServer struct{}
+
+func (UnimplementedServer) UploadFile(ctx echo.Context, req UploadFileMultipartRequest) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+var _ ServerInterface = (*UnimplementedServer)(nil)
+
 type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
 func (w *ServerInterfaceWrapper) UploadFile(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "uploadFile", "/upload")))
+	limitBody(ctx.Response(), ctx.Request(), MaxBodySize)
 	var req UploadFileMultipartRequest
 	if err := ctx.Request().ParseMultipartForm(32 << 20); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, "failed to parse multipart form")
+		return echo.NewHTTPError(BodyErrorStatus(err), "failed to parse multipart form")
 	}
 	if file, err := ctx.FormFile("file"); err == nil {
 		req.File = file
 	}
+	if req.File != nil && !matchesMediaType(req.File.Header.Get("Content-Type"), "image/png, image/jpeg") {
+		return echo.NewHTTPError(http.StatusUnsupportedMediaType, "unsupported content type for file")
+	}
 	req.Description = ctx.FormValue("description")
 	if ctx.Request().MultipartForm != nil && ctx.Request().MultipartForm.Value != nil {
 		req.Tags = ctx.Request().MultipartForm.Value["tags"]
@@ -1,4 +1,5 @@
 // Code generated by eugene. DO NOT EDIT.
+
 package gen
 
 import (
@@ -71,35 +72,63 @@ type ServerInterface interface {
 	AdvancedSearch(w http.ResponseWriter, r *http.Request, query *AdvancedSearchQuery)
 }
 
+// UnimplementedServer answers every operation with 501 Not Implemented.
+// Embed it to serve only some operations. Implementations that do not embed
+// it fail to compile, naming the method, when the spec gains an operation.
+type UnimplementedServer struct{}
+
+func (UnimplementedServer) SearchItems(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) StreamEvents(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) ListItems(w http.ResponseWriter, r *http.Request, params ListItemsQueryParams) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) StreamSse(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) StreamJsonl(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) AdvancedSearch(w http.ResponseWriter, r *http.Request, query *AdvancedSearchQuery) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+var _ ServerInterface = (*UnimplementedServer)(nil)
+
 type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
 func (w *ServerInterfaceWrapper) SearchItems(rw http.ResponseWriter, r *http.Request) {
-	w.Handler.SearchItems(rw, r)
+	(&searchWrapper{Handler: w.Handler}).SearchItems(rw, r)
 }
 
 func (w *ServerInterfaceWrapper) StreamEvents(rw http.ResponseWriter, r *http.Request) {
-	w.Handler.StreamEvents(rw, r)
+	(&eventsWrapper{Handler: w.Handler}).StreamEvents(rw, r)
 }
 
 func (w *ServerInterfaceWrapper) ListItems(rw http.ResponseWriter, r *http.Request) {
-	var params ListItemsQueryParams
-	if v := r.URL.Query().Get("filter"); v != "" {
-		params.Filter = &v
-	}
-	w.Handler.ListItems(rw, r, params)
+	(&itemsWrapper{Handler: w.Handler}).ListItems(rw, r)
 }
 
 func (w *ServerInterfaceWrapper) StreamSse(rw http.ResponseWriter, r *http.Request) {
-	w.Handler.StreamSse(rw, r)
+	(&eventsWrapper{Handler: w.Handler}).StreamSse(rw, r)
 }
 
 func (w *ServerInterfaceWrapper) StreamJsonl(rw http.ResponseWriter, r *http.Request) {
-	w.Handler.StreamJsonl(rw, r)
+	(&eventsWrapper{Handler: w.Handler}).StreamJsonl(rw, r)
 }
 
 func (w *ServerInterfaceWrapper) AdvancedSearch(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "advancedSearch", "/advanced-search"))
 	var query AdvancedSearchQuery
 	if err := decodeQueryString(r, &query); err != nil {
 		http.Error(rw, "invalid query parameters", http.StatusBadRequest)
@@ -108,6 +137,114 @@ func (w *ServerInterfaceWrapper) AdvancedSearch(rw http.ResponseWriter, r *http.
 	w.Handler.AdvancedSearch(rw, r, &query)
 }
 
+// SearchHandler serves the operations tagged "search". ServerInterface
+// implementations satisfy it.
+type SearchHandler interface {
+	// SearchItems - Search using QUERY method
+	SearchItems(w http.ResponseWriter, r *http.Request)
+}
+
+var _ SearchHandler = ServerInterface(nil)
+
+type searchWrapper struct {
+	Handler SearchHandler
+}
+
+func (w *searchWrapper) SearchItems(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "searchItems", "/search"))
+	limitBody(rw, r, MaxBodySize)
+	w.Handler.SearchItems(rw, r)
+}
+
+// MountSearch registers the operations tagged "search" on r, which can be
+// an existing router, a group or a sub-router created with r.Route.
+func MountSearch(r chi.Router, h SearchHandler) {
+	mountSearch(r, "", h)
+}
+
+func mountSearch(r chi.Router, baseURL string, h SearchHandler) {
+	wrapper := &searchWrapper{Handler: h}
+	r.Method("QUERY", baseURL+"/search", http.HandlerFunc(wrapper.SearchItems))
+}
+
+// EventsHandler serves the operations tagged "events". ServerInterface
+// implementations satisfy it.
+type EventsHandler interface {
+	// StreamEvents - Stream events via SSE (streaming)
+	StreamEvents(w http.ResponseWriter, r *http.Request)
+	// StreamSse - Stream data via SSE with itemSchema (streaming)
+	StreamSse(w http.ResponseWriter, r *http.Request)
+	// StreamJsonl - Stream data via JSON Lines
+	StreamJsonl(w http.ResponseWriter, r *http.Request)
+}
+
+var _ EventsHandler = ServerInterface(nil)
+
+type eventsWrapper struct {
+	Handler EventsHandler
+}
+
+func (w *eventsWrapper) StreamEvents(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "streamEvents", "/events"))
+	w.Handler.StreamEvents(rw, r)
+}
+
+func (w *eventsWrapper) StreamSse(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "streamSSE", "/stream/sse"))
+	w.Handler.StreamSse(rw, r)
+}
+
+func (w *eventsWrapper) StreamJsonl(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "streamJSONL", "/stream/jsonl"))
+	w.Handler.StreamJsonl(rw, r)
+}
+
+// MountEvents registers the operations tagged "events" on r, which can be
+// an existing router, a group or a sub-router created with r.Route.
+func MountEvents(r chi.Router, h EventsHandler) {
+	mountEvents(r, "", h)
+}
+
+func mountEvents(r chi.Router, baseURL string, h EventsHandler) {
+	wrapper := &eventsWrapper{Handler: h}
+	r.Method("GET", baseURL+"/events", http.HandlerFunc(wrapper.StreamEvents))
+	r.Method("GET", baseURL+"/stream/sse", http.HandlerFunc(wrapper.StreamSse))
+	r.Method("GET", baseURL+"/stream/jsonl", http.HandlerFunc(wrapper.StreamJsonl))
+}
+
+// ItemsHandler serves the operations tagged "items". ServerInterface
+// implementations satisfy it.
+type ItemsHandler interface {
+	// ListItems - List items with query parameter
+	ListItems(w http.ResponseWriter, r *http.Request, params ListItemsQueryParams)
+}
+
+var _ ItemsHandler = ServerInterface(nil)
+
+type itemsWrapper struct {
+	Handler ItemsHandler
+}
+
+func (w *itemsWrapper) ListItems(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "listItems", "/items"))
+	var params ListItemsQueryParams
+	if v := r.URL.Query().Get("filter"); v != "" {
+		params.Filter = &v
+	}
+	w.Handler.ListItems(rw, r, params)
+}
+
+// MountItems registers the operations tagged "items" on r, which can be
+// an existing router, a group or a sub-router created with r.Route.
+func MountItems(r chi.Router, h ItemsHandler) {
+	mountItems(r, "", h)
+}
+
+func mountItems(r chi.Router, baseURL string, h ItemsHandler) {
+	wrapper := &itemsWrapper{Handler: h}
+	r.Method("GET", baseURL+"/items", http.HandlerFunc(wrapper.ListItems))
+}
+
 func decodeQueryString(r *http.Request, v any) error {
 	data := make(map[string]any)
 	for key, values := range r.URL.Query() {
@@ -140,13 +277,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		r.Use(m)
 	}
 
+	mountSearch(r, options.BaseURL, si)
+	mountEvents(r, options.BaseURL, si)
+	mountItems(r, options.BaseURL, si)
+
 	wrapper := &ServerInterfaceWrapper{Handler: si}
 
-	r.Method("QUERY", options.BaseURL+"/search", http.HandlerFunc(wrapper.SearchItems))
-	r.Method("GET", options.BaseURL+"/events", http.HandlerFunc(wrapper.StreamEvents))
-	r.Method("GET", options.BaseURL+"/items", http.HandlerFunc(wrapper.ListItems))
-	r.Method("GET", options.BaseURL+"/stream/sse", http.HandlerFunc(wrapper.StreamSse))
-	r.Method("GET", options.BaseURL+"/stream/jsonl", http.HandlerFunc(wrapper.StreamJsonl))
 	r.Method("GET", options.BaseURL+"/advanced-search", http.HandlerFunc(wrapper.AdvancedSearch))
 
 	return r
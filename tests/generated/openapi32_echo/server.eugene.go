@@ -1,4 +1,5 @@
 // Code generated by eugene. DO NOT EDIT.
+
 package gen
 
 import (
@@ -72,35 +73,76 @@ type ServerInterface interface {
 	AdvancedSearch(ctx echo.Context, query *AdvancedSearchQuery) error
 }
 
+// UnimplementedServer answers every operation with 501 Not Implemented.
+// Embed it to serve only some operations. Implementations that do not embed
+// it fail to compile, naming the method, when the spec gains an operation.
+type UnimplementedServer struct{}
+
+func (UnimplementedServer) SearchItems(ctx echo.Context) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) StreamEvents(ctx echo.Context) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) ListItems(ctx echo.Context, params ListItemsQueryParams) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) StreamSse(ctx echo.Context) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) StreamJsonl(ctx echo.Context) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) AdvancedSearch(ctx echo.Context, query *AdvancedSearchQuery) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+var _ ServerInterface = (*UnimplementedServer)(nil)
+
 type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
 func (w *ServerInterfaceWrapper) SearchItems(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "searchItems", "/search")))
+	limitBody(ctx.Response(), ctx.Request(), MaxBodySize)
 	return w.Handler.SearchItems(ctx)
 }
 
 func (w *ServerInterfaceWrapper) StreamEvents(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "streamEvents", "/events")))
 	return w.Handler.StreamEvents(ctx)
 }
 
 func (w *ServerInterfaceWrapper) ListItems(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "listItems", "/items")))
 	var params ListItemsQueryParams
 	if err := (&echo.DefaultBinder{}).BindQueryParams(ctx, &params); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid query parameters")
 	}
+	if ctx.QueryParam("filter") == "" {
+		params.Filter = nil
+	}
 	return w.Handler.ListItems(ctx, params)
 }
 
 func (w *ServerInterfaceWrapper) StreamSse(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "streamSSE", "/stream/sse")))
 	return w.Handler.StreamSse(ctx)
 }
 
 func (w *ServerInterfaceWrapper) StreamJsonl(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "streamJSONL", "/stream/jsonl")))
 	return w.Handler.StreamJsonl(ctx)
 }
 
 func (w *ServerInterfaceWrapper) AdvancedSearch(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "advancedSearch", "/advanced-search")))
 	var query AdvancedSearchQuery
 	if err := ctx.Bind(&query); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid query parameters")
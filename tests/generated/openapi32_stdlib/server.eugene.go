@@ -1,4 +1,5 @@
 // Code generated by eugene. DO NOT EDIT.
+
 package gen
 
 import (
@@ -69,19 +70,54 @@ type ServerInterface interface {
 	AdvancedSearch(w http.ResponseWriter, r *http.Request, query *AdvancedSearchQuery)
 }
 
+// UnimplementedServer answers every operation with 501 Not Implemented.
+// Embed it to serve only some operations. Implementations that do not embed
+// it fail to compile, naming the method, when the spec gains an operation.
+type UnimplementedServer struct{}
+
+func (UnimplementedServer) SearchItems(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) StreamEvents(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) ListItems(w http.ResponseWriter, r *http.Request, params ListItemsQueryParams) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) StreamSse(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) StreamJsonl(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) AdvancedSearch(w http.ResponseWriter, r *http.Request, query *AdvancedSearchQuery) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+var _ ServerInterface = (*UnimplementedServer)(nil)
+
 type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
 func (w *ServerInterfaceWrapper) SearchItems(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "searchItems", "/search"))
+	limitBody(rw, r, MaxBodySize)
 	w.Handler.SearchItems(rw, r)
 }
 
 func (w *ServerInterfaceWrapper) StreamEvents(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "streamEvents", "/events"))
 	w.Handler.StreamEvents(rw, r)
 }
 
 func (w *ServerInterfaceWrapper) ListItems(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "listItems", "/items"))
 	var params ListItemsQueryParams
 	if v := r.URL.Query().Get("filter"); v != "" {
 		params.Filter = &v
@@ -90,14 +126,17 @@ func (w *ServerInterfaceWrapper) ListItems(rw http.ResponseWriter, r *http.Reque
 }
 
 func (w *ServerInterfaceWrapper) StreamSse(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "streamSSE", "/stream/sse"))
 	w.Handler.StreamSse(rw, r)
 }
 
 func (w *ServerInterfaceWrapper) StreamJsonl(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "streamJSONL", "/stream/jsonl"))
 	w.Handler.StreamJsonl(rw, r)
 }
 
 func (w *ServerInterfaceWrapper) AdvancedSearch(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "advancedSearch", "/advanced-search"))
 	var query AdvancedSearchQuery
 	if err := decodeQueryString(r, &query); err != nil {
 		http.Error(rw, "invalid query parameters", http.StatusBadRequest)
@@ -1,4 +1,5 @@
 // Code generated by eugene. DO NOT EDIT.
+
 package gen
 
 import (
@@ -17,16 +18,34 @@ type ServerInterface interface {
 	GetItem(ctx echo.Context, id string, params GetItemQueryParams) error
 }
 
+// UnimplementedServer answers every operation with 501 Not Implemented.
+// Embed it to serve only some operations. Implementations that do not embed
+// it fail to compile, naming the method, when the spec gains an operation.
+type UnimplementedServer struct{}
+
+func (UnimplementedServer) GetItem(ctx echo.Context, id string, params GetItemQueryParams) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+var _ ServerInterface = (*UnimplementedServer)(nil)
+
 type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
 func (w *ServerInterfaceWrapper) GetItem(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "getItem", "/items/{id}")))
 	id := ctx.Param("id")
 	var params GetItemQueryParams
 	if err := (&echo.DefaultBinder{}).BindQueryParams(ctx, &params); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid query parameters")
 	}
+	if ctx.QueryParam("filter") == "" {
+		params.Filter = nil
+	}
+	if ctx.QueryParam("limit") == "" {
+		params.Limit = nil
+	}
 	return w.Handler.GetItem(ctx, id, params)
 }
 
@@ -1,4 +1,5 @@
 // Code generated by eugene. DO NOT EDIT.
+
 package gen
 
 import (
@@ -24,23 +25,47 @@ type ServerInterface interface {
 	CreateSearch(ctx echo.Context, params CreateSearchQueryParams) error
 }
 
+// UnimplementedServer answers every operation with 501 Not Implemented.
+// Embed it to serve only some operations. Implementations that do not embed
+// it fail to compile, naming the method, when the spec gains an operation.
+type UnimplementedServer struct{}
+
+func (UnimplementedServer) SearchItems(ctx echo.Context, params SearchItemsQueryParams) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) CreateSearch(ctx echo.Context, params CreateSearchQueryParams) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+var _ ServerInterface = (*UnimplementedServer)(nil)
+
 type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
 func (w *ServerInterfaceWrapper) SearchItems(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "searchItems", "/search")))
 	var params SearchItemsQueryParams
 	if err := (&echo.DefaultBinder{}).BindQueryParams(ctx, &params); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid query parameters")
 	}
+	if ctx.QueryParam("limit") == "" {
+		params.Limit = nil
+	}
 	return w.Handler.SearchItems(ctx, params)
 }
 
 func (w *ServerInterfaceWrapper) CreateSearch(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "createSearch", "/search")))
+	limitBody(ctx.Response(), ctx.Request(), MaxBodySize)
 	var params CreateSearchQueryParams
 	if err := (&echo.DefaultBinder{}).BindQueryParams(ctx, &params); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid query parameters")
 	}
+	if ctx.QueryParam("limit") == "" {
+		params.Limit = nil
+	}
 	return w.Handler.CreateSearch(ctx, params)
 }
 
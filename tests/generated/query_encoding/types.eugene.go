@@ -0,0 +1,13 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+type SearchEcho struct {
+	Q         string   `json:"q"`
+	Path      *string  `json:"path,omitempty"`
+	Filter    *string  `json:"filter,omitempty"`
+	FilterSet *bool    `json:"filterSet,omitempty"`
+	Tag       []string `json:"tag,omitempty"`
+	Limit     *int     `json:"limit,omitempty"`
+	RawQuery  string   `json:"rawQuery"`
+}
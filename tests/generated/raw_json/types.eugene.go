@@ -0,0 +1,16 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"encoding/json"
+)
+
+type VendorPayload = json.RawMessage
+
+type WebhookEvent struct {
+	Vendor      string            `json:"vendor"`
+	Payload     VendorPayload     `json:"payload"`
+	Metadata    json.RawMessage   `json:"metadata,omitempty"`
+	Attachments []json.RawMessage `json:"attachments,omitempty"`
+}
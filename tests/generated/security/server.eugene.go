@@ -1,7 +1,10 @@
 // Code generated by eugene. DO NOT EDIT.
+
 package gen
 
 import (
+	"net/http"
+
 	"github.com/labstack/echo/v4"
 )
 
@@ -14,28 +17,77 @@ type ServerInterface interface {
 	AdminEndpoint(ctx echo.Context) error
 	// APIEndpoint
 	APIEndpoint(ctx echo.Context) error
+	// ReportsEndpoint
+	ReportsEndpoint(ctx echo.Context) error
+	// InheritedEndpoint
+	InheritedEndpoint(ctx echo.Context) error
+}
+
+// UnimplementedServer answers every operation with 501 Not Implemented.
+// Embed it to serve only some operations. Implementations that do not embed
+// it fail to compile, naming the method, when the spec gains an operation.
+type UnimplementedServer struct{}
+
+func (UnimplementedServer) PublicEndpoint(ctx echo.Context) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) ProtectedEndpoint(ctx echo.Context) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) AdminEndpoint(ctx echo.Context) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) APIEndpoint(ctx echo.Context) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) ReportsEndpoint(ctx echo.Context) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) InheritedEndpoint(ctx echo.Context) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
 }
 
+var _ ServerInterface = (*UnimplementedServer)(nil)
+
 type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
 func (w *ServerInterfaceWrapper) PublicEndpoint(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "publicEndpoint", "/public")))
 	return w.Handler.PublicEndpoint(ctx)
 }
 
 func (w *ServerInterfaceWrapper) ProtectedEndpoint(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "protectedEndpoint", "/protected")))
 	return w.Handler.ProtectedEndpoint(ctx)
 }
 
 func (w *ServerInterfaceWrapper) AdminEndpoint(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "adminEndpoint", "/admin")))
 	return w.Handler.AdminEndpoint(ctx)
 }
 
 func (w *ServerInterfaceWrapper) APIEndpoint(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "apiEndpoint", "/api")))
 	return w.Handler.APIEndpoint(ctx)
 }
 
+func (w *ServerInterfaceWrapper) ReportsEndpoint(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "reportsEndpoint", "/reports")))
+	return w.Handler.ReportsEndpoint(ctx)
+}
+
+func (w *ServerInterfaceWrapper) InheritedEndpoint(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "inheritedEndpoint", "/inherited")))
+	return w.Handler.InheritedEndpoint(ctx)
+}
+
 func RegisterHandlers(router Router, si ServerInterface) {
 	wrapper := &ServerInterfaceWrapper{Handler: si}
 
@@ -43,6 +95,8 @@ func RegisterHandlers(router Router, si ServerInterface) {
 	router.GET("/protected", wrapper.ProtectedEndpoint)
 	router.GET("/admin", wrapper.AdminEndpoint)
 	router.GET("/api", wrapper.APIEndpoint)
+	router.GET("/reports", wrapper.ReportsEndpoint)
+	router.GET("/inherited", wrapper.InheritedEndpoint)
 }
 
 func RegisterHandlersWithBaseURL(router Router, si ServerInterface, baseURL string) {
@@ -52,4 +106,6 @@ func RegisterHandlersWithBaseURL(router Router, si ServerInterface, baseURL stri
 	router.GET(baseURL+"/protected", wrapper.ProtectedEndpoint)
 	router.GET(baseURL+"/admin", wrapper.AdminEndpoint)
 	router.GET(baseURL+"/api", wrapper.APIEndpoint)
+	router.GET(baseURL+"/reports", wrapper.ReportsEndpoint)
+	router.GET(baseURL+"/inherited", wrapper.InheritedEndpoint)
 }
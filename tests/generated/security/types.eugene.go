@@ -1,2 +1,3 @@
 // Code generated by eugene. DO NOT EDIT.
+
 package gen
@@ -1,4 +1,5 @@
 // Code generated by eugene. DO NOT EDIT.
+
 package gen
 
 import (
@@ -24,31 +25,68 @@ type ServerInterface interface {
 	DeleteItem(ctx echo.Context) error
 }
 
+// UnimplementedServer answers every operation with 501 Not Implemented.
+// Embed it to serve only some operations. Implementations that do not embed
+// it fail to compile, naming the method, when the spec gains an operation.
+type UnimplementedServer struct{}
+
+func (UnimplementedServer) ListItems(ctx echo.Context, params ListItemsQueryParams) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) CreateItem(ctx echo.Context) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) GetItem(ctx echo.Context) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) UpdateItem(ctx echo.Context) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) DeleteItem(ctx echo.Context) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+var _ ServerInterface = (*UnimplementedServer)(nil)
+
 type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
 func (w *ServerInterfaceWrapper) ListItems(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "listItems", "/items")))
 	var params ListItemsQueryParams
 	if err := (&echo.DefaultBinder{}).BindQueryParams(ctx, &params); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid query parameters")
 	}
+	if ctx.QueryParam("limit") == "" {
+		params.Limit = nil
+	}
 	return w.Handler.ListItems(ctx, params)
 }
 
 func (w *ServerInterfaceWrapper) CreateItem(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "createItem", "/items")))
+	limitBody(ctx.Response(), ctx.Request(), MaxBodySize)
 	return w.Handler.CreateItem(ctx)
 }
 
 func (w *ServerInterfaceWrapper) GetItem(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "getItem", "/items/{id}")))
 	return w.Handler.GetItem(ctx)
 }
 
 func (w *ServerInterfaceWrapper) UpdateItem(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "updateItem", "/items/{id}")))
+	limitBody(ctx.Response(), ctx.Request(), MaxBodySize)
 	return w.Handler.UpdateItem(ctx)
 }
 
 func (w *ServerInterfaceWrapper) DeleteItem(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "deleteItem", "/items/{id}")))
 	return w.Handler.DeleteItem(ctx)
 }
 
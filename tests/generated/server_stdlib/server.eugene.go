@@ -1,4 +1,5 @@
 // Code generated by eugene. DO NOT EDIT.
+
 package gen
 
 import (
@@ -23,11 +24,39 @@ type ServerInterface interface {
 	DeleteItem(w http.ResponseWriter, r *http.Request)
 }
 
+// UnimplementedServer answers every operation with 501 Not Implemented.
+// Embed it to serve only some operations. Implementations that do not embed
+// it fail to compile, naming the method, when the spec gains an operation.
+type UnimplementedServer struct{}
+
+func (UnimplementedServer) ListItems(w http.ResponseWriter, r *http.Request, params ListItemsQueryParams) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) CreateItem(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) GetItem(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) UpdateItem(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) DeleteItem(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+var _ ServerInterface = (*UnimplementedServer)(nil)
+
 type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
 func (w *ServerInterfaceWrapper) ListItems(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "listItems", "/items"))
 	var params ListItemsQueryParams
 	if v := r.URL.Query().Get("limit"); v != "" {
 		if parsed, err := strconv.Atoi(v); err == nil {
@@ -38,18 +67,24 @@ func (w *ServerInterfaceWrapper) ListItems(rw http.ResponseWriter, r *http.Reque
 }
 
 func (w *ServerInterfaceWrapper) CreateItem(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "createItem", "/items"))
+	limitBody(rw, r, MaxBodySize)
 	w.Handler.CreateItem(rw, r)
 }
 
 func (w *ServerInterfaceWrapper) GetItem(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "getItem", "/items/{id}"))
 	w.Handler.GetItem(rw, r)
 }
 
 func (w *ServerInterfaceWrapper) UpdateItem(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "updateItem", "/items/{id}"))
+	limitBody(rw, r, MaxBodySize)
 	w.Handler.UpdateItem(rw, r)
 }
 
 func (w *ServerInterfaceWrapper) DeleteItem(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "deleteItem", "/items/{id}"))
 	w.Handler.DeleteItem(rw, r)
 }
 
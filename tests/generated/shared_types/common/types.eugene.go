@@ -0,0 +1,19 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package common
+
+type Money struct {
+	Amount   int64    `json:"amount"`
+	Currency Currency `json:"currency"`
+}
+
+type Currency string
+
+type Error struct {
+	Message string `json:"message"`
+}
+
+const (
+	CurrencyEur Currency = "EUR"
+	CurrencyUsd Currency = "USD"
+)
@@ -0,0 +1,73 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package store
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Tool describes an API operation as a callable tool for LLM agents. Its
+// JSON form matches the tool definitions of common tool-calling APIs.
+type Tool struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	InputSchema json.RawMessage `json:"inputSchema"`
+}
+
+// Tools lists the operations exposed as tools, in spec order.
+var Tools = []Tool{
+	{
+		Name:        "getInventory",
+		Description: "",
+		InputSchema: json.RawMessage("{\"properties\":{},\"type\":\"object\"}"),
+	},
+}
+
+// ToolManifest returns Tools encoded as an indented JSON array.
+func ToolManifest() ([]byte, error) {
+	return json.MarshalIndent(Tools, "", "  ")
+}
+
+// ErrUnknownTool is returned by CallTool for a name not in Tools.
+var ErrUnknownTool = errors.New("unknown tool")
+
+type getInventoryToolArgs struct {
+}
+
+// CallTool invokes the operation behind the named tool with arguments
+// matching its InputSchema and returns the typed response, such as
+// *GetPetResponse. Unknown argument fields are rejected.
+func (c *Client) CallTool(ctx context.Context, name string, args json.RawMessage) (any, error) {
+	switch name {
+	case "getInventory":
+		var a getInventoryToolArgs
+		if err := decodeToolArgs(args, &a); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		resp, err := c.GetInventory(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return resp, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTool, name)
+	}
+}
+
+// decodeToolArgs decodes a JSON arguments object into v. Empty arguments
+// leave v at its zero value.
+func decodeToolArgs(args json.RawMessage, v any) error {
+	if len(bytes.TrimSpace(args)) == 0 {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(args))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("decoding arguments: %w", err)
+	}
+	return nil
+}
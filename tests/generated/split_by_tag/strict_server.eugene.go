@@ -0,0 +1,41 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// StrictChiHandler wraps a StrictServerInterface to handle Chi requests.
+type StrictChiHandler struct {
+	ssi StrictServerInterface
+}
+
+// NewStrictHandler creates a new StrictChiHandler.
+func NewStrictHandler(ssi StrictServerInterface) *StrictChiHandler {
+	return &StrictChiHandler{ssi: ssi}
+}
+
+// HealthCheck handles GET /health
+func (h *StrictChiHandler) HealthCheck(w http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "healthCheck", "/health"))
+
+	response, err := h.ssi.HealthCheck(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := response.VisitHealthCheckResponseObject(w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// RegisterStrictHandlers registers all strict handlers with the Chi router.
+func RegisterStrictHandlers(r chi.Router, ssi StrictServerInterface) {
+	h := NewStrictHandler(ssi)
+
+	r.Method("GET", "/health", http.HandlerFunc(h.HealthCheck))
+}
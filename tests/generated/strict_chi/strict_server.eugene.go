@@ -1,8 +1,8 @@
 // Code generated by eugene. DO NOT EDIT.
+
 package gen
 
 import (
-	"encoding/json"
 	"net/http"
 	"strconv"
 
@@ -21,6 +21,7 @@ func NewStrictHandler(ssi StrictServerInterface) *StrictChiHandler {
 
 // ListItems handles GET /items
 func (h *StrictChiHandler) ListItems(w http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "listItems", "/items"))
 	var request ListItemsRequestObject
 	if v := r.URL.Query().Get("limit"); v != "" {
 		if parsed, err := strconv.Atoi(v); err == nil {
@@ -41,10 +42,12 @@ func (h *StrictChiHandler) ListItems(w http.ResponseWriter, r *http.Request) {
 
 // CreateItem handles POST /items
 func (h *StrictChiHandler) CreateItem(w http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "createItem", "/items"))
+	limitBody(w, r, MaxBodySize)
 	var request CreateItemRequestObject
 	var body NewItem
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+	if err := DecodeJSONBody(r, &body); err != nil {
+		http.Error(w, err.Error(), BodyErrorStatus(err))
 		return
 	}
 	request.Body = body
@@ -62,6 +65,7 @@ func (h *StrictChiHandler) CreateItem(w http.ResponseWriter, r *http.Request) {
 
 // GetItem handles GET /items/{id}
 func (h *StrictChiHandler) GetItem(w http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "getItem", "/items/{id}"))
 
 	response, err := h.ssi.GetItem(r.Context())
 	if err != nil {
@@ -76,10 +80,12 @@ func (h *StrictChiHandler) GetItem(w http.ResponseWriter, r *http.Request) {
 
 // UpdateItem handles PUT /items/{id}
 func (h *StrictChiHandler) UpdateItem(w http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "updateItem", "/items/{id}"))
+	limitBody(w, r, MaxBodySize)
 	var request UpdateItemRequestObject
 	var body NewItem
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+	if err := DecodeJSONBody(r, &body); err != nil {
+		http.Error(w, err.Error(), BodyErrorStatus(err))
 		return
 	}
 	request.Body = body
@@ -97,6 +103,7 @@ func (h *StrictChiHandler) UpdateItem(w http.ResponseWriter, r *http.Request) {
 
 // DeleteItem handles DELETE /items/{id}
 func (h *StrictChiHandler) DeleteItem(w http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "deleteItem", "/items/{id}"))
 
 	response, err := h.ssi.DeleteItem(r.Context())
 	if err != nil {
@@ -1,4 +1,5 @@
 // Code generated by eugene. DO NOT EDIT.
+
 package gen
 
 import (
@@ -103,3 +104,59 @@ type StrictServerInterface interface {
 	// DeleteItem
 	DeleteItem(ctx context.Context) (DeleteItemResponseObject, error)
 }
+
+// UnimplementedStrictServer answers every operation with 501 Not
+// Implemented. Embed it to serve only some operations. Implementations that
+// do not embed it fail to compile, naming the method, when the spec gains an
+// operation.
+type UnimplementedStrictServer struct{}
+
+func (UnimplementedStrictServer) ListItems(ctx context.Context, request ListItemsRequestObject) (ListItemsResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+func (UnimplementedStrictServer) CreateItem(ctx context.Context, request CreateItemRequestObject) (CreateItemResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+func (UnimplementedStrictServer) GetItem(ctx context.Context) (GetItemResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+func (UnimplementedStrictServer) UpdateItem(ctx context.Context, request UpdateItemRequestObject) (UpdateItemResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+func (UnimplementedStrictServer) DeleteItem(ctx context.Context) (DeleteItemResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+var _ StrictServerInterface = (*UnimplementedStrictServer)(nil)
+
+// notImplementedResponse is the 501 response of UnimplementedStrictServer.
+type notImplementedResponse struct{}
+
+func (notImplementedResponse) VisitListItemsResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
+
+func (notImplementedResponse) VisitCreateItemResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
+
+func (notImplementedResponse) VisitGetItemResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
+
+func (notImplementedResponse) VisitUpdateItemResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
+
+func (notImplementedResponse) VisitDeleteItemResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
@@ -1,8 +1,8 @@
 // Code generated by eugene. DO NOT EDIT.
+
 package gen
 
 import (
-	"net/http"
 	"strconv"
 
 	"github.com/labstack/echo/v4"
@@ -20,6 +20,7 @@ func NewStrictHandler(ssi StrictServerInterface) *StrictEchoHandler {
 
 // ListItems handles GET /items
 func (h *StrictEchoHandler) ListItems(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "listItems", "/items")))
 	var request ListItemsRequestObject
 	if v := ctx.QueryParam("limit"); v != "" {
 		if parsed, err := strconv.Atoi(v); err == nil {
@@ -37,10 +38,12 @@ func (h *StrictEchoHandler) ListItems(ctx echo.Context) error {
 
 // CreateItem handles POST /items
 func (h *StrictEchoHandler) CreateItem(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "createItem", "/items")))
+	limitBody(ctx.Response(), ctx.Request(), MaxBodySize)
 	var request CreateItemRequestObject
 	var body NewItem
-	if err := ctx.Bind(&body); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	if err := DecodeJSONBody(ctx.Request(), &body); err != nil {
+		return echo.NewHTTPError(BodyErrorStatus(err), err.Error())
 	}
 	request.Body = body
 
@@ -54,6 +57,7 @@ func (h *StrictEchoHandler) CreateItem(ctx echo.Context) error {
 
 // GetItem handles GET /items/{id}
 func (h *StrictEchoHandler) GetItem(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "getItem", "/items/{id}")))
 
 	response, err := h.ssi.GetItem(ctx.Request().Context())
 	if err != nil {
@@ -65,10 +69,12 @@ func (h *StrictEchoHandler) GetItem(ctx echo.Context) error {
 
 // UpdateItem handles PUT /items/{id}
 func (h *StrictEchoHandler) UpdateItem(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "updateItem", "/items/{id}")))
+	limitBody(ctx.Response(), ctx.Request(), MaxBodySize)
 	var request UpdateItemRequestObject
 	var body NewItem
-	if err := ctx.Bind(&body); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	if err := DecodeJSONBody(ctx.Request(), &body); err != nil {
+		return echo.NewHTTPError(BodyErrorStatus(err), err.Error())
 	}
 	request.Body = body
 
@@ -82,6 +88,7 @@ func (h *StrictEchoHandler) UpdateItem(ctx echo.Context) error {
 
 // DeleteItem handles DELETE /items/{id}
 func (h *StrictEchoHandler) DeleteItem(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "deleteItem", "/items/{id}")))
 
 	response, err := h.ssi.DeleteItem(ctx.Request().Context())
 	if err != nil {
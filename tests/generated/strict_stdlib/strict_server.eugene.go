@@ -1,8 +1,8 @@
 // Code generated by eugene. DO NOT EDIT.
+
 package gen
 
 import (
-	"encoding/json"
 	"net/http"
 	"strconv"
 )
@@ -19,6 +19,7 @@ func NewStrictHandler(ssi StrictServerInterface) *StrictHandler {
 
 // ListItems handles GET /items
 func (h *StrictHandler) ListItems(w http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "listItems", "/items"))
 	var request ListItemsRequestObject
 	if v := r.URL.Query().Get("limit"); v != "" {
 		if parsed, err := strconv.Atoi(v); err == nil {
@@ -39,10 +40,12 @@ func (h *StrictHandler) ListItems(w http.ResponseWriter, r *http.Request) {
 
 // CreateItem handles POST /items
 func (h *StrictHandler) CreateItem(w http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "createItem", "/items"))
+	limitBody(w, r, MaxBodySize)
 	var request CreateItemRequestObject
 	var body NewItem
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+	if err := DecodeJSONBody(r, &body); err != nil {
+		http.Error(w, err.Error(), BodyErrorStatus(err))
 		return
 	}
 	request.Body = body
@@ -60,6 +63,7 @@ func (h *StrictHandler) CreateItem(w http.ResponseWriter, r *http.Request) {
 
 // GetItem handles GET /items/{id}
 func (h *StrictHandler) GetItem(w http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "getItem", "/items/{id}"))
 
 	response, err := h.ssi.GetItem(r.Context())
 	if err != nil {
@@ -74,10 +78,12 @@ func (h *StrictHandler) GetItem(w http.ResponseWriter, r *http.Request) {
 
 // UpdateItem handles PUT /items/{id}
 func (h *StrictHandler) UpdateItem(w http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "updateItem", "/items/{id}"))
+	limitBody(w, r, MaxBodySize)
 	var request UpdateItemRequestObject
 	var body NewItem
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+	if err := DecodeJSONBody(r, &body); err != nil {
+		http.Error(w, err.Error(), BodyErrorStatus(err))
 		return
 	}
 	request.Body = body
@@ -95,6 +101,7 @@ func (h *StrictHandler) UpdateItem(w http.ResponseWriter, r *http.Request) {
 
 // DeleteItem handles DELETE /items/{id}
 func (h *StrictHandler) DeleteItem(w http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withOperation(r.Context(), "deleteItem", "/items/{id}"))
 
 	response, err := h.ssi.DeleteItem(r.Context())
 	if err != nil {
@@ -0,0 +1,29 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+type NewPet struct {
+	Name string   `json:"name"`
+	Kind Kind     `json:"kind"`
+	Age  *int     `json:"age,omitempty"`
+	Tags []string `json:"tags,omitempty"`
+}
+
+type Pet struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type Kind string
+
+const (
+	KindCat Kind = "cat"
+	KindDog Kind = "dog"
+)
+
+type Status string
+
+const (
+	StatusAvailable Status = "available"
+	StatusSold      Status = "sold"
+)
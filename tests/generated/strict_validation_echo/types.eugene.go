@@ -0,0 +1,85 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+)
+
+type NewPet struct {
+	Name string   `json:"name"`
+	Kind Kind     `json:"kind"`
+	Age  *int     `json:"age,omitempty"`
+	Tags []string `json:"tags,omitempty"`
+}
+
+type Pet struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type Kind struct {
+	value string
+}
+
+func (e Kind) String() string { return fmt.Sprintf("%v", e.value) }
+func (e Kind) Value() string  { return e.value }
+func (e Kind) IsValid() bool  { return e.Index() >= 0 }
+
+// Index returns the position of e in the enum, or -1 for a value the enum
+// does not list.
+func (e Kind) Index() int {
+	switch e.value {
+	case "cat":
+		return 0
+	case "dog":
+		return 1
+	}
+	return -1
+}
+
+// Compare orders values as the enum lists them, after values it does not
+// list, which are ordered by value. It suits slices.SortFunc.
+func (e Kind) Compare(other Kind) int {
+	if c := cmp.Compare(e.Index(), other.Index()); c != 0 {
+		return c
+	}
+	return cmp.Compare(e.value, other.value)
+}
+
+// KindValues returns the values of the enum in order.
+func KindValues() []Kind {
+	return []Kind{KindCat, KindDog}
+}
+
+func (e Kind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.value)
+}
+
+func (e *Kind) UnmarshalJSON(data []byte) error {
+	return json.Unmarshal(data, &e.value)
+}
+
+// MarshalText lets Kind be used as a JSON object key.
+func (e Kind) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+func (e *Kind) UnmarshalText(text []byte) error {
+	e.value = string(text)
+	return nil
+}
+
+var (
+	KindCat = Kind{value: "cat"}
+	KindDog = Kind{value: "dog"}
+)
+
+type Status string
+
+const (
+	StatusAvailable Status = "available"
+	StatusSold      Status = "sold"
+)
@@ -0,0 +1,190 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// AddPetRequestObject represents the request for AddPet.
+type AddPetRequestObject struct {
+	Body Pet
+}
+
+// GetPetsPetIDRequestObject represents the request for GetPetsPetID.
+type GetPetsPetIDRequestObject struct {
+	PetID string // path parameter
+}
+
+// DeletePetRequestObject represents the request for DeletePet.
+type DeletePetRequestObject struct {
+	PetID string // path parameter
+}
+
+// GetUsersUserIDAvatarPngRequestObject represents the request for GetUsersUserIDAvatarPng.
+type GetUsersUserIDAvatarPngRequestObject struct {
+	UserID string // path parameter
+}
+
+// GetRootResponseObject is the interface for GetRoot responses.
+type GetRootResponseObject interface {
+	VisitGetRootResponseObject(w http.ResponseWriter) error
+}
+
+// GetRoot200Response is the response for GetRoot with status 200.
+type GetRoot200Response struct{}
+
+func (r GetRoot200Response) VisitGetRootResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+// GetPetsResponseObject is the interface for GetPets responses.
+type GetPetsResponseObject interface {
+	VisitGetPetsResponseObject(w http.ResponseWriter) error
+}
+
+// GetPets200JSONResponse is the response for GetPets with status 200.
+type GetPets200JSONResponse []Pet
+
+func (r GetPets200JSONResponse) VisitGetPetsResponseObject(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(r)
+}
+
+// AddPetResponseObject is the interface for AddPet responses.
+type AddPetResponseObject interface {
+	VisitAddPetResponseObject(w http.ResponseWriter) error
+}
+
+// AddPet201Response is the response for AddPet with status 201.
+type AddPet201Response struct{}
+
+func (r AddPet201Response) VisitAddPetResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
+}
+
+// GetPetsPetIDResponseObject is the interface for GetPetsPetID responses.
+type GetPetsPetIDResponseObject interface {
+	VisitGetPetsPetIDResponseObject(w http.ResponseWriter) error
+}
+
+// GetPetsPetID200JSONResponse is the response for GetPetsPetID with status 200.
+type GetPetsPetID200JSONResponse Pet
+
+func (r GetPetsPetID200JSONResponse) VisitGetPetsPetIDResponseObject(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(r)
+}
+
+// DeletePetResponseObject is the interface for DeletePet responses.
+type DeletePetResponseObject interface {
+	VisitDeletePetResponseObject(w http.ResponseWriter) error
+}
+
+// DeletePet204Response is the response for DeletePet with status 204.
+type DeletePet204Response struct{}
+
+func (r DeletePet204Response) VisitDeletePetResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+// GetUsersUserIDAvatarPngResponseObject is the interface for GetUsersUserIDAvatarPng responses.
+type GetUsersUserIDAvatarPngResponseObject interface {
+	VisitGetUsersUserIDAvatarPngResponseObject(w http.ResponseWriter) error
+}
+
+// GetUsersUserIDAvatarPng200Response is the response for GetUsersUserIDAvatarPng with status 200.
+type GetUsersUserIDAvatarPng200Response struct{}
+
+func (r GetUsersUserIDAvatarPng200Response) VisitGetUsersUserIDAvatarPngResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+// StrictServerInterface is the strict server interface with typed request/response.
+type StrictServerInterface interface {
+	// GetRoot
+	GetRoot(ctx context.Context) (GetRootResponseObject, error)
+	// GetPets
+	GetPets(ctx context.Context) (GetPetsResponseObject, error)
+	// AddPet
+	AddPet(ctx context.Context, request AddPetRequestObject) (AddPetResponseObject, error)
+	// GetPetsPetID
+	GetPetsPetID(ctx context.Context, request GetPetsPetIDRequestObject) (GetPetsPetIDResponseObject, error)
+	// DeletePet
+	DeletePet(ctx context.Context, request DeletePetRequestObject) (DeletePetResponseObject, error)
+	// GetUsersUserIDAvatarPng
+	GetUsersUserIDAvatarPng(ctx context.Context, request GetUsersUserIDAvatarPngRequestObject) (GetUsersUserIDAvatarPngResponseObject, error)
+}
+
+// UnimplementedStrictServer answers every operation with 501 Not
+// Implemented. Embed it to serve only some operations. Implementations that
+// do not embed it fail to compile, naming the method, when the spec gains an
+// operation.
+type UnimplementedStrictServer struct{}
+
+func (UnimplementedStrictServer) GetRoot(ctx context.Context) (GetRootResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+func (UnimplementedStrictServer) GetPets(ctx context.Context) (GetPetsResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+func (UnimplementedStrictServer) AddPet(ctx context.Context, request AddPetRequestObject) (AddPetResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+func (UnimplementedStrictServer) GetPetsPetID(ctx context.Context, request GetPetsPetIDRequestObject) (GetPetsPetIDResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+func (UnimplementedStrictServer) DeletePet(ctx context.Context, request DeletePetRequestObject) (DeletePetResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+func (UnimplementedStrictServer) GetUsersUserIDAvatarPng(ctx context.Context, request GetUsersUserIDAvatarPngRequestObject) (GetUsersUserIDAvatarPngResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+var _ StrictServerInterface = (*UnimplementedStrictServer)(nil)
+
+// notImplementedResponse is the 501 response of UnimplementedStrictServer.
+type notImplementedResponse struct{}
+
+func (notImplementedResponse) VisitGetRootResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
+
+func (notImplementedResponse) VisitGetPetsResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
+
+func (notImplementedResponse) VisitAddPetResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
+
+func (notImplementedResponse) VisitGetPetsPetIDResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
+
+func (notImplementedResponse) VisitDeletePetResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
+
+func (notImplementedResponse) VisitGetUsersUserIDAvatarPngResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
@@ -0,0 +1,62 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ServerInterface interface {
+	// ListProjects
+	ListProjects(ctx echo.Context) error
+	// GetProject
+	GetProject(ctx echo.Context, projectID string) error
+}
+
+// UnimplementedServer answers every operation with 501 Not Implemented.
+// Embed it to serve only some operations. Implementations that do not embed
+// it fail to compile, naming the method, when the spec gains an operation.
+type UnimplementedServer struct{}
+
+func (UnimplementedServer) ListProjects(ctx echo.Context) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) GetProject(ctx echo.Context, projectID string) error {
+	return echo.NewHTTPError(http.StatusNotImplemented)
+}
+
+var _ ServerInterface = (*UnimplementedServer)(nil)
+
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+func (w *ServerInterfaceWrapper) ListProjects(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withPrefixVariables(ctx)))
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "listProjects", "/projects")))
+	return w.Handler.ListProjects(ctx)
+}
+
+func (w *ServerInterfaceWrapper) GetProject(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withPrefixVariables(ctx)))
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "getProject", "/projects/{projectId}")))
+	projectID := ctx.Param("projectId")
+	return w.Handler.GetProject(ctx, projectID)
+}
+
+func RegisterHandlers(router Router, si ServerInterface) {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+
+	router.GET("/tenants/:tenantId/projects", wrapper.ListProjects)
+	router.GET("/tenants/:tenantId/projects/:projectId", wrapper.GetProject)
+}
+
+func RegisterHandlersWithBaseURL(router Router, si ServerInterface, baseURL string) {
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+
+	router.GET(baseURL+"/tenants/:tenantId/projects", wrapper.ListProjects)
+	router.GET(baseURL+"/tenants/:tenantId/projects/:projectId", wrapper.GetProject)
+}
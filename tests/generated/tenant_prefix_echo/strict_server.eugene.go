@@ -0,0 +1,61 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"github.com/labstack/echo/v4"
+)
+
+// StrictEchoHandler wraps a StrictServerInterface to handle Echo requests.
+type StrictEchoHandler struct {
+	ssi StrictServerInterface
+}
+
+// NewStrictHandler creates a new StrictEchoHandler.
+func NewStrictHandler(ssi StrictServerInterface) *StrictEchoHandler {
+	return &StrictEchoHandler{ssi: ssi}
+}
+
+// ListProjects handles GET /projects
+func (h *StrictEchoHandler) ListProjects(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withPrefixVariables(ctx)))
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "listProjects", "/projects")))
+
+	response, err := h.ssi.ListProjects(ctx.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	return response.VisitListProjectsResponseObject(ctx.Response().Writer)
+}
+
+// GetProject handles GET /projects/{projectId}
+func (h *StrictEchoHandler) GetProject(ctx echo.Context) error {
+	ctx.SetRequest(ctx.Request().WithContext(withPrefixVariables(ctx)))
+	ctx.SetRequest(ctx.Request().WithContext(withOperation(ctx.Request().Context(), "getProject", "/projects/{projectId}")))
+	var request GetProjectRequestObject
+	request.ProjectID = ctx.Param("projectId")
+
+	response, err := h.ssi.GetProject(ctx.Request().Context(), request)
+	if err != nil {
+		return err
+	}
+
+	return response.VisitGetProjectResponseObject(ctx.Response().Writer)
+}
+
+// RegisterStrictHandlers registers all strict handlers with the Echo instance.
+func RegisterStrictHandlers(router Router, ssi StrictServerInterface) {
+	h := NewStrictHandler(ssi)
+
+	router.GET("/tenants/:tenantId/projects", h.ListProjects)
+	router.GET("/tenants/:tenantId/projects/:projectId", h.GetProject)
+}
+
+// RegisterStrictHandlersWithBaseURL registers all strict handlers with a base URL.
+func RegisterStrictHandlersWithBaseURL(router Router, ssi StrictServerInterface, baseURL string) {
+	h := NewStrictHandler(ssi)
+
+	router.GET(baseURL+"/tenants/:tenantId/projects", h.ListProjects)
+	router.GET(baseURL+"/tenants/:tenantId/projects/:projectId", h.GetProject)
+}
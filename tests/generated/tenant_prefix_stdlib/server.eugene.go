@@ -0,0 +1,70 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"net/http"
+)
+
+type ServerInterface interface {
+	// ListProjects
+	ListProjects(w http.ResponseWriter, r *http.Request)
+	// GetProject
+	GetProject(w http.ResponseWriter, r *http.Request, projectID string)
+}
+
+// UnimplementedServer answers every operation with 501 Not Implemented.
+// Embed it to serve only some operations. Implementations that do not embed
+// it fail to compile, naming the method, when the spec gains an operation.
+type UnimplementedServer struct{}
+
+func (UnimplementedServer) ListProjects(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+func (UnimplementedServer) GetProject(w http.ResponseWriter, r *http.Request, projectID string) {
+	http.Error(w, http.StatusText(http.StatusNotImplemented), http.StatusNotImplemented)
+}
+
+var _ ServerInterface = (*UnimplementedServer)(nil)
+
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+func (w *ServerInterfaceWrapper) ListProjects(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withPrefixVariables(r))
+	r = r.WithContext(withOperation(r.Context(), "listProjects", "/projects"))
+	w.Handler.ListProjects(rw, r)
+}
+
+func (w *ServerInterfaceWrapper) GetProject(rw http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withPrefixVariables(r))
+	r = r.WithContext(withOperation(r.Context(), "getProject", "/projects/{projectId}"))
+	projectID := r.PathValue("projectId")
+	w.Handler.GetProject(rw, r, projectID)
+}
+
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdlibServerOptions{})
+}
+
+type StdlibServerOptions struct {
+	BaseURL     string
+	Middlewares []func(http.Handler) http.Handler
+}
+
+func HandlerWithOptions(si ServerInterface, options StdlibServerOptions) http.Handler {
+	mux := http.NewServeMux()
+	wrapper := &ServerInterfaceWrapper{Handler: si}
+
+	mux.HandleFunc("GET "+options.BaseURL+"/tenants/{tenantId}/projects", wrapper.ListProjects)
+	mux.HandleFunc("GET "+options.BaseURL+"/tenants/{tenantId}/projects/{projectId}", wrapper.GetProject)
+
+	var handler http.Handler = mux
+	for i := len(options.Middlewares) - 1; i >= 0; i-- {
+		handler = options.Middlewares[i](handler)
+	}
+
+	return handler
+}
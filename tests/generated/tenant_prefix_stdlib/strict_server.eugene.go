@@ -0,0 +1,59 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"net/http"
+)
+
+// StrictHandler wraps a StrictServerInterface to handle stdlib requests.
+type StrictHandler struct {
+	ssi StrictServerInterface
+}
+
+// NewStrictHandler creates a new StrictHandler.
+func NewStrictHandler(ssi StrictServerInterface) *StrictHandler {
+	return &StrictHandler{ssi: ssi}
+}
+
+// ListProjects handles GET /projects
+func (h *StrictHandler) ListProjects(w http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withPrefixVariables(r))
+	r = r.WithContext(withOperation(r.Context(), "listProjects", "/projects"))
+
+	response, err := h.ssi.ListProjects(r.Context())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := response.VisitListProjectsResponseObject(w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// GetProject handles GET /projects/{projectId}
+func (h *StrictHandler) GetProject(w http.ResponseWriter, r *http.Request) {
+	r = r.WithContext(withPrefixVariables(r))
+	r = r.WithContext(withOperation(r.Context(), "getProject", "/projects/{projectId}"))
+	var request GetProjectRequestObject
+	request.ProjectID = r.PathValue("projectId")
+
+	response, err := h.ssi.GetProject(r.Context(), request)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := response.VisitGetProjectResponseObject(w); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// RegisterStrictHandlers registers all strict handlers with the http.ServeMux.
+func RegisterStrictHandlers(mux *http.ServeMux, ssi StrictServerInterface) {
+	h := NewStrictHandler(ssi)
+
+	mux.HandleFunc("GET /tenants/{tenantId}/projects", h.ListProjects)
+	mux.HandleFunc("GET /tenants/{tenantId}/projects/{projectId}", h.GetProject)
+}
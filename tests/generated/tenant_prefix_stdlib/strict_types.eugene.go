@@ -0,0 +1,79 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// GetProjectRequestObject represents the request for GetProject.
+type GetProjectRequestObject struct {
+	ProjectID string // path parameter
+}
+
+// ListProjectsResponseObject is the interface for ListProjects responses.
+type ListProjectsResponseObject interface {
+	VisitListProjectsResponseObject(w http.ResponseWriter) error
+}
+
+// ListProjects200JSONResponse is the response for ListProjects with status 200.
+type ListProjects200JSONResponse []Project
+
+func (r ListProjects200JSONResponse) VisitListProjectsResponseObject(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(r)
+}
+
+// GetProjectResponseObject is the interface for GetProject responses.
+type GetProjectResponseObject interface {
+	VisitGetProjectResponseObject(w http.ResponseWriter) error
+}
+
+// GetProject200JSONResponse is the response for GetProject with status 200.
+type GetProject200JSONResponse Project
+
+func (r GetProject200JSONResponse) VisitGetProjectResponseObject(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+	return json.NewEncoder(w).Encode(r)
+}
+
+// StrictServerInterface is the strict server interface with typed request/response.
+type StrictServerInterface interface {
+	// ListProjects
+	ListProjects(ctx context.Context) (ListProjectsResponseObject, error)
+	// GetProject
+	GetProject(ctx context.Context, request GetProjectRequestObject) (GetProjectResponseObject, error)
+}
+
+// UnimplementedStrictServer answers every operation with 501 Not
+// Implemented. Embed it to serve only some operations. Implementations that
+// do not embed it fail to compile, naming the method, when the spec gains an
+// operation.
+type UnimplementedStrictServer struct{}
+
+func (UnimplementedStrictServer) ListProjects(ctx context.Context) (ListProjectsResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+func (UnimplementedStrictServer) GetProject(ctx context.Context, request GetProjectRequestObject) (GetProjectResponseObject, error) {
+	return notImplementedResponse{}, nil
+}
+
+var _ StrictServerInterface = (*UnimplementedStrictServer)(nil)
+
+// notImplementedResponse is the 501 response of UnimplementedStrictServer.
+type notImplementedResponse struct{}
+
+func (notImplementedResponse) VisitListProjectsResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
+
+func (notImplementedResponse) VisitGetProjectResponseObject(w http.ResponseWriter) error {
+	w.WriteHeader(http.StatusNotImplemented)
+	return nil
+}
@@ -0,0 +1,189 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Tool describes an API operation as a callable tool for LLM agents. Its
+// JSON form matches the tool definitions of common tool-calling APIs.
+type Tool struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	InputSchema json.RawMessage `json:"inputSchema"`
+}
+
+// Tools lists the operations exposed as tools, in spec order.
+var Tools = []Tool{
+	{
+		Name:        "echoJSON",
+		Description: "",
+		InputSchema: json.RawMessage("{\"$defs\":{\"EchoPayload\":{\"properties\":{\"message\":{\"type\":\"string\"},\"nested\":{\"properties\":{\"value\":{\"type\":\"string\"}},\"type\":\"object\"},\"number\":{\"type\":\"integer\"}},\"required\":[\"message\"],\"type\":\"object\"}},\"properties\":{\"body\":{\"$ref\":\"#/$defs/EchoPayload\"}},\"required\":[\"body\"],\"type\":\"object\"}"),
+	},
+	{
+		Name:        "getItem",
+		Description: "",
+		InputSchema: json.RawMessage("{\"properties\":{\"filter\":{\"type\":\"string\"},\"id\":{\"type\":\"string\"}},\"required\":[\"id\"],\"type\":\"object\"}"),
+	},
+	{
+		Name:        "createResource",
+		Description: "",
+		InputSchema: json.RawMessage("{\"$defs\":{\"NewResource\":{\"properties\":{\"description\":{\"type\":[\"string\",\"null\"]},\"name\":{\"type\":\"string\"},\"status\":{\"$ref\":\"#/$defs/Status\"}},\"required\":[\"name\"],\"type\":\"object\"},\"Status\":{\"enum\":[\"pending\",\"active\",\"completed\"],\"type\":\"string\"}},\"properties\":{\"body\":{\"$ref\":\"#/$defs/NewResource\"}},\"required\":[\"body\"],\"type\":\"object\"}"),
+	},
+	{
+		Name:        "deleteResource",
+		Description: "",
+		InputSchema: json.RawMessage("{\"properties\":{\"id\":{\"type\":\"string\"}},\"required\":[\"id\"],\"type\":\"object\"}"),
+	},
+	{
+		Name:        "getSession",
+		Description: "",
+		InputSchema: json.RawMessage("{\"properties\":{},\"type\":\"object\"}"),
+	},
+	{
+		Name:        "getSecureData",
+		Description: "",
+		InputSchema: json.RawMessage("{\"properties\":{},\"type\":\"object\"}"),
+	},
+	{
+		Name:        "createShape",
+		Description: "",
+		InputSchema: json.RawMessage("{\"$defs\":{\"Circle\":{\"properties\":{\"radius\":{\"type\":\"number\"},\"type\":{\"type\":\"string\"}},\"required\":[\"type\",\"radius\"],\"type\":\"object\"},\"Rectangle\":{\"properties\":{\"height\":{\"type\":\"number\"},\"type\":{\"type\":\"string\"},\"width\":{\"type\":\"number\"}},\"required\":[\"type\",\"width\",\"height\"],\"type\":\"object\"},\"Shape\":{\"oneOf\":[{\"$ref\":\"#/$defs/Circle\"},{\"$ref\":\"#/$defs/Rectangle\"}]}},\"properties\":{\"body\":{\"$ref\":\"#/$defs/Shape\"}},\"required\":[\"body\"],\"type\":\"object\"}"),
+	},
+}
+
+// ToolManifest returns Tools encoded as an indented JSON array.
+func ToolManifest() ([]byte, error) {
+	return json.MarshalIndent(Tools, "", "  ")
+}
+
+// ErrUnknownTool is returned by CallTool for a name not in Tools.
+var ErrUnknownTool = errors.New("unknown tool")
+
+type echoJSONToolArgs struct {
+	Body EchoPayload `json:"body"`
+}
+
+type getItemToolArgs struct {
+	ID     string  `json:"id"`
+	Filter *string `json:"filter,omitempty"`
+}
+
+type createResourceToolArgs struct {
+	Body NewResource `json:"body"`
+}
+
+type deleteResourceToolArgs struct {
+	ID string `json:"id"`
+}
+
+type getSessionToolArgs struct {
+}
+
+type getSecureDataToolArgs struct {
+}
+
+type createShapeToolArgs struct {
+	Body Shape `json:"body"`
+}
+
+// CallTool invokes the operation behind the named tool with arguments
+// matching its InputSchema and returns the typed response, such as
+// *GetPetResponse. Unknown argument fields are rejected.
+func (c *Client) CallTool(ctx context.Context, name string, args json.RawMessage) (any, error) {
+	switch name {
+	case "echoJSON":
+		var a echoJSONToolArgs
+		if err := decodeToolArgs(args, &a); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		resp, err := c.EchoJSON(ctx, a.Body)
+		if err != nil {
+			return nil, err
+		}
+		return resp, nil
+	case "getItem":
+		var a getItemToolArgs
+		if err := decodeToolArgs(args, &a); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		resp, err := c.GetItem(ctx, a.ID, &GetItemParams{
+			Filter: a.Filter,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return resp, nil
+	case "createResource":
+		var a createResourceToolArgs
+		if err := decodeToolArgs(args, &a); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		resp, err := c.CreateResource(ctx, a.Body)
+		if err != nil {
+			return nil, err
+		}
+		return resp, nil
+	case "deleteResource":
+		var a deleteResourceToolArgs
+		if err := decodeToolArgs(args, &a); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		resp, err := c.DeleteResource(ctx, a.ID)
+		if err != nil {
+			return nil, err
+		}
+		return resp, nil
+	case "getSession":
+		var a getSessionToolArgs
+		if err := decodeToolArgs(args, &a); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		resp, err := c.GetSession(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return resp, nil
+	case "getSecureData":
+		var a getSecureDataToolArgs
+		if err := decodeToolArgs(args, &a); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		resp, err := c.GetSecureData(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return resp, nil
+	case "createShape":
+		var a createShapeToolArgs
+		if err := decodeToolArgs(args, &a); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		resp, err := c.CreateShape(ctx, a.Body)
+		if err != nil {
+			return nil, err
+		}
+		return resp, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTool, name)
+	}
+}
+
+// decodeToolArgs decodes a JSON arguments object into v. Empty arguments
+// leave v at its zero value.
+func decodeToolArgs(args json.RawMessage, v any) error {
+	if len(bytes.TrimSpace(args)) == 0 {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(args))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("decoding arguments: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"time"
+)
+
+type Auditable struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Revision  *int      `json:"revision,omitempty"`
+}
+
+type Document struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Revision  *string   `json:"revision,omitempty"`
+	Title     string    `json:"title"`
+}
@@ -1,4 +1,5 @@
 // Code generated by eugene. DO NOT EDIT.
+
 package gen
 
 import (
@@ -24,6 +25,16 @@ type PaymentResult struct {
 	Status *string `json:"status,omitempty"`
 }
 
+type Cat struct {
+	Kind   string `json:"kind"`
+	Indoor *bool  `json:"indoor,omitempty"`
+}
+
+type Dog struct {
+	Kind  string  `json:"kind"`
+	Breed *string `json:"breed,omitempty"`
+}
+
 type PaymentSource struct {
 	Type string          `json:"-"`
 	Raw  json.RawMessage `json:"-"`
@@ -45,6 +56,32 @@ func (u PaymentSource) MarshalJSON() ([]byte, error) {
 	return u.Raw, nil
 }
 
+// PaymentSourceVariant is the closed set of PaymentSource variants. Each variant
+// implements it, so code can accept any of them without the wrapper.
+type PaymentSourceVariant interface {
+	isPaymentSource()
+}
+
+func (CardPayment) isPaymentSource() {}
+func (BankPayment) isPaymentSource() {}
+
+// Variant decodes u into the variant its type names, as a pointer.
+func (u *PaymentSource) Variant() (PaymentSourceVariant, error) {
+	var v PaymentSourceVariant
+	switch u.Type {
+	case "card":
+		v = &CardPayment{}
+	case "bank":
+		v = &BankPayment{}
+	default:
+		return nil, fmt.Errorf("unknown PaymentSource type %q", u.Type)
+	}
+	if err := json.Unmarshal(u.Raw, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
 func (u *PaymentSource) AsCardPayment() (*CardPayment, error) {
 	if u.Type != "card" {
 		return nil, fmt.Errorf("not a CardPayment, type is %s", u.Type)
@@ -66,3 +103,72 @@ func (u *PaymentSource) AsBankPayment() (*BankPayment, error) {
 	}
 	return &v, nil
 }
+
+type Pet struct {
+	Type string          `json:"-"`
+	Raw  json.RawMessage `json:"-"`
+}
+
+func (u *Pet) UnmarshalJSON(data []byte) error {
+	var d struct {
+		Kind string `json:"kind"`
+	}
+	if err := json.Unmarshal(data, &d); err != nil {
+		return err
+	}
+	u.Type = d.Kind
+	u.Raw = data
+	return nil
+}
+
+func (u Pet) MarshalJSON() ([]byte, error) {
+	return u.Raw, nil
+}
+
+// PetVariant is the closed set of Pet variants. Each variant
+// implements it, so code can accept any of them without the wrapper.
+type PetVariant interface {
+	isPet()
+}
+
+func (Cat) isPet() {}
+func (Dog) isPet() {}
+
+// Variant decodes u into the variant its kind names, as a pointer.
+func (u *Pet) Variant() (PetVariant, error) {
+	var v PetVariant
+	switch u.Type {
+	case "cat", "kitty":
+		v = &Cat{}
+	case "dog":
+		v = &Dog{}
+	default:
+		return nil, fmt.Errorf("unknown Pet type %q", u.Type)
+	}
+	if err := json.Unmarshal(u.Raw, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (u *Pet) AsCat() (*Cat, error) {
+	if u.Type != "cat" && u.Type != "kitty" {
+		return nil, fmt.Errorf("not a Cat, type is %s", u.Type)
+	}
+	var v Cat
+	if err := json.Unmarshal(u.Raw, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func (u *Pet) AsDog() (*Dog, error) {
+	if u.Type != "dog" {
+		return nil, fmt.Errorf("not a Dog, type is %s", u.Type)
+	}
+	var v Dog
+	if err := json.Unmarshal(u.Raw, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
@@ -0,0 +1,28 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"time"
+)
+
+type User struct {
+	AvatarURL *string   `json:"avatarUrl,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	Email     string    `json:"email"`
+	FirstName *string   `json:"firstName,omitempty"`
+	ID        string    `json:"id"`
+	LastName  *string   `json:"lastName,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type WorkspaceOwner struct {
+	AvatarURL  *string   `json:"avatarUrl,omitempty"`
+	CreatedAt  time.Time `json:"createdAt"`
+	Email      string    `json:"email"`
+	FirstName  *string   `json:"firstName,omitempty"`
+	ID         string    `json:"id"`
+	LastName   *string   `json:"lastName,omitempty"`
+	PromotedAt time.Time `json:"promotedAt"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+}
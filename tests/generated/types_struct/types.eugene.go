@@ -1,7 +1,9 @@
 // Code generated by eugene. DO NOT EDIT.
+
 package gen
 
 import (
+	"cmp"
 	"encoding/json"
 	"fmt"
 )
@@ -22,18 +24,36 @@ type Item struct {
 
 func (e Status) String() string { return fmt.Sprintf("%v", e.value) }
 func (e Status) Value() string  { return e.value }
-func (e Status) IsValid() bool {
+func (e Status) IsValid() bool  { return e.Index() >= 0 }
+
+// Index returns the position of e in the enum, or -1 for a value the enum
+// does not list.
+func (e Status) Index() int {
 	switch e.value {
 	case "pending":
-		return true
+		return 0
 	case "active":
-		return true
+		return 1
 	case "completed":
-		return true
+		return 2
 	case "cancelled":
-		return true
+		return 3
+	}
+	return -1
+}
+
+// Compare orders values as the enum lists them, after values it does not
+// list, which are ordered by value. It suits slices.SortFunc.
+func (e Status) Compare(other Status) int {
+	if c := cmp.Compare(e.Index(), other.Index()); c != 0 {
+		return c
 	}
-	return false
+	return cmp.Compare(e.value, other.value)
+}
+
+// StatusValues returns the values of the enum in order.
+func StatusValues() []Status {
+	return []Status{StatusPending, StatusActive, StatusCompleted, StatusCancelled}
 }
 
 func (e Status) MarshalJSON() ([]byte, error) {
@@ -44,6 +64,16 @@ func (e *Status) UnmarshalJSON(data []byte) error {
 	return json.Unmarshal(data, &e.value)
 }
 
+// MarshalText lets Status be used as a JSON object key.
+func (e Status) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+func (e *Status) UnmarshalText(text []byte) error {
+	e.value = string(text)
+	return nil
+}
+
 var (
 	StatusPending   = Status{value: "pending"}
 	StatusActive    = Status{value: "active"}
@@ -53,16 +83,34 @@ var (
 
 func (e Priority) String() string { return fmt.Sprintf("%v", e.value) }
 func (e Priority) Value() int     { return e.value }
-func (e Priority) IsValid() bool {
+func (e Priority) IsValid() bool  { return e.Index() >= 0 }
+
+// Index returns the position of e in the enum, or -1 for a value the enum
+// does not list.
+func (e Priority) Index() int {
 	switch e.value {
 	case 1:
-		return true
+		return 0
 	case 2:
-		return true
+		return 1
 	case 3:
-		return true
+		return 2
 	}
-	return false
+	return -1
+}
+
+// Compare orders values as the enum lists them, after values it does not
+// list, which are ordered by value. It suits slices.SortFunc.
+func (e Priority) Compare(other Priority) int {
+	if c := cmp.Compare(e.Index(), other.Index()); c != 0 {
+		return c
+	}
+	return cmp.Compare(e.value, other.value)
+}
+
+// PriorityValues returns the values of the enum in order.
+func PriorityValues() []Priority {
+	return []Priority{Priority1, Priority2, Priority3}
 }
 
 func (e Priority) MarshalJSON() ([]byte, error) {
@@ -73,6 +121,15 @@ func (e *Priority) UnmarshalJSON(data []byte) error {
 	return json.Unmarshal(data, &e.value)
 }
 
+// MarshalText lets Priority be used as a JSON object key.
+func (e Priority) MarshalText() ([]byte, error) {
+	return []byte(e.String()), nil
+}
+
+func (e *Priority) UnmarshalText(text []byte) error {
+	return json.Unmarshal(text, &e.value)
+}
+
 var (
 	Priority1 = Priority{value: 1}
 	Priority2 = Priority{value: 2}
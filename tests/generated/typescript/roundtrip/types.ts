@@ -0,0 +1,72 @@
+// Code generated by eugene. DO NOT EDIT.
+// Types of E2E Round-trip Test 1.0.0.
+
+export interface EchoPayload {
+  message: string;
+  number?: number;
+  nested?: {
+    value?: string;
+  };
+}
+
+export interface FormEchoResponse {
+  receivedField1?: string;
+  receivedField2?: number;
+  receivedTags?: string[];
+}
+
+export interface FileEchoResponse {
+  filename?: string;
+  size?: number;
+  description?: string;
+}
+
+export interface ItemWithParams {
+  id?: string;
+  filter?: string;
+  requestId?: string;
+}
+
+export interface ErrorResponse {
+  code?: string;
+  message?: string;
+}
+
+export type Status = "pending" | "active" | "completed";
+
+export interface NewResource {
+  name: string;
+  status?: Status;
+  description?: string | null;
+}
+
+export interface Resource {
+  id?: string;
+  name?: string;
+  status?: Status;
+  description?: string | null;
+}
+
+export interface SessionInfo {
+  sessionId?: string;
+  userId?: string;
+  expiresAt?: string;
+}
+
+export interface SecureData {
+  secret?: string;
+  accessLevel?: string;
+}
+
+export type Shape = Circle | Rectangle;
+
+export interface Circle {
+  type: string;
+  radius: number;
+}
+
+export interface Rectangle {
+  type: string;
+  width: number;
+  height: number;
+}
@@ -0,0 +1,381 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type Pet struct {
+	Name  string                     `json:"name" yaml:"name"`
+	Tag   *string                    `json:"tag,omitempty" yaml:"tag,omitempty"`
+	Owner PetOwner                   `json:"owner,omitempty" yaml:"owner,omitempty"`
+	Extra map[string]json.RawMessage `json:"-" yaml:"-"`
+}
+
+// MarshalJSON encodes v followed by the members kept in Extra.
+func (v Pet) MarshalJSON() ([]byte, error) {
+	type plain Pet
+	buf, err := json.Marshal(plain(v))
+	if err != nil || len(v.Extra) == 0 {
+		return buf, err
+	}
+	return appendUnknownFields(buf, v.Extra, "name", "tag", "owner")
+}
+
+// UnmarshalJSON decodes v and keeps the members it has no field for in
+// Extra.
+func (v *Pet) UnmarshalJSON(data []byte) error {
+	type plain Pet
+	if err := json.Unmarshal(data, (*plain)(v)); err != nil {
+		return err
+	}
+	var err error
+	v.Extra, err = unknownFields(data, "name", "tag", "owner")
+	return err
+}
+
+type Audit struct {
+	By    *string                    `json:"by,omitempty" yaml:"by,omitempty"`
+	Extra map[string]json.RawMessage `json:"-" yaml:"-"`
+}
+
+// MarshalJSON encodes v followed by the members kept in Extra.
+func (v Audit) MarshalJSON() ([]byte, error) {
+	type plain Audit
+	buf, err := json.Marshal(plain(v))
+	if err != nil || len(v.Extra) == 0 {
+		return buf, err
+	}
+	return appendUnknownFields(buf, v.Extra, "by")
+}
+
+// UnmarshalJSON decodes v and keeps the members it has no field for in
+// Extra.
+func (v *Audit) UnmarshalJSON(data []byte) error {
+	type plain Audit
+	if err := json.Unmarshal(data, (*plain)(v)); err != nil {
+		return err
+	}
+	var err error
+	v.Extra, err = unknownFields(data, "by")
+	return err
+}
+
+type Note struct {
+	Audit
+	Text   *string                    `json:"body,omitempty" yaml:"body,omitempty"`
+	Extra  *string                    `json:"extra,omitempty" yaml:"extra,omitempty"`
+	Extra_ map[string]json.RawMessage `json:"-" yaml:"-"`
+}
+
+// MarshalJSON encodes v followed by the members kept in Extra_.
+func (v Note) MarshalJSON() ([]byte, error) {
+	type plain Note
+	buf, err := json.Marshal(struct {
+		plain
+		MarshalJSON struct{} `json:"-"` // hides the methods of embedded types
+	}{plain: plain(v)})
+	if err != nil || len(v.Extra_) == 0 {
+		return buf, err
+	}
+	return appendUnknownFields(buf, v.Extra_, "body", "extra", "by")
+}
+
+// UnmarshalJSON decodes v and keeps the members it has no field for in
+// Extra_.
+func (v *Note) UnmarshalJSON(data []byte) error {
+	type plain Note
+	shadow := struct {
+		*plain
+		UnmarshalJSON struct{} `json:"-"` // hides the methods of embedded types
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &shadow); err != nil {
+		return err
+	}
+	var err error
+	v.Extra_, err = unknownFields(data, "body", "extra", "by")
+	return err
+}
+
+type Labels struct {
+	Team  *string                    `json:"team,omitempty" yaml:"team,omitempty"`
+	Extra map[string]json.RawMessage `json:"-" yaml:"-"`
+}
+
+// MarshalJSON encodes v followed by the members kept in Extra.
+func (v Labels) MarshalJSON() ([]byte, error) {
+	type plain Labels
+	buf, err := json.Marshal(plain(v))
+	if err != nil || len(v.Extra) == 0 {
+		return buf, err
+	}
+	return appendUnknownFields(buf, v.Extra, "team")
+}
+
+// UnmarshalJSON decodes v and keeps the members it has no field for in
+// Extra.
+func (v *Labels) UnmarshalJSON(data []byte) error {
+	type plain Labels
+	if err := json.Unmarshal(data, (*plain)(v)); err != nil {
+		return err
+	}
+	var err error
+	v.Extra, err = unknownFields(data, "team")
+	return err
+}
+
+type Cat struct {
+	Lives *int                       `json:"lives,omitempty" yaml:"lives,omitempty"`
+	Extra map[string]json.RawMessage `json:"-" yaml:"-"`
+}
+
+// MarshalJSON encodes v followed by the members kept in Extra.
+func (v Cat) MarshalJSON() ([]byte, error) {
+	type plain Cat
+	buf, err := json.Marshal(plain(v))
+	if err != nil || len(v.Extra) == 0 {
+		return buf, err
+	}
+	return appendUnknownFields(buf, v.Extra, "lives", "kind")
+}
+
+// UnmarshalJSON decodes v and keeps the members it has no field for in
+// Extra.
+func (v *Cat) UnmarshalJSON(data []byte) error {
+	type plain Cat
+	if err := json.Unmarshal(data, (*plain)(v)); err != nil {
+		return err
+	}
+	var err error
+	v.Extra, err = unknownFields(data, "lives", "kind")
+	return err
+}
+
+type Bird struct {
+	Kind     string                     `json:"kind" yaml:"kind"`
+	Wingspan *float64                   `json:"wingspan,omitempty" yaml:"wingspan,omitempty"`
+	Extra    map[string]json.RawMessage `json:"-" yaml:"-"`
+}
+
+// MarshalJSON encodes v followed by the members kept in Extra.
+func (v Bird) MarshalJSON() ([]byte, error) {
+	type plain Bird
+	buf, err := json.Marshal(plain(v))
+	if err != nil || len(v.Extra) == 0 {
+		return buf, err
+	}
+	return appendUnknownFields(buf, v.Extra, "kind", "wingspan")
+}
+
+// UnmarshalJSON decodes v and keeps the members it has no field for in
+// Extra.
+func (v *Bird) UnmarshalJSON(data []byte) error {
+	type plain Bird
+	if err := json.Unmarshal(data, (*plain)(v)); err != nil {
+		return err
+	}
+	var err error
+	v.Extra, err = unknownFields(data, "kind", "wingspan")
+	return err
+}
+
+type PetOwner struct {
+	Email *string                    `json:"email,omitempty" yaml:"email,omitempty"`
+	Extra map[string]json.RawMessage `json:"-" yaml:"-"`
+}
+
+// MarshalJSON encodes v followed by the members kept in Extra.
+func (v PetOwner) MarshalJSON() ([]byte, error) {
+	type plain PetOwner
+	buf, err := json.Marshal(plain(v))
+	if err != nil || len(v.Extra) == 0 {
+		return buf, err
+	}
+	return appendUnknownFields(buf, v.Extra, "email")
+}
+
+// UnmarshalJSON decodes v and keeps the members it has no field for in
+// Extra.
+func (v *PetOwner) UnmarshalJSON(data []byte) error {
+	type plain PetOwner
+	if err := json.Unmarshal(data, (*plain)(v)); err != nil {
+		return err
+	}
+	var err error
+	v.Extra, err = unknownFields(data, "email")
+	return err
+}
+
+type Dog struct {
+	Pet
+	Breed *string                    `json:"breed,omitempty" yaml:"breed,omitempty"`
+	Extra map[string]json.RawMessage `json:"-" yaml:"-"`
+}
+
+// MarshalJSON encodes v followed by the members kept in Extra.
+func (v Dog) MarshalJSON() ([]byte, error) {
+	type plain Dog
+	buf, err := json.Marshal(struct {
+		plain
+		MarshalJSON struct{} `json:"-"` // hides the methods of embedded types
+	}{plain: plain(v)})
+	if err != nil || len(v.Extra) == 0 {
+		return buf, err
+	}
+	return appendUnknownFields(buf, v.Extra, "breed", "name", "tag", "owner")
+}
+
+// UnmarshalJSON decodes v and keeps the members it has no field for in
+// Extra.
+func (v *Dog) UnmarshalJSON(data []byte) error {
+	type plain Dog
+	shadow := struct {
+		*plain
+		UnmarshalJSON struct{} `json:"-"` // hides the methods of embedded types
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &shadow); err != nil {
+		return err
+	}
+	var err error
+	v.Extra, err = unknownFields(data, "breed", "name", "tag", "owner")
+	return err
+}
+
+type Animal struct {
+	Type string          `json:"-"`
+	Raw  json.RawMessage `json:"-"`
+}
+
+func (u *Animal) UnmarshalJSON(data []byte) error {
+	var d struct {
+		Kind string `json:"kind"`
+	}
+	if err := json.Unmarshal(data, &d); err != nil {
+		return err
+	}
+	u.Type = d.Kind
+	u.Raw = data
+	return nil
+}
+
+func (u Animal) MarshalJSON() ([]byte, error) {
+	return u.Raw, nil
+}
+
+// AnimalVariant is the closed set of Animal variants. Each variant
+// implements it, so code can accept any of them without the wrapper.
+type AnimalVariant interface {
+	isAnimal()
+}
+
+func (Cat) isAnimal()  {}
+func (Bird) isAnimal() {}
+
+// Variant decodes u into the variant its kind names, as a pointer.
+func (u *Animal) Variant() (AnimalVariant, error) {
+	var v AnimalVariant
+	switch u.Type {
+	case "cat":
+		v = &Cat{}
+	case "bird":
+		v = &Bird{}
+	default:
+		return nil, fmt.Errorf("unknown Animal type %q", u.Type)
+	}
+	if err := json.Unmarshal(u.Raw, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (u *Animal) AsCat() (*Cat, error) {
+	if u.Type != "cat" {
+		return nil, fmt.Errorf("not a Cat, type is %s", u.Type)
+	}
+	var v Cat
+	if err := json.Unmarshal(u.Raw, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func (u *Animal) AsBird() (*Bird, error) {
+	if u.Type != "bird" {
+		return nil, fmt.Errorf("not a Bird, type is %s", u.Type)
+	}
+	var v Bird
+	if err := json.Unmarshal(u.Raw, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// unknownFields returns the members of the JSON object in data that match
+// none of the known names, or nil if there are none. Names match ignoring
+// case, as encoding/json matches them to fields.
+func unknownFields(data []byte, known ...string) (map[string]json.RawMessage, error) {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(data, &members); err != nil {
+		return nil, err
+	}
+	for name := range members {
+		if isKnownField(name, known) {
+			delete(members, name)
+		}
+	}
+	if len(members) == 0 {
+		return nil, nil
+	}
+	return members, nil
+}
+
+func isKnownField(name string, known []string) bool {
+	for _, k := range known {
+		if strings.EqualFold(k, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// unknownFieldNames returns the names in members that match none of the
+// known names, sorted.
+func unknownFieldNames(members map[string]json.RawMessage, known []string) []string {
+	var names []string
+	for name := range members {
+		if !isKnownField(name, known) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// appendUnknownFields appends the members that match none of the known
+// names to the JSON object in buf, in name order.
+func appendUnknownFields(buf []byte, members map[string]json.RawMessage, known ...string) ([]byte, error) {
+	names := unknownFieldNames(members, known)
+	if len(names) == 0 {
+		return buf, nil
+	}
+	buf = buf[:len(buf)-1]
+	for _, name := range names {
+		k, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		v, err := json.Marshal(members[name])
+		if err != nil {
+			return nil, err
+		}
+		if len(buf) > 1 {
+			buf = append(buf, ',')
+		}
+		buf = append(append(append(buf, k...), ':'), v...)
+	}
+	return append(buf, '}'), nil
+}
@@ -0,0 +1,239 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type Pet struct {
+	Name  string   `json:"name"`
+	Tag   *string  `json:"tag,omitempty"`
+	Owner PetOwner `json:"owner,omitempty"`
+}
+
+// UnmarshalJSON decodes v and rejects the members it has no field for.
+func (v *Pet) UnmarshalJSON(data []byte) error {
+	type plain Pet
+	if err := json.Unmarshal(data, (*plain)(v)); err != nil {
+		return err
+	}
+	return rejectUnknownFields("Pet", data, "name", "tag", "owner")
+}
+
+type Audit struct {
+	By *string `json:"by,omitempty"`
+}
+
+// UnmarshalJSON decodes v and rejects the members it has no field for.
+func (v *Audit) UnmarshalJSON(data []byte) error {
+	type plain Audit
+	if err := json.Unmarshal(data, (*plain)(v)); err != nil {
+		return err
+	}
+	return rejectUnknownFields("Audit", data, "by")
+}
+
+type Note struct {
+	Audit
+	Text  *string `json:"body,omitempty"`
+	Extra *string `json:"extra,omitempty"`
+}
+
+// UnmarshalJSON decodes v and rejects the members it has no field for.
+func (v *Note) UnmarshalJSON(data []byte) error {
+	type plain Note
+	shadow := struct {
+		*plain
+		UnmarshalJSON struct{} `json:"-"` // hides the methods of embedded types
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &shadow); err != nil {
+		return err
+	}
+	return rejectUnknownFields("Note", data, "body", "extra", "by")
+}
+
+type Labels struct {
+	Team *string `json:"team,omitempty"`
+}
+
+type Cat struct {
+	Lives *int `json:"lives,omitempty"`
+}
+
+// UnmarshalJSON decodes v and rejects the members it has no field for.
+func (v *Cat) UnmarshalJSON(data []byte) error {
+	type plain Cat
+	if err := json.Unmarshal(data, (*plain)(v)); err != nil {
+		return err
+	}
+	return rejectUnknownFields("Cat", data, "lives", "kind")
+}
+
+type Bird struct {
+	Kind     string   `json:"kind"`
+	Wingspan *float64 `json:"wingspan,omitempty"`
+}
+
+// UnmarshalJSON decodes v and rejects the members it has no field for.
+func (v *Bird) UnmarshalJSON(data []byte) error {
+	type plain Bird
+	if err := json.Unmarshal(data, (*plain)(v)); err != nil {
+		return err
+	}
+	return rejectUnknownFields("Bird", data, "kind", "wingspan")
+}
+
+type PetOwner struct {
+	Email *string `json:"email,omitempty"`
+}
+
+// UnmarshalJSON decodes v and rejects the members it has no field for.
+func (v *PetOwner) UnmarshalJSON(data []byte) error {
+	type plain PetOwner
+	if err := json.Unmarshal(data, (*plain)(v)); err != nil {
+		return err
+	}
+	return rejectUnknownFields("PetOwner", data, "email")
+}
+
+type Dog struct {
+	Pet
+	Breed *string `json:"breed,omitempty"`
+}
+
+// UnmarshalJSON decodes v and rejects the members it has no field for.
+func (v *Dog) UnmarshalJSON(data []byte) error {
+	type plain Dog
+	shadow := struct {
+		*plain
+		UnmarshalJSON struct{} `json:"-"` // hides the methods of embedded types
+	}{plain: (*plain)(v)}
+	if err := json.Unmarshal(data, &shadow); err != nil {
+		return err
+	}
+	return rejectUnknownFields("Dog", data, "breed", "name", "tag", "owner")
+}
+
+type Animal struct {
+	Type string          `json:"-"`
+	Raw  json.RawMessage `json:"-"`
+}
+
+func (u *Animal) UnmarshalJSON(data []byte) error {
+	var d struct {
+		Kind string `json:"kind"`
+	}
+	if err := json.Unmarshal(data, &d); err != nil {
+		return err
+	}
+	u.Type = d.Kind
+	u.Raw = data
+	return nil
+}
+
+func (u Animal) MarshalJSON() ([]byte, error) {
+	return u.Raw, nil
+}
+
+// AnimalVariant is the closed set of Animal variants. Each variant
+// implements it, so code can accept any of them without the wrapper.
+type AnimalVariant interface {
+	isAnimal()
+}
+
+func (Cat) isAnimal()  {}
+func (Bird) isAnimal() {}
+
+// Variant decodes u into the variant its kind names, as a pointer.
+func (u *Animal) Variant() (AnimalVariant, error) {
+	var v AnimalVariant
+	switch u.Type {
+	case "cat":
+		v = &Cat{}
+	case "bird":
+		v = &Bird{}
+	default:
+		return nil, fmt.Errorf("unknown Animal type %q", u.Type)
+	}
+	if err := json.Unmarshal(u.Raw, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (u *Animal) AsCat() (*Cat, error) {
+	if u.Type != "cat" {
+		return nil, fmt.Errorf("not a Cat, type is %s", u.Type)
+	}
+	var v Cat
+	if err := json.Unmarshal(u.Raw, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func (u *Animal) AsBird() (*Bird, error) {
+	if u.Type != "bird" {
+		return nil, fmt.Errorf("not a Bird, type is %s", u.Type)
+	}
+	var v Bird
+	if err := json.Unmarshal(u.Raw, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// unknownFields returns the members of the JSON object in data that match
+// none of the known names, or nil if there are none. Names match ignoring
+// case, as encoding/json matches them to fields.
+func unknownFields(data []byte, known ...string) (map[string]json.RawMessage, error) {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(data, &members); err != nil {
+		return nil, err
+	}
+	for name := range members {
+		if isKnownField(name, known) {
+			delete(members, name)
+		}
+	}
+	if len(members) == 0 {
+		return nil, nil
+	}
+	return members, nil
+}
+
+func isKnownField(name string, known []string) bool {
+	for _, k := range known {
+		if strings.EqualFold(k, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// unknownFieldNames returns the names in members that match none of the
+// known names, sorted.
+func unknownFieldNames(members map[string]json.RawMessage, known []string) []string {
+	var names []string
+	for name := range members {
+		if !isKnownField(name, known) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// rejectUnknownFields reports the first, in name order, of the members of
+// the JSON object in data that match none of the known names.
+func rejectUnknownFields(typeName string, data []byte, known ...string) error {
+	members, err := unknownFields(data, known...)
+	if err != nil || len(members) == 0 {
+		return err
+	}
+	return fmt.Errorf("unknown field %q in %s", unknownFieldNames(members, known)[0], typeName)
+}
@@ -0,0 +1,14 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package gen
+
+type Order struct {
+	ID       *string `json:"id,omitempty"`
+	Item     string  `json:"item"`
+	Quantity *int    `json:"quantity,omitempty"`
+}
+
+type Problem struct {
+	Title  *string `json:"title,omitempty"`
+	Status *int    `json:"status,omitempty"`
+}
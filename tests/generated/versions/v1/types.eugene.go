@@ -0,0 +1,90 @@
+// Code generated by eugene. DO NOT EDIT.
+
+package v1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/kolah/eugene/tests/generated/versions/common"
+)
+
+type Pet struct {
+	ID    string       `json:"id"`
+	Name  string       `json:"name"`
+	Price common.Money `json:"price"`
+	Shape Shape        `json:"shape,omitempty"`
+}
+
+type Circle struct {
+	Kind   string  `json:"kind"`
+	Radius float64 `json:"radius"`
+}
+
+type Square struct {
+	Kind string  `json:"kind"`
+	Side float64 `json:"side"`
+}
+
+type Shape struct {
+	Type string          `json:"-"`
+	Raw  json.RawMessage `json:"-"`
+}
+
+func (u *Shape) UnmarshalJSON(data []byte) error {
+	var d struct {
+		Kind string `json:"kind"`
+	}
+	if err := json.Unmarshal(data, &d); err != nil {
+		return err
+	}
+	u.Type = d.Kind
+	u.Raw = data
+	return nil
+}
+
+func (u Shape) MarshalJSON() ([]byte, error) {
+	return u.Raw, nil
+}
+
+// ShapeVariant is the closed set of Shape variants. Each variant
+// implements it, so code can accept any of them without the wrapper.
+type ShapeVariant interface {
+	isShape()
+}
+
+func (Circle) isShape() {}
+func (Square) isShape() {}
+
+// Variant decodes u into the variant its kind names, as a pointer.
+func (u *Shape) Variant() (ShapeVariant, error) {
+	var v ShapeVariant
+	switch u.Type {
+	case "Circle":
+		v = &Circle{}
+	case "Square":
+		v = &Square{}
+	default:
+		return nil, fmt.Errorf("unknown Shape type %q", u.Type)
+	}
+	if err := json.Unmarshal(u.Raw, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (u *Shape) AsCircle() (*Circle, error) {
+	var v Circle
+	if err := json.Unmarshal(u.Raw, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func (u *Shape) AsSquare() (*Square, error) {
+	var v Square
+	if err := json.Unmarshal(u.Raw, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
@@ -0,0 +1,62 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/kolah/eugene/internal/codegen"
+	"github.com/kolah/eugene/internal/config"
+	"github.com/kolah/eugene/internal/loader"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateJVM(t *testing.T) {
+	result, err := loader.LoadFile("testdata/specs/e2e/roundtrip.yaml")
+	require.NoError(t, err)
+	spec, err := loader.Transform(result)
+	require.NoError(t, err)
+
+	t.Run("kotlin", func(t *testing.T) {
+		cfg := &config.Config{
+			Language: config.LanguageJVM,
+			JVM:      config.JVMConfig{OutputDir: "generated/jvm/kotlin", Package: "com.example.roundtrip", Targets: []string{"types"}},
+		}
+		gen, err := codegen.New(cfg)
+		require.NoError(t, err)
+		outputs, err := gen.GenerateJVM(spec)
+		require.NoError(t, err)
+		writeOutputs(t, cfg.JVM.OutputDir, outputs)
+
+		require.Len(t, outputs, 1)
+		require.Equal(t, "Types.kt", outputs[0].Filename)
+		types := outputs[0].Content
+		require.Contains(t, types, "// Code generated by eugene. DO NOT EDIT.\n\npackage com.example.roundtrip\n")
+		require.Contains(t, types, "@Serializable\ndata class NewResource(\n    val name: String,\n    val status: Status? = null,\n    val description: String? = null,\n)")
+		require.Contains(t, types, "@Serializable\nenum class Status {\n    @SerialName(\"pending\")\n    PENDING,")
+		require.Contains(t, types, "data class SessionInfo(\n    val sessionId: String? = null,")
+		// Inline objects are declared after their parent
+		require.Contains(t, types, "    val nested: EchoPayloadNested? = null,")
+		require.Contains(t, types, "data class EchoPayloadNested(")
+		require.Contains(t, types, "typealias Shape = JsonElement")
+	})
+
+	t.Run("java", func(t *testing.T) {
+		cfg := &config.Config{
+			Language: config.LanguageJVM,
+			JVM:      config.JVMConfig{OutputDir: "generated/jvm/java", Package: "com.example.roundtrip", Language: "java", Targets: []string{"types"}},
+		}
+		gen, err := codegen.New(cfg)
+		require.NoError(t, err)
+		outputs, err := gen.GenerateJVM(spec)
+		require.NoError(t, err)
+		writeOutputs(t, cfg.JVM.OutputDir, outputs)
+
+		files := make(map[string]string)
+		for _, o := range outputs {
+			files[o.Filename] = o.Content
+		}
+		require.NotContains(t, files, "Shape.java", "Java has no aliases")
+		require.Contains(t, files["NewResource.java"], "package com.example.roundtrip;\n\npublic record NewResource(\n    String name,\n    Status status,\n    String description) {\n}")
+		require.Contains(t, files["Status.java"], "public enum Status {\n    @JsonProperty(\"pending\")\n    PENDING,")
+		require.Contains(t, files["FormEchoResponse.java"], "import java.util.List;")
+	})
+}
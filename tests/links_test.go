@@ -0,0 +1,64 @@
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	links "github.com/kolah/eugene/tests/generated/links"
+)
+
+func TestLinksFollow(t *testing.T) {
+	var lastQuery string
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /users", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]any{"id": 42, "name": "ada", "managerId": 7})
+	})
+	mux.HandleFunc("GET /users/{userId}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{"id": json.Number(r.PathValue("userId")), "name": "user"})
+	})
+	mux.HandleFunc("GET /users/{userId}/orders", func(w http.ResponseWriter, r *http.Request) {
+		lastQuery = r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]map[string]any{{"id": "order-" + r.PathValue("userId")}})
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	ctx := context.Background()
+	client := links.NewClient(server.URL)
+
+	created, err := client.CreateUser(ctx, links.NewUser{Name: "ada"})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, created.StatusCode)
+
+	t.Run("operationId with response body expression", func(t *testing.T) {
+		user, err := created.FollowUser(ctx, client)
+		require.NoError(t, err)
+		require.NotNil(t, user.JSON200)
+		require.Equal(t, int64(42), user.JSON200.ID)
+	})
+
+	t.Run("operationRef with constant query parameter", func(t *testing.T) {
+		orders, err := created.FollowOrders(ctx, client)
+		require.NoError(t, err)
+		require.NotNil(t, orders.JSON200)
+		require.Equal(t, "order-42", *(*orders.JSON200)[0].ID)
+		require.Equal(t, "limit=10", lastQuery)
+	})
+
+	t.Run("implicit path parameter from request", func(t *testing.T) {
+		user, err := client.GetUser(ctx, 5)
+		require.NoError(t, err)
+		orders, err := user.FollowOrders(ctx, client)
+		require.NoError(t, err)
+		require.Equal(t, "order-5", *(*orders.JSON200)[0].ID)
+	})
+}
@@ -0,0 +1,80 @@
+package tests
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/kolah/eugene/internal/codegen"
+	"github.com/kolah/eugene/internal/config"
+	"github.com/kolah/eugene/internal/loader"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateTS(t *testing.T) {
+	result, err := loader.LoadFile("testdata/specs/e2e/roundtrip.yaml")
+	require.NoError(t, err)
+	spec, err := loader.Transform(result)
+	require.NoError(t, err)
+
+	cfg := &config.Config{
+		Language: config.LanguageTS,
+		TS:       config.TSConfig{OutputDir: "generated/typescript/roundtrip", Targets: []string{"client", "types"}},
+	}
+	gen, err := codegen.New(cfg)
+	require.NoError(t, err)
+	outputs, err := gen.GenerateTS(spec)
+	require.NoError(t, err)
+	writeOutputs(t, cfg.TS.OutputDir, outputs)
+
+	files := make(map[string]string)
+	for _, o := range outputs {
+		files[o.Filename] = o.Content
+	}
+	require.Len(t, files, 2)
+
+	types := files["types.ts"]
+	require.Contains(t, types, "// Code generated by eugene. DO NOT EDIT.")
+	require.Contains(t, types, "export interface NewResource {\n  name: string;\n  status?: Status;\n  description?: string | null;\n}")
+	require.Contains(t, types, `export type Status = "pending" | "active" | "completed";`)
+	require.Contains(t, types, "export type Shape = Circle | Rectangle;")
+
+	client := files["client.ts"]
+	require.Contains(t, client, `import type * as types from "./types";`)
+	// Path parameters are substituted, query and header parameters read from params
+	require.Contains(t, client, "export interface GetItemParams {\n  id: string;\n  filter?: string;\n  \"X-Request-ID\"?: string;\n}")
+	require.Contains(t, client, "async getItem(params: GetItemParams, init?: RequestInit): Promise<types.ItemWithParams> {")
+	require.Contains(t, client, "`/items/${encodeURIComponent(String(params.id))}`")
+	require.Contains(t, client, `appendQuery(query, "filter", params.filter);`)
+	require.Contains(t, client, `headers["X-Request-ID"] = String(params["X-Request-ID"]);`)
+	// Bodies are encoded by media type
+	require.Contains(t, client, "async createResource(body: types.NewResource, init?: RequestInit): Promise<types.Resource> {")
+	require.Contains(t, client, "body: JSON.stringify(body)")
+	require.Contains(t, client, "async echoForm(body: EchoFormBody, init?: RequestInit)")
+	require.Contains(t, client, "body: formBody(body)")
+	require.Contains(t, client, "async echoMultipart(body: FormData, init?: RequestInit)")
+	require.Contains(t, client, "async deleteResource(params: DeleteResourceParams, init?: RequestInit): Promise<void> {")
+	// Cookies are left to the browser
+	require.Contains(t, client, "async getSession(init?: RequestInit): Promise<types.SessionInfo> {")
+
+	t.Run("type checks", func(t *testing.T) {
+		tsc, err := exec.LookPath("tsc")
+		if err != nil {
+			t.Skip("tsc not found on PATH (npm install -g typescript)")
+		}
+		cmd := exec.Command(tsc, "--noEmit", "--strict", "--target", "es2022", "--module", "es2022",
+			"--moduleResolution", "bundler", "--lib", "es2022,dom", "client.ts", "types.ts")
+		cmd.Dir = cfg.TS.OutputDir
+		output, err := cmd.CombinedOutput()
+		require.NoError(t, err, "generated TypeScript failed to type-check:\n%s", string(output))
+	})
+
+	t.Run("types only", func(t *testing.T) {
+		cfg := &config.Config{Language: config.LanguageTS, TS: config.TSConfig{Targets: []string{"types"}}}
+		gen, err := codegen.New(cfg)
+		require.NoError(t, err)
+		outputs, err := gen.GenerateTS(spec)
+		require.NoError(t, err)
+		require.Len(t, outputs, 1)
+		require.Equal(t, "types.ts", outputs[0].Filename)
+	})
+}